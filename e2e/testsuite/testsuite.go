@@ -711,7 +711,7 @@ func (s *E2ETestSuite) SetUpgradeTimeoutParam(ctx context.Context, chain ibc.Cha
 	s.Require().NotNil(govModuleAddress)
 
 	upgradeTimeout := channeltypes.NewTimeout(channeltypes.DefaultTimeout.Height, timeoutDelta)
-	msg := channeltypes.NewMsgUpdateChannelParams(govModuleAddress.String(), channeltypes.NewParams(upgradeTimeout))
+	msg := channeltypes.NewMsgUpdateChannelParams(govModuleAddress.String(), channeltypes.NewParams(upgradeTimeout, channeltypes.DefaultForceTimeoutThreshold))
 	s.ExecuteAndPassGovV1Proposal(ctx, msg, chain, wallet)
 }
 
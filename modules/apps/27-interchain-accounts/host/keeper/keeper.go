@@ -6,12 +6,6 @@ import (
 	"fmt"
 	"strings"
 
-	gogoproto "github.com/cosmos/gogoproto/proto"
-	"google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protoreflect"
-
-	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
-	queryv1 "cosmossdk.io/api/cosmos/query/v1"
 	corestore "cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/log"
@@ -75,7 +69,7 @@ func NewKeeper(
 		accountKeeper:  accountKeeper,
 		msgRouter:      msgRouter,
 		queryRouter:    queryRouter,
-		mqsAllowList:   newModuleQuerySafeAllowList(),
+		mqsAllowList:   icatypes.NewModuleQuerySafeAllowList(),
 		authority:      authority,
 	}
 }
@@ -272,41 +266,3 @@ func (k Keeper) SetParams(ctx context.Context, params types.Params) {
 		panic(err)
 	}
 }
-
-// newModuleQuerySafeAllowList returns a list of all query paths labeled with module_query_safe in the proto files.
-func newModuleQuerySafeAllowList() []string {
-	allowList := []string{}
-	gogoproto.GogoResolver.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
-		for i := 0; i < fd.Services().Len(); i++ {
-			// Get the service descriptor
-			sd := fd.Services().Get(i)
-
-			// Skip services that are annotated with the "cosmos.msg.v1.service" option.
-			if ext := proto.GetExtension(sd.Options(), msgv1.E_Service); ext != nil {
-				val, ok := ext.(bool)
-				if !ok {
-					panic(fmt.Errorf("cannot convert %T to %T", ext, ok))
-				}
-				if val {
-					continue
-				}
-			}
-
-			for j := 0; j < sd.Methods().Len(); j++ {
-				// Get the method descriptor
-				md := sd.Methods().Get(j)
-
-				// Skip methods that are not annotated with the "cosmos.query.v1.module_query_safe" option.
-				if ext := proto.GetExtension(md.Options(), queryv1.E_ModuleQuerySafe); ext == nil || !ext.(bool) {
-					continue
-				}
-
-				// Add the method to the whitelist
-				allowList = append(allowList, fmt.Sprintf("/%s/%s", sd.FullName(), md.Name()))
-			}
-		}
-		return true
-	})
-
-	return allowList
-}
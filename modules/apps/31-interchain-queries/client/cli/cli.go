@@ -0,0 +1,43 @@
+package cli
+
+import (
+	"github.com/spf13/cobra"
+
+	controllercli "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/client/cli"
+	hostcli "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/client/cli"
+)
+
+// GetQueryCmd returns the query commands for the interchain-queries submodule
+func GetQueryCmd() *cobra.Command {
+	icqQueryCmd := &cobra.Command{
+		Use:                        "interchain-queries",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC interchain queries query subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	icqQueryCmd.AddCommand(
+		controllercli.GetQueryCmd(),
+		hostcli.GetQueryCmd(),
+	)
+
+	return icqQueryCmd
+}
+
+// NewTxCmd returns the tx commands for the interchain-queries submodule
+func NewTxCmd() *cobra.Command {
+	icqTxCmd := &cobra.Command{
+		Use:                        "interchain-queries",
+		Aliases:                    []string{"icq"},
+		Short:                      "IBC interchain queries transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+	}
+
+	icqTxCmd.AddCommand(
+		controllercli.NewTxCmd(),
+	)
+
+	return icqTxCmd
+}
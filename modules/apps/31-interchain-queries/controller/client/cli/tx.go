@@ -0,0 +1,94 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+)
+
+const (
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagCallback               = "callback"
+	flagMemo                   = "memo"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current block timestamp of the controller chain. The default is currently set
+// to a 10 minute timeout.
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+func newSendQueryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-query [channel-id] [path/to/queries.json]",
+		Short: "Send interchain queries on the provided channel.",
+		Long: strings.TrimSpace(`Submits query requests to be executed on the host chain and attempts to send the packet.
+The query requests are provided as a CosmosQuery in json, either as a string or as a path to a file, for example:
+{"requests":[{"path":"/cosmos.bank.v1beta1.Query/Balance","data":"<base64 encoded request>"}]}
+A timeout timestamp relative to the block time of the controller chain can be provided using the flag {packet-timeout-timestamp}.
+If no timeout value is set then a default relative timeout value of 10 minutes is used.
+The responses are routed to the callbacks registered under the {callback} route, if provided.`),
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cdc := codec.NewProtoCodec(clientCtx.InterfaceRegistry)
+
+			channelID := args[0]
+			sender := clientCtx.GetFromAddress().String()
+
+			// attempt to unmarshal query requests argument
+			var cosmosQuery icqtypes.CosmosQuery
+			queriesContentOrFileName := args[1]
+			if err := cdc.UnmarshalJSON([]byte(queriesContentOrFileName), &cosmosQuery); err != nil {
+				// check for file path if JSON input is not provided
+				contents, err := os.ReadFile(queriesContentOrFileName)
+				if err != nil {
+					return fmt.Errorf("neither JSON input nor path to .json file for query requests were provided: %w", err)
+				}
+
+				if err := cdc.UnmarshalJSON(contents, &cosmosQuery); err != nil {
+					return fmt.Errorf("error unmarshalling query requests file: %w", err)
+				}
+			}
+
+			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			if err != nil {
+				return err
+			}
+
+			callback, err := cmd.Flags().GetString(flagCallback)
+			if err != nil {
+				return err
+			}
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSendQuery(sender, channelID, cosmosQuery.Requests, timeoutTimestamp, callback, memo)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from the controller chain block time. Default is 10 minutes.")
+	cmd.Flags().String(flagCallback, "", "Callback route to which the query responses are routed")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// emitCallbackEvent emits an event signalling the execution of the callback of an interchain query and
+// including the error details if any.
+func emitCallbackEvent(ctx context.Context, packet channeltypes.Packet, callback string, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icqtypes.ModuleName),
+		sdk.NewAttribute(icqtypes.AttributeKeyControllerChannelID, packet.GetSourceChannel()),
+		sdk.NewAttribute(icqtypes.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(icqtypes.AttributeKeyCallback, callback),
+	}
+
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icqtypes.AttributeKeyCallbackError, err.Error()))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			icqtypes.EventTypeCallback,
+			attributes...,
+		),
+	)
+}
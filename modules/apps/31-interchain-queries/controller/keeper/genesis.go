@@ -0,0 +1,27 @@
+package keeper
+
+import (
+	"context"
+
+	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+)
+
+// InitGenesis initializes the interchain queries controller application state from a provided genesis state
+func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.ControllerGenesisState) {
+	keeper.setPort(ctx, state.Port)
+
+	for _, entry := range state.PendingQueries {
+		keeper.SetPendingQuery(ctx, entry.ChannelId, entry.Sequence, entry.PendingQuery)
+	}
+
+	keeper.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain queries controller exported genesis
+func ExportGenesis(ctx context.Context, keeper Keeper) genesistypes.ControllerGenesisState {
+	return genesistypes.NewControllerGenesisState(
+		keeper.GetPort(ctx),
+		keeper.GetAllPendingQueries(ctx),
+		keeper.GetParams(ctx),
+	)
+}
@@ -0,0 +1,55 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestInitGenesis() {
+	suite.SetupTest()
+
+	pendingQuery := types.PendingQuery{
+		Sender:   suite.chainA.SenderAccount.GetAddress().String(),
+		Callback: mockCallbackRoute,
+		Requests: suite.balanceQueryRequests(suite.chainB),
+	}
+
+	genesisState := genesistypes.NewControllerGenesisState(
+		icqtypes.ControllerPortID,
+		[]genesistypes.PendingQueryEntry{
+			{ChannelId: ibctesting.FirstChannelID, Sequence: 1, PendingQuery: pendingQuery},
+		},
+		types.NewParams(false),
+	)
+
+	keeper.InitGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICQControllerKeeper, genesisState)
+
+	controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+	suite.Require().Equal(icqtypes.ControllerPortID, controllerKeeper.GetPort(suite.chainA.GetContext()))
+	suite.Require().Equal(types.NewParams(false), controllerKeeper.GetParams(suite.chainA.GetContext()))
+
+	storedQuery, found := controllerKeeper.GetPendingQuery(suite.chainA.GetContext(), ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(pendingQuery, storedQuery)
+}
+
+func (suite *KeeperTestSuite) TestExportGenesis() {
+	suite.SetupTest()
+
+	path := ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	packet := suite.sendQuery(path, mockCallbackRoute)
+
+	genesisState := keeper.ExportGenesis(suite.chainA.GetContext(), suite.chainA.GetSimApp().ICQControllerKeeper)
+
+	suite.Require().Equal(icqtypes.ControllerPortID, genesisState.Port)
+	suite.Require().Equal(types.DefaultParams(), genesisState.Params)
+	suite.Require().Len(genesisState.PendingQueries, 1)
+	suite.Require().Equal(path.EndpointA.ChannelID, genesisState.PendingQueries[0].ChannelId)
+	suite.Require().Equal(packet.Sequence, genesisState.PendingQueries[0].Sequence)
+	suite.Require().Equal(mockCallbackRoute, genesisState.PendingQueries[0].PendingQuery.Callback)
+}
@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// OnChanOpenInit performs basic validation of channel initialization.
+// The channel order must be UNORDERED, the counterparty port identifier must be the host chain representation
+// and the version must be the ICS31 protocol version. The default version is used if none is provided.
+func (k Keeper) OnChanOpenInit(
+	ctx context.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID string,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	version string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	if boundPort := k.GetPort(ctx); boundPort != portID {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidControllerPort, "expected %s, got %s", boundPort, portID)
+	}
+
+	if counterparty.PortId != icqtypes.HostPortID {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidHostPort, "expected %s, got %s", icqtypes.HostPortID, counterparty.PortId)
+	}
+
+	if version == "" {
+		version = icqtypes.Version
+	}
+
+	if version != icqtypes.Version {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidVersion, "expected %s, got %s", icqtypes.Version, version)
+	}
+
+	return version, nil
+}
+
+// OnChanOpenAck validates the version proposed by the host chain.
+func (Keeper) OnChanOpenAck(
+	ctx context.Context,
+	portID,
+	channelID string,
+	counterpartyVersion string,
+) error {
+	if counterpartyVersion != icqtypes.Version {
+		return errorsmod.Wrapf(icqtypes.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", icqtypes.Version, counterpartyVersion)
+	}
+
+	return nil
+}
+
+// OnChanCloseConfirm is a no-op, pending queries on the closed channel are cleared upon their timeout.
+func (Keeper) OnChanCloseConfirm(
+	ctx context.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
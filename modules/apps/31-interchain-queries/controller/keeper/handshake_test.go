@@ -0,0 +1,144 @@
+package keeper_test
+
+import (
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnChanOpenInit() {
+	var (
+		path         *ibctesting.Path
+		order        channeltypes.Order
+		counterparty channeltypes.Counterparty
+		version      string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: empty version defaults to the ICS31 version", func() {
+				version = ""
+			}, nil,
+		},
+		{
+			"invalid order - ORDERED", func() {
+				order = channeltypes.ORDERED
+			}, channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointA.ChannelConfig.PortID = ibctesting.MockPort
+			}, icqtypes.ErrInvalidControllerPort,
+		},
+		{
+			"invalid counterparty port ID", func() {
+				counterparty.PortId = ibctesting.MockPort
+			}, icqtypes.ErrInvalidHostPort,
+		},
+		{
+			"invalid version", func() {
+				version = "icq-2"
+			}, icqtypes.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			order = channeltypes.UNORDERED
+			counterparty = channeltypes.NewCounterparty(path.EndpointB.ChannelConfig.PortID, "")
+			version = icqtypes.Version
+
+			tc.malleate() // malleate mutates test data
+
+			channelVersion, err := suite.chainA.GetSimApp().ICQControllerKeeper.OnChanOpenInit(
+				suite.chainA.GetContext(), order, []string{path.EndpointA.ConnectionID},
+				path.EndpointA.ChannelConfig.PortID, ibctesting.FirstChannelID, counterparty, version,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(icqtypes.Version, channelVersion)
+			} else {
+				suite.Require().Empty(channelVersion)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnChanOpenAck() {
+	var counterpartyVersion string
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"invalid counterparty version", func() {
+				counterpartyVersion = "icq-2"
+			}, icqtypes.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			err = path.EndpointB.ChanOpenTry()
+			suite.Require().NoError(err)
+
+			counterpartyVersion = path.EndpointB.ChannelConfig.Version
+
+			tc.malleate() // malleate mutates test data
+
+			err = suite.chainA.GetSimApp().ICQControllerKeeper.OnChanOpenAck(
+				suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, counterpartyVersion,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestChannelHandshake tests that an interchain queries channel can be established between the
+// controller and host submodules.
+func (suite *KeeperTestSuite) TestChannelHandshake() {
+	path := ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	channel := path.EndpointA.GetChannel()
+	suite.Require().Equal(channeltypes.OPEN, channel.State)
+	suite.Require().Equal(channeltypes.UNORDERED, channel.Ordering)
+	suite.Require().Equal(icqtypes.Version, channel.Version)
+	suite.Require().Equal(icqtypes.HostPortID, channel.Counterparty.PortId)
+}
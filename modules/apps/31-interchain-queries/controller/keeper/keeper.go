@@ -0,0 +1,196 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// Keeper defines the IBC interchain queries controller keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper icqtypes.ChannelKeeper
+
+	callbackRouter *types.CallbackRouter
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new interchain queries controller Keeper instance
+func NewKeeper(
+	cdc codec.Codec, storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icqtypes.ChannelKeeper,
+	callbackRouter *types.CallbackRouter, authority string,
+) Keeper {
+	if callbackRouter == nil {
+		panic(errors.New("callback router must not be nil"))
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		storeService:   storeService,
+		cdc:            cdc,
+		ics4Wrapper:    ics4Wrapper,
+		channelKeeper:  channelKeeper,
+		callbackRouter: callbackRouter,
+		authority:      authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetCallbackRouter returns the router of the callbacks executed upon the acknowledgement or timeout of queries.
+func (k Keeper) GetCallbackRouter() *types.CallbackRouter {
+	return k.callbackRouter
+}
+
+// GetAuthority returns the ics31 controller submodule's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: remove after context.Context is removed from core IBC
+	return sdkCtx.Logger().With("module", fmt.Sprintf("x/%s-%s", exported.ModuleName, icqtypes.ModuleName))
+}
+
+// GetPort returns the portID for the interchain queries controller submodule.
+func (k Keeper) GetPort(ctx context.Context) string {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(icqtypes.PortKey))
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// setPort sets the portID for the interchain queries controller submodule.
+func (k Keeper) setPort(ctx context.Context, portID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set([]byte(icqtypes.PortKey), []byte(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAppVersion calls the ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx context.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// GetPendingQuery retrieves the query sent on the provided channel with the provided sequence which has not been acknowledged yet.
+func (k Keeper) GetPendingQuery(ctx context.Context, channelID string, sequence uint64) (types.PendingQuery, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PendingQueryKey(channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.PendingQuery{}, false
+	}
+
+	var pendingQuery types.PendingQuery
+	k.cdc.MustUnmarshal(bz, &pendingQuery)
+	return pendingQuery, true
+}
+
+// SetPendingQuery stores the query sent on the provided channel with the provided sequence.
+func (k Keeper) SetPendingQuery(ctx context.Context, channelID string, sequence uint64, pendingQuery types.PendingQuery) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&pendingQuery)
+	if err := store.Set(types.PendingQueryKey(channelID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// DeletePendingQuery removes the query sent on the provided channel with the provided sequence from state.
+func (k Keeper) DeletePendingQuery(ctx context.Context, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PendingQueryKey(channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPendingQueries returns all the pending queries stored, together with the channel and sequence they were sent with.
+func (k Keeper) GetAllPendingQueries(ctx context.Context) []genesistypes.PendingQueryEntry {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(types.PendingQueryKeyPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pendingQueries []genesistypes.PendingQueryEntry
+	for ; iterator.Valid(); iterator.Next() {
+		channelID, sequence, err := types.ParsePendingQueryKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+
+		var pendingQuery types.PendingQuery
+		k.cdc.MustUnmarshal(iterator.Value(), &pendingQuery)
+
+		pendingQueries = append(pendingQueries, genesistypes.PendingQueryEntry{
+			ChannelId:    channelID,
+			Sequence:     sequence,
+			PendingQuery: pendingQuery,
+		})
+	}
+
+	return pendingQueries
+}
+
+// GetParams returns the current ics31 controller submodule parameters.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("icq/controller params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the ics31 controller submodule parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,232 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channelkeeper "github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const (
+	// mockCallbackRoute defines the callback route registered for the mockCallbacks in tests
+	mockCallbackRoute = "mockcallbacks"
+	// mockCallbackGasLimit defines the gas limit of the callback route registered for the mockCallbacks in tests
+	mockCallbackGasLimit = 1_000_000
+	// balancePath defines the module query safe bank balance query path
+	balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+)
+
+// relativeTimeout defines a relative packet timeout in nanoseconds used for testing purposes
+var relativeTimeout = uint64(time.Hour.Nanoseconds())
+
+var _ types.QueryCallbacks = (*mockCallbacks)(nil)
+
+// mockCallbacks records the results of the interchain queries routed to it.
+type mockCallbacks struct {
+	responses []icqtypes.CosmosResponse
+	ackErrors []string
+	timeouts  []uint64
+
+	// err is returned by all the callbacks if set
+	err error
+	// gasConsumed is consumed by all the callbacks
+	gasConsumed uint64
+}
+
+func (m *mockCallbacks) OnQueryResponse(ctx context.Context, _ string, _ uint64, _ types.PendingQuery, response icqtypes.CosmosResponse) error {
+	m.responses = append(m.responses, response)
+	return m.result(ctx)
+}
+
+func (m *mockCallbacks) OnQueryError(ctx context.Context, _ string, _ uint64, _ types.PendingQuery, ackError string) error {
+	m.ackErrors = append(m.ackErrors, ackError)
+	return m.result(ctx)
+}
+
+func (m *mockCallbacks) OnQueryTimeout(ctx context.Context, _ string, sequence uint64, _ types.PendingQuery) error {
+	m.timeouts = append(m.timeouts, sequence)
+	return m.result(ctx)
+}
+
+// result consumes the configured amount of gas and returns the configured error.
+func (m *mockCallbacks) result(ctx context.Context) error {
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(m.gasConsumed, "mock callback")
+	return m.err
+}
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+
+	callbacks *mockCallbacks
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	suite.callbacks = &mockCallbacks{}
+	suite.chainA.GetSimApp().ICQControllerKeeper.GetCallbackRouter().AddRoute(mockCallbackRoute, suite.callbacks, mockCallbackGasLimit, suite.chainA.SenderAccount.GetAddress().String())
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// balanceQueryRequests returns the query requests for the bond denom balance of the sender account of the provided chain.
+func (suite *KeeperTestSuite) balanceQueryRequests(chain *ibctesting.TestChain) []icqtypes.QueryRequest {
+	bz, err := banktypes.NewQueryBalanceRequest(chain.SenderAccount.GetAddress(), sdk.DefaultBondDenom).Marshal()
+	suite.Require().NoError(err)
+
+	return []icqtypes.QueryRequest{{Path: balancePath, Data: bz}}
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expError      error
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				types.NewCallbackRouter(),
+				suite.chainA.GetSimApp().ICQControllerKeeper.GetAuthority(),
+			)
+		}, nil},
+		{"failure: nil callback router", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				nil,
+				suite.chainA.GetSimApp().ICQControllerKeeper.GetAuthority(),
+			)
+		}, errors.New("callback router must not be nil")},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				types.NewCallbackRouter(),
+				"", // authority
+			)
+		}, errors.New("authority must be non-empty")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.expError == nil {
+				suite.Require().NotPanics(
+					tc.instantiateFn,
+				)
+			} else {
+				suite.Require().PanicsWithError(
+					tc.expError.Error(),
+					tc.instantiateFn,
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetPort() {
+	suite.SetupTest()
+
+	port := suite.chainA.GetSimApp().ICQControllerKeeper.GetPort(suite.chainA.GetContext())
+	suite.Require().Equal(icqtypes.ControllerPortID, port)
+}
+
+func (suite *KeeperTestSuite) TestSetGetPendingQuery() {
+	suite.SetupTest()
+
+	ctx := suite.chainA.GetContext()
+	controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+
+	_, found := controllerKeeper.GetPendingQuery(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+
+	expPendingQuery := types.PendingQuery{
+		Sender:   suite.chainA.SenderAccount.GetAddress().String(),
+		Callback: mockCallbackRoute,
+		Requests: suite.balanceQueryRequests(suite.chainB),
+	}
+
+	controllerKeeper.SetPendingQuery(ctx, ibctesting.FirstChannelID, 1, expPendingQuery)
+	controllerKeeper.SetPendingQuery(ctx, ibctesting.FirstChannelID, 2, expPendingQuery)
+
+	pendingQuery, found := controllerKeeper.GetPendingQuery(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().True(found)
+	suite.Require().Equal(expPendingQuery, pendingQuery)
+
+	entries := controllerKeeper.GetAllPendingQueries(ctx)
+	suite.Require().Len(entries, 2)
+	suite.Require().Equal(ibctesting.FirstChannelID, entries[0].ChannelId)
+	suite.Require().Equal(uint64(1), entries[0].Sequence)
+	suite.Require().Equal(uint64(2), entries[1].Sequence)
+
+	controllerKeeper.DeletePendingQuery(ctx, ibctesting.FirstChannelID, 1)
+
+	_, found = controllerKeeper.GetPendingQuery(ctx, ibctesting.FirstChannelID, 1)
+	suite.Require().False(found)
+	suite.Require().Len(controllerKeeper.GetAllPendingQueries(ctx), 1)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainA.GetSimApp().ICQControllerKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams.ControllerEnabled = false
+	suite.chainA.GetSimApp().ICQControllerKeeper.SetParams(suite.chainA.GetContext(), expParams)
+	params = suite.chainA.GetSimApp().ICQControllerKeeper.GetParams(suite.chainA.GetContext())
+	suite.Require().Equal(expParams, params)
+}
+
+func (suite *KeeperTestSuite) TestUnsetParams() {
+	suite.SetupTest()
+	ctx := suite.chainA.GetContext()
+	store := suite.chainA.GetContext().KVStore(suite.chainA.GetSimApp().GetKey(types.StoreKey))
+	store.Delete([]byte(types.ParamsKey))
+
+	suite.Require().Panics(func() {
+		suite.chainA.GetSimApp().ICQControllerKeeper.GetParams(ctx)
+	})
+}
+
+func (suite *KeeperTestSuite) TestWithICS4Wrapper() {
+	suite.SetupTest()
+
+	// test if the ics4 wrapper is the channel keeper initially
+	ics4Wrapper := suite.chainA.GetSimApp().ICQControllerKeeper.GetICS4Wrapper()
+	suite.Require().IsType((*channelkeeper.Keeper)(nil), ics4Wrapper)
+}
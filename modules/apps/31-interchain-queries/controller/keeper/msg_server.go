@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ types.MsgServer = (*msgServer)(nil)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the ICS31 controller MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+// SendQuery defines a rpc handler for MsgSendQuery
+func (s msgServer) SendQuery(goCtx context.Context, msg *types.MsgSendQuery) (*types.MsgSendQueryResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// the absolute timeout value is calculated using the controller chain block time + the relative timeout value
+	// this assumes time synchrony to a certain degree between the controller and counterparty host chain
+	absoluteTimeout := uint64(ctx.BlockTime().UnixNano()) + msg.RelativeTimeout
+	seq, err := s.Keeper.SendQuery(ctx, msg.Sender, msg.ChannelId, msg.Requests, absoluteTimeout, msg.Callback, msg.Memo)
+	if err != nil {
+		return nil, err
+	}
+
+	s.Logger(ctx).Info("successfully sent interchain query", "channel-id", msg.ChannelId, "sequence", seq)
+
+	return &types.MsgSendQueryResponse{Sequence: seq}, nil
+}
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the icq/controller submodule's parameters.
+func (s msgServer) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if s.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", s.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	s.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
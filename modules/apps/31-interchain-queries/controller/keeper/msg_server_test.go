@@ -0,0 +1,104 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgSendQuery() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgSendQuery
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"channel not found", func() {
+				msg.ChannelId = ibctesting.InvalidID
+			}, channeltypes.ErrChannelNotFound,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICQControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, types.ErrControllerSubModuleDisabled,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = types.NewMsgSendQuery(
+				suite.chainA.SenderAccount.GetAddress().String(), path.EndpointA.ChannelID,
+				suite.balanceQueryRequests(suite.chainB), relativeTimeout, mockCallbackRoute, "",
+			)
+
+			tc.malleate() // malleate mutates test data
+
+			controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+			res, err := keeper.NewMsgServerImpl(&controllerKeeper).SendQuery(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(uint64(1), res.Sequence)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	signer := suite.chainA.GetSimApp().ICQControllerKeeper.GetAuthority()
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgUpdateParams
+		expError error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(signer, types.DefaultParams()),
+			nil,
+		},
+		{
+			"invalid signer address",
+			types.NewMsgUpdateParams("signer", types.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			controllerKeeper := suite.chainA.GetSimApp().ICQControllerKeeper
+			_, err := keeper.NewMsgServerImpl(&controllerKeeper).UpdateParams(suite.chainA.GetContext(), tc.msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
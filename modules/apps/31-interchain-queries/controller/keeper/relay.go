@@ -0,0 +1,186 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// SendQuery sends the provided query requests on the given channel to be executed on the host chain. The query is
+// stored as pending until the packet is acknowledged or times out, at which point the callbacks registered for the
+// provided callback route, if any, are executed. The packet sequence of the outgoing packet is returned.
+func (k Keeper) SendQuery(
+	ctx context.Context,
+	sender string,
+	channelID string,
+	requests []icqtypes.QueryRequest,
+	timeoutTimestamp uint64,
+	callback string,
+	memo string,
+) (uint64, error) {
+	if !k.GetParams(ctx).ControllerEnabled {
+		return 0, types.ErrControllerSubModuleDisabled
+	}
+
+	portID := k.GetPort(ctx)
+	if _, found := k.channelKeeper.GetChannel(ctx, portID, channelID); !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if callback != "" {
+		route, found := k.callbackRouter.GetRoute(callback)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrCallbackRouteNotFound, "callback route %s", callback)
+		}
+
+		if !route.IsAuthorizedSender(sender) {
+			return 0, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "sender %s is not authorized for callback route %s", sender, callback)
+		}
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	if uint64(sdkCtx.BlockTime().UnixNano()) >= timeoutTimestamp {
+		return 0, errorsmod.Wrapf(channeltypes.ErrTimeoutElapsed, "timeout timestamp %d has already passed", timeoutTimestamp)
+	}
+
+	if err := icqtypes.ValidateQueryRequests(requests); err != nil {
+		return 0, err
+	}
+
+	data, err := icqtypes.SerializeCosmosQuery(k.cdc, requests)
+	if err != nil {
+		return 0, err
+	}
+
+	packetData := icqtypes.NewInterchainQueryPacketData(data, memo)
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrap(err, "invalid interchain query packet data")
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, portID, channelID, clienttypes.ZeroHeight(), timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	k.SetPendingQuery(ctx, channelID, sequence, types.PendingQuery{
+		Sender:   sender,
+		Callback: callback,
+		Requests: requests,
+	})
+
+	return sequence, nil
+}
+
+// OnAcknowledgementPacket removes the pending query sent with the packet and routes the responses of the
+// query, or the error returned by the host chain, to the callbacks of the query.
+func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	pendingQuery, found := k.GetPendingQuery(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPendingQueryNotFound, "channel ID (%s) sequence (%d)", packet.SourceChannel, packet.Sequence)
+	}
+
+	k.DeletePendingQuery(ctx, packet.SourceChannel, packet.Sequence)
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		var queryAck icqtypes.InterchainQueryPacketAck
+		if err := queryAck.UnmarshalJSON(resp.Result); err != nil {
+			return errorsmod.Wrapf(icqtypes.ErrInvalidAcknowledgement, "cannot unmarshal ICS-31 interchain query packet acknowledgement: %v", err)
+		}
+
+		response, err := icqtypes.DeserializeCosmosResponse(k.cdc, queryAck.Data)
+		if err != nil {
+			return err
+		}
+
+		k.executeCallback(ctx, packet, pendingQuery, func(cbs types.QueryCallbacks, cacheCtx sdk.Context) error {
+			return cbs.OnQueryResponse(cacheCtx, packet.SourceChannel, packet.Sequence, pendingQuery, response)
+		})
+	case *channeltypes.Acknowledgement_Error:
+		k.executeCallback(ctx, packet, pendingQuery, func(cbs types.QueryCallbacks, cacheCtx sdk.Context) error {
+			return cbs.OnQueryError(cacheCtx, packet.SourceChannel, packet.Sequence, pendingQuery, resp.Error)
+		})
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
+	}
+
+	return nil
+}
+
+// OnTimeoutPacket removes the pending query sent with the packet and executes the timeout callback of the query.
+func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet) error {
+	pendingQuery, found := k.GetPendingQuery(ctx, packet.SourceChannel, packet.Sequence)
+	if !found {
+		return errorsmod.Wrapf(types.ErrPendingQueryNotFound, "channel ID (%s) sequence (%d)", packet.SourceChannel, packet.Sequence)
+	}
+
+	k.DeletePendingQuery(ctx, packet.SourceChannel, packet.Sequence)
+
+	k.executeCallback(ctx, packet, pendingQuery, func(cbs types.QueryCallbacks, cacheCtx sdk.Context) error {
+		return cbs.OnQueryTimeout(cacheCtx, packet.SourceChannel, packet.Sequence, pendingQuery)
+	})
+
+	return nil
+}
+
+// executeCallback executes the provided callback function against the callbacks registered for the route of the
+// pending query, if any. The outcome of the callback is emitted as an event.
+func (k Keeper) executeCallback(ctx context.Context, packet channeltypes.Packet, pendingQuery types.PendingQuery, callbackFn func(types.QueryCallbacks, sdk.Context) error) {
+	if pendingQuery.Callback == "" {
+		return
+	}
+
+	route, found := k.callbackRouter.GetRoute(pendingQuery.Callback)
+	if !found {
+		err := errorsmod.Wrapf(types.ErrCallbackRouteNotFound, "callback route %s", pendingQuery.Callback)
+		k.Logger(ctx).Error("failed to execute interchain query callback", "error", err.Error())
+		emitCallbackEvent(ctx, packet, pendingQuery.Callback, err)
+		return
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	err := processCallback(sdkCtx, route, callbackFn)
+	if err != nil {
+		k.Logger(ctx).Error("interchain query callback failed", "callback", pendingQuery.Callback, "sequence", packet.Sequence, "error", err.Error())
+	}
+
+	emitCallbackEvent(ctx, packet, pendingQuery.Callback, err)
+}
+
+// processCallback executes the callback function in a cached context whose gas meter is limited to the gas limit
+// of the callback route, and consumes the gas used by the callback on the provided context. The state changes of
+// the callback are only written if it succeeds. A panic of the callback, including running out of gas, is recovered
+// and returned as an error, so that it does not fail the acknowledgement or timeout of the query packet.
+func processCallback(ctx sdk.Context, route types.CallbackRoute, callbackFn func(types.QueryCallbacks, sdk.Context) error) (err error) {
+	cacheCtx, writeCache := ctx.CacheContext()
+	cacheCtx = cacheCtx.WithGasMeter(storetypes.NewGasMeter(route.GasLimit))
+
+	defer func() {
+		// consume the minimum of the gas consumed by the callback and its gas limit
+		ctx.GasMeter().ConsumeGas(cacheCtx.GasMeter().GasConsumedToLimit(), "ics31 query callback")
+
+		if r := recover(); r != nil {
+			err = errorsmod.Wrapf(types.ErrCallbackPanic, "ics31 query callback panicked with: %v", r)
+		}
+
+		if cacheCtx.GasMeter().IsPastLimit() {
+			err = errorsmod.Wrapf(types.ErrCallbackOutOfGas, "ics31 query callback out of gas; gas limit: %d", route.GasLimit)
+		}
+	}()
+
+	err = callbackFn(route.Callbacks, cacheCtx)
+	if err == nil && !cacheCtx.GasMeter().IsPastLimit() {
+		writeCache()
+	}
+
+	return err
+}
@@ -0,0 +1,236 @@
+package keeper_test
+
+import (
+	"errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestSendQuery() {
+	var (
+		path             *ibctesting.Path
+		requests         []icqtypes.QueryRequest
+		timeoutTimestamp uint64
+		callback         string
+		sender           string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: no callback", func() {
+				callback = ""
+			}, nil,
+		},
+		{
+			"controller submodule disabled", func() {
+				suite.chainA.GetSimApp().ICQControllerKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false))
+			}, types.ErrControllerSubModuleDisabled,
+		},
+		{
+			"channel not found", func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			}, channeltypes.ErrChannelNotFound,
+		},
+		{
+			"callback route not found", func() {
+				callback = "unknown"
+			}, types.ErrCallbackRouteNotFound,
+		},
+		{
+			"sender not authorized for callback route", func() {
+				sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"timeout timestamp has elapsed", func() {
+				timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano())
+			}, channeltypes.ErrTimeoutElapsed,
+		},
+		{
+			"empty query requests", func() {
+				requests = nil
+			}, icqtypes.ErrInvalidQueryRequest,
+		},
+		{
+			"query request with empty path", func() {
+				requests = []icqtypes.QueryRequest{{Path: " "}}
+			}, icqtypes.ErrInvalidQueryRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			requests = suite.balanceQueryRequests(suite.chainB)
+			timeoutTimestamp = uint64(suite.chainA.GetContext().BlockTime().UnixNano()) + relativeTimeout
+			callback = mockCallbackRoute
+			sender = suite.chainA.SenderAccount.GetAddress().String()
+
+			tc.malleate() // malleate mutates test data
+
+			sequence, err := suite.chainA.GetSimApp().ICQControllerKeeper.SendQuery(
+				suite.chainA.GetContext(), sender, path.EndpointA.ChannelID, requests, timeoutTimestamp, callback, "",
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(1), sequence)
+
+				pendingQuery, found := suite.chainA.GetSimApp().ICQControllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, sequence)
+				suite.Require().True(found)
+				suite.Require().Equal(types.PendingQuery{Sender: sender, Callback: callback, Requests: requests}, pendingQuery)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				suite.Require().Zero(sequence)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name      string
+		malleate  func()
+		expResult func()
+	}{
+		{
+			"success: query responses are routed to the callbacks",
+			func() {},
+			func() {
+				suite.Require().Len(suite.callbacks.responses, 1)
+				suite.Require().Empty(suite.callbacks.ackErrors)
+
+				response := suite.callbacks.responses[0]
+				suite.Require().Len(response.Responses, 1)
+
+				var balanceResponse banktypes.QueryBalanceResponse
+				suite.Require().NoError(balanceResponse.Unmarshal(response.Responses[0]))
+
+				expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(expBalance, *balanceResponse.Balance)
+			},
+		},
+		{
+			"success: error acknowledgement is routed to the callbacks",
+			func() {
+				hostParams := hosttypes.NewParams(true, []string{"/cosmos.staking.v1beta1.Query/Params"})
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), hostParams)
+			},
+			func() {
+				suite.Require().Empty(suite.callbacks.responses)
+				suite.Require().Len(suite.callbacks.ackErrors, 1)
+			},
+		},
+		{
+			"success: failed callback does not fail the acknowledgement",
+			func() {
+				suite.callbacks.err = errors.New("callback failed")
+			},
+			func() {
+				suite.Require().Len(suite.callbacks.responses, 1)
+			},
+		},
+		{
+			"success: callback out of gas does not fail the acknowledgement",
+			func() {
+				suite.callbacks.gasConsumed = mockCallbackGasLimit + 1
+			},
+			func() {
+				suite.Require().Len(suite.callbacks.responses, 1)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate() // malleate mutates test data
+
+			packet := suite.sendQuery(path, mockCallbackRoute)
+
+			err := path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			_, found := suite.chainA.GetSimApp().ICQControllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.Sequence)
+			suite.Require().False(found)
+
+			tc.expResult()
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacketPendingQueryNotFound() {
+	path := ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	packet := suite.sendQuery(path, mockCallbackRoute)
+	suite.chainA.GetSimApp().ICQControllerKeeper.DeletePendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.Sequence)
+
+	ack := channeltypes.NewResultAcknowledgement(icqtypes.InterchainQueryPacketAck{}.GetBytes())
+	err := suite.chainA.GetSimApp().ICQControllerKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, ack)
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrPendingQueryNotFound)
+}
+
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	packet := suite.sendQuery(path, mockCallbackRoute)
+
+	err := suite.chainA.GetSimApp().ICQControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	suite.Require().NoError(err)
+
+	suite.Require().Equal([]uint64{packet.Sequence}, suite.callbacks.timeouts)
+
+	_, found := suite.chainA.GetSimApp().ICQControllerKeeper.GetPendingQuery(suite.chainA.GetContext(), path.EndpointA.ChannelID, packet.Sequence)
+	suite.Require().False(found)
+
+	// the pending query is removed so the packet cannot be timed out twice
+	err = suite.chainA.GetSimApp().ICQControllerKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet)
+	ibctesting.RequireErrorIsOrContains(suite.T(), err, types.ErrPendingQueryNotFound)
+}
+
+// sendQuery sends a bank balance query for the sender account of chainB on the provided path and returns the packet.
+func (suite *KeeperTestSuite) sendQuery(path *ibctesting.Path, callback string) channeltypes.Packet {
+	msg := types.NewMsgSendQuery(
+		suite.chainA.SenderAccount.GetAddress().String(), path.EndpointA.ChannelID,
+		suite.balanceQueryRequests(suite.chainB), relativeTimeout, callback, "",
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	return packet
+}
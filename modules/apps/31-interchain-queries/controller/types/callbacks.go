@@ -0,0 +1,92 @@
+package types
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+)
+
+// QueryCallbacks defines the callbacks which are executed on the controller chain once the result
+// of an interchain query is known. Modules register their callbacks with the CallbackRouter and
+// queries are routed to them using the callback route specified when sending the query.
+//
+// The state changes of a callback are discarded if it returns an error. An error returned by a callback
+// does not affect the processing of the acknowledgement or timeout of the query packet.
+type QueryCallbacks interface {
+	// OnQueryResponse is executed with the responses of the query requests if they were executed successfully on the host chain.
+	OnQueryResponse(ctx context.Context, channelID string, sequence uint64, query PendingQuery, response icqtypes.CosmosResponse) error
+	// OnQueryError is executed with the error acknowledgement if the query requests failed on the host chain.
+	OnQueryError(ctx context.Context, channelID string, sequence uint64, query PendingQuery, ackError string) error
+	// OnQueryTimeout is executed if the query packet timed out.
+	OnQueryTimeout(ctx context.Context, channelID string, sequence uint64, query PendingQuery) error
+}
+
+// CallbackRoute defines the QueryCallbacks registered for a route, along with the maximum amount of gas
+// their execution may consume and the senders authorized to route the results of their queries to them.
+type CallbackRoute struct {
+	Callbacks         QueryCallbacks
+	GasLimit          uint64
+	AuthorizedSenders []string
+}
+
+// IsAuthorizedSender returns true if the sender is authorized to route the results of its queries to the
+// callbacks of the route.
+func (r CallbackRoute) IsAuthorizedSender(sender string) bool {
+	return slices.Contains(r.AuthorizedSenders, sender)
+}
+
+// CallbackRouter is a map from callback route to the QueryCallbacks registered for it.
+type CallbackRouter struct {
+	routes map[string]CallbackRoute
+}
+
+// NewCallbackRouter creates and returns a new empty CallbackRouter.
+func NewCallbackRouter() *CallbackRouter {
+	return &CallbackRouter{
+		routes: make(map[string]CallbackRoute),
+	}
+}
+
+// AddRoute adds the QueryCallbacks for a given route, along with the maximum amount of gas their execution
+// may consume and the senders, typically the module accounts of the querying modules, authorized to route
+// the results of their queries to them. It returns the CallbackRouter so AddRoute calls can be linked. It will
+// panic if the route is not alphanumeric or already registered, or if the gas limit or the authorized senders
+// are empty.
+func (rtr *CallbackRouter) AddRoute(route string, cbs QueryCallbacks, gasLimit uint64, authorizedSenders ...string) *CallbackRouter {
+	if !sdk.IsAlphaNumeric(route) {
+		panic(errors.New("route expressions can only contain alphanumeric characters"))
+	}
+	if rtr.HasRoute(route) {
+		panic(fmt.Errorf("route %s has already been registered", route))
+	}
+	if gasLimit == 0 {
+		panic(fmt.Errorf("gas limit of route %s must be greater than zero", route))
+	}
+	if len(authorizedSenders) == 0 {
+		panic(fmt.Errorf("authorized senders of route %s must not be empty", route))
+	}
+
+	rtr.routes[route] = CallbackRoute{
+		Callbacks:         cbs,
+		GasLimit:          gasLimit,
+		AuthorizedSenders: authorizedSenders,
+	}
+	return rtr
+}
+
+// HasRoute returns true if the CallbackRouter has callbacks registered for the route or false otherwise.
+func (rtr *CallbackRouter) HasRoute(route string) bool {
+	_, ok := rtr.routes[route]
+	return ok
+}
+
+// GetRoute returns the CallbackRoute registered for the route.
+func (rtr *CallbackRouter) GetRoute(route string) (CallbackRoute, bool) {
+	cbs, ok := rtr.routes[route]
+	return cbs, ok
+}
@@ -0,0 +1,14 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ICQ Controller sentinel errors
+var (
+	ErrControllerSubModuleDisabled = errorsmod.Register(SubModuleName, 2, "controller submodule is disabled")
+	ErrCallbackRouteNotFound       = errorsmod.Register(SubModuleName, 3, "callback route not found")
+	ErrPendingQueryNotFound        = errorsmod.Register(SubModuleName, 4, "pending query not found")
+	ErrCallbackOutOfGas            = errorsmod.Register(SubModuleName, 5, "callback out of gas")
+	ErrCallbackPanic               = errorsmod.Register(SubModuleName, 6, "callback panic")
+)
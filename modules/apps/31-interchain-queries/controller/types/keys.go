@@ -0,0 +1,45 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+const (
+	// SubModuleName defines the interchain queries controller module name
+	SubModuleName = "icqcontroller"
+
+	// StoreKey is the store key string for the interchain queries controller module
+	StoreKey = SubModuleName
+
+	// ParamsKey is the store key for the interchain queries controller parameters
+	ParamsKey = "params"
+
+	// PendingQueryKeyPrefix defines the key prefix used to store pending queries
+	PendingQueryKeyPrefix = "pendingQuery"
+)
+
+// PendingQueryKey returns the store key for the pending query sent on the provided channel with the provided sequence
+func PendingQueryKey(channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%d", PendingQueryKeyPrefix, channelID, sequence))
+}
+
+// ParsePendingQueryKey parses the channel identifier and sequence from a pending query store key.
+func ParsePendingQueryKey(key []byte) (string, uint64, error) {
+	split := strings.Split(string(key), "/")
+	if len(split) != 3 || split[0] != PendingQueryKeyPrefix {
+		return "", 0, errorsmod.Wrapf(host.ErrInvalidPath, "key is not a valid pending query key: %s", key)
+	}
+
+	sequence, err := strconv.ParseUint(split[2], 10, 64)
+	if err != nil {
+		return "", 0, errorsmod.Wrapf(host.ErrInvalidPath, "invalid sequence in pending query key %s: %v", key, err)
+	}
+
+	return split[1], sequence, nil
+}
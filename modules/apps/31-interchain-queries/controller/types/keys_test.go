@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestPendingQueryKey(t *testing.T) {
+	key := types.PendingQueryKey(ibctesting.FirstChannelID, 1)
+	require.Equal(t, "pendingQuery/channel-0/1", string(key))
+}
+
+func TestParsePendingQueryKey(t *testing.T) {
+	testCases := []struct {
+		name        string
+		key         []byte
+		expChannel  string
+		expSequence uint64
+		expErr      error
+	}{
+		{
+			"success",
+			types.PendingQueryKey(ibctesting.FirstChannelID, 10),
+			ibctesting.FirstChannelID,
+			10,
+			nil,
+		},
+		{
+			"failure: invalid prefix",
+			[]byte("pending/channel-0/1"),
+			"",
+			0,
+			host.ErrInvalidPath,
+		},
+		{
+			"failure: missing sequence",
+			[]byte("pendingQuery/channel-0"),
+			"",
+			0,
+			host.ErrInvalidPath,
+		},
+		{
+			"failure: invalid sequence",
+			[]byte("pendingQuery/channel-0/sequence"),
+			"",
+			0,
+			host.ErrInvalidPath,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			channelID, sequence, err := types.ParsePendingQueryKey(tc.key)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+				require.Equal(t, tc.expChannel, channelID)
+				require.Equal(t, tc.expSequence, sequence)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
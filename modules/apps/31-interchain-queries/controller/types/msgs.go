@@ -0,0 +1,79 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var (
+	_ sdk.Msg = (*MsgSendQuery)(nil)
+	_ sdk.Msg = (*MsgUpdateParams)(nil)
+
+	_ sdk.HasValidateBasic = (*MsgSendQuery)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
+)
+
+// NewMsgSendQuery creates a new instance of MsgSendQuery
+func NewMsgSendQuery(sender, channelID string, requests []icqtypes.QueryRequest, relativeTimeoutTimestamp uint64, callback, memo string) *MsgSendQuery {
+	return &MsgSendQuery{
+		Sender:          sender,
+		ChannelId:       channelID,
+		Requests:        requests,
+		RelativeTimeout: relativeTimeoutTimestamp,
+		Callback:        callback,
+		Memo:            memo,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgSendQuery) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.ChannelIdentifierValidator(msg.ChannelId); err != nil {
+		return errorsmod.Wrap(err, "invalid channel ID")
+	}
+
+	if err := icqtypes.ValidateQueryRequests(msg.Requests); err != nil {
+		return err
+	}
+
+	if msg.RelativeTimeout == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "relative timeout cannot be zero")
+	}
+
+	if msg.Callback != "" && !sdk.IsAlphaNumeric(msg.Callback) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "callback route %s must only contain alphanumeric characters", msg.Callback)
+	}
+
+	if len(msg.Memo) > icqtypes.MaxMemoCharLength {
+		return errorsmod.Wrapf(icqtypes.ErrInvalidOutgoingData, "memo cannot be greater than %d characters", icqtypes.MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// NewMsgUpdateParams creates a new MsgUpdateParams instance
+func NewMsgUpdateParams(signer string, params Params) *MsgUpdateParams {
+	return &MsgUpdateParams{
+		Signer: signer,
+		Params: params,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgUpdateParams) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,136 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const sender = "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs"
+
+func TestMsgSendQueryValidateBasic(t *testing.T) {
+	var msg *types.MsgSendQuery
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: no callback",
+			func() {
+				msg.Callback = ""
+			},
+			nil,
+		},
+		{
+			"failure: invalid sender address",
+			func() {
+				msg.Sender = "invalid-address"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				msg.ChannelId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: empty query requests",
+			func() {
+				msg.Requests = nil
+			},
+			icqtypes.ErrInvalidQueryRequest,
+		},
+		{
+			"failure: zero relative timeout",
+			func() {
+				msg.RelativeTimeout = 0
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: callback route is not alphanumeric",
+			func() {
+				msg.Callback = "mock-callbacks"
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"failure: memo too long",
+			func() {
+				msg.Memo = strings.Repeat("a", icqtypes.MaxMemoCharLength+1)
+			},
+			icqtypes.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			msg = types.NewMsgSendQuery(
+				sender, ibctesting.FirstChannelID,
+				[]icqtypes.QueryRequest{{Path: "/cosmos.bank.v1beta1.Query/Balance"}},
+				1000, "mockcallbacks", "",
+			)
+
+			tc.malleate()
+
+			err := msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgUpdateParams(sender, types.DefaultParams()),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams("signer", types.DefaultParams()),
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,10 @@
+/*
+Package icq implements the packet data structure, state machine handling logic,
+and encoding details for asynchronous interchain queries over an IBC channel
+between separate chains. The host chain executes allow-listed, module query safe
+gRPC queries and returns their responses in the packet acknowledgement, which the
+controller chain routes to the callbacks registered by the querying module.
+This implementation is based off the ICS 31 specification
+(https://github.com/cosmos/ibc/tree/main/spec/app/ics-031-crosschain-queries)
+*/
+package icq
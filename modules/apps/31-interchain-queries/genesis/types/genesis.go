@@ -0,0 +1,103 @@
+package types
+
+import (
+	"fmt"
+
+	controllertypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// DefaultGenesis creates and returns the interchain queries GenesisState
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: DefaultControllerGenesis(),
+		HostGenesisState:       DefaultHostGenesis(),
+	}
+}
+
+// NewGenesisState creates and returns a new GenesisState instance from the provided controller and host genesis state types
+func NewGenesisState(controllerGenesisState ControllerGenesisState, hostGenesisState HostGenesisState) *GenesisState {
+	return &GenesisState{
+		ControllerGenesisState: controllerGenesisState,
+		HostGenesisState:       hostGenesisState,
+	}
+}
+
+// Validate performs basic validation of the interchain queries GenesisState
+func (gs GenesisState) Validate() error {
+	if err := gs.ControllerGenesisState.Validate(); err != nil {
+		return err
+	}
+
+	return gs.HostGenesisState.Validate()
+}
+
+// DefaultControllerGenesis creates and returns the default interchain queries ControllerGenesisState
+func DefaultControllerGenesis() ControllerGenesisState {
+	return ControllerGenesisState{
+		Port:   icqtypes.ControllerPortID,
+		Params: controllertypes.DefaultParams(),
+	}
+}
+
+// NewControllerGenesisState creates a returns a new ControllerGenesisState instance
+func NewControllerGenesisState(port string, pendingQueries []PendingQueryEntry, controllerParams controllertypes.Params) ControllerGenesisState {
+	return ControllerGenesisState{
+		Port:           port,
+		PendingQueries: pendingQueries,
+		Params:         controllerParams,
+	}
+}
+
+// Validate performs basic validation of the ControllerGenesisState
+func (gs ControllerGenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+
+	seenQueries := make(map[string]bool)
+	for _, entry := range gs.PendingQueries {
+		if err := host.ChannelIdentifierValidator(entry.ChannelId); err != nil {
+			return err
+		}
+
+		key := string(controllertypes.PendingQueryKey(entry.ChannelId, entry.Sequence))
+		if seenQueries[key] {
+			return fmt.Errorf("duplicate pending query for channel %s and sequence %d", entry.ChannelId, entry.Sequence)
+		}
+		seenQueries[key] = true
+
+		if err := icqtypes.ValidateQueryRequests(entry.PendingQuery.Requests); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// DefaultHostGenesis creates and returns the default interchain queries HostGenesisState
+func DefaultHostGenesis() HostGenesisState {
+	return HostGenesisState{
+		Port:   icqtypes.HostPortID,
+		Params: hosttypes.DefaultParams(),
+	}
+}
+
+// NewHostGenesisState creates and returns a new HostGenesisState instance
+func NewHostGenesisState(port string, hostParams hosttypes.Params) HostGenesisState {
+	return HostGenesisState{
+		Port:   port,
+		Params: hostParams,
+	}
+}
+
+// Validate performs basic validation of the HostGenesisState
+func (gs HostGenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.Port); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
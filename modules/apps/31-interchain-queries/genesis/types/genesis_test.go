@@ -0,0 +1,111 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	controllertypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestValidateGenesisState(t *testing.T) {
+	var genesisState *types.GenesisState
+
+	pendingQuery := controllertypes.PendingQuery{
+		Sender:   "cosmos17dtl0mjt3t77kpuhg2edqzjpszulwhgzuj9ljs",
+		Callback: "mockcallbacks",
+		Requests: []icqtypes.QueryRequest{{Path: "/cosmos.bank.v1beta1.Query/Balance"}},
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   bool
+	}{
+		{
+			"success: default genesis",
+			func() {},
+			false,
+		},
+		{
+			"success: with pending queries",
+			func() {
+				genesisState.ControllerGenesisState.PendingQueries = []types.PendingQueryEntry{
+					{ChannelId: ibctesting.FirstChannelID, Sequence: 1, PendingQuery: pendingQuery},
+					{ChannelId: ibctesting.FirstChannelID, Sequence: 2, PendingQuery: pendingQuery},
+				}
+			},
+			false,
+		},
+		{
+			"failure: invalid controller port",
+			func() {
+				genesisState.ControllerGenesisState.Port = ""
+			},
+			true,
+		},
+		{
+			"failure: invalid pending query channel ID",
+			func() {
+				genesisState.ControllerGenesisState.PendingQueries = []types.PendingQueryEntry{
+					{ChannelId: "", Sequence: 1, PendingQuery: pendingQuery},
+				}
+			},
+			true,
+		},
+		{
+			"failure: duplicate pending queries",
+			func() {
+				genesisState.ControllerGenesisState.PendingQueries = []types.PendingQueryEntry{
+					{ChannelId: ibctesting.FirstChannelID, Sequence: 1, PendingQuery: pendingQuery},
+					{ChannelId: ibctesting.FirstChannelID, Sequence: 1, PendingQuery: pendingQuery},
+				}
+			},
+			true,
+		},
+		{
+			"failure: pending query without requests",
+			func() {
+				genesisState.ControllerGenesisState.PendingQueries = []types.PendingQueryEntry{
+					{ChannelId: ibctesting.FirstChannelID, Sequence: 1, PendingQuery: controllertypes.PendingQuery{}},
+				}
+			},
+			true,
+		},
+		{
+			"failure: invalid host port",
+			func() {
+				genesisState.HostGenesisState.Port = ""
+			},
+			true,
+		},
+		{
+			"failure: invalid host params",
+			func() {
+				genesisState.HostGenesisState.Params = hosttypes.NewParams(true, []string{""})
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			genesisState = types.DefaultGenesis()
+
+			tc.malleate()
+
+			err := genesisState.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	"context"
+
+	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+)
+
+// InitGenesis initializes the interchain queries host application state from a provided genesis state
+func InitGenesis(ctx context.Context, keeper Keeper, state genesistypes.HostGenesisState) {
+	keeper.setPort(ctx, state.Port)
+	keeper.SetParams(ctx, state.Params)
+}
+
+// ExportGenesis returns the interchain queries host exported genesis
+func ExportGenesis(ctx context.Context, keeper Keeper) genesistypes.HostGenesisState {
+	return genesistypes.NewHostGenesisState(
+		keeper.GetPort(ctx),
+		keeper.GetParams(ctx),
+	)
+}
@@ -0,0 +1,59 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// OnChanOpenTry performs basic validation of the ICQ channel. The channel order must be UNORDERED,
+// the counterparty port identifier must be the controller chain representation and the counterparty
+// version must be the ICS31 protocol version.
+func (k Keeper) OnChanOpenTry(
+	ctx context.Context,
+	order channeltypes.Order,
+	connectionHops []string,
+	portID,
+	channelID string,
+	counterparty channeltypes.Counterparty,
+	counterpartyVersion string,
+) (string, error) {
+	if order != channeltypes.UNORDERED {
+		return "", errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s", channeltypes.UNORDERED, order)
+	}
+
+	if boundPort := k.GetPort(ctx); boundPort != portID {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidHostPort, "expected %s, got %s", boundPort, portID)
+	}
+
+	if counterparty.PortId != icqtypes.ControllerPortID {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidControllerPort, "expected %s, got %s", icqtypes.ControllerPortID, counterparty.PortId)
+	}
+
+	if counterpartyVersion != icqtypes.Version {
+		return "", errorsmod.Wrapf(icqtypes.ErrInvalidVersion, "invalid counterparty version: expected %s, got %s", icqtypes.Version, counterpartyVersion)
+	}
+
+	return icqtypes.Version, nil
+}
+
+// OnChanOpenConfirm completes the handshake process on the host chain.
+func (Keeper) OnChanOpenConfirm(
+	ctx context.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
+
+// OnChanCloseConfirm is a no-op as the host chain does not keep any channel state.
+func (Keeper) OnChanCloseConfirm(
+	ctx context.Context,
+	portID,
+	channelID string,
+) error {
+	return nil
+}
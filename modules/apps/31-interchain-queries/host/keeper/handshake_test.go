@@ -0,0 +1,79 @@
+package keeper_test
+
+import (
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestOnChanOpenTry() {
+	var (
+		path                *ibctesting.Path
+		order               channeltypes.Order
+		counterparty        channeltypes.Counterparty
+		counterpartyVersion string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"invalid order - ORDERED", func() {
+				order = channeltypes.ORDERED
+			}, channeltypes.ErrInvalidChannelOrdering,
+		},
+		{
+			"invalid port ID", func() {
+				path.EndpointB.ChannelConfig.PortID = ibctesting.MockPort
+			}, icqtypes.ErrInvalidHostPort,
+		},
+		{
+			"invalid counterparty port ID", func() {
+				counterparty.PortId = ibctesting.MockPort
+			}, icqtypes.ErrInvalidControllerPort,
+		},
+		{
+			"invalid counterparty version", func() {
+				counterpartyVersion = "icq-2"
+			}, icqtypes.ErrInvalidVersion,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.SetupConnections()
+
+			err := path.EndpointA.ChanOpenInit()
+			suite.Require().NoError(err)
+
+			order = channeltypes.UNORDERED
+			counterparty = channeltypes.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			counterpartyVersion = path.EndpointA.ChannelConfig.Version
+
+			tc.malleate() // malleate mutates test data
+
+			version, err := suite.chainB.GetSimApp().ICQHostKeeper.OnChanOpenTry(
+				suite.chainB.GetContext(), order, []string{path.EndpointB.ConnectionID},
+				path.EndpointB.ChannelConfig.PortID, ibctesting.FirstChannelID, counterparty, counterpartyVersion,
+			)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(icqtypes.Version, version)
+			} else {
+				suite.Require().Empty(version)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
@@ -0,0 +1,130 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icatypes "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/types"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// Keeper defines the IBC interchain queries host keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.Codec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper icqtypes.ChannelKeeper
+
+	queryRouter icqtypes.QueryRouter
+
+	// mqsAllowList is a list of all module safe query paths
+	mqsAllowList []string
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new interchain queries host Keeper instance
+func NewKeeper(
+	cdc codec.Codec, storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper, channelKeeper icqtypes.ChannelKeeper,
+	queryRouter icqtypes.QueryRouter, authority string,
+) Keeper {
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		storeService:  storeService,
+		cdc:           cdc,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		queryRouter:   queryRouter,
+		mqsAllowList:  icatypes.NewModuleQuerySafeAllowList(),
+		authority:     authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the ics31 host submodule's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns the application logger, scoped to the associated module
+func (Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: remove after context.Context is removed from core IBC
+	return sdkCtx.Logger().With("module", fmt.Sprintf("x/%s-%s", exported.ModuleName, icqtypes.ModuleName))
+}
+
+// GetPort returns the portID for the interchain queries host submodule.
+func (k Keeper) GetPort(ctx context.Context) string {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(icqtypes.PortKey))
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// setPort sets the portID for the interchain queries host submodule.
+func (k Keeper) setPort(ctx context.Context, portID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set([]byte(icqtypes.PortKey), []byte(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAppVersion calls the ICS4Wrapper GetAppVersion function.
+func (k Keeper) GetAppVersion(ctx context.Context, portID, channelID string) (string, bool) {
+	return k.ics4Wrapper.GetAppVersion(ctx, portID, channelID)
+}
+
+// GetParams returns the total set of the host submodule parameters.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("icq/host params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the total set of the host submodule parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,114 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		expError      error
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainB.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainB.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainB.GetSimApp().GRPCQueryRouter(),
+				suite.chainB.GetSimApp().ICQHostKeeper.GetAuthority(),
+			)
+		}, nil},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainB.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainB.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainB.GetSimApp().GRPCQueryRouter(),
+				"", // authority
+			)
+		}, errors.New("authority must be non-empty")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.expError == nil {
+				suite.Require().NotPanics(
+					tc.instantiateFn,
+				)
+			} else {
+				suite.Require().PanicsWithError(
+					tc.expError.Error(),
+					tc.instantiateFn,
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetPort() {
+	suite.SetupTest()
+
+	port := suite.chainB.GetSimApp().ICQHostKeeper.GetPort(suite.chainB.GetContext())
+	suite.Require().Equal(icqtypes.HostPortID, port)
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	expParams := types.DefaultParams()
+
+	params := suite.chainB.GetSimApp().ICQHostKeeper.GetParams(suite.chainB.GetContext())
+	suite.Require().Equal(expParams, params)
+
+	expParams = types.NewParams(false, []string{"/cosmos.bank.v1beta1.Query/Balance"})
+	suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), expParams)
+	params = suite.chainB.GetSimApp().ICQHostKeeper.GetParams(suite.chainB.GetContext())
+	suite.Require().Equal(expParams, params)
+}
+
+func (suite *KeeperTestSuite) TestUnsetParams() {
+	suite.SetupTest()
+	ctx := suite.chainB.GetContext()
+	store := suite.chainB.GetContext().KVStore(suite.chainB.GetSimApp().GetKey(types.StoreKey))
+	store.Delete([]byte(types.ParamsKey))
+
+	suite.Require().Panics(func() {
+		suite.chainB.GetSimApp().ICQHostKeeper.GetParams(ctx)
+	})
+}
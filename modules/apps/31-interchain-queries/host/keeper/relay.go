@@ -0,0 +1,95 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// OnRecvPacket handles a given interchain queries packet on a destination host chain.
+// If the queries are successfully executed, the acknowledgement bytes containing the query responses will be returned.
+func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet) ([]byte, error) {
+	var data icqtypes.InterchainQueryPacketData
+	if err := data.UnmarshalJSON(packet.GetData()); err != nil {
+		// UnmarshalJSON errors are indeterminate and therefore are not wrapped and included in failed acks
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-31 interchain queries packet data")
+	}
+
+	if err := data.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	requests, err := icqtypes.DeserializeCosmosQuery(k.cdc, data.Data)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to deserialize interchain queries")
+	}
+
+	if err := icqtypes.ValidateQueryRequests(requests); err != nil {
+		return nil, err
+	}
+
+	response, err := k.executeQueries(ctx, requests)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to execute interchain queries")
+	}
+
+	bz, err := icqtypes.SerializeCosmosResponse(k.cdc, response)
+	if err != nil {
+		return nil, err
+	}
+
+	ack := icqtypes.InterchainQueryPacketAck{Data: bz}
+	return ack.GetBytes(), nil
+}
+
+// executeQueries routes the query requests to the keeper's query router if they are module_query_safe and
+// allowed by the host submodule parameters. The queries are executed against a branched context which is
+// never written, and all the queries must succeed for a response to be returned.
+func (k Keeper) executeQueries(ctx context.Context, requests []icqtypes.QueryRequest) (icqtypes.CosmosResponse, error) {
+	allowQueries := k.GetParams(ctx).AllowQueries
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	cacheCtx, _ := sdkCtx.CacheContext()
+
+	responses := make([][]byte, len(requests))
+	for i, query := range requests {
+		if !slices.Contains(k.mqsAllowList, query.Path) {
+			return icqtypes.CosmosResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "not module query safe: %s", query.Path)
+		}
+
+		if !types.ContainsQueryPath(allowQueries, query.Path) {
+			return icqtypes.CosmosResponse{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "query path not allowed: %s", query.Path)
+		}
+
+		route := k.queryRouter.Route(query.Path)
+		if route == nil {
+			return icqtypes.CosmosResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no route to query: %s", query.Path)
+		}
+
+		res, err := route(cacheCtx, &abci.RequestQuery{
+			Path: query.Path,
+			Data: query.Data,
+		})
+		if err != nil {
+			k.Logger(ctx).Debug("query failed", "path", query.Path, "error", err)
+			return icqtypes.CosmosResponse{}, err
+		}
+		if res == nil || res.Value == nil {
+			return icqtypes.CosmosResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "no response for query: %s", query.Path)
+		}
+
+		responses[i] = res.Value
+	}
+
+	return icqtypes.CosmosResponse{Height: uint64(sdkCtx.BlockHeight()), Responses: responses}, nil
+}
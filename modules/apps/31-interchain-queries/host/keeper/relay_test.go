@@ -0,0 +1,123 @@
+package keeper_test
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypesv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+func (suite *KeeperTestSuite) TestOnRecvPacket() {
+	var (
+		path       *ibctesting.Path
+		requests   []icqtypes.QueryRequest
+		packetData []byte
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success", func() {}, nil,
+		},
+		{
+			"success: query path explicitly allowed", func() {
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{balancePath}))
+			}, nil,
+		},
+		{
+			"query path not allowed by params", func() {
+				suite.chainB.GetSimApp().ICQHostKeeper.SetParams(suite.chainB.GetContext(), types.NewParams(true, []string{"/cosmos.staking.v1beta1.Query/Params"}))
+			}, ibcerrors.ErrUnauthorized,
+		},
+		{
+			"query path is not module query safe", func() {
+				bz, err := (&govtypesv1.QueryProposalsRequest{}).Marshal()
+				suite.Require().NoError(err)
+
+				requests = append(requests, icqtypes.QueryRequest{Path: "/cosmos.gov.v1.Query/Proposals", Data: bz})
+			}, ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"empty query requests", func() {
+				requests = nil
+			}, icqtypes.ErrInvalidOutgoingData,
+		},
+		{
+			"cannot unmarshal packet data", func() {
+				packetData = []byte("invalid packet data")
+			}, ibcerrors.ErrInvalidType,
+		},
+		{
+			"cannot deserialize cosmos query", func() {
+				packetData = icqtypes.NewInterchainQueryPacketData([]byte{0xff}, "").GetBytes()
+			}, icqtypes.ErrInvalidCodec,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewInterchainQueryPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			bz, err := banktypes.NewQueryBalanceRequest(suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom).Marshal()
+			suite.Require().NoError(err)
+
+			requests = []icqtypes.QueryRequest{{Path: balancePath, Data: bz}}
+			packetData = nil
+
+			tc.malleate() // malleate mutates test data
+
+			if packetData == nil {
+				data, err := icqtypes.SerializeCosmosQuery(suite.chainB.GetSimApp().AppCodec(), requests)
+				suite.Require().NoError(err)
+
+				packetData = icqtypes.NewInterchainQueryPacketData(data, "").GetBytes()
+			}
+
+			packet := channeltypes.NewPacket(
+				packetData, 1,
+				path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+				path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+				clienttypes.ZeroHeight(), suite.chainB.GetTimeoutTimestamp(),
+			)
+
+			ackBz, err := suite.chainB.GetSimApp().ICQHostKeeper.OnRecvPacket(suite.chainB.GetContext(), packet)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				var ack icqtypes.InterchainQueryPacketAck
+				suite.Require().NoError(ack.UnmarshalJSON(ackBz))
+
+				response, err := icqtypes.DeserializeCosmosResponse(suite.chainB.GetSimApp().AppCodec(), ack.Data)
+				suite.Require().NoError(err)
+				suite.Require().Equal(uint64(suite.chainB.GetContext().BlockHeight()), response.Height)
+				suite.Require().Len(response.Responses, 1)
+
+				var balanceResponse banktypes.QueryBalanceResponse
+				suite.Require().NoError(balanceResponse.Unmarshal(response.Responses[0]))
+
+				expBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				suite.Require().Equal(expBalance, *balanceResponse.Balance)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				suite.Require().Nil(ackBz)
+			}
+		})
+	}
+}
@@ -0,0 +1,29 @@
+package types
+
+import (
+	"slices"
+)
+
+const (
+	// SubModuleName defines the interchain queries host module name
+	SubModuleName = "icqhost"
+
+	// StoreKey is the store key string for the interchain queries host module
+	StoreKey = SubModuleName
+
+	// ParamsKey is the key to use for the storing params.
+	ParamsKey = "params"
+
+	// AllowAllHostQueries holds the string key that allows all module safe query paths on interchain queries host module
+	AllowAllHostQueries = "*"
+)
+
+// ContainsQueryPath returns true if the query path is present in allowQueries, otherwise false
+func ContainsQueryPath(allowQueries []string, path string) bool {
+	// check that wildcard * option for allowing all query paths is the only string in the array, if so, return true
+	if len(allowQueries) == 1 && allowQueries[0] == AllowAllHostQueries {
+		return true
+	}
+
+	return slices.Contains(allowQueries, path)
+}
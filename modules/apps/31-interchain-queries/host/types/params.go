@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+const (
+	// DefaultHostEnabled is the default value for the host param (set to true)
+	DefaultHostEnabled = true
+	// Maximum length of the allowlist
+	MaxAllowListLength = 500
+)
+
+// NewParams creates a new parameter configuration for the host submodule
+func NewParams(enableHost bool, allowQueries []string) Params {
+	return Params{
+		HostEnabled:  enableHost,
+		AllowQueries: allowQueries,
+	}
+}
+
+// DefaultParams is the default parameter configuration for the host submodule
+func DefaultParams() Params {
+	return NewParams(DefaultHostEnabled, []string{AllowAllHostQueries})
+}
+
+// Validate validates all host submodule parameters
+func (p Params) Validate() error {
+	return validateAllowlist(p.AllowQueries)
+}
+
+func validateAllowlist(allowQueries []string) error {
+	if len(allowQueries) > MaxAllowListLength {
+		return fmt.Errorf("allow list length must not exceed %d items", MaxAllowListLength)
+	}
+
+	if slices.Contains(allowQueries, AllowAllHostQueries) && len(allowQueries) > 1 {
+		return fmt.Errorf("allow list must have only one element because the allow all host queries wildcard (%s) is present", AllowAllHostQueries)
+	}
+
+	for _, path := range allowQueries {
+		if strings.TrimSpace(path) == "" {
+			return fmt.Errorf("parameter must not contain empty strings: %s", allowQueries)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,50 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+)
+
+func TestValidateParams(t *testing.T) {
+	tooManyPaths := make([]string, types.MaxAllowListLength+1)
+	for i := range tooManyPaths {
+		tooManyPaths[i] = "/cosmos.bank.v1beta1.Query/Balance"
+	}
+
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr bool
+	}{
+		{"default params", types.DefaultParams(), false},
+		{"success: host disabled", types.NewParams(false, []string{types.AllowAllHostQueries}), false},
+		{"success: empty allow list", types.NewParams(true, nil), false},
+		{"success: explicit query paths", types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.staking.v1beta1.Query/Params"}), false},
+		{"failure: wildcard with other paths", types.NewParams(true, []string{types.AllowAllHostQueries, "/cosmos.bank.v1beta1.Query/Balance"}), true},
+		{"failure: empty path", types.NewParams(true, []string{"/cosmos.bank.v1beta1.Query/Balance", " "}), true},
+		{"failure: allow list too long", types.NewParams(true, tooManyPaths), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestContainsQueryPath(t *testing.T) {
+	require.True(t, types.ContainsQueryPath([]string{types.AllowAllHostQueries}, "/cosmos.bank.v1beta1.Query/Balance"))
+	require.True(t, types.ContainsQueryPath([]string{"/cosmos.bank.v1beta1.Query/Balance"}, "/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, types.ContainsQueryPath([]string{"/cosmos.staking.v1beta1.Query/Params"}, "/cosmos.bank.v1beta1.Query/Balance"))
+	require.False(t, types.ContainsQueryPath(nil, "/cosmos.bank.v1beta1.Query/Balance"))
+}
@@ -0,0 +1,171 @@
+package icq
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/client/cli"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller"
+	controllerkeeper "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/keeper"
+	controllertypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	genesistypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/genesis/types"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host"
+	hostkeeper "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/keeper"
+	hosttypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+)
+
+var (
+	_ module.AppModule           = (*AppModule)(nil)
+	_ module.AppModuleBasic      = (*AppModuleBasic)(nil)
+	_ module.HasGenesis          = (*AppModule)(nil)
+	_ module.HasName             = (*AppModule)(nil)
+	_ module.HasConsensusVersion = (*AppModule)(nil)
+	_ module.HasServices         = (*AppModule)(nil)
+	_ appmodule.AppModule        = (*AppModule)(nil)
+
+	_ porttypes.IBCModule = (*controller.IBCModule)(nil)
+	_ porttypes.IBCModule = (*host.IBCModule)(nil)
+)
+
+// AppModuleBasic is the IBC interchain queries AppModuleBasic
+type AppModuleBasic struct{}
+
+// Name implements AppModuleBasic interface
+func (AppModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (AppModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (AppModule) IsAppModule() {}
+
+// RegisterLegacyAminoCodec implements AppModuleBasic.
+func (AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {}
+
+// RegisterInterfaces registers module concrete types into protobuf Any
+func (AppModuleBasic) RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	controllertypes.RegisterInterfaces(registry)
+	hosttypes.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the IBC
+// interchain queries module
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(genesistypes.DefaultGenesis())
+}
+
+// ValidateGenesis performs genesis state validation for the IBC interchain queries module
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var gs genesistypes.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &gs); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return gs.Validate()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the interchain queries module.
+func (AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	err := controllertypes.RegisterQueryHandlerClient(context.Background(), mux, controllertypes.NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
+
+	err = hosttypes.RegisterQueryHandlerClient(context.Background(), mux, hosttypes.NewQueryClient(clientCtx))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// GetTxCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// GetQueryCmd implements AppModuleBasic interface
+func (AppModuleBasic) GetQueryCmd() *cobra.Command {
+	return cli.GetQueryCmd()
+}
+
+// AppModule is the application module for the IBC interchain queries module
+type AppModule struct {
+	AppModuleBasic
+	controllerKeeper *controllerkeeper.Keeper
+	hostKeeper       *hostkeeper.Keeper
+}
+
+// NewAppModule creates a new IBC interchain queries module. Either of the keepers may be
+// nil if the chain does not wish to enable the associated submodule.
+func NewAppModule(controllerKeeper *controllerkeeper.Keeper, hostKeeper *hostkeeper.Keeper) AppModule {
+	return AppModule{
+		controllerKeeper: controllerKeeper,
+		hostKeeper:       hostKeeper,
+	}
+}
+
+// RegisterServices registers module services
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	if am.controllerKeeper != nil {
+		controllertypes.RegisterMsgServer(cfg.MsgServer(), controllerkeeper.NewMsgServerImpl(am.controllerKeeper))
+		controllertypes.RegisterQueryServer(cfg.QueryServer(), am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hosttypes.RegisterMsgServer(cfg.MsgServer(), hostkeeper.NewMsgServerImpl(am.hostKeeper))
+		hosttypes.RegisterQueryServer(cfg.QueryServer(), am.hostKeeper)
+	}
+}
+
+// InitGenesis performs genesis initialization for the interchain queries module.
+// It returns no validator updates.
+func (am AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	var genesisState genesistypes.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	if am.controllerKeeper != nil {
+		controllerkeeper.InitGenesis(ctx, *am.controllerKeeper, genesisState.ControllerGenesisState)
+	}
+
+	if am.hostKeeper != nil {
+		hostkeeper.InitGenesis(ctx, *am.hostKeeper, genesisState.HostGenesisState)
+	}
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the interchain queries module
+func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	var (
+		controllerGenesisState = genesistypes.DefaultControllerGenesis()
+		hostGenesisState       = genesistypes.DefaultHostGenesis()
+	)
+
+	if am.controllerKeeper != nil {
+		controllerGenesisState = controllerkeeper.ExportGenesis(ctx, *am.controllerKeeper)
+	}
+
+	if am.hostKeeper != nil {
+		hostGenesisState = hostkeeper.ExportGenesis(ctx, *am.hostKeeper)
+	}
+
+	gs := genesistypes.NewGenesisState(controllerGenesisState, hostGenesisState)
+
+	return cdc.MustMarshalJSON(gs)
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (AppModule) ConsensusVersion() uint64 { return 1 }
@@ -0,0 +1,56 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+)
+
+// ModuleCdc references the global interchain queries module codec. Note, the codec
+// should ONLY be used in certain instances of tests and for JSON encoding.
+//
+// The actual codec used for serialization should be provided to interchain queries and
+// defined at the application level.
+var ModuleCdc = codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+
+// SerializeCosmosQuery serializes a slice of query requests using the CosmosQuery type. The
+// CosmosQuery is marshaled using protobuf and the marshaled bytes are returned.
+func SerializeCosmosQuery(cdc codec.BinaryCodec, requests []QueryRequest) ([]byte, error) {
+	bz, err := cdc.Marshal(&CosmosQuery{Requests: requests})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "cannot marshal CosmosQuery with protobuf")
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosQuery unmarshals the protobuf encoded bytes of a CosmosQuery and returns its query requests.
+func DeserializeCosmosQuery(cdc codec.BinaryCodec, data []byte) ([]QueryRequest, error) {
+	var cosmosQuery CosmosQuery
+	if err := cdc.Unmarshal(data, &cosmosQuery); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidCodec, "cannot unmarshal CosmosQuery with protobuf: %v", err)
+	}
+
+	return cosmosQuery.Requests, nil
+}
+
+// SerializeCosmosResponse serializes the provided CosmosResponse using protobuf.
+func SerializeCosmosResponse(cdc codec.BinaryCodec, response CosmosResponse) ([]byte, error) {
+	bz, err := cdc.Marshal(&response)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "cannot marshal CosmosResponse with protobuf")
+	}
+
+	return bz, nil
+}
+
+// DeserializeCosmosResponse unmarshals the protobuf encoded bytes of a CosmosResponse.
+func DeserializeCosmosResponse(cdc codec.BinaryCodec, data []byte) (CosmosResponse, error) {
+	var cosmosResponse CosmosResponse
+	if err := cdc.Unmarshal(data, &cosmosResponse); err != nil {
+		return CosmosResponse{}, errorsmod.Wrapf(ErrInvalidCodec, "cannot unmarshal CosmosResponse with protobuf: %v", err)
+	}
+
+	return cosmosResponse, nil
+}
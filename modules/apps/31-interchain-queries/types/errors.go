@@ -0,0 +1,17 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ICS31 Interchain Queries sentinel errors
+var (
+	ErrInvalidChannelFlow     = errorsmod.Register(ModuleName, 2, "invalid message sent to channel end")
+	ErrInvalidVersion         = errorsmod.Register(ModuleName, 3, "invalid interchain queries version")
+	ErrInvalidControllerPort  = errorsmod.Register(ModuleName, 4, "invalid controller port")
+	ErrInvalidHostPort        = errorsmod.Register(ModuleName, 5, "invalid host port")
+	ErrInvalidOutgoingData    = errorsmod.Register(ModuleName, 6, "invalid outgoing data")
+	ErrInvalidQueryRequest    = errorsmod.Register(ModuleName, 7, "invalid query request")
+	ErrInvalidCodec           = errorsmod.Register(ModuleName, 8, "codec is not supported")
+	ErrInvalidAcknowledgement = errorsmod.Register(ModuleName, 9, "invalid acknowledgement")
+)
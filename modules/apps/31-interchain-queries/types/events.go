@@ -0,0 +1,15 @@
+package types
+
+// ICS31 Interchain Queries events
+const (
+	EventTypePacket   = "ics31_packet"
+	EventTypeCallback = "ics31_callback"
+
+	AttributeKeyAckError            = "error"
+	AttributeKeyHostChannelID       = "host_channel_id"
+	AttributeKeyControllerChannelID = "controller_channel_id"
+	AttributeKeyAckSuccess          = "success"
+	AttributeKeySequence            = "sequence"
+	AttributeKeyCallback            = "callback"
+	AttributeKeyCallbackError       = "callback_error"
+)
@@ -0,0 +1,22 @@
+package types
+
+import (
+	"context"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx context.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+}
+
+// QueryRouter ADR 021 query type routing
+// https://github.com/cosmos/cosmos-sdk/blob/main/docs/architecture/adr-021-protobuf-query-encoding.md
+type QueryRouter interface {
+	// Route returns the GRPCQueryHandler for a given query route path or nil
+	// if not found
+	Route(path string) baseapp.GRPCQueryHandler
+}
@@ -0,0 +1,18 @@
+package types
+
+const (
+	// ModuleName defines the interchain queries module name
+	ModuleName = "interchainqueries"
+
+	// ControllerPortID is the default port id that the interchain queries controller submodule binds to
+	ControllerPortID = "icqcontroller"
+
+	// HostPortID is the default port id that the interchain queries host submodule binds to
+	HostPortID = "icqhost"
+
+	// Version defines the current version for interchain queries
+	Version = "icq-1"
+
+	// PortKey defines the key to store the port ID in store
+	PortKey = "port"
+)
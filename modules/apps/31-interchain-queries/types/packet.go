@@ -0,0 +1,87 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+var _ ibcexported.PacketData = (*InterchainQueryPacketData)(nil)
+
+const (
+	// MaxMemoCharLength defines the maximum length for the InterchainQueryPacketData memo field
+	MaxMemoCharLength = 32768
+
+	// MaxQueryRequests defines the maximum number of query requests which can be sent in a single packet
+	MaxQueryRequests = 50
+)
+
+// NewInterchainQueryPacketData creates a new InterchainQueryPacketData instance
+func NewInterchainQueryPacketData(data []byte, memo string) InterchainQueryPacketData {
+	return InterchainQueryPacketData{
+		Data: data,
+		Memo: memo,
+	}
+}
+
+// ValidateBasic performs basic validation of the interchain query packet data.
+// The memo may be empty.
+func (iqpd InterchainQueryPacketData) ValidateBasic() error {
+	if len(iqpd.Data) == 0 {
+		return errorsmod.Wrap(ErrInvalidOutgoingData, "packet data cannot be empty")
+	}
+
+	if len(iqpd.Memo) > MaxMemoCharLength {
+		return errorsmod.Wrapf(ErrInvalidOutgoingData, "packet data memo cannot be greater than %d characters", MaxMemoCharLength)
+	}
+
+	return nil
+}
+
+// GetBytes returns the JSON marshalled interchain query packet data.
+func (iqpd InterchainQueryPacketData) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpd)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into an InterchainQueryPacketData.
+func (iqpd *InterchainQueryPacketData) UnmarshalJSON(bz []byte) error {
+	return ModuleCdc.UnmarshalJSON(bz, iqpd)
+}
+
+// GetPacketSender returns an empty string as the sender of interchain queries is not
+// included in the packet data.
+func (InterchainQueryPacketData) GetPacketSender(_ string) string {
+	return ""
+}
+
+// GetBytes returns the JSON marshalled interchain query packet acknowledgement.
+func (iqpa InterchainQueryPacketAck) GetBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(&iqpa)
+}
+
+// UnmarshalJSON unmarshals raw JSON bytes into an InterchainQueryPacketAck.
+func (iqpa *InterchainQueryPacketAck) UnmarshalJSON(bz []byte) error {
+	return ModuleCdc.UnmarshalJSON(bz, iqpa)
+}
+
+// ValidateQueryRequests validates that at least one and at most MaxQueryRequests query requests
+// are provided and that each of them has a non-empty path.
+func ValidateQueryRequests(requests []QueryRequest) error {
+	if len(requests) == 0 {
+		return errorsmod.Wrap(ErrInvalidQueryRequest, "query requests cannot be empty")
+	}
+
+	if len(requests) > MaxQueryRequests {
+		return errorsmod.Wrapf(ErrInvalidQueryRequest, "number of query requests must not exceed %d", MaxQueryRequests)
+	}
+
+	for i, request := range requests {
+		if strings.TrimSpace(request.Path) == "" {
+			return errorsmod.Wrapf(ErrInvalidQueryRequest, "query request %d path cannot be empty", i)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+)
+
+const balancePath = "/cosmos.bank.v1beta1.Query/Balance"
+
+func TestInterchainQueryPacketDataValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name       string
+		packetData types.InterchainQueryPacketData
+		expErr     error
+	}{
+		{
+			"success",
+			types.NewInterchainQueryPacketData([]byte("data"), ""),
+			nil,
+		},
+		{
+			"success: with memo",
+			types.NewInterchainQueryPacketData([]byte("data"), "memo"),
+			nil,
+		},
+		{
+			"failure: empty data",
+			types.NewInterchainQueryPacketData(nil, "memo"),
+			types.ErrInvalidOutgoingData,
+		},
+		{
+			"failure: memo too long",
+			types.NewInterchainQueryPacketData([]byte("data"), strings.Repeat("a", types.MaxMemoCharLength+1)),
+			types.ErrInvalidOutgoingData,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.packetData.ValidateBasic()
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestValidateQueryRequests(t *testing.T) {
+	testCases := []struct {
+		name     string
+		requests []types.QueryRequest
+		expErr   error
+	}{
+		{
+			"success",
+			[]types.QueryRequest{{Path: balancePath, Data: []byte("data")}},
+			nil,
+		},
+		{
+			"success: maximum number of requests",
+			balanceQueryRequests(types.MaxQueryRequests),
+			nil,
+		},
+		{
+			"failure: empty requests",
+			nil,
+			types.ErrInvalidQueryRequest,
+		},
+		{
+			"failure: too many requests",
+			balanceQueryRequests(types.MaxQueryRequests + 1),
+			types.ErrInvalidQueryRequest,
+		},
+		{
+			"failure: empty path",
+			[]types.QueryRequest{{Path: balancePath}, {Path: "  "}},
+			types.ErrInvalidQueryRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := types.ValidateQueryRequests(tc.requests)
+
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestCosmosQuerySerialization(t *testing.T) {
+	requests := []types.QueryRequest{
+		{Path: balancePath, Data: []byte("balance")},
+		{Path: "/cosmos.staking.v1beta1.Query/Params"},
+	}
+
+	bz, err := types.SerializeCosmosQuery(types.ModuleCdc, requests)
+	require.NoError(t, err)
+
+	deserialized, err := types.DeserializeCosmosQuery(types.ModuleCdc, bz)
+	require.NoError(t, err)
+	require.Len(t, deserialized, len(requests))
+	for i, request := range requests {
+		require.Equal(t, request.Path, deserialized[i].Path)
+		require.Equal(t, request.Data, deserialized[i].Data)
+	}
+
+	_, err = types.DeserializeCosmosQuery(types.ModuleCdc, []byte{0xff})
+	require.ErrorIs(t, err, types.ErrInvalidCodec)
+}
+
+func TestCosmosResponseSerialization(t *testing.T) {
+	response := types.CosmosResponse{Height: 10, Responses: [][]byte{[]byte("response-1"), []byte("response-2")}}
+
+	bz, err := types.SerializeCosmosResponse(types.ModuleCdc, response)
+	require.NoError(t, err)
+
+	deserialized, err := types.DeserializeCosmosResponse(types.ModuleCdc, bz)
+	require.NoError(t, err)
+	require.Equal(t, response, deserialized)
+
+	_, err = types.DeserializeCosmosResponse(types.ModuleCdc, []byte{0xff})
+	require.ErrorIs(t, err, types.ErrInvalidCodec)
+}
+
+// balanceQueryRequests returns the provided number of balance query requests.
+func balanceQueryRequests(n int) []types.QueryRequest {
+	requests := make([]types.QueryRequest, n)
+	for i := range requests {
+		requests[i] = types.QueryRequest{Path: balancePath}
+	}
+
+	return requests
+}
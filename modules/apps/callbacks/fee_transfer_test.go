@@ -52,12 +52,12 @@ func (s *CallbacksTestSuite) TestIncentivizedTransferCallbacks() {
 			true,
 		},
 		{
-			"success: dest callback with malformed json",
+			"failure: dest callback with malformed json",
 			func() {
 				transferMemo = fmt.Sprintf(`{"dest_callback": {"address": "%s"}, malformed}`, simapp.SuccessContract)
 			},
 			"none",
-			true,
+			false,
 		},
 		{
 			"success: dest callback with missing address",
@@ -85,12 +85,12 @@ func (s *CallbacksTestSuite) TestIncentivizedTransferCallbacks() {
 			true,
 		},
 		{
-			"success: source callback with malformed json",
+			"failure: source callback with malformed json",
 			func() {
 				transferMemo = fmt.Sprintf(`{"src_callback": {"address": "%s"}, malformed}`, simapp.SuccessContract)
 			},
 			"none",
-			true,
+			false,
 		},
 		{
 			"success: source callback with missing address",
@@ -79,6 +79,7 @@ import (
 	abci "github.com/cometbft/cometbft/abci/types"
 
 	ibccallbacks "github.com/cosmos/ibc-go/modules/apps/callbacks"
+	ibccallbacktypes "github.com/cosmos/ibc-go/modules/apps/callbacks/types"
 	ica "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts"
 	icacontroller "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller"
 	icacontrollerkeeper "github.com/cosmos/ibc-go/v9/modules/apps/27-interchain-accounts/controller/keeper"
@@ -387,6 +388,7 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -427,6 +429,12 @@ func NewSimApp(
 	transferStack = ibcfee.NewIBCMiddleware(transferStack, app.IBCFeeKeeper)
 	// Since the callbacks middleware itself is an ics4wrapper, it needs to be passed to the transfer keeper
 	app.TransferKeeper.WithICS4Wrapper(transferICS4Wrapper)
+	// the callbacks middleware interprets the callback keys of the transfer memos, so they are registered
+	// alongside the memo keys of the transfer application for the memos to be validated against them
+	memoRegistry := ibctransfertypes.NewMemoRegistry(false)
+	memoRegistry.MustRegisterMemoKey(ibccallbacktypes.ModuleName, ibccallbacktypes.SourceCallbackKey, ibccallbacktypes.CallbackMemoSchema)
+	memoRegistry.MustRegisterMemoKey(ibccallbacktypes.ModuleName, ibccallbacktypes.DestinationCallbackKey, ibccallbacktypes.CallbackMemoSchema)
+	app.TransferKeeper.WithMemoRegistry(memoRegistry)
 
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
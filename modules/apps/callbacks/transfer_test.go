@@ -40,10 +40,10 @@ func (s *CallbacksTestSuite) TestTransferCallbacks() {
 			true,
 		},
 		{
-			"success: dest callback with malformed json",
+			"failure: dest callback with malformed json",
 			fmt.Sprintf(`{"dest_callback": {"address": "%s"}, malformed}`, simapp.SuccessContract),
 			"none",
-			true,
+			false,
 		},
 		{
 			"success: dest callback with missing address",
@@ -64,10 +64,10 @@ func (s *CallbacksTestSuite) TestTransferCallbacks() {
 			true,
 		},
 		{
-			"success: source callback with malformed json",
+			"failure: source callback with malformed json",
 			fmt.Sprintf(`{"src_callback": {"address": "%s"}, malformed}`, simapp.SuccessContract),
 			"none",
-			true,
+			false,
 		},
 		{
 			"success: source callback with missing address",
@@ -112,6 +112,18 @@ func (s *CallbacksTestSuite) TestTransferCallbacks() {
 	}
 }
 
+func (s *CallbacksTestSuite) TestTransferCallbackMemoKeys() {
+	s.SetupTransferTest()
+
+	res, err := GetSimApp(s.chainA).TransferKeeper.MemoKeys(s.chainA.GetContext(), &transfertypes.QueryMemoKeysRequest{})
+	s.Require().NoError(err)
+	s.Require().Equal([]transfertypes.MemoKey{
+		{Key: types.DestinationCallbackKey, Owner: types.ModuleName, Schema: types.CallbackMemoSchema},
+		{Key: transfertypes.ForwardMemoKey, Owner: transfertypes.ModuleName, Schema: transfertypes.ForwardMemoSchema},
+		{Key: types.SourceCallbackKey, Owner: types.ModuleName, Schema: types.CallbackMemoSchema},
+	}, res.MemoKeys)
+}
+
 func (s *CallbacksTestSuite) TestTransferTimeoutCallbacks() {
 	testCases := []struct {
 		name         string
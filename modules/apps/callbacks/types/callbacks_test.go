@@ -1233,3 +1233,14 @@ func (s *CallbacksTypesTestSuite) TestUserDefinedGasLimit() {
 		})
 	}
 }
+
+func (s *CallbacksTypesTestSuite) TestCallbackMemoSchema() {
+	registry := transfertypes.NewMemoRegistry(true)
+	s.Require().NoError(registry.RegisterMemoKey(types.ModuleName, types.SourceCallbackKey, types.CallbackMemoSchema))
+	s.Require().NoError(registry.RegisterMemoKey(types.ModuleName, types.DestinationCallbackKey, types.CallbackMemoSchema))
+
+	s.Require().NoError(registry.ValidateMemo(fmt.Sprintf(`{"src_callback": {"address": "%s", "gas_limit": "200000"}}`, ibctesting.TestAccAddress), false))
+	s.Require().NoError(registry.ValidateMemo(fmt.Sprintf(`{"dest_callback": {"address": "%s"}}`, ibctesting.TestAccAddress), false))
+	s.Require().ErrorIs(registry.ValidateMemo(`{"src_callback": {"address": "cosmos1", "gas_limit": 200000}}`, false), transfertypes.ErrInvalidMemo)
+	s.Require().ErrorIs(registry.ValidateMemo(`{"dest_callback": "string"}`, false), transfertypes.ErrInvalidMemo)
+}
@@ -28,4 +28,9 @@ const (
 	// The expected format for ICS20 and ICS27 memo field is as follows:
 	// { "{callbackKey}": { ... , "gas_limit": {stringForCallback} }
 	UserDefinedGasLimitKey = "gas_limit"
+
+	// CallbackMemoSchema is the JSON schema of the callback data found under the SourceCallbackKey and
+	// DestinationCallbackKey of the memo. Chains validating transfer memos may register it for both keys
+	// with the memo registry of the transfer application.
+	CallbackMemoSchema = `{"type":"object","properties":{"address":{"type":"string"},"gas_limit":{"type":"string"}}}`
 )
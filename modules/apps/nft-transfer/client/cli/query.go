@@ -0,0 +1,169 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+)
+
+// GetCmdQueryClass defines the command to query a class trace from a given hash or ibc class identifier.
+func GetCmdQueryClass() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class [hash/class-id]",
+		Short:   "Query the class trace info from a given hash or ibc class identifier",
+		Long:    "Query the class trace info from a given hash or ibc class identifier",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer class 27A6394C3F9FF9C9DCF5DFFADF9BB5FE9A37C7E92B006199894CF1824DF9AC7C", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClassRequest{
+				Hash: args[0],
+			}
+
+			res, err := queryClient.Class(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryClasses defines the command to query all the class traces that this chain maintains.
+func GetCmdQueryClasses() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "classes",
+		Short:   "Query for all class traces",
+		Long:    "Query for all class traces",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer classes", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryClassesRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.Classes(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "classes")
+
+	return cmd
+}
+
+// GetCmdQueryClassHash defines the command to query a class hash from a given trace.
+func GetCmdQueryClassHash() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "class-hash [trace]",
+		Short:   "Query the class hash info from a given class trace",
+		Long:    "Query the class hash info from a given class trace",
+		Example: fmt.Sprintf("%s query ibc-nft-transfer class-hash nft-transfer/channel-0/kitties", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryClassHashRequest{
+				Trace: args[0],
+			}
+
+			res, err := queryClient.ClassHash(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryEscrowAddress returns the command handler for ibc nft-transfer escrow address querying.
+func GetCmdQueryEscrowAddress() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "escrow-address",
+		Short:   "Get the escrow address for a channel",
+		Long:    "Get the escrow address for a channel",
+		Args:    cobra.ExactArgs(2),
+		Example: fmt.Sprintf("%s query ibc-nft-transfer escrow-address [port] [channel-id]", version.AppName),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			port := args[0]
+			channel := args[1]
+			addr := types.GetEscrowAddress(port, channel)
+			return clientCtx.PrintString(fmt.Sprintf("%s\n", addr.String()))
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdParams returns the command handler for ibc nft-transfer parameter querying.
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "params",
+		Short:   "Query the current ibc nft-transfer parameters",
+		Long:    "Query the current ibc nft-transfer parameters",
+		Args:    cobra.NoArgs,
+		Example: fmt.Sprintf("%s query ibc-nft-transfer params", version.AppName),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Params(cmd.Context(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res.Params)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,222 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+const (
+	flagPacketTimeoutHeight    = "packet-timeout-height"
+	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts       = "absolute-timeouts"
+	flagMemo                   = "memo"
+	flagForwarding             = "forwarding"
+	flagUnwind                 = "unwind"
+)
+
+// defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
+// relative to the current block timestamp of the counterparty chain provided by the client
+// state. The timeout is disabled when set to 0. The default is currently set to a 10 minute
+// timeout.
+var defaultRelativePacketTimeoutTimestamp = uint64((time.Duration(10) * time.Minute).Nanoseconds())
+
+// NewTransferTxCmd returns the command to create a NewMsgTransfer transaction
+func NewTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [src-port] [src-channel] [receiver] [class-id] [token-ids]",
+		Short: "Transfer one or more non-fungible tokens of a class through IBC",
+		Long: strings.TrimSpace(`Transfer one or more non-fungible tokens of a class through IBC. Multiple tokens can be transferred in a single
+packet if the token ids are a comma-separated string (e.g. token1,token2). Class identifiers which are not in the ibc/{hash} format
+are interpreted as full class paths and converted into the corresponding class identifier. Timeouts can be specified as absolute
+using the {absolute-timeouts} flag. Timeout height can be set by passing in the height string in the form {revision}-{height} using
+the {packet-timeout-height} flag. Note, relative timeout height is not supported. Relative timeout timestamp is added to the value
+of the user's local system clock time using the {packet-timeout-timestamp} flag. If no timeout value is set then a default relative
+timeout value of 10 minutes is used. IBC tokens can be automatically unwound to their native chain using the {unwind} flag, in which
+case the src-port and src-channel arguments must not be specified. Tokens can also be automatically forwarded through multiple chains
+using the {forwarding} flag and specifying a comma-separated list of source portID/channelID pairs for each intermediary chain.`),
+		Example: fmt.Sprintf("%s tx ibc-nft-transfer transfer [src-port] [src-channel] [receiver] [class-id] [token-ids]", version.AppName),
+		Args:    cobra.RangeArgs(3, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			sender := clientCtx.GetFromAddress().String()
+
+			args, err = normalizeArgs(cmd, args)
+			if err != nil {
+				return err
+			}
+
+			srcPort := args[0]
+			srcChannel := args[1]
+			receiver := args[2]
+			classID := parseClassID(args[3])
+			tokenIDs := strings.Split(args[4], ",")
+
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
+			}
+
+			forwarding, err := parseForwarding(cmd)
+			if err != nil {
+				return err
+			}
+
+			timeoutHeight, timeoutTimestamp, err := parseTimeouts(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, classID, tokenIDs, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
+			)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
+	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
+	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
+	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the tokens should be unwound to their native chain before forwarding.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTimeouts parses the timeout flags into an absolute timeout height and timestamp. If the timeouts are
+// not absolute, the timeout timestamp is computed relative to the local clock time.
+func parseTimeouts(cmd *cobra.Command) (clienttypes.Height, uint64, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	// NOTE: relative timeouts using block height are not supported.
+	// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
+	if !absoluteTimeouts {
+		if !timeoutHeight.IsZero() {
+			return clienttypes.ZeroHeight(), 0, errors.New("relative timeouts using block height is not supported")
+		}
+
+		if timeoutTimestamp == 0 {
+			return clienttypes.ZeroHeight(), 0, errors.New("relative timeouts must provide a non zero value timestamp")
+		}
+
+		// use local clock time as reference time for calculating timeout timestamp.
+		now := time.Now().UnixNano()
+		if now <= 0 {
+			return clienttypes.ZeroHeight(), 0, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+		}
+
+		timeoutTimestamp = uint64(now) + timeoutTimestamp
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
+
+// parseClassID returns the class identifier as is if it is in the ibc/{hash} format, otherwise it is
+// interpreted as a full class path and converted into the corresponding class identifier.
+func parseClassID(classID string) string {
+	if strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return classID
+	}
+
+	return types.ExtractClassFromPath(classID).ClassID()
+}
+
+// parseForwarding parses the forwarding flag into a Forwarding object or nil if the flag is not specified. If the flag cannot
+// be parsed or the hops aren't in the portID/channelID format an error is returned.
+func parseForwarding(cmd *cobra.Command) (*transfertypes.Forwarding, error) {
+	var hops []transfertypes.Hop
+
+	unwind, err := cmd.Flags().GetBool(flagUnwind)
+	if err != nil {
+		return nil, err
+	}
+	forwarding := transfertypes.NewForwarding(unwind)
+
+	forwardingString, err := cmd.Flags().GetString(flagForwarding)
+	if err != nil {
+		return nil, err
+	}
+
+	if strings.TrimSpace(forwardingString) == "" {
+		// If forwarding not specified, we might have unwind set
+		return forwarding, nil
+	}
+
+	pairs := strings.Split(forwardingString, ",")
+	for _, pair := range pairs {
+		pairSplit := strings.Split(pair, "/")
+		if len(pairSplit) != 2 {
+			return nil, fmt.Errorf("expected a portID/channelID pair, found %s", pair)
+		}
+
+		hops = append(hops, transfertypes.NewHop(pairSplit[0], pairSplit[1]))
+	}
+
+	forwarding.Hops = hops
+	return forwarding, nil
+}
+
+// normalizeArgs takes the positional arguments specified and if the unwind flag
+// is false and the args array is of length 5, returns them as-is or, if unwind is true and the
+// args array has a length of 3, inserts two empty strings at the beginning signifying the
+// portID and channelID.
+func normalizeArgs(cmd *cobra.Command, args []string) ([]string, error) {
+	unwind, err := cmd.Flags().GetBool(flagUnwind)
+	if err != nil {
+		return nil, err
+	}
+
+	if unwind {
+		if len(args) != 3 {
+			return nil, fmt.Errorf("expected only 3 arguments, got %d", len(args))
+		}
+
+		// Inject empty source portID/channelID.
+		args = append([]string{"", ""}, args...)
+		return args, nil
+	}
+
+	// Unwind false, just ensure we have 5 args.
+	if len(args) != 5 {
+		return nil, fmt.Errorf("expected 5 args, got %d", len(args))
+	}
+
+	return args, nil
+}
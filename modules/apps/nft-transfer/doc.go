@@ -0,0 +1,8 @@
+/*
+Package nfttransfer implements the packet data structure, state machine handling logic,
+and encoding details for the transfer of non-fungible tokens over an IBC channel between
+two modules on separate chains.
+This implementation is based off the ICS 721 specification
+(https://github.com/cosmos/ibc/blob/main/spec/app/ics-721-nft-transfer)
+*/
+package nfttransfer
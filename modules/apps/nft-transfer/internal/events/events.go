@@ -0,0 +1,145 @@
+package events
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// EmitTransferEvent emits a ibc nft-transfer event on successful transfers.
+func EmitTransferEvent(ctx context.Context, sender, receiver, classID string, tokenIDs []string, memo string, forwardingHops []transfertypes.Hop) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, classID),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, mustMarshalJSON(tokenIDs)),
+			sdk.NewAttribute(types.AttributeKeyMemo, memo),
+			sdk.NewAttribute(types.AttributeKeyForwardingHops, mustMarshalJSON(forwardingHops)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitOnRecvPacketEvent emits a non-fungible token packet event in the OnRecvPacket callback
+func EmitOnRecvPacketEvent(ctx context.Context, packetData types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement, ackErr error) {
+	eventAttributes := []sdk.Attribute{
+		sdk.NewAttribute(types.AttributeKeySender, packetData.Sender),
+		sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+		sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+		sdk.NewAttribute(types.AttributeKeyTokenIDs, mustMarshalJSON(packetData.TokenIds)),
+		sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+		sdk.NewAttribute(types.AttributeKeyForwardingHops, mustMarshalJSON(packetData.Forwarding.Hops)),
+		sdk.NewAttribute(types.AttributeKeyAckSuccess, strconv.FormatBool(ack.Success())),
+	}
+
+	if ackErr != nil {
+		eventAttributes = append(eventAttributes, sdk.NewAttribute(types.AttributeKeyAckError, ackErr.Error()))
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacket,
+			eventAttributes...,
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitOnAcknowledgementPacketEvent emits a non-fungible token packet event in the OnAcknowledgementPacket callback
+func EmitOnAcknowledgementPacketEvent(ctx context.Context, packetData types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypePacket,
+			sdk.NewAttribute(sdk.AttributeKeySender, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyReceiver, packetData.Receiver),
+			sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+			sdk.NewAttribute(types.AttributeKeyTokenIDs, mustMarshalJSON(packetData.TokenIds)),
+			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+			sdk.NewAttribute(types.AttributeKeyForwardingHops, mustMarshalJSON(packetData.Forwarding.Hops)),
+			sdk.NewAttribute(types.AttributeKeyAck, ack.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+
+	switch resp := ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckSuccess, string(resp.Result)),
+			),
+		)
+	case *channeltypes.Acknowledgement_Error:
+		sdkCtx.EventManager().EmitEvent(
+			sdk.NewEvent(
+				types.EventTypePacket,
+				sdk.NewAttribute(types.AttributeKeyAckError, resp.Error),
+			),
+		)
+	}
+}
+
+// EmitOnTimeoutEvent emits a non-fungible token packet event in the OnTimeoutPacket callback
+func EmitOnTimeoutEvent(ctx context.Context, packetData types.NonFungibleTokenPacketData) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeTimeout,
+			sdk.NewAttribute(types.AttributeKeyRefundReceiver, packetData.Sender),
+			sdk.NewAttribute(types.AttributeKeyClassID, packetData.ClassId),
+			sdk.NewAttribute(types.AttributeKeyRefundTokenIDs, mustMarshalJSON(packetData.TokenIds)),
+			sdk.NewAttribute(types.AttributeKeyMemo, packetData.Memo),
+			sdk.NewAttribute(types.AttributeKeyForwardingHops, mustMarshalJSON(packetData.Forwarding.Hops)),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitClassEvent emits a class event in the OnRecv callback when the tokens of a class are received
+// for the first time.
+func EmitClassEvent(ctx context.Context, class types.Class) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvent(
+		sdk.NewEvent(
+			types.EventTypeClass,
+			sdk.NewAttribute(types.AttributeKeyClassHash, class.Hash().String()),
+			sdk.NewAttribute(types.AttributeKeyClass, mustMarshalJSON(class)),
+		),
+	)
+}
+
+func mustMarshalJSON(v any) string {
+	bz, err := json.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+
+	return string(bz)
+}
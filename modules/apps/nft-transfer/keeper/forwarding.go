@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// forwardPacket forwards the tokens of a NonFungibleTokenPacketData to the next hop in the forwarding path.
+// The tokens are expected to have been received by the forwarding address under the provided class.
+func (k Keeper) forwardPacket(ctx context.Context, data types.NonFungibleTokenPacketData, packet channeltypes.Packet, receivedClassID string) error {
+	var nextForwardingPath *transfertypes.Forwarding
+	if len(data.Forwarding.Hops) > 1 {
+		// remove the first hop since we are going to send to the first hop now and we want to propagate the rest of the hops to the receiver
+		nextForwardingPath = transfertypes.NewForwarding(false, data.Forwarding.Hops[1:]...)
+	}
+
+	// sending from module account (used as a temporary forward escrow) to the original receiver address.
+	sender := k.authKeeper.GetModuleAddress(types.ModuleName)
+
+	msg := types.NewMsgTransfer(
+		data.Forwarding.Hops[0].PortId,
+		data.Forwarding.Hops[0].ChannelId,
+		receivedClassID,
+		data.TokenIds,
+		sender.String(),
+		data.Receiver,
+		clienttypes.ZeroHeight(),
+		packet.TimeoutTimestamp,
+		data.Forwarding.DestinationMemo,
+		nextForwardingPath,
+	)
+
+	resp, err := k.Transfer(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	k.setForwardedPacket(ctx, data.Forwarding.Hops[0].PortId, data.Forwarding.Hops[0].ChannelId, resp.Sequence, packet)
+	return nil
+}
+
+// acknowledgeForwardedPacket writes the async acknowledgement for forwardedPacket
+func (k Keeper) acknowledgeForwardedPacket(ctx context.Context, forwardedPacket, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
+	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, forwardedPacket, ack); err != nil {
+		return err
+	}
+
+	k.deleteForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	return nil
+}
+
+// revertForwardedPacket reverts the logic of receive packet that occurs in the middle chains during a packet forwarding.
+// If the packet fails to be forwarded all the way to the final destination, the state changes on this chain must be reverted
+// before sending back the error acknowledgement to ensure atomic packet forwarding.
+func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket channeltypes.Packet, failedPacketData types.NonFungibleTokenPacketData) error {
+	/*
+		Recall that RecvPacket handles an incoming packet depending on the class of the received tokens:
+			1. If the tokens are native, then they are sent to the receiver from the escrow.
+			2. If the tokens are foreign, then vouchers are minted.
+		We revert it in this function by:
+			1. Sending the tokens back to escrow if the tokens are native.
+			2. Burning the vouchers if the tokens are foreign
+	*/
+
+	// we can iterate over the received tokens of forwardedPacket by iterating over the sent tokens of failedPacketData
+	class := types.ExtractClassFromPath(failedPacketData.ClassId)
+	classID := class.ClassID()
+
+	escrowAddress := types.GetEscrowAddress(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+	for _, tokenID := range failedPacketData.TokenIds {
+		// check if the tokens we received originated on the sender
+		// given that the packet is being reversed, we check the DestinationChannel and DestinationPort
+		// of the forwardedPacket to see if a hop was added to the trace during the receive step
+		if class.HasPrefix(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel) {
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return err
+			}
+		} else {
+			// send it back to the escrow address
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, escrowAddress); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
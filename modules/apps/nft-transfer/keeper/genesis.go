@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+)
+
+// InitGenesis initializes the ibc nft-transfer state and binds to PortID.
+func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
+	k.SetPort(ctx, state.PortId)
+
+	for _, class := range state.Classes {
+		k.SetClass(ctx, class)
+	}
+
+	k.SetParams(ctx, state.Params)
+
+	// Set any forwarded packets imported.
+	for _, forwardPacketState := range state.ForwardedPackets {
+		forwardKey := forwardPacketState.ForwardKey
+		k.setForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.Packet)
+	}
+}
+
+// ExportGenesis exports ibc nft-transfer module's portID, class traces and forwarded packets into its genesis state.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		PortId:           k.GetPort(ctx),
+		Classes:          k.GetAllClasses(ctx),
+		Params:           k.GetParams(ctx),
+		ForwardedPackets: k.getAllForwardedPackets(ctx),
+	}
+}
@@ -0,0 +1,28 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestGenesis() {
+	var classes types.Classes
+	for i := 0; i < 5; i++ {
+		class := types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, fmt.Sprintf("channel-%d", i)))
+		classes = append(classes, class)
+		suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), class)
+	}
+
+	genesis := suite.chainA.GetSimApp().NFTTransferKeeper.ExportGenesis(suite.chainA.GetContext())
+
+	suite.Require().Equal(types.PortID, genesis.PortId)
+	suite.Require().Equal(classes.Sort(), genesis.Classes.Sort())
+	suite.Require().Equal(types.DefaultParams(), genesis.Params)
+
+	suite.Require().NotPanics(func() {
+		suite.chainA.GetSimApp().NFTTransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
+	})
+}
@@ -0,0 +1,239 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestQueryClass() {
+	var (
+		req      *types.QueryClassRequest
+		expClass types.Class
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success: correct ibc class",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, ibctesting.FirstChannelID))
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), expClass)
+
+				req = &types.QueryClassRequest{
+					Hash: expClass.ClassID(),
+				}
+			},
+			true,
+		},
+		{
+			"success: correct hex hash",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, ibctesting.FirstChannelID))
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), expClass)
+
+				req = &types.QueryClassRequest{
+					Hash: expClass.Hash().String(),
+				}
+			},
+			true,
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req = &types.QueryClassRequest{
+					Hash: "!@#!@#!",
+				}
+			},
+			false,
+		},
+		{
+			"failure: not found class trace",
+			func() {
+				expClass = types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, ibctesting.FirstChannelID))
+
+				req = &types.QueryClassRequest{
+					Hash: expClass.ClassID(),
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Class(ctx, req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(&expClass, res.Class)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryClasses() {
+	suite.SetupTest() // reset
+
+	expClasses := types.Classes{
+		types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-0")),
+		types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-1")),
+		types.NewClass("doggies", transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-0")),
+	}
+
+	for _, class := range expClasses {
+		suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), class)
+	}
+
+	res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Classes(suite.chainA.GetContext(), &types.QueryClassesRequest{})
+	suite.Require().NoError(err)
+	suite.Require().Equal(expClasses.Sort(), res.Classes)
+}
+
+func (suite *KeeperTestSuite) TestQueryClassHash() {
+	class := types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, ibctesting.FirstChannelID))
+
+	var req *types.QueryClassHashRequest
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {},
+			true,
+		},
+		{
+			"failure: invalid trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nfttransfer//kitties",
+				}
+			},
+			false,
+		},
+		{
+			"failure: not found class trace",
+			func() {
+				req = &types.QueryClassHashRequest{
+					Trace: "nfttransfer/channel-10/kitties",
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryClassHashRequest{
+				Trace: class.Path(),
+			}
+			suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), class)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.ClassHash(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(class.Hash().String(), res.Hash)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestEscrowAddress() {
+	var req *types.QueryEscrowAddressRequest
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{
+			"success",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    ibctesting.NFTTransferPort,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			true,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    ibctesting.NFTTransferPort,
+					ChannelId: ibctesting.InvalidID,
+				}
+			},
+			false,
+		},
+		{
+			"failure: invalid port ID",
+			func() {
+				req = &types.QueryEscrowAddressRequest{
+					PortId:    "",
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.name), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.EscrowAddress(suite.chainA.GetContext(), req)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+				expected := types.GetEscrowAddress(ibctesting.NFTTransferPort, path.EndpointA.ChannelID).String()
+				suite.Require().Equal(expected, res.EscrowAddress)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryParams() {
+	ctx := suite.chainA.GetContext()
+	expParams := types.DefaultParams()
+	res, _ := suite.chainA.GetSimApp().NFTTransferKeeper.Params(ctx, &types.QueryParamsRequest{})
+	suite.Require().Equal(&expParams, res.Params)
+}
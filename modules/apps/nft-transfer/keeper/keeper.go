@@ -0,0 +1,323 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v9/modules/core/05-port/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// Keeper defines the IBC non-fungible token transfer keeper
+type Keeper struct {
+	storeService corestore.KVStoreService
+	cdc          codec.BinaryCodec
+
+	ics4Wrapper   porttypes.ICS4Wrapper
+	channelKeeper types.ChannelKeeper
+	authKeeper    types.AccountKeeper
+	nftKeeper     types.NFTKeeper
+
+	// the address capable of executing a MsgUpdateParams message. Typically, this
+	// should be the x/gov module account.
+	authority string
+}
+
+// NewKeeper creates a new IBC nft-transfer Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService corestore.KVStoreService,
+	ics4Wrapper porttypes.ICS4Wrapper,
+	channelKeeper types.ChannelKeeper,
+	authKeeper types.AccountKeeper,
+	nftKeeper types.NFTKeeper,
+	authority string,
+) Keeper {
+	// ensure ibc nft-transfer module account is set
+	if addr := authKeeper.GetModuleAddress(types.ModuleName); addr == nil {
+		panic(errors.New("the IBC nft-transfer module account has not been set"))
+	}
+
+	if strings.TrimSpace(authority) == "" {
+		panic(errors.New("authority must be non-empty"))
+	}
+
+	return Keeper{
+		cdc:           cdc,
+		storeService:  storeService,
+		ics4Wrapper:   ics4Wrapper,
+		channelKeeper: channelKeeper,
+		authKeeper:    authKeeper,
+		nftKeeper:     nftKeeper,
+		authority:     authority,
+	}
+}
+
+// WithICS4Wrapper sets the ICS4Wrapper. This function may be used after
+// the keepers creation to set the middleware which is above this module
+// in the IBC application stack.
+func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
+	k.ics4Wrapper = wrapper
+}
+
+// GetICS4Wrapper returns the ICS4Wrapper.
+func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
+	return k.ics4Wrapper
+}
+
+// GetAuthority returns the nft-transfer module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
+// Logger returns a module-specific logger.
+func (Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	return sdkCtx.Logger().With("module", "x/"+exported.ModuleName+"-"+types.ModuleName)
+}
+
+// GetPort returns the portID for the nft-transfer module. Used in ExportGenesis
+func (k Keeper) GetPort(ctx context.Context) string {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PortKey)
+	if err != nil {
+		panic(err)
+	}
+	return string(bz)
+}
+
+// SetPort sets the portID for the nft-transfer module. Used in InitGenesis
+func (k Keeper) SetPort(ctx context.Context, portID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PortKey, []byte(portID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetParams returns the current nft-transfer module parameters.
+func (k Keeper) GetParams(ctx context.Context) types.Params {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.ParamsKey))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil { // only panic on unset params and not on empty params
+		panic(errors.New("nft-transfer params are not set in store"))
+	}
+
+	var params types.Params
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the nft-transfer module parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&params)
+	if err := store.Set([]byte(types.ParamsKey), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetClass retrieves the class trace from store given the hash of the class.
+func (k Keeper) GetClass(ctx context.Context, classHash cmtbytes.HexBytes) (types.Class, bool) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	bz := store.Get(classHash)
+	if len(bz) == 0 {
+		return types.Class{}, false
+	}
+
+	var class types.Class
+	k.cdc.MustUnmarshal(bz, &class)
+
+	return class, true
+}
+
+// HasClass checks if a the key with the given class hash exists on the store.
+func (k Keeper) HasClass(ctx context.Context, classHash cmtbytes.HexBytes) bool {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	return store.Has(classHash)
+}
+
+// SetClass sets a new {class hash -> class} pair to the store.
+// This allows for reverse lookup of the class given the hash.
+func (k Keeper) SetClass(ctx context.Context, class types.Class) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ClassKey)
+	bz := k.cdc.MustMarshal(&class)
+	store.Set(class.Hash(), bz)
+}
+
+// GetAllClasses returns all the class traces.
+func (k Keeper) GetAllClasses(ctx context.Context) types.Classes {
+	classes := types.Classes{}
+	k.IterateClasses(ctx, func(class types.Class) bool {
+		classes = append(classes, class)
+		return false
+	})
+
+	return classes.Sort()
+}
+
+// IterateClasses iterates over the class traces in the store and performs a callback function.
+func (k Keeper) IterateClasses(ctx context.Context, cb func(class types.Class) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ClassKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var class types.Class
+		k.cdc.MustUnmarshal(iterator.Value(), &class)
+
+		if cb(class) {
+			break
+		}
+	}
+}
+
+// setForwardedPacket sets the forwarded packet in the store.
+func (k Keeper) setForwardedPacket(ctx context.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&packet)
+	if err := store.Set(types.PacketForwardKey(portID, channelID, sequence), bz); err != nil {
+		panic(err)
+	}
+}
+
+// getForwardedPacket gets the forwarded packet from the store.
+func (k Keeper) getForwardedPacket(ctx context.Context, portID, channelID string, sequence uint64) (channeltypes.Packet, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketForwardKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if bz == nil {
+		return channeltypes.Packet{}, false
+	}
+
+	var storedPacket channeltypes.Packet
+	k.cdc.MustUnmarshal(bz, &storedPacket)
+
+	return storedPacket, true
+}
+
+// deleteForwardedPacket deletes the forwarded packet from the store.
+func (k Keeper) deleteForwardedPacket(ctx context.Context, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PacketForwardKey(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
+// getAllForwardedPackets gets all forward packets stored in state.
+func (k Keeper) getAllForwardedPackets(ctx context.Context) []transfertypes.ForwardedPacket {
+	var packets []transfertypes.ForwardedPacket
+	k.iterateForwardedPackets(ctx, func(packet transfertypes.ForwardedPacket) bool {
+		packets = append(packets, packet)
+		return false
+	})
+
+	return packets
+}
+
+// iterateForwardedPackets iterates over the forward packets in the store and performs a callback function.
+func (k Keeper) iterateForwardedPackets(ctx context.Context, cb func(packet transfertypes.ForwardedPacket) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ForwardedPacketKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		forwardPacket, err := k.parseForwardedPacket(iterator.Key(), iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(forwardPacket) {
+			break
+		}
+	}
+}
+
+// parseForwardedPacket parses a forwarded packet from its store key and value.
+// The key consists of types.ForwardedPacketKey/portID/channelID/sequence.
+func (k Keeper) parseForwardedPacket(key, value []byte) (transfertypes.ForwardedPacket, error) {
+	// the big endian encoded sequence may contain slashes, hence it is taken from the end of the key
+	if len(key) < 8 {
+		return transfertypes.ForwardedPacket{}, fmt.Errorf("key path should always end with the packet sequence")
+	}
+	sequence := sdk.BigEndianToUint64(key[len(key)-8:])
+
+	parts := strings.Split(string(key[:len(key)-8]), "/")
+	if len(parts) != 4 || parts[3] != "" {
+		return transfertypes.ForwardedPacket{}, fmt.Errorf("key path should always have 4 elements")
+	}
+	if parts[0] != string(types.ForwardedPacketKey) {
+		return transfertypes.ForwardedPacket{}, fmt.Errorf("key path does not start with expected prefix: %s", types.ForwardedPacketKey)
+	}
+
+	portID, channelID := parts[1], parts[2]
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return transfertypes.ForwardedPacket{}, fmt.Errorf("port identifier validation failed while parsing forward key path")
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return transfertypes.ForwardedPacket{}, fmt.Errorf("channel identifier validation failed while parsing forward key path")
+	}
+
+	var packet channeltypes.Packet
+	if err := k.cdc.Unmarshal(value, &packet); err != nil {
+		return transfertypes.ForwardedPacket{}, err
+	}
+
+	return transfertypes.ForwardedPacket{
+		ForwardKey: channeltypes.NewPacketID(portID, channelID, sequence),
+		Packet:     packet,
+	}, nil
+}
+
+// classFromID returns the class trace of the class with the provided identifier on this chain.
+// Classes which are not in the format 'ibc/{hash}' are native to this chain.
+func (k Keeper) classFromID(ctx context.Context, classID string) (types.Class, error) {
+	if !strings.HasPrefix(classID, types.ClassPrefix+"/") {
+		return types.NewClass(classID), nil
+	}
+
+	hexHash := classID[len(types.ClassPrefix+"/"):]
+
+	hash, err := transfertypes.ParseHexHash(hexHash)
+	if err != nil {
+		return types.Class{}, fmt.Errorf("%w: %s", types.ErrInvalidClassForTransfer, err.Error())
+	}
+
+	class, found := k.GetClass(ctx, hash)
+	if !found {
+		return types.Class{}, fmt.Errorf("%w: %s", types.ErrClassNotFound, hexHash)
+	}
+
+	return class, nil
+}
+
+// GetChannelKeeper returns the channel keeper used by the nft-transfer keeper.
+func (k Keeper) GetChannelKeeper() types.ChannelKeeper {
+	return k.channelKeeper
+}
+
+// GetNFTKeeper returns the keeper of the non-fungible tokens used by the nft-transfer keeper.
+func (k Keeper) GetNFTKeeper() types.NFTKeeper {
+	return k.nftKeeper
+}
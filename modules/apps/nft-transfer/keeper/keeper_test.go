@@ -0,0 +1,160 @@
+package keeper_test
+
+import (
+	"testing"
+
+	testifysuite "github.com/stretchr/testify/suite"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	authkeeper "github.com/cosmos/cosmos-sdk/x/auth/keeper"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+const (
+	classID  = "kitties"
+	classURI = "https://kitties.example/class"
+	tokenURI = "https://kitties.example/token"
+)
+
+var tokenIDs = []string{"kitty-1", "kitty-2"}
+
+type KeeperTestSuite struct {
+	testifysuite.Suite
+
+	coordinator *ibctesting.Coordinator
+
+	// testing chains used for convenience and readability
+	chainA *ibctesting.TestChain
+	chainB *ibctesting.TestChain
+	chainC *ibctesting.TestChain
+}
+
+func (suite *KeeperTestSuite) SetupTest() {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+	suite.chainA = suite.coordinator.GetChain(ibctesting.GetChainID(1))
+	suite.chainB = suite.coordinator.GetChain(ibctesting.GetChainID(2))
+	suite.chainC = suite.coordinator.GetChain(ibctesting.GetChainID(3))
+
+	queryHelper := baseapp.NewQueryServerTestHelper(suite.chainA.GetContext(), suite.chainA.GetSimApp().InterfaceRegistry())
+	types.RegisterQueryServer(queryHelper, suite.chainA.GetSimApp().NFTTransferKeeper)
+}
+
+func TestKeeperTestSuite(t *testing.T) {
+	testifysuite.Run(t, new(KeeperTestSuite))
+}
+
+// mintNFTs creates the native class on the provided chain and mints the test tokens to its sender account.
+func (suite *KeeperTestSuite) mintNFTs(chain *ibctesting.TestChain) {
+	ctx := chain.GetContext()
+	nftKeeper := chain.GetSimApp().MockNFTKeeper
+
+	suite.Require().NoError(nftKeeper.SaveClass(ctx, classID, classURI, ""))
+	for _, tokenID := range tokenIDs {
+		suite.Require().NoError(nftKeeper.Mint(ctx, classID, tokenID, tokenURI, "", chain.SenderAccount.GetAddress()))
+	}
+}
+
+func (suite *KeeperTestSuite) TestNewKeeper() {
+	testCases := []struct {
+		name          string
+		instantiateFn func()
+		panicMsg      string
+	}{
+		{"success", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().AccountKeeper,
+				suite.chainA.GetSimApp().MockNFTKeeper,
+				suite.chainA.GetSimApp().NFTTransferKeeper.GetAuthority(),
+			)
+		}, ""},
+		{"failure: nft-transfer module account does not exist", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				authkeeper.AccountKeeper{}, // empty account keeper
+				suite.chainA.GetSimApp().MockNFTKeeper,
+				suite.chainA.GetSimApp().NFTTransferKeeper.GetAuthority(),
+			)
+		}, "the IBC nft-transfer module account has not been set"},
+		{"failure: empty authority", func() {
+			keeper.NewKeeper(
+				suite.chainA.GetSimApp().AppCodec(),
+				runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(types.StoreKey)),
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper,
+				suite.chainA.GetSimApp().AccountKeeper,
+				ibcmock.NewNFTKeeper(runtime.NewKVStoreService(suite.chainA.GetSimApp().GetKey(ibcmock.NFTStoreKey))),
+				"", // authority
+			)
+		}, "authority must be non-empty"},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.SetupTest()
+
+		suite.Run(tc.name, func() {
+			if tc.panicMsg == "" {
+				suite.Require().NotPanics(
+					tc.instantiateFn,
+				)
+			} else {
+				suite.Require().PanicsWithError(
+					tc.panicMsg,
+					tc.instantiateFn,
+				)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestSetGetClass() {
+	ctx := suite.chainA.GetContext()
+	nftTransferKeeper := suite.chainA.GetSimApp().NFTTransferKeeper
+
+	class := types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, ibctesting.FirstChannelID))
+	suite.Require().False(nftTransferKeeper.HasClass(ctx, class.Hash()))
+
+	nftTransferKeeper.SetClass(ctx, class)
+	suite.Require().True(nftTransferKeeper.HasClass(ctx, class.Hash()))
+
+	storedClass, found := nftTransferKeeper.GetClass(ctx, class.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(class, storedClass)
+	suite.Require().Equal(types.Classes{class}, nftTransferKeeper.GetAllClasses(ctx))
+}
+
+func (suite *KeeperTestSuite) TestParams() {
+	testCases := []struct {
+		name  string
+		input types.Params
+	}{
+		{"success: set default params", types.DefaultParams()},
+		{"success: disable send and receive", types.NewParams(false, false)},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			ctx := suite.chainA.GetContext()
+
+			suite.chainA.GetSimApp().NFTTransferKeeper.SetParams(ctx, tc.input)
+			suite.Require().Equal(tc.input, suite.chainA.GetSimApp().NFTTransferKeeper.GetParams(ctx))
+		})
+	}
+}
@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ types.MsgServer = (*Keeper)(nil)
+
+// Transfer defines an rpc handler method for MsgTransfer.
+func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, err
+	}
+
+	if msg.Forwarding.GetUnwind() {
+		msg, err = k.unwindHops(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	sequence, err := k.sendTransfer(
+		ctx, msg.SourcePort, msg.SourceChannel, msg.ClassId, msg.TokenIds, sender, msg.Receiver,
+		msg.TimeoutHeight, msg.TimeoutTimestamp, msg.Memo, msg.Forwarding.GetHops())
+	if err != nil {
+		return nil, err
+	}
+
+	k.Logger(ctx).Info("IBC non-fungible token transfer", "class", msg.ClassId, "tokens", msg.TokenIds, "sender", msg.Sender, "receiver", msg.Receiver)
+
+	return &types.MsgTransferResponse{Sequence: sequence}, nil
+}
+
+// UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc nft-transfer module's parameters.
+func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.SetParams(ctx, msg.Params)
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// unwindHops unwinds the hops present in the trace of the class and returns the message modified to reflect
+// the unwound path to take. It ensures that the class is not native to the chain.
+func (k Keeper) unwindHops(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgTransfer, error) {
+	class, err := k.classFromID(ctx, msg.ClassId)
+	if err != nil {
+		return nil, err
+	}
+
+	if class.IsNative() {
+		return nil, errorsmod.Wrap(types.ErrInvalidForwarding, "cannot unwind a native class")
+	}
+
+	// Update message fields.
+	msg.SourcePort, msg.SourceChannel = class.Trace[0].PortId, class.Trace[0].ChannelId
+	msg.Forwarding.Hops = append(class.Trace[1:], msg.Forwarding.Hops...)
+	msg.Forwarding.Unwind = false
+
+	// Message is validate again, this would only fail if hops now exceeds maximum allowed.
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
@@ -0,0 +1,117 @@
+package keeper_test
+
+import (
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestUpdateParams tests UpdateParams rpc handler
+func (suite *KeeperTestSuite) TestUpdateParams() {
+	signer := suite.chainA.GetSimApp().NFTTransferKeeper.GetAuthority()
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{
+			"success: valid signer and default params",
+			types.NewMsgUpdateParams(signer, types.DefaultParams()),
+			nil,
+		},
+		{
+			"failure: invalid signer address",
+			types.NewMsgUpdateParams("signer", types.DefaultParams()),
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+			_, err := suite.chainA.GetSimApp().NFTTransferKeeper.UpdateParams(suite.chainA.GetContext(), tc.msg)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestUnwindTransfer tests that tokens are sent back along the path they arrived on when unwind is set.
+func (suite *KeeperTestSuite) TestUnwindTransfer() {
+	var msg *types.MsgTransfer
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: native class cannot be unwound",
+			func() {
+				msg.ClassId = classID
+			},
+			types.ErrInvalidForwarding,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// receive vouchers on chainA from chainB
+			voucherClass := types.NewClass(classID, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			suite.chainA.GetSimApp().NFTTransferKeeper.SetClass(suite.chainA.GetContext(), voucherClass)
+			nftKeeper := suite.chainA.GetSimApp().MockNFTKeeper
+			suite.Require().NoError(nftKeeper.SaveClass(suite.chainA.GetContext(), voucherClass.ClassID(), classURI, ""))
+			suite.Require().NoError(nftKeeper.SaveClass(suite.chainA.GetContext(), classID, classURI, ""))
+			for _, tokenID := range tokenIDs {
+				suite.Require().NoError(nftKeeper.Mint(suite.chainA.GetContext(), voucherClass.ClassID(), tokenID, tokenURI, "", suite.chainA.SenderAccount.GetAddress()))
+				suite.Require().NoError(nftKeeper.Mint(suite.chainA.GetContext(), classID, tokenID, tokenURI, "", suite.chainA.SenderAccount.GetAddress()))
+			}
+
+			msg = types.NewMsgTransfer(
+				"", "",
+				voucherClass.ClassID(), tokenIDs,
+				suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(),
+				"", transfertypes.NewForwarding(true),
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				// the vouchers are burned as they are returned to their source chain
+				for _, tokenID := range tokenIDs {
+					_, found := nftKeeper.GetNFT(suite.chainA.GetContext(), voucherClass.ClassID(), tokenID)
+					suite.Require().False(found)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
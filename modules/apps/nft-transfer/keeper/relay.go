@@ -0,0 +1,339 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// sendTransfer handles non-fungible token transfer sending logic. As for fungible token transfers,
+// there are 2 possible cases:
+//
+// 1. Sender chain is acting as the source zone. The tokens are transferred to the escrow address
+// of the source channel end (i.e locked) on the sender chain and then transferred to the receiving
+// chain through IBC TAO logic. It is expected that the receiving chain will mint vouchers of the
+// tokens to the receiving address, under a class with the destination hop prepended to its trace.
+//
+// 2. Sender chain is acting as the sink zone. The tokens (vouchers) are burned on the sender chain
+// and then transferred to the receiving chain though IBC TAO logic. It is expected that the receiving
+// chain, which had previously sent the original tokens, will unescrow them and send them to the
+// receiving address.
+func (k Keeper) sendTransfer(
+	ctx context.Context,
+	sourcePort,
+	sourceChannel string,
+	classID string,
+	tokenIDs []string,
+	sender sdk.AccAddress,
+	receiver string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	memo string,
+	hops []transfertypes.Hop,
+) (uint64, error) {
+	if _, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel); !found {
+		return 0, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", sourcePort, sourceChannel)
+	}
+
+	if !k.GetParams(ctx).SendEnabled {
+		return 0, types.ErrSendDisabled
+	}
+
+	class, err := k.classFromID(ctx, classID)
+	if err != nil {
+		return 0, err
+	}
+
+	nftClass, found := k.nftKeeper.GetClass(ctx, classID)
+	if !found {
+		return 0, errorsmod.Wrapf(types.ErrClassNotFound, "class %s", classID)
+	}
+
+	tokenURIs := make([]string, 0, len(tokenIDs))
+	tokenData := make([]string, 0, len(tokenIDs))
+	for _, tokenID := range tokenIDs {
+		nft, found := k.nftKeeper.GetNFT(ctx, classID, tokenID)
+		if !found {
+			return 0, errorsmod.Wrapf(types.ErrNFTNotFound, "class %s token %s", classID, tokenID)
+		}
+
+		if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !sender.Equals(owner) {
+			return 0, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not the owner of token %s of class %s", sender, tokenID, classID)
+		}
+
+		// if the class is prefixed by the port and channel on which we are sending
+		// the token, then we must be returning the token back to the chain it originated from
+		if class.HasPrefix(sourcePort, sourceChannel) {
+			if err := k.nftKeeper.Burn(ctx, classID, tokenID); err != nil {
+				return 0, err
+			}
+		} else {
+			// escrow the token for the source channel end
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, types.GetEscrowAddress(sourcePort, sourceChannel)); err != nil {
+				return 0, err
+			}
+		}
+
+		tokenURIs = append(tokenURIs, nft.GetURI())
+		tokenData = append(tokenData, nft.GetData())
+	}
+
+	// NOTE: sendTransfer simply sends the class as it exists on its own chain inside the packet data.
+	// The receiving chain will perform class prefixing as necessary.
+	packetData := types.NewNonFungibleTokenPacketData(
+		class.Path(), nftClass.GetURI(), nftClass.GetData(),
+		tokenIDs, tokenURIs, tokenData,
+		sender.String(), receiver, memo,
+		transfertypes.ForwardingPacketData{Hops: hops},
+	)
+
+	if err := packetData.ValidateBasic(); err != nil {
+		return 0, errorsmod.Wrapf(err, "failed to validate %s packet data", types.Version)
+	}
+
+	sequence, err := k.ics4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, packetData.GetBytes())
+	if err != nil {
+		return 0, err
+	}
+
+	events.EmitTransferEvent(ctx, sender.String(), receiver, classID, tokenIDs, memo, hops)
+
+	return sequence, nil
+}
+
+// OnRecvPacket processes a cross chain non-fungible token transfer.
+//
+// If the sender chain is the source of the tokens then vouchers will be minted, under a class
+// prefixed by the destination port and channel, and sent to the receiving address. Otherwise if
+// the sender chain is sending back tokens this chain originally transferred to it, the tokens
+// are unescrowed and sent to the receiving address.
+//
+// In the case of packet forwarding, the tokens are sent on the next hop as specified in the
+// packet's ForwardingPacketData.
+func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// validate packet data upon receiving
+	if err := data.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(err, "error validating ICS-721 transfer packet data")
+	}
+
+	if !k.GetParams(ctx).ReceiveEnabled {
+		return types.ErrReceiveDisabled
+	}
+
+	receiver, err := k.getReceiverFromPacketData(data)
+	if err != nil {
+		return err
+	}
+
+	class := types.ExtractClassFromPath(data.ClassId)
+
+	// This is the prefix that would have been prefixed to the class on sender chain IF and only
+	// if the tokens originally came from the receiving chain.
+	//
+	// NOTE: We use SourcePort and SourceChannel here, because the counterparty chain would have
+	// prefixed with DestPort and DestChannel when originally receiving the tokens.
+	if class.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+		// sender chain is not the source, unescrow tokens
+
+		// remove prefix added by sender chain
+		class.Trace = class.Trace[1:]
+
+		classID := class.ClassID()
+		escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		for _, tokenID := range data.TokenIds {
+			if owner := k.nftKeeper.GetOwner(ctx, classID, tokenID); !escrowAddress.Equals(owner) {
+				// NOTE: this error is only expected to occur given an unexpected bug or a malicious
+				// counterparty module sending back tokens which were not escrowed for the channel end.
+				return errorsmod.Wrapf(types.ErrNFTNotFound, "token %s of class %s is not in escrow for port %s and channel %s", tokenID, classID, packet.GetDestPort(), packet.GetDestChannel())
+			}
+
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, receiver); err != nil {
+				return errorsmod.Wrap(err, "unable to unescrow token")
+			}
+		}
+	} else {
+		// sender chain is the source, mint vouchers
+
+		// since SendPacket did not prefix the class, we must add the destination port and channel to the trace
+		trace := []transfertypes.Hop{transfertypes.NewHop(packet.DestinationPort, packet.DestinationChannel)}
+		class.Trace = append(trace, class.Trace...)
+
+		if !k.HasClass(ctx, class.Hash()) {
+			k.SetClass(ctx, class)
+		}
+
+		classID := class.ClassID()
+		if _, found := k.nftKeeper.GetClass(ctx, classID); !found {
+			if err := k.nftKeeper.SaveClass(ctx, classID, data.ClassUri, data.ClassData); err != nil {
+				return errorsmod.Wrap(err, "failed to save IBC class")
+			}
+		}
+
+		events.EmitClassEvent(ctx, class)
+
+		for i, tokenID := range data.TokenIds {
+			if err := k.nftKeeper.Mint(ctx, classID, tokenID, data.GetTokenURI(i), data.TokenDataAt(i), receiver); err != nil {
+				return errorsmod.Wrap(err, "failed to mint IBC tokens")
+			}
+		}
+	}
+
+	if data.HasForwarding() {
+		// we are now sending from the forward escrow address to the final receiver address.
+		if err := k.forwardPacket(ctx, data, packet, class.ClassID()); err != nil {
+			return err
+		}
+	}
+
+	// The ibc_module.go module will return the proper ack.
+	return nil
+}
+
+// OnAcknowledgementPacket responds to the success or failure of a packet acknowledgment
+// written on the receiving chain.
+//
+// If no forwarding occurs and the acknowledgement was a success then nothing occurs. Otherwise,
+// if the acknowledgement failed, then the sender is refunded their tokens.
+//
+// If forwarding is used and the acknowledgement was a success, a successful acknowledgement is written
+// for the forwarded packet. Otherwise, if the acknowledgement failed, after refunding the sender, the
+// tokens of the forwarded packet that were received are in turn refunded or burned.
+func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData, ack channeltypes.Acknowledgement) error {
+	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+
+	switch ack.Response.(type) {
+	case *channeltypes.Acknowledgement_Result:
+		if isForwarded {
+			// Write a successful async ack for the forwardedPacket
+			return k.acknowledgeForwardedPacket(ctx, forwardedPacket, packet, ack)
+		}
+
+		// the acknowledgement succeeded on the receiving chain so nothing
+		// needs to be executed and no error needs to be returned
+		return nil
+	case *channeltypes.Acknowledgement_Error:
+		// We refund the tokens from the escrow address to the sender
+		if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+			return err
+		}
+		if isForwarded {
+			// the forwarded packet has failed, thus the tokens have been refunded to the intermediate address.
+			// we must revert the changes that came from successfully receiving the tokens on our chain
+			// before propagating the error acknowledgement back to original sender chain
+			if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+				return err
+			}
+
+			forwardAck := newForwardErrorAcknowledgement(packet, ack)
+			return k.acknowledgeForwardedPacket(ctx, forwardedPacket, packet, forwardAck)
+		}
+
+		return nil
+	default:
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected one of [%T, %T], got %T", channeltypes.Acknowledgement_Result{}, channeltypes.Acknowledgement_Error{}, ack.Response)
+	}
+}
+
+// OnTimeoutPacket processes a non-fungible token transfer packet timeout.
+//
+// If no forwarding occurs, it refunds the tokens to the sender.
+//
+// If forwarding is used and the chain acted as a middle hop on a multihop transfer, after refunding
+// the tokens to the sender, the tokens of the forwarded packet that were received are in turn refunded
+// or burned.
+func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
+		return err
+	}
+
+	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	if isForwarded {
+		if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
+			return err
+		}
+
+		forwardAck := newForwardTimeoutAcknowledgement(packet)
+		return k.acknowledgeForwardedPacket(ctx, forwardedPacket, packet, forwardAck)
+	}
+
+	return nil
+}
+
+// refundPacketTokens will unescrow and send back the tokens back to sender
+// if the sending chain was the source chain. Otherwise, the sent tokens
+// were burnt in the original send so new tokens are minted and sent to
+// the sending address.
+func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Packet, data types.NonFungibleTokenPacketData) error {
+	// NOTE: packet data type already checked in handler.go
+
+	sender, err := sdk.AccAddressFromBech32(data.Sender)
+	if err != nil {
+		return err
+	}
+
+	class := types.ExtractClassFromPath(data.ClassId)
+	classID := class.ClassID()
+
+	for i, tokenID := range data.TokenIds {
+		// if the class of the tokens we must refund is prefixed by the source port and channel
+		// then the tokens were burnt when the packet was sent and we must mint new tokens
+		if class.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
+			if err := k.nftKeeper.Mint(ctx, classID, tokenID, data.GetTokenURI(i), data.TokenDataAt(i), sender); err != nil {
+				return err
+			}
+		} else {
+			// unescrow the token back to sender from the source channel end
+			if err := k.nftKeeper.Transfer(ctx, classID, tokenID, sender); err != nil {
+				return errorsmod.Wrap(err, "unable to unescrow token")
+			}
+		}
+	}
+
+	return nil
+}
+
+// getReceiverFromPacketData returns either the receiver specified in the packet data or the forwarding
+// address if there are still hops left to perform.
+func (k Keeper) getReceiverFromPacketData(data types.NonFungibleTokenPacketData) (sdk.AccAddress, error) {
+	if data.HasForwarding() {
+		// since data.Receiver can potentially be a non-CosmosSDK AccAddress, we return early if the packet should be forwarded
+		return k.authKeeper.GetModuleAddress(types.ModuleName), nil
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(data.Receiver)
+	if err != nil {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "failed to decode receiver address %s: %v", data.Receiver, err)
+	}
+
+	return receiver, nil
+}
+
+// newForwardErrorAcknowledgement returns a new error acknowledgement with path forwarding information.
+func newForwardErrorAcknowledgement(packet channeltypes.Packet, ack channeltypes.Acknowledgement) channeltypes.Acknowledgement {
+	ackErr := fmt.Sprintf("forwarding packet failed on %s/%s: %s", packet.GetSourcePort(), packet.GetSourceChannel(), ack.GetError())
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: ackErr,
+		},
+	}
+}
+
+// newForwardTimeoutAcknowledgement returns a new error acknowledgement with path forwarding information.
+func newForwardTimeoutAcknowledgement(packet channeltypes.Packet) channeltypes.Acknowledgement {
+	ackErr := fmt.Sprintf("forwarding packet timed out on %s/%s", packet.GetSourcePort(), packet.GetSourceChannel())
+	return channeltypes.Acknowledgement{
+		Response: &channeltypes.Acknowledgement_Error{
+			Error: ackErr,
+		},
+	}
+}
@@ -0,0 +1,316 @@
+package keeper_test
+
+import (
+	"errors"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestSendTransfer tests sending a non-fungible token transfer from chainA.
+func (suite *KeeperTestSuite) TestSendTransfer() {
+	var (
+		path *ibctesting.Path
+		msg  *types.MsgTransfer
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: source chain escrows tokens",
+			func() {},
+			nil,
+		},
+		{
+			"failure: send disabled",
+			func() {
+				suite.chainA.GetSimApp().NFTTransferKeeper.SetParams(suite.chainA.GetContext(), types.NewParams(false, true))
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.SourceChannel = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: class not found",
+			func() {
+				msg.ClassId = "doggies"
+			},
+			types.ErrClassNotFound,
+		},
+		{
+			"failure: ibc class trace not found",
+			func() {
+				msg.ClassId = types.NewClass(classID, transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-10")).ClassID()
+			},
+			types.ErrClassNotFound,
+		},
+		{
+			"failure: token not found",
+			func() {
+				msg.TokenIds = []string{"kitty-100"}
+			},
+			types.ErrNFTNotFound,
+		},
+		{
+			"failure: sender is not the owner",
+			func() {
+				msg.Sender = suite.chainA.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			suite.mintNFTs(suite.chainA)
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				classID, tokenIDs,
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"", nil,
+			)
+
+			tc.malleate()
+
+			res, err := suite.chainA.GetSimApp().NFTTransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				for _, tokenID := range tokenIDs {
+					owner := suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID)
+					suite.Require().Equal(escrowAddress, owner)
+				}
+			} else {
+				suite.Require().Error(err)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError, err.Error())
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestRoundTrip transfers tokens from chainA to chainB and then back to chainA, asserting that
+// vouchers are minted on chainB and burned again when the tokens are returned.
+func (suite *KeeperTestSuite) TestRoundTrip() {
+	path := ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	suite.mintNFTs(suite.chainA)
+
+	senderA := suite.chainA.SenderAccount.GetAddress()
+	receiverB := suite.chainB.SenderAccount.GetAddress()
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		classID, tokenIDs,
+		senderA.String(), receiverB.String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"", nil,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucherClass := types.NewClass(classID, transfertypes.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	voucherClassID := voucherClass.ClassID()
+
+	storedClass, found := suite.chainB.GetSimApp().NFTTransferKeeper.GetClass(suite.chainB.GetContext(), voucherClass.Hash())
+	suite.Require().True(found)
+	suite.Require().Equal(voucherClass, storedClass)
+
+	nftClass, found := suite.chainB.GetSimApp().MockNFTKeeper.GetClass(suite.chainB.GetContext(), voucherClassID)
+	suite.Require().True(found)
+	suite.Require().Equal(classURI, nftClass.GetURI())
+
+	for _, tokenID := range tokenIDs {
+		nft, found := suite.chainB.GetSimApp().MockNFTKeeper.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+		suite.Require().True(found)
+		suite.Require().Equal(tokenURI, nft.GetURI())
+		suite.Require().Equal(receiverB, suite.chainB.GetSimApp().MockNFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassID, tokenID))
+	}
+
+	// send the vouchers back to chainA
+	msg = types.NewMsgTransfer(
+		path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID,
+		voucherClassID, tokenIDs,
+		receiverB.String(), senderA.String(),
+		suite.chainA.GetTimeoutHeight(), 0,
+		"", nil,
+	)
+
+	res, err = suite.chainB.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	for _, tokenID := range tokenIDs {
+		_, found := suite.chainB.GetSimApp().MockNFTKeeper.GetNFT(suite.chainB.GetContext(), voucherClassID, tokenID)
+		suite.Require().False(found, "voucher should be burned")
+		suite.Require().Equal(senderA, suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+	}
+}
+
+// TestForwarding transfers tokens from chainA to chainC through chainB using a single MsgTransfer.
+func (suite *KeeperTestSuite) TestForwarding() {
+	pathAToB := ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+	pathBToC := ibctesting.NewNFTTransferPath(suite.chainB, suite.chainC)
+	pathBToC.Setup()
+
+	suite.mintNFTs(suite.chainA)
+
+	receiverC := suite.chainC.SenderAccount.GetAddress()
+
+	msg := types.NewMsgTransfer(
+		pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID,
+		classID, tokenIDs,
+		suite.chainA.SenderAccount.GetAddress().String(), receiverC.String(),
+		clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(),
+		"", transfertypes.NewForwarding(false, transfertypes.NewHop(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID)),
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathAToB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	res, err = pathAToB.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+
+	// the vouchers on chainB are escrowed for the channel to chainC
+	voucherClassB := types.NewClass(classID, transfertypes.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID))
+	escrowAddressB := types.GetEscrowAddress(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID)
+	for _, tokenID := range tokenIDs {
+		suite.Require().Equal(escrowAddressB, suite.chainB.GetSimApp().MockNFTKeeper.GetOwner(suite.chainB.GetContext(), voucherClassB.ClassID(), tokenID))
+	}
+
+	packet, err = ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	err = pathBToC.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	voucherClassC := types.NewClass(classID,
+		transfertypes.NewHop(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID),
+		transfertypes.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID),
+	)
+	for _, tokenID := range tokenIDs {
+		suite.Require().Equal(receiverC, suite.chainC.GetSimApp().MockNFTKeeper.GetOwner(suite.chainC.GetContext(), voucherClassC.ClassID(), tokenID))
+	}
+}
+
+// TestOnAcknowledgementPacket tests that the tokens are refunded to the sender upon an error acknowledgement.
+func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
+	testCases := []struct {
+		name      string
+		ack       channeltypes.Acknowledgement
+		expRefund bool
+	}{
+		{"success: successful acknowledgement", channeltypes.NewResultAcknowledgement([]byte{byte(1)}), false},
+		{"success: error acknowledgement refunds the sender", channeltypes.NewErrorAcknowledgement(errors.New("failed packet transfer")), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packet, data := suite.sendNFTs(path)
+
+			err := suite.chainA.GetSimApp().NFTTransferKeeper.OnAcknowledgementPacket(suite.chainA.GetContext(), packet, data, tc.ack)
+			suite.Require().NoError(err)
+
+			expOwner := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expRefund {
+				expOwner = suite.chainA.SenderAccount.GetAddress()
+			}
+
+			for _, tokenID := range tokenIDs {
+				suite.Require().Equal(expOwner, suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+			}
+		})
+	}
+}
+
+// TestOnTimeoutPacket tests that the tokens are refunded to the sender upon a packet timeout.
+func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
+	path := ibctesting.NewNFTTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	packet, data := suite.sendNFTs(path)
+
+	err := suite.chainA.GetSimApp().NFTTransferKeeper.OnTimeoutPacket(suite.chainA.GetContext(), packet, data)
+	suite.Require().NoError(err)
+
+	for _, tokenID := range tokenIDs {
+		suite.Require().Equal(suite.chainA.SenderAccount.GetAddress(), suite.chainA.GetSimApp().MockNFTKeeper.GetOwner(suite.chainA.GetContext(), classID, tokenID))
+	}
+}
+
+// sendNFTs mints and sends the test tokens from chainA to chainB, returning the sent packet and its data.
+func (suite *KeeperTestSuite) sendNFTs(path *ibctesting.Path) (channeltypes.Packet, types.NonFungibleTokenPacketData) {
+	suite.mintNFTs(suite.chainA)
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID,
+		classID, tokenIDs,
+		suite.chainA.SenderAccount.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"", nil,
+	)
+
+	res, err := suite.chainA.SendMsgs(msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(res.Events)
+	suite.Require().NoError(err)
+
+	data, err := types.UnmarshalPacketData(packet.GetData())
+	suite.Require().NoError(err)
+
+	return packet, data
+}
@@ -0,0 +1,43 @@
+package simulation
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/types/kv"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// NewDecodeStore returns a decoder function closure that unmarshals the KVPair's
+// Value to the corresponding nft-transfer type.
+func NewDecodeStore() func(kvA, kvB kv.Pair) string {
+	return func(kvA, kvB kv.Pair) string {
+		switch {
+		case bytes.Equal(kvA.Key, []byte(types.ParamsKey)):
+			var paramsA, paramsB types.Params
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &paramsA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &paramsB)
+			return fmt.Sprintf("Params A: %s\nParams B: %s", paramsA.String(), paramsB.String())
+
+		case bytes.Equal(kvA.Key[:1], types.PortKey):
+			return fmt.Sprintf("Port A: %s\nPort B: %s", string(kvA.Value), string(kvB.Value))
+
+		case bytes.Equal(kvA.Key[:1], types.ClassKey):
+			var classA, classB types.Class
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &classA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &classB)
+			return fmt.Sprintf("Class A: %s\nClass B: %s", classA.ClassID(), classB.ClassID())
+
+		case bytes.Equal(kvA.Key[:1], types.ForwardedPacketKey):
+			var packetA, packetB channeltypes.Packet
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &packetA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &packetB)
+			return fmt.Sprintf("ForwardedPacket A: %s\nForwardedPacket B: %s", packetA.String(), packetB.String())
+
+		default:
+			panic(fmt.Errorf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
+		}
+	}
+}
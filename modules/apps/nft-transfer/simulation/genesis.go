@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+)
+
+// Simulation parameter constants
+const (
+	port           = "port_id"
+	sendEnabled    = "send_enabled"
+	receiveEnabled = "receive_enabled"
+)
+
+// RandomEnabled randomized send or receive enabled param with 75% prob of being true.
+func RandomEnabled(r *rand.Rand) bool {
+	return r.Int63n(101) <= 75
+}
+
+// RandomizedGenState generates a random GenesisState for nft-transfer.
+func RandomizedGenState(simState *module.SimulationState) {
+	var portID string
+	simState.AppParams.GetOrGenerate(
+		port, &portID, simState.Rand,
+		func(r *rand.Rand) { portID = strings.ToLower(simtypes.RandStringOfLength(r, 20)) },
+	)
+
+	var send bool
+	simState.AppParams.GetOrGenerate(
+		sendEnabled, &send, simState.Rand,
+		func(r *rand.Rand) { send = RandomEnabled(r) },
+	)
+
+	var receive bool
+	simState.AppParams.GetOrGenerate(
+		receiveEnabled, &receive, simState.Rand,
+		func(r *rand.Rand) { receive = RandomEnabled(r) },
+	)
+
+	nftTransferGenesis := types.GenesisState{
+		PortId:  portID,
+		Classes: types.Classes{},
+		Params:  types.NewParams(send, receive),
+	}
+
+	bz, err := json.MarshalIndent(&nftTransferGenesis, "", " ")
+	if err != nil {
+		panic(err)
+	}
+	fmt.Printf("Selected randomly generated %s parameters:\n%s\n", types.ModuleName, bz)
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(&nftTransferGenesis)
+}
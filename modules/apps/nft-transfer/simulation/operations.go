@@ -0,0 +1,116 @@
+package simulation
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/client"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// Simulation operation weights constants
+const (
+	OpWeightMsgTransfer = "op_weight_msg_nft_transfer" //nolint:gosec
+
+	DefaultWeightMsgTransfer = 100
+)
+
+// AccountKeeper defines the account keeper used to deliver the transactions of the simulation operations.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// BankKeeper defines the bank keeper used to deliver the transactions of the simulation operations.
+type BankKeeper interface {
+	SpendableCoins(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+}
+
+// WeightedOperations returns all the operations from the nft-transfer module with their respective weights.
+func WeightedOperations(appParams simtypes.AppParams, txGen client.TxConfig, k keeper.Keeper, ak AccountKeeper, bk BankKeeper) simulation.WeightedOperations {
+	var weightMsgTransfer int
+	appParams.GetOrGenerate(OpWeightMsgTransfer, &weightMsgTransfer, nil,
+		func(_ *rand.Rand) { weightMsgTransfer = DefaultWeightMsgTransfer },
+	)
+
+	return simulation.WeightedOperations{
+		simulation.NewWeightedOperation(
+			weightMsgTransfer,
+			SimulateMsgTransfer(txGen, k, ak, bk),
+		),
+	}
+}
+
+// SimulateMsgTransfer generates a MsgTransfer sending a token owned by a random account over a random
+// open nft-transfer channel. A no-op message is returned if there is no such channel or token.
+func SimulateMsgTransfer(txGen client.TxConfig, k keeper.Keeper, ak AccountKeeper, bk BankKeeper) simtypes.Operation {
+	return func(
+		r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
+	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+		msgType := sdk.MsgTypeURL(&types.MsgTransfer{})
+
+		if !k.GetParams(ctx).SendEnabled {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "send is disabled"), nil, nil
+		}
+
+		var channels []channeltypes.IdentifiedChannel
+		for _, channel := range k.GetChannelKeeper().GetAllChannelsWithPortPrefix(ctx, k.GetPort(ctx)) {
+			if channel.State == channeltypes.OPEN {
+				channels = append(channels, channel)
+			}
+		}
+
+		if len(channels) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no open channel"), nil, nil
+		}
+
+		channel := channels[r.Intn(len(channels))]
+		sender, _ := simtypes.RandomAcc(r, accs)
+
+		var (
+			classID string
+			tokenID string
+		)
+		for _, class := range k.GetNFTKeeper().GetClasses(ctx) {
+			nfts := k.GetNFTKeeper().GetNFTsOfClassByOwner(ctx, class.GetID(), sender.Address)
+			if len(nfts) > 0 {
+				classID, tokenID = class.GetID(), nfts[r.Intn(len(nfts))].GetID()
+				break
+			}
+		}
+
+		if tokenID == "" {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "sender owns no token"), nil, nil
+		}
+
+		receiver, _ := simtypes.RandomAcc(r, accs)
+
+		msg := types.NewMsgTransfer(
+			channel.PortId, channel.ChannelId, classID, []string{tokenID},
+			sender.Address.String(), receiver.Address.String(),
+			clienttypes.ZeroHeight(), uint64(ctx.BlockTime().Add(time.Hour).UnixNano()), "", nil,
+		)
+
+		txCtx := simulation.OperationInput{
+			R:             r,
+			App:           app,
+			TxGen:         txGen,
+			Msg:           msg,
+			Context:       ctx,
+			SimAccount:    sender,
+			AccountKeeper: ak,
+			Bankkeeper:    bk,
+			ModuleName:    types.ModuleName,
+		}
+
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	cmtbytes "github.com/cometbft/cometbft/libs/bytes"
+
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// NewClass creates a new Class instance given the base class identifier and a variable number of hops.
+func NewClass(base string, trace ...transfertypes.Hop) Class {
+	return Class{
+		Base:  base,
+		Trace: trace,
+	}
+}
+
+// Validate performs a basic validation of the Class fields.
+func (c Class) Validate() error {
+	// NOTE: base class identifier validation cannot be performed as each chain may define
+	// its own class identifier validation
+	if strings.TrimSpace(c.Base) == "" {
+		return errorsmod.Wrap(ErrInvalidClassForTransfer, "base class identifier cannot be blank")
+	}
+
+	for _, hop := range c.Trace {
+		if err := hop.Validate(); err != nil {
+			return errorsmod.Wrap(err, "invalid trace")
+		}
+	}
+
+	return nil
+}
+
+// Hash returns the hex bytes of the SHA256 hash of the Class fields using the following formula:
+//
+// hash = sha256(trace + "/" + baseClassID)
+func (c Class) Hash() cmtbytes.HexBytes {
+	hash := sha256.Sum256([]byte(c.Path()))
+	return hash[:]
+}
+
+// ClassID returns the identifier of the class on this chain in the format 'ibc/{hash(trace + baseClassID)}'.
+// If the trace is empty, it will return the base class identifier.
+func (c Class) ClassID() string {
+	if c.IsNative() {
+		return c.Base
+	}
+
+	return fmt.Sprintf("%s/%s", ClassPrefix, c.Hash())
+}
+
+// Path returns the full class identifier according to the ICS721 specification:
+// trace + "/" + baseClassID
+// If there exists no trace then the base class identifier is returned.
+func (c Class) Path() string {
+	if c.IsNative() {
+		return c.Base
+	}
+
+	var sb strings.Builder
+	for _, t := range c.Trace {
+		sb.WriteString(t.String()) // nolint:revive // no error returned by WriteString
+		sb.WriteByte('/')          //nolint:revive // no error returned by WriteByte
+	}
+	sb.WriteString(c.Base) //nolint:revive
+	return sb.String()
+}
+
+// IsNative returns true if the class is native, thus containing no trace history.
+func (c Class) IsNative() bool {
+	return len(c.Trace) == 0
+}
+
+// HasPrefix returns true if the first element of the trace of the class
+// matches the provided portId and channelId.
+func (c Class) HasPrefix(portID, channelID string) bool {
+	// if the class is native, then it is not prefixed by any port/channel pair
+	if c.IsNative() {
+		return false
+	}
+
+	return c.Trace[0].PortId == portID && c.Trace[0].ChannelId == channelID
+}
+
+// Classes defines a wrapper type for a slice of Class.
+type Classes []Class
+
+// Validate performs a basic validation of each class trace.
+func (c Classes) Validate() error {
+	seenClasses := make(map[string]bool)
+	for i, class := range c {
+		hash := class.Hash().String()
+		if seenClasses[hash] {
+			return fmt.Errorf("duplicated class with hash %s", class.Hash())
+		}
+
+		if err := class.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "failed class %d validation", i)
+		}
+		seenClasses[hash] = true
+	}
+	return nil
+}
+
+var _ sort.Interface = (*Classes)(nil)
+
+// Len implements sort.Interface for Classes
+func (c Classes) Len() int { return len(c) }
+
+// Less implements sort.Interface for Classes
+func (c Classes) Less(i, j int) bool {
+	if c[i].Base != c[j].Base {
+		return c[i].Base < c[j].Base
+	}
+
+	if len(c[i].Trace) != len(c[j].Trace) {
+		return len(c[i].Trace) < len(c[j].Trace)
+	}
+
+	return c[i].Path() < c[j].Path()
+}
+
+// Swap implements sort.Interface for Classes
+func (c Classes) Swap(i, j int) { c[i], c[j] = c[j], c[i] }
+
+// Sort is a helper function to sort the set of classes in-place
+func (c Classes) Sort() Classes {
+	sort.Sort(c)
+	return c
+}
+
+// ExtractClassFromPath returns the class from the full path, in the format
+// ([port_id]/[channel_id])+/[base_class_id].
+func ExtractClassFromPath(fullPath string) Class {
+	classSplit := strings.Split(fullPath, "/")
+
+	if classSplit[0] == fullPath {
+		return Class{
+			Base: fullPath,
+		}
+	}
+
+	var (
+		trace          []transfertypes.Hop
+		baseClassSlice []string
+	)
+
+	length := len(classSplit)
+	for i := 0; i < length; i += 2 {
+		// as for the fungible token denominations, only the channel identifiers in the format
+		// ibc-go specifies are recognized as part of the trace, the remaining path being the
+		// base class identifier.
+		if i < length-1 && length > 2 && channeltypes.IsValidChannelID(classSplit[i+1]) {
+			trace = append(trace, transfertypes.NewHop(classSplit[i], classSplit[i+1]))
+		} else {
+			baseClassSlice = classSplit[i:]
+			break
+		}
+	}
+
+	return Class{
+		Base:  strings.Join(baseClassSlice, "/"),
+		Trace: trace,
+	}
+}
+
+// validateClassID validates that the given class identifier is not blank and, if it is in the format
+// 'ibc/{hash}', that the hash is a valid hex hash.
+func validateClassID(classID string) error {
+	if strings.TrimSpace(classID) == "" {
+		return errorsmod.Wrap(ErrInvalidClassForTransfer, "class identifier cannot be blank")
+	}
+
+	classSplit := strings.SplitN(classID, "/", 2)
+	if len(classSplit) == 2 && classSplit[0] == ClassPrefix {
+		if _, err := transfertypes.ParseHexHash(classSplit[1]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidClassForTransfer, "invalid class trace hash %s: %s", classSplit[1], err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,107 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func TestClassValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		class    types.Class
+		expError error
+	}{
+		{
+			"success: native class",
+			types.NewClass("kitties"),
+			nil,
+		},
+		{
+			"success: class with trace",
+			types.NewClass("kitties", transfertypes.NewHop("nfttransfer", "channel-0"), transfertypes.NewHop("nfttransfer", "channel-1")),
+			nil,
+		},
+		{
+			"success: base class identifier with slashes",
+			types.NewClass("kitties/gen/1", transfertypes.NewHop("nfttransfer", "channel-0")),
+			nil,
+		},
+		{
+			"failure: empty base class identifier",
+			types.NewClass("", transfertypes.NewHop("nfttransfer", "channel-0")),
+			types.ErrInvalidClassForTransfer,
+		},
+		{
+			"failure: invalid hop",
+			types.NewClass("kitties", transfertypes.NewHop("", "channel-0")),
+			host.ErrInvalidID,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.class.Validate()
+		if tc.expError == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expError, tc.name)
+		}
+	}
+}
+
+func TestClassPathAndID(t *testing.T) {
+	native := types.NewClass("kitties")
+	require.Equal(t, "kitties", native.Path())
+	require.Equal(t, "kitties", native.ClassID())
+	require.True(t, native.IsNative())
+
+	class := types.NewClass("kitties/gen/1", transfertypes.NewHop("nfttransfer", "channel-0"), transfertypes.NewHop("nfttransfer", "channel-1"))
+	require.Equal(t, "nfttransfer/channel-0/nfttransfer/channel-1/kitties/gen/1", class.Path())
+	require.Equal(t, "ibc/"+class.Hash().String(), class.ClassID())
+	require.False(t, class.IsNative())
+
+	require.True(t, class.HasPrefix("nfttransfer", "channel-0"))
+	require.False(t, class.HasPrefix("nfttransfer", "channel-1"))
+	require.False(t, native.HasPrefix("nfttransfer", "channel-0"))
+}
+
+func TestExtractClassFromPath(t *testing.T) {
+	testCases := []struct {
+		name     string
+		path     string
+		expClass types.Class
+	}{
+		{"native class", "kitties", types.NewClass("kitties")},
+		{"native class with slashes", "kitties/gen/1", types.NewClass("kitties/gen/1")},
+		{"single hop", "nfttransfer/channel-0/kitties", types.NewClass("kitties", transfertypes.NewHop("nfttransfer", "channel-0"))},
+		{
+			"multiple hops with base class identifier with slashes",
+			"nfttransfer/channel-0/nfttransfer/channel-1/kitties/gen/1",
+			types.NewClass("kitties/gen/1", transfertypes.NewHop("nfttransfer", "channel-0"), transfertypes.NewHop("nfttransfer", "channel-1")),
+		},
+		{"invalid channel identifier is part of the base class identifier", "nfttransfer/gen/kitties", types.NewClass("nfttransfer/gen/kitties")},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		class := types.ExtractClassFromPath(tc.path)
+		require.Equal(t, tc.expClass, class, tc.name)
+		require.Equal(t, tc.path, class.Path(), tc.name)
+	}
+}
+
+func TestClassesValidate(t *testing.T) {
+	class := types.NewClass("kitties", transfertypes.NewHop("nfttransfer", "channel-0"))
+
+	require.NoError(t, types.Classes{}.Validate())
+	require.NoError(t, types.Classes{class, types.NewClass("kitties")}.Validate())
+	require.ErrorContains(t, types.Classes{class, class}.Validate(), "duplicated class")
+	require.ErrorIs(t, types.Classes{types.NewClass("")}.Validate(), types.ErrInvalidClassForTransfer)
+}
@@ -0,0 +1,21 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+)
+
+// IBC non-fungible token transfer sentinel errors
+var (
+	ErrInvalidPacketTimeout    = errorsmod.Register(ModuleName, 2, "invalid packet timeout")
+	ErrInvalidClassForTransfer = errorsmod.Register(ModuleName, 3, "invalid class for cross-chain non-fungible token transfer")
+	ErrInvalidTokenID          = errorsmod.Register(ModuleName, 4, "invalid non-fungible token identifier")
+	ErrInvalidVersion          = errorsmod.Register(ModuleName, 5, "invalid ICS721 version")
+	ErrInvalidPacket           = errorsmod.Register(ModuleName, 6, "invalid non-fungible token packet")
+	ErrClassNotFound           = errorsmod.Register(ModuleName, 7, "class not found")
+	ErrNFTNotFound             = errorsmod.Register(ModuleName, 8, "non-fungible token not found")
+	ErrSendDisabled            = errorsmod.Register(ModuleName, 9, "non-fungible token transfers from this chain are disabled")
+	ErrReceiveDisabled         = errorsmod.Register(ModuleName, 10, "non-fungible token transfers to this chain are disabled")
+	ErrMaxTransferChannels     = errorsmod.Register(ModuleName, 11, "max non-fungible token transfer channels")
+	ErrInvalidMemo             = errorsmod.Register(ModuleName, 12, "invalid memo")
+	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 13, "invalid non-fungible token forwarding")
+)
@@ -0,0 +1,23 @@
+package types
+
+// IBC non-fungible token transfer events
+const (
+	EventTypeTimeout  = "timeout"
+	EventTypePacket   = "non_fungible_token_packet"
+	EventTypeTransfer = "ibc_nft_transfer"
+	EventTypeClass    = "class"
+
+	AttributeKeySender         = "sender"
+	AttributeKeyReceiver       = "receiver"
+	AttributeKeyClassID        = "class_id"
+	AttributeKeyClass          = "class"
+	AttributeKeyClassHash      = "class_hash"
+	AttributeKeyTokenIDs       = "token_ids"
+	AttributeKeyRefundReceiver = "refund_receiver"
+	AttributeKeyRefundTokenIDs = "refund_token_ids"
+	AttributeKeyAckSuccess     = "success"
+	AttributeKeyAck            = "acknowledgement"
+	AttributeKeyAckError       = "error"
+	AttributeKeyMemo           = "memo"
+	AttributeKeyForwardingHops = "forwarding_hops"
+)
@@ -0,0 +1,51 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// AccountKeeper defines the contract required for account APIs.
+type AccountKeeper interface {
+	GetModuleAddress(name string) sdk.AccAddress
+}
+
+// ChannelKeeper defines the expected IBC channel keeper
+type ChannelKeeper interface {
+	GetChannel(ctx context.Context, srcPort, srcChan string) (channel channeltypes.Channel, found bool)
+	GetAllChannelsWithPortPrefix(ctx context.Context, portPrefix string) []channeltypes.IdentifiedChannel
+}
+
+// NFTKeeper defines the expected keeper of the non-fungible tokens, such as an adapter of the x/nft
+// keeper. The classes received from other chains are saved under their ibc/{hash} identifier.
+type NFTKeeper interface {
+	SaveClass(ctx context.Context, classID, classURI, classData string) error
+	GetClass(ctx context.Context, classID string) (NFTClass, bool)
+	GetClasses(ctx context.Context) []NFTClass
+
+	Mint(ctx context.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error
+	Transfer(ctx context.Context, classID, tokenID string, receiver sdk.AccAddress) error
+	Burn(ctx context.Context, classID, tokenID string) error
+
+	GetNFT(ctx context.Context, classID, tokenID string) (NFT, bool)
+	GetNFTsOfClassByOwner(ctx context.Context, classID string, owner sdk.AccAddress) []NFT
+	GetOwner(ctx context.Context, classID, tokenID string) sdk.AccAddress
+}
+
+// NFTClass defines the expected non-fungible token class.
+type NFTClass interface {
+	GetID() string
+	GetURI() string
+	GetData() string
+}
+
+// NFT defines the expected non-fungible token.
+type NFT interface {
+	GetClassID() string
+	GetID() string
+	GetURI() string
+	GetData() string
+}
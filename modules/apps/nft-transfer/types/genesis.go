@@ -0,0 +1,36 @@
+package types
+
+import (
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// NewGenesisState creates a new ibc nft-transfer GenesisState instance.
+func NewGenesisState(portID string, classes Classes, params Params) *GenesisState {
+	return &GenesisState{
+		PortId:  portID,
+		Classes: classes,
+		Params:  params,
+	}
+}
+
+// DefaultGenesisState returns a GenesisState with "nfttransfer" as the default PortID.
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		PortId:  PortID,
+		Classes: Classes{},
+		Params:  DefaultParams(),
+	}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	if err := host.PortIdentifierValidator(gs.PortId); err != nil {
+		return err
+	}
+	if err := gs.Classes.Validate(); err != nil {
+		return err
+	}
+
+	return gs.Params.Validate()
+}
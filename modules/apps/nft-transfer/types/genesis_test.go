@@ -0,0 +1,51 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func TestValidateGenesis(t *testing.T) {
+	testCases := []struct {
+		name     string
+		genState *types.GenesisState
+		expErr   error
+	}{
+		{
+			"success: default",
+			types.DefaultGenesisState(),
+			nil,
+		},
+		{
+			"success: valid genesis",
+			types.NewGenesisState("nfttransfer", types.Classes{types.NewClass("kitties", transfertypes.NewHop("nfttransfer", "channel-0"))}, types.DefaultParams()),
+			nil,
+		},
+		{
+			"failure: invalid port identifier",
+			types.NewGenesisState("(invalidport)", types.Classes{}, types.DefaultParams()),
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid class",
+			types.NewGenesisState(types.PortID, types.Classes{types.NewClass("")}, types.DefaultParams()),
+			types.ErrInvalidClassForTransfer,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.genState.Validate()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
@@ -0,0 +1,64 @@
+package types
+
+import (
+	"crypto/sha256"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// ModuleName defines the IBC non-fungible token transfer name
+	ModuleName = "nfttransfer"
+
+	// PortID is the default port id that the nft-transfer module binds to
+	PortID = "nfttransfer"
+
+	// StoreKey is the store key string for IBC non-fungible token transfer
+	StoreKey = ModuleName
+
+	// RouterKey is the message route for IBC non-fungible token transfer
+	RouterKey = ModuleName
+
+	// QuerierRoute is the querier route for IBC non-fungible token transfer
+	QuerierRoute = ModuleName
+
+	// ClassPrefix is the prefix of the identifiers of the classes received from other chains.
+	ClassPrefix = "ibc"
+
+	ParamsKey = "params"
+
+	// Version defines the current version of the IBC non-fungible token transfer module
+	Version = "ics721-1"
+)
+
+var (
+	// PortKey defines the key to store the port ID in store
+	PortKey = []byte{0x01}
+	// ClassKey defines the key to store the class traces in store
+	ClassKey = []byte{0x02}
+	// ForwardedPacketKey defines the key to store the forwarded packets in store
+	ForwardedPacketKey = []byte{0x03}
+)
+
+// GetEscrowAddress returns the escrow address for the specified channel.
+// The escrow address follows the format as outlined in ADR 028:
+// https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-028-public-key-addresses.md
+func GetEscrowAddress(portID, channelID string) sdk.AccAddress {
+	// a slash is used to create domain separation between port and channel identifiers to
+	// prevent address collisions between escrow addresses created for different channels
+	contents := fmt.Sprintf("%s/%s", portID, channelID)
+
+	// ADR 028 AddressHash construction
+	preImage := []byte(Version)
+	preImage = append(preImage, 0)
+	preImage = append(preImage, contents...)
+	hash := sha256.Sum256(preImage)
+	return hash[:20]
+}
+
+// PacketForwardKey returns the store key under which the forwarded packet is stored
+// for the provided portID, channelID, and packet sequence.
+func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
@@ -0,0 +1,97 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var (
+	sender   = sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address()).String()
+	receiver = sdk.AccAddress("testaddr2").String()
+
+	timeoutHeight = clienttypes.NewHeight(0, 10)
+)
+
+// TestMsgTransferValidation tests ValidateBasic for MsgTransfer
+func TestMsgTransferValidation(t *testing.T) {
+	ibcClassID := types.ExtractClassFromPath(classPath).ClassID()
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgTransfer
+		expErr error
+	}{
+		{"success", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), nil},
+		{"success: ibc class", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, ibcClassID, tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), nil},
+		{"success: with forwarding", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, receiver, clienttypes.ZeroHeight(), 100, "", transfertypes.NewForwarding(false, transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-1"))), nil},
+		{"success: unwind", types.NewMsgTransfer("", "", ibcClassID, tokenIDs, sender, receiver, clienttypes.ZeroHeight(), 100, "", transfertypes.NewForwarding(true)), nil},
+		{"failure: invalid source port", types.NewMsgTransfer("(invalidport)", ibctesting.FirstChannelID, "kitties", tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), host.ErrInvalidID},
+		{"failure: invalid source channel", types.NewMsgTransfer(ibctesting.NFTTransferPort, "(invalidchannel)", "kitties", tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), host.ErrInvalidID},
+		{"failure: empty class identifier", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "", tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), types.ErrInvalidClassForTransfer},
+		{"failure: invalid ibc class hash", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "ibc/kitties", tokenIDs, sender, receiver, timeoutHeight, 0, "", nil), types.ErrInvalidClassForTransfer},
+		{"failure: no token ids", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", nil, sender, receiver, timeoutHeight, 0, "", nil), types.ErrInvalidTokenID},
+		{"failure: too many token ids", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", manyTokenIDs(types.MaximumTokensLength+1), sender, receiver, timeoutHeight, 0, "", nil), types.ErrInvalidTokenID},
+		{"failure: invalid sender address", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, "invalid", receiver, timeoutHeight, 0, "", nil), ibcerrors.ErrInvalidAddress},
+		{"failure: missing recipient address", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, "", timeoutHeight, 0, "", nil), ibcerrors.ErrInvalidAddress},
+		{"failure: recipient address too long", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, ibctesting.GenerateString(types.MaximumReceiverLength+1), timeoutHeight, 0, "", nil), ibcerrors.ErrInvalidAddress},
+		{"failure: memo too long", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, receiver, timeoutHeight, 0, ibctesting.GenerateString(types.MaximumMemoLength+1), nil), types.ErrInvalidMemo},
+		{"failure: forwarding with timeout height", types.NewMsgTransfer(ibctesting.NFTTransferPort, ibctesting.FirstChannelID, "kitties", tokenIDs, sender, receiver, timeoutHeight, 0, "", transfertypes.NewForwarding(false, transfertypes.NewHop(ibctesting.NFTTransferPort, "channel-1"))), types.ErrInvalidPacketTimeout},
+		{"failure: unwind with source port", types.NewMsgTransfer(ibctesting.NFTTransferPort, "", ibcClassID, tokenIDs, sender, receiver, clienttypes.ZeroHeight(), 100, "", transfertypes.NewForwarding(true)), types.ErrInvalidForwarding},
+		{"failure: unwind with source channel", types.NewMsgTransfer("", ibctesting.FirstChannelID, ibcClassID, tokenIDs, sender, receiver, clienttypes.ZeroHeight(), 100, "", transfertypes.NewForwarding(true)), types.ErrInvalidForwarding},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+// TestMsgUpdateParamsValidateBasic tests ValidateBasic for MsgUpdateParams
+func TestMsgUpdateParamsValidateBasic(t *testing.T) {
+	testCases := []struct {
+		name   string
+		msg    *types.MsgUpdateParams
+		expErr error
+	}{
+		{"success: valid signer and params", types.NewMsgUpdateParams(sender, types.DefaultParams()), nil},
+		{"failure: invalid signer address", types.NewMsgUpdateParams("invalid", types.DefaultParams()), ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		err := tc.msg.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func manyTokenIDs(n int) []string {
+	ids := make([]string, n)
+	for i := range ids {
+		ids[i] = strings.Repeat("a", i+1)
+	}
+
+	return ids
+}
@@ -0,0 +1,169 @@
+package types
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+var _ ibcexported.PacketData = (*NonFungibleTokenPacketData)(nil)
+
+// NewNonFungibleTokenPacketData constructs a new NonFungibleTokenPacketData instance
+func NewNonFungibleTokenPacketData(
+	classID, classURI, classData string,
+	tokenIDs, tokenURIs, tokenData []string,
+	sender, receiver string,
+	memo string,
+	forwarding transfertypes.ForwardingPacketData,
+) NonFungibleTokenPacketData {
+	return NonFungibleTokenPacketData{
+		ClassId:    classID,
+		ClassUri:   classURI,
+		ClassData:  classData,
+		TokenIds:   tokenIDs,
+		TokenUris:  tokenURIs,
+		TokenData:  tokenData,
+		Sender:     sender,
+		Receiver:   receiver,
+		Memo:       memo,
+		Forwarding: forwarding,
+	}
+}
+
+// ValidateBasic is used for validating the non-fungible token transfer.
+// NOTE: The addresses formats are not validated as the sender and recipient can have different
+// formats defined by their corresponding chains that are not known to IBC.
+func (nftpd NonFungibleTokenPacketData) ValidateBasic() error {
+	if strings.TrimSpace(nftpd.ClassId) == "" {
+		return errorsmod.Wrap(ErrInvalidClassForTransfer, "class identifier cannot be blank")
+	}
+
+	if err := ExtractClassFromPath(nftpd.ClassId).Validate(); err != nil {
+		return err
+	}
+
+	if err := validateTokenIDs(nftpd.TokenIds); err != nil {
+		return err
+	}
+
+	if len(nftpd.TokenUris) != 0 && len(nftpd.TokenUris) != len(nftpd.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "number of token uris (%d) must match the number of token ids (%d)", len(nftpd.TokenUris), len(nftpd.TokenIds))
+	}
+
+	if len(nftpd.TokenData) != 0 && len(nftpd.TokenData) != len(nftpd.TokenIds) {
+		return errorsmod.Wrapf(ErrInvalidPacket, "number of token data (%d) must match the number of token ids (%d)", len(nftpd.TokenData), len(nftpd.TokenIds))
+	}
+
+	if strings.TrimSpace(nftpd.Sender) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "sender address cannot be blank")
+	}
+
+	if strings.TrimSpace(nftpd.Receiver) == "" {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "receiver address cannot be blank")
+	}
+
+	if len(nftpd.Memo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
+	}
+
+	if err := nftpd.Forwarding.Validate(); err != nil {
+		return errorsmod.Wrap(ErrInvalidForwarding, err.Error())
+	}
+
+	if len(nftpd.Forwarding.FallbackReceivers) > 0 {
+		return errorsmod.Wrap(ErrInvalidForwarding, "fallback receivers are not supported for non-fungible token transfers")
+	}
+
+	// We cannot have non-empty memo and non-empty forwarding path hops at the same time.
+	if nftpd.HasForwarding() && nftpd.Memo != "" {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo must be empty if forwarding path hops is not empty: %s, %s", nftpd.Memo, nftpd.Forwarding.Hops)
+	}
+
+	return nil
+}
+
+// GetBytes is a helper for serialising a NonFungibleTokenPacketData. It uses JSON to serialise
+// the packet data, as defined by the ICS721 specification, and panics on failure.
+func (nftpd NonFungibleTokenPacketData) GetBytes() []byte {
+	bz, err := json.Marshal(nftpd)
+	if err != nil {
+		panic(errors.New("cannot marshal NonFungibleTokenPacketData into bytes"))
+	}
+
+	return bz
+}
+
+// GetTokenURI returns the uri of the token at the provided index, or an empty string if no uris are set.
+func (nftpd NonFungibleTokenPacketData) GetTokenURI(i int) string {
+	if len(nftpd.TokenUris) == 0 {
+		return ""
+	}
+
+	return nftpd.TokenUris[i]
+}
+
+// TokenDataAt returns the data of the token at the provided index, or an empty string if no data are set.
+func (nftpd NonFungibleTokenPacketData) TokenDataAt(i int) string {
+	if len(nftpd.TokenData) == 0 {
+		return ""
+	}
+
+	return nftpd.TokenData[i]
+}
+
+// GetPacketSender returns the sender address embedded in the packet data.
+//
+// NOTE:
+//   - The sender address is set by the module which requested the packet to be sent,
+//     and this module may not have validated the sender address by a signature check.
+//   - The sender address must only be used by modules on the sending chain.
+//   - sourcePortID is not used in this implementation.
+func (nftpd NonFungibleTokenPacketData) GetPacketSender(sourcePortID string) string {
+	return nftpd.Sender
+}
+
+// HasForwarding determines if the packet should be forwarded to the next hop.
+func (nftpd NonFungibleTokenPacketData) HasForwarding() bool {
+	return len(nftpd.Forwarding.Hops) > 0
+}
+
+// UnmarshalPacketData attempts to unmarshal the provided packet data bytes into a NonFungibleTokenPacketData.
+func UnmarshalPacketData(bz []byte) (NonFungibleTokenPacketData, error) {
+	var data NonFungibleTokenPacketData
+	if err := json.Unmarshal(bz, &data); err != nil {
+		return NonFungibleTokenPacketData{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "failed to unmarshal ICS721 packet data: %s", err.Error())
+	}
+
+	return data, nil
+}
+
+// validateTokenIDs validates that the token identifiers are not empty, not blank and unique.
+func validateTokenIDs(tokenIDs []string) error {
+	if len(tokenIDs) == 0 {
+		return errorsmod.Wrap(ErrInvalidTokenID, "token ids cannot be empty")
+	}
+
+	if len(tokenIDs) > MaximumTokensLength {
+		return errorsmod.Wrapf(ErrInvalidTokenID, "number of token ids must not exceed %d", MaximumTokensLength)
+	}
+
+	seenTokenIDs := make(map[string]bool)
+	for _, tokenID := range tokenIDs {
+		if strings.TrimSpace(tokenID) == "" {
+			return errorsmod.Wrap(ErrInvalidTokenID, "token id cannot be blank")
+		}
+
+		if seenTokenIDs[tokenID] {
+			return errorsmod.Wrapf(ErrInvalidTokenID, "duplicate token id %s", tokenID)
+		}
+		seenTokenIDs[tokenID] = true
+	}
+
+	return nil
+}
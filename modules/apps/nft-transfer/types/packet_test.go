@@ -0,0 +1,170 @@
+package types_test
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+const classPath = "nfttransfer/channel-0/kitties"
+
+var tokenIDs = []string{"kitty-1", "kitty-2"}
+
+// TestNonFungibleTokenPacketDataValidateBasic tests ValidateBasic for NonFungibleTokenPacketData
+func TestNonFungibleTokenPacketDataValidateBasic(t *testing.T) {
+	var packetData types.NonFungibleTokenPacketData
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: with token uris and data",
+			func() {
+				packetData.TokenUris = []string{"uri-1", "uri-2"}
+				packetData.TokenData = []string{"data-1", "data-2"}
+			},
+			nil,
+		},
+		{
+			"success: with forwarding",
+			func() {
+				packetData.Memo = ""
+				packetData.Forwarding = transfertypes.NewForwardingPacketData("", transfertypes.NewHop("nfttransfer", "channel-1"))
+			},
+			nil,
+		},
+		{
+			"failure: empty class identifier",
+			func() {
+				packetData.ClassId = ""
+			},
+			types.ErrInvalidClassForTransfer,
+		},
+		{
+			"failure: no token ids",
+			func() {
+				packetData.TokenIds = nil
+			},
+			types.ErrInvalidTokenID,
+		},
+		{
+			"failure: duplicate token ids",
+			func() {
+				packetData.TokenIds = []string{"kitty-1", "kitty-1"}
+			},
+			types.ErrInvalidTokenID,
+		},
+		{
+			"failure: blank token id",
+			func() {
+				packetData.TokenIds = []string{"kitty-1", " "}
+			},
+			types.ErrInvalidTokenID,
+		},
+		{
+			"failure: number of token uris does not match the number of token ids",
+			func() {
+				packetData.TokenUris = []string{"uri-1"}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: number of token data does not match the number of token ids",
+			func() {
+				packetData.TokenData = []string{"data-1"}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: missing sender address",
+			func() {
+				packetData.Sender = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: missing receiver address",
+			func() {
+				packetData.Receiver = ""
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: memo too long",
+			func() {
+				packetData.Memo = strings.Repeat("a", types.MaximumMemoLength+1)
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: memo and forwarding hops set",
+			func() {
+				packetData.Forwarding = transfertypes.NewForwardingPacketData("", transfertypes.NewHop("nfttransfer", "channel-1"))
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: fallback receivers set",
+			func() {
+				packetData.Memo = ""
+				packetData.Forwarding = transfertypes.NewForwardingPacketData("", transfertypes.NewHop("nfttransfer", "channel-1"))
+				packetData.Forwarding.FallbackReceivers = []string{receiver}
+			},
+			types.ErrInvalidForwarding,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		packetData = types.NewNonFungibleTokenPacketData(classPath, "class-uri", "class-data", tokenIDs, nil, nil, sender, receiver, "memo", transfertypes.ForwardingPacketData{})
+
+		tc.malleate()
+
+		err := packetData.ValidateBasic()
+		if tc.expErr == nil {
+			require.NoError(t, err, tc.name)
+		} else {
+			require.ErrorIs(t, err, tc.expErr, tc.name)
+		}
+	}
+}
+
+func TestNonFungibleTokenPacketDataGetBytes(t *testing.T) {
+	packetData := types.NewNonFungibleTokenPacketData(classPath, "", "", tokenIDs, nil, []string{"data-1", "data-2"}, sender, receiver, "", transfertypes.ForwardingPacketData{})
+
+	var fields map[string]any
+	require.NoError(t, json.Unmarshal(packetData.GetBytes(), &fields))
+
+	// the fields are encoded in camel case as defined by the ICS721 specification, empty optional fields are omitted
+	require.Equal(t, classPath, fields["classId"])
+	require.Equal(t, []any{"kitty-1", "kitty-2"}, fields["tokenIds"])
+	require.Equal(t, []any{"data-1", "data-2"}, fields["tokenData"])
+	require.NotContains(t, fields, "classUri")
+	require.NotContains(t, fields, "tokenUris")
+	require.NotContains(t, fields, "memo")
+
+	unmarshalled, err := types.UnmarshalPacketData(packetData.GetBytes())
+	require.NoError(t, err)
+	require.Equal(t, packetData.ClassId, unmarshalled.ClassId)
+	require.Equal(t, packetData.TokenIds, unmarshalled.TokenIds)
+	require.Equal(t, packetData.TokenData, unmarshalled.TokenData)
+	require.Equal(t, "", unmarshalled.GetTokenURI(0))
+	require.Equal(t, "data-2", unmarshalled.TokenDataAt(1))
+
+	_, err = types.UnmarshalPacketData([]byte("invalid"))
+	require.ErrorIs(t, err, ibcerrors.ErrInvalidType)
+}
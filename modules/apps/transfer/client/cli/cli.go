@@ -22,6 +22,18 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryEscrowAddress(),
 		GetCmdQueryDenomHash(),
 		GetCmdQueryTotalEscrowForDenom(),
+		GetCmdQueryRateLimits(),
+		GetCmdQueryRateLimit(),
+		GetCmdQueryTransferEnabled(),
+		GetCmdQuerySimulateProtocolFee(),
+		GetCmdQueryMemoKeys(),
+		GetCmdQueryCounterpartyAddressFormat(),
+		GetCmdQueryDenomMetadataOrigin(),
+		GetCmdQueryChannelEscrows(),
+		GetCmdQueryDenomChannelEscrows(),
+		GetCmdQueryForwardedPackets(),
+		GetCmdQueryForwardedPacket(),
+		GetCmdQueryUnwindRoute(),
 	)
 
 	return queryCmd
@@ -39,6 +51,8 @@ func NewTxCmd() *cobra.Command {
 
 	txCmd.AddCommand(
 		NewTransferTxCmd(),
+		NewMultiTransferTxCmd(),
+		NewSetDenomMetadataTxCmd(),
 	)
 
 	return txCmd
@@ -2,11 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"strconv"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -200,3 +202,443 @@ func GetCmdQueryTotalEscrowForDenom() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryRateLimits defines the command to query all the configured rate limits.
+func GetCmdQueryRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limits",
+		Short:   "Query all the rate limits along with their current window usage",
+		Long:    "Query all the rate limits along with their current window usage",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limits", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryRateLimitsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.RateLimits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "rate limits")
+
+	return cmd
+}
+
+// GetCmdQueryRateLimit defines the command to query the rate limit of a denom over a channel.
+func GetCmdQueryRateLimit() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "rate-limit [port] [channel-id] [denom]",
+		Short:   "Query the rate limit of a denom over a channel along with its current window usage",
+		Long:    "Query the rate limit of a denom over a channel along with its current window usage",
+		Example: fmt.Sprintf("%s query ibc-transfer rate-limit transfer channel-0 uatom", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryRateLimitRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+			}
+
+			res, err := queryClient.RateLimit(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryCounterpartyAddressFormat defines the command to query the address format of the counterparty chain of a channel.
+func GetCmdQueryCounterpartyAddressFormat() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "counterparty-address-format [port] [channel-id]",
+		Short:   "Query the address format of the counterparty chain of a channel",
+		Long:    "Query the address format the receivers of the transfers sent over a channel are validated against",
+		Example: fmt.Sprintf("%s query ibc-transfer counterparty-address-format transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryCounterpartyAddressFormatRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.CounterpartyAddressFormat(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryDenomMetadataOrigin defines the command to query whether the bank metadata of an IBC denomination was overridden.
+func GetCmdQueryDenomMetadataOrigin() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-metadata-origin [denom]",
+		Short:   "Query whether the bank metadata of an IBC denomination was generated or overridden by governance",
+		Long:    "Query whether the bank metadata of an IBC denomination was generated when its vouchers were first received or overridden by governance, along with the override if any",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-metadata-origin ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryDenomMetadataOriginRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.DenomMetadataOrigin(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryTransferEnabled defines the command to query whether transfers of a denom over a channel are enabled.
+func GetCmdQueryTransferEnabled() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "transfer-enabled [port] [channel-id] [denom]",
+		Short:   "Query whether sending and receiving a denom over a channel is enabled",
+		Long:    "Query whether sending and receiving a denom over a channel is enabled, taking into account the denom and channel overrides",
+		Example: fmt.Sprintf("%s query ibc-transfer transfer-enabled transfer channel-0 uatom", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryTransferEnabledRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Denom:     args[2],
+			}
+
+			res, err := queryClient.TransferEnabled(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQuerySimulateProtocolFee defines the command to query the protocol fee charged on a transfer.
+func GetCmdQuerySimulateProtocolFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "simulate-protocol-fee [port] [channel-id] [sender] [coin]",
+		Short:   "Query the protocol fee charged for sending a coin over a channel",
+		Long:    "Query the protocol fee charged for sending a coin over a channel, along with the amount sent in the packet net of the fee",
+		Example: fmt.Sprintf("%s query ibc-transfer simulate-protocol-fee transfer channel-0 cosmos1... 1000uatom", version.AppName),
+		Args:    cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			coin, err := sdk.ParseCoinNormalized(args[3])
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySimulateProtocolFeeRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sender:    args[2],
+				Token:     coin,
+			}
+
+			res, err := queryClient.SimulateProtocolFee(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryMemoKeys defines the command to query the memo keys understood by the chain.
+func GetCmdQueryMemoKeys() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "memo-keys",
+		Short:   "Query the memo keys understood by the chain",
+		Long:    "Query the top-level keys of the transfer memo understood by the chain, along with the JSON schemas their values must conform to",
+		Example: fmt.Sprintf("%s query ibc-transfer memo-keys", version.AppName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.MemoKeys(cmd.Context(), &types.QueryMemoKeysRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryChannelEscrows defines the command to query the amount of tokens in escrow per denom over a channel.
+func GetCmdQueryChannelEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "channel-escrows [port] [channel-id]",
+		Short:   "Query the amount of tokens in escrow per denom over a channel",
+		Long:    "Query the amount of tokens in escrow per denom over a channel",
+		Example: fmt.Sprintf("%s query ibc-transfer channel-escrows transfer channel-0", version.AppName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryChannelEscrowsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ChannelEscrows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel escrows")
+
+	return cmd
+}
+
+// GetCmdQueryDenomChannelEscrows defines the command to query the amount of tokens in escrow over each channel for a denom.
+func GetCmdQueryDenomChannelEscrows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "denom-channel-escrows [denom]",
+		Short:   "Query the amount of tokens in escrow over each channel for a denom",
+		Long:    "Query the amount of tokens in escrow over each channel for a denom",
+		Example: fmt.Sprintf("%s query ibc-transfer denom-channel-escrows uosmo", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryDenomChannelEscrowsRequest{
+				Denom:      args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.DenomChannelEscrows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "channel escrows")
+
+	return cmd
+}
+
+// GetCmdQueryForwardedPackets defines the command to query the packets whose tokens are being forwarded.
+func GetCmdQueryForwardedPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "forwarded-packets [port] [channel-id]",
+		Short: "Query the packets whose tokens are being forwarded",
+		Long: `Query the packets whose tokens are being forwarded to the next hop, along with the packet sent to the next hop and its status.
+The packets can be filtered by the port and channel over which the tokens are forwarded.`,
+		Example: fmt.Sprintf("%s query ibc-transfer forwarded-packets transfer channel-0", version.AppName),
+		Args: func(_ *cobra.Command, args []string) error {
+			if len(args) != 0 && len(args) != 2 {
+				return fmt.Errorf("accepts either no arguments or both the port and channel-id, received %d", len(args))
+			}
+
+			return nil
+		},
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryV2Client(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForwardedPacketsRequest{
+				Pagination: pageReq,
+			}
+
+			if len(args) == 2 {
+				req.PortId, req.ChannelId = args[0], args[1]
+			}
+
+			res, err := queryClient.ForwardedPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "forwarded packets")
+
+	return cmd
+}
+
+// GetCmdQueryForwardedPacket defines the command to query the packet whose tokens are being forwarded
+// by the packet sent over a channel with a given sequence.
+func GetCmdQueryForwardedPacket() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "forwarded-packet [port] [channel-id] [sequence]",
+		Short:   "Query the packet whose tokens are being forwarded by the packet sent over a channel with a given sequence",
+		Long:    "Query the packet whose tokens are being forwarded by the packet sent over a channel with a given sequence, along with the status of the sent packet",
+		Example: fmt.Sprintf("%s query ibc-transfer forwarded-packet transfer channel-0 1", version.AppName),
+		Args:    cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryV2Client(clientCtx)
+
+			sequence, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForwardedPacketRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+				Sequence:  sequence,
+			}
+
+			res, err := queryClient.ForwardedPacket(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryUnwindRoute defines the command to query the route taken by the tokens of a denomination
+// when they are unwound to their native chain.
+func GetCmdQueryUnwindRoute() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unwind-route [denom]",
+		Short:   "Query the route taken by the tokens of a denomination when they are unwound to their native chain",
+		Long:    "Query the hops over which the tokens of a denomination are sent when they are unwound, along with their base denomination on their native chain. The query fails if the channel of the first hop is not OPEN.",
+		Example: fmt.Sprintf("%s query ibc-transfer unwind-route ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryV2Client(clientCtx)
+
+			req := &types.QueryUnwindRouteRequest{
+				Denom: args[0],
+			}
+
+			res, err := queryClient.UnwindRoute(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,8 +1,14 @@
 package cli
 
 import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"os"
+	"path/filepath"
+	"slices"
 	"strings"
 	"time"
 
@@ -12,19 +18,25 @@ import (
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
 	"github.com/cosmos/cosmos-sdk/version"
+	govcli "github.com/cosmos/cosmos-sdk/x/gov/client/cli"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 )
 
 const (
-	flagPacketTimeoutHeight    = "packet-timeout-height"
-	flagPacketTimeoutTimestamp = "packet-timeout-timestamp"
-	flagAbsoluteTimeouts       = "absolute-timeouts"
-	flagMemo                   = "memo"
-	flagForwarding             = "forwarding"
-	flagUnwind                 = "unwind"
+	flagPacketTimeoutHeight       = "packet-timeout-height"
+	flagPacketTimeoutTimestamp    = "packet-timeout-timestamp"
+	flagAbsoluteTimeouts          = "absolute-timeouts"
+	flagChainRelativeTimeouts     = "chain-relative-timeouts"
+	flagPacketTimeoutHeightOffset = "packet-timeout-height-offset"
+	flagMemo                      = "memo"
+	flagForwarding                = "forwarding"
+	flagUnwind                    = "unwind"
+	flagAuthority                 = "authority"
 )
 
 // defaultRelativePacketTimeoutTimestamp is the default packet timeout timestamp (in nanoseconds)
@@ -42,7 +54,9 @@ func NewTransferTxCmd() *cobra.Command {
 packet if the coins list is a comma-separated string (e.g. 100uatom,100uosmo). Timeouts can be specified as absolute using the {absolute-timeouts} flag. 
 Timeout height can be set by passing in the height string in the form {revision}-{height} using the {packet-timeout-height} flag. 
 Note, relative timeout height is not supported. Relative timeout timestamp is added to the value of the user's local system clock time 
-using the {packet-timeout-timestamp} flag. If no timeout value is set then a default relative timeout value of 10 minutes is used. IBC tokens
+using the {packet-timeout-timestamp} flag. If no timeout value is set then a default relative timeout value of 10 minutes is used. Alternatively,
+the {chain-relative-timeouts} flag sends the {packet-timeout-timestamp} and {packet-timeout-height-offset} values as offsets which are added on-chain to
+the latest timestamp and height of the counterparty chain, as tracked by the client of the source channel. IBC tokens
 can be automatically unwound to their native chain using the {unwind} flag. Please note that if the {unwind} flag is used, then all coins must
 be IBC vouchers and share exactly the same denomination trace path, and the src-port and src-channel arguments must not be specified. Tokens can also be 
 automatically forwarded through multiple chains using the {fowarding} flag and specifying a comma-separated list of source portID/channelID pairs for 
@@ -65,86 +79,335 @@ each intermediary chain. {unwind} and {forwarding} flags can be used together to
 			srcChannel := args[1]
 			receiver := args[2]
 
-			coins, err := sdk.ParseCoinsNormalized(args[3])
+			coins, err := parseCoins(args[3])
 			if err != nil {
 				return err
 			}
 
-			for i, coin := range coins {
-				if !strings.HasPrefix(coin.Denom, "ibc/") {
-					denom := types.ExtractDenomFromPath(coin.Denom)
-					coins[i].Denom = denom.IBCDenom()
-				}
+			memo, err := cmd.Flags().GetString(flagMemo)
+			if err != nil {
+				return err
 			}
 
-			timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+			forwarding, err := parseForwarding(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+			timeoutHeight, timeoutTimestamp, relativeTimeout, err := parseTimeouts(cmd)
 			if err != nil {
 				return err
 			}
 
-			timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+			msg := types.NewMsgTransfer(
+				srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
+			)
+			msg.RelativeTimeout = relativeTimeout
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
+	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
+	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the coin should be unwound to its native chain before forwarding.")
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// transferFileEntry defines a transfer of the file provided to the multi-transfer command.
+type transferFileEntry struct {
+	SourcePort    string `json:"source_port"`
+	SourceChannel string `json:"source_channel"`
+	Receiver      string `json:"receiver"`
+	Coins         string `json:"coins"`
+	Memo          string `json:"memo"`
+}
+
+// transfersCSVHeader is the header expected in the first row of a CSV file provided to the multi-transfer command.
+var transfersCSVHeader = []string{"source_port", "source_channel", "receiver", "coins", "memo"}
+
+// NewMultiTransferTxCmd returns the command to create a MsgMultiTransfer transaction
+func NewMultiTransferTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "multi-transfer [transfers-file]",
+		Short: "Transfer fungible tokens to multiple receivers through IBC in a single message",
+		Long: strings.TrimSpace(`Transfer fungible tokens to multiple receivers, possibly over multiple channels, in a single message.
+Either all the transfers succeed or none of them is executed. The transfers are read from a JSON file containing an array of objects
+with the source_port, source_channel, receiver, coins and memo fields, or from a CSV file (with the .csv extension) whose first row is
+the header source_port,source_channel,receiver,coins,memo. Coins are provided as a comma-separated string (e.g. 100uatom,100uosmo),
+which must be quoted in CSV files. The timeout flags are applied to all the transfers and behave as for the transfer command.`),
+		Example: fmt.Sprintf(`%s tx ibc-transfer multi-transfer transfers.json
+%s tx ibc-transfer multi-transfer transfers.csv --chain-relative-timeouts`, version.AppName, version.AppName),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
+			sender := clientCtx.GetFromAddress().String()
 
-			absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+			transfers, err := parseTransfersFile(args[0])
 			if err != nil {
 				return err
 			}
 
-			memo, err := cmd.Flags().GetString(flagMemo)
+			timeoutHeight, timeoutTimestamp, relativeTimeout, err := parseTimeouts(cmd)
 			if err != nil {
 				return err
 			}
 
-			forwarding, err := parseForwarding(cmd)
+			msg := types.NewMsgMultiTransfer(sender, transfers, timeoutHeight, timeoutTimestamp, relativeTimeout)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	addTimeoutFlags(cmd)
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// parseTransfersFile parses the transfers of the provided JSON or CSV file.
+func parseTransfersFile(path string) ([]types.TransferEntry, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []transferFileEntry
+	if strings.EqualFold(filepath.Ext(path), ".csv") {
+		entries, err = parseTransfersCSV(bz)
+	} else {
+		err = json.Unmarshal(bz, &entries)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse transfers file %s: %w", path, err)
+	}
+
+	transfers := make([]types.TransferEntry, 0, len(entries))
+	for i, entry := range entries {
+		coins, err := parseCoins(entry.Coins)
+		if err != nil {
+			return nil, fmt.Errorf("invalid coins of transfer %d: %w", i, err)
+		}
+
+		transfers = append(transfers, types.NewTransferEntry(entry.SourcePort, entry.SourceChannel, entry.Receiver, coins, entry.Memo))
+	}
+
+	return transfers, nil
+}
+
+// parseTransfersCSV parses the rows of a CSV file into transfer file entries. The first row must be the expected header.
+func parseTransfersCSV(bz []byte) ([]transferFileEntry, error) {
+	reader := csv.NewReader(bytes.NewReader(bz))
+	reader.FieldsPerRecord = len(transfersCSVHeader)
+	reader.TrimLeadingSpace = true
+
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(records) == 0 || !slices.Equal(records[0], transfersCSVHeader) {
+		return nil, fmt.Errorf("expected header %s", strings.Join(transfersCSVHeader, ","))
+	}
+
+	entries := make([]transferFileEntry, 0, len(records)-1)
+	for _, record := range records[1:] {
+		entries = append(entries, transferFileEntry{
+			SourcePort:    record[0],
+			SourceChannel: record[1],
+			Receiver:      record[2],
+			Coins:         record[3],
+			Memo:          record[4],
+		})
+	}
+
+	return entries, nil
+}
+
+// NewSetDenomMetadataTxCmd returns the command to submit a governance proposal overriding the bank metadata of IBC denominations
+func NewSetDenomMetadataTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-denom-metadata [metadata-file]",
+		Short: "Submit a governance proposal overriding the bank metadata of IBC denominations",
+		Long: strings.TrimSpace(`Submit a governance proposal overriding the bank metadata of IBC denominations with the entries read from a JSON file.
+The file contains an array of objects with the denom (in the format ibc/{hash}), name, symbol, display, decimals and uri fields. The
+display denomination unit has the decimals as exponent, and must be the IBC denomination if the decimals are zero. Overridden metadata
+is not generated again when vouchers are received.`),
+		Example: fmt.Sprintf("%s tx ibc-transfer set-denom-metadata metadata.json --title \"IBC token metadata\" --summary \"Set the metadata of IBC tokens\" --deposit 10000000stake", version.AppName),
+		Args:    cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
 				return err
 			}
 
-			// NOTE: relative timeouts using block height are not supported.
-			// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
-			if !absoluteTimeouts {
-				if !timeoutHeight.IsZero() {
-					return errors.New("relative timeouts using block height is not supported")
-				}
+			proposal, err := govcli.ReadGovPropFlags(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
 
-				if timeoutTimestamp == 0 {
-					return errors.New("relative timeouts must provide a non zero value timestamp")
+			authority, _ := cmd.Flags().GetString(flagAuthority)
+			if authority != "" {
+				if _, err = sdk.AccAddressFromBech32(authority); err != nil {
+					return fmt.Errorf("invalid authority address: %w", err)
 				}
+			} else {
+				authority = sdk.AccAddress(address.Module(govtypes.ModuleName)).String()
+			}
 
-				// use local clock time as reference time for calculating timeout timestamp.
-				now := time.Now().UnixNano()
-				if now <= 0 {
-					return errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
-				}
+			overrides, err := parseDenomMetadataFile(args[0])
+			if err != nil {
+				return err
+			}
 
-				timeoutTimestamp = uint64(now) + timeoutTimestamp
+			msg := types.NewMsgSetDenomMetadata(authority, overrides...)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
 			}
 
-			msg := types.NewMsgTransfer(
-				srcPort, srcChannel, coins, sender, receiver, timeoutHeight, timeoutTimestamp, memo, forwarding,
-			)
+			if err := proposal.SetMsgs([]sdk.Msg{msg}); err != nil {
+				return fmt.Errorf("failed to create a set denom metadata proposal message: %w", err)
+			}
 
-			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), proposal)
 		},
 	}
 
+	cmd.Flags().String(flagAuthority, "", "The address of the transfer module authority (defaults to gov)")
+
+	flags.AddTxFlagsToCmd(cmd)
+	govcli.AddGovPropFlagsToCmd(cmd)
+	if err := cmd.MarkFlagRequired(govcli.FlagTitle); err != nil {
+		panic(err)
+	}
+
+	return cmd
+}
+
+// parseDenomMetadataFile parses the denom metadata overrides of the provided JSON file.
+func parseDenomMetadataFile(path string) ([]types.DenomMetadataOverride, error) {
+	bz, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.DisallowUnknownFields()
+
+	var overrides []types.DenomMetadataOverride
+	if err := decoder.Decode(&overrides); err != nil {
+		return nil, fmt.Errorf("failed to parse denom metadata file %s: %w", path, err)
+	}
+
+	return overrides, nil
+}
+
+// addTimeoutFlags adds the flags used to set the packet timeouts to the provided command.
+func addTimeoutFlags(cmd *cobra.Command) {
 	cmd.Flags().String(flagPacketTimeoutHeight, "0-0", "Packet timeout block height in the format {revision}-{height}. The timeout is disabled when set to 0-0.")
 	cmd.Flags().Uint64(flagPacketTimeoutTimestamp, defaultRelativePacketTimeoutTimestamp, "Packet timeout timestamp in nanoseconds from now. Default is 10 minutes. The timeout is disabled when set to 0.")
 	cmd.Flags().Bool(flagAbsoluteTimeouts, false, "Timeout flags are used as absolute timeouts.")
-	cmd.Flags().String(flagMemo, "", "Memo to be sent along with the packet.")
-	cmd.Flags().String(flagForwarding, "", "Forwarding information in the form of a comma separated list of portID/channelID pairs.")
-	cmd.Flags().Bool(flagUnwind, false, "Flag to indicate if the coin should be unwound to its native chain before forwarding.")
+	cmd.Flags().Bool(flagChainRelativeTimeouts, false, "Timeout flags are resolved on-chain relative to the counterparty chain state tracked by the client of the source channel.")
+	cmd.Flags().Uint64(flagPacketTimeoutHeightOffset, 0, "Packet timeout block height offset, only used along with chain relative timeouts. The timeout height is disabled when set to 0.")
+}
 
-	flags.AddTxFlagsToCmd(cmd)
+// parseTimeouts parses the timeout flags into either an absolute timeout height and timestamp, or a relative timeout
+// resolved on-chain if the chain relative timeouts flag is set. If the timeouts are neither absolute nor chain relative,
+// the timeout timestamp is computed relative to the local clock time.
+func parseTimeouts(cmd *cobra.Command) (clienttypes.Height, uint64, *types.RelativeTimeout, error) {
+	timeoutHeightStr, err := cmd.Flags().GetString(flagPacketTimeoutHeight)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
 
-	return cmd
+	timeoutHeight, err := clienttypes.ParseHeight(timeoutHeightStr)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
+
+	timeoutTimestamp, err := cmd.Flags().GetUint64(flagPacketTimeoutTimestamp)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
+
+	absoluteTimeouts, err := cmd.Flags().GetBool(flagAbsoluteTimeouts)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
+
+	chainRelativeTimeouts, err := cmd.Flags().GetBool(flagChainRelativeTimeouts)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
+
+	timeoutHeightOffset, err := cmd.Flags().GetUint64(flagPacketTimeoutHeightOffset)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, nil, err
+	}
+
+	if chainRelativeTimeouts {
+		if absoluteTimeouts {
+			return clienttypes.ZeroHeight(), 0, nil, fmt.Errorf("flags %s and %s cannot be used together", flagAbsoluteTimeouts, flagChainRelativeTimeouts)
+		}
+
+		if !timeoutHeight.IsZero() {
+			return clienttypes.ZeroHeight(), 0, nil, fmt.Errorf("chain relative timeouts use the %s flag instead of %s", flagPacketTimeoutHeightOffset, flagPacketTimeoutHeight)
+		}
+
+		return clienttypes.ZeroHeight(), 0, types.NewRelativeTimeout(timeoutHeightOffset, timeoutTimestamp), nil
+	}
+
+	if timeoutHeightOffset != 0 {
+		return clienttypes.ZeroHeight(), 0, nil, fmt.Errorf("flag %s can only be used along with %s", flagPacketTimeoutHeightOffset, flagChainRelativeTimeouts)
+	}
+
+	// NOTE: relative timeouts using block height are not supported.
+	// if the timeouts are not absolute, CLI users rely solely on local clock time in order to calculate relative timestamps.
+	if !absoluteTimeouts {
+		if !timeoutHeight.IsZero() {
+			return clienttypes.ZeroHeight(), 0, nil, errors.New("relative timeouts using block height is not supported")
+		}
+
+		if timeoutTimestamp == 0 {
+			return clienttypes.ZeroHeight(), 0, nil, errors.New("relative timeouts must provide a non zero value timestamp")
+		}
+
+		// use local clock time as reference time for calculating timeout timestamp.
+		now := time.Now().UnixNano()
+		if now <= 0 {
+			return clienttypes.ZeroHeight(), 0, nil, errors.New("local clock time is not greater than Jan 1st, 1970 12:00 AM")
+		}
+
+		timeoutTimestamp = uint64(now) + timeoutTimestamp
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil, nil
+}
+
+// parseCoins parses a comma-separated list of coins. Denominations which are not IBC denominations
+// are interpreted as full denomination paths and converted into the corresponding IBC denomination.
+func parseCoins(coinsStr string) (sdk.Coins, error) {
+	coins, err := sdk.ParseCoinsNormalized(coinsStr)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, coin := range coins {
+		if !strings.HasPrefix(coin.Denom, "ibc/") {
+			denom := types.ExtractDenomFromPath(coin.Denom)
+			coins[i].Denom = denom.IBCDenom()
+		}
+	}
+
+	return coins, nil
 }
 
 // parseForwarding parses the forwarding flag into a Forwarding object or nil if the flag is not specified. If the flag cannot
@@ -39,7 +39,7 @@ func NewIBCModule(k keeper.Keeper) IBCModule {
 }
 
 // ValidateTransferChannelParams does validation of a newly created transfer channel. A transfer
-// channel must be UNORDERED, use the correct port (by default 'transfer'), and use the current
+// channel must be UNORDERED or UNORDERED_EXPIRING_RECEIPTS, use the correct port (by default 'transfer'), and use the current
 // supported version. Only 2^32 channels are allowed to be created.
 func ValidateTransferChannelParams(
 	ctx context.Context,
@@ -57,8 +57,8 @@ func ValidateTransferChannelParams(
 	if channelSequence > uint64(math.MaxUint32) {
 		return errorsmod.Wrapf(types.ErrMaxTransferChannels, "channel sequence %d is greater than max allowed transfer channels %d", channelSequence, uint64(math.MaxUint32))
 	}
-	if order != channeltypes.UNORDERED {
-		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s channel, got %s ", channeltypes.UNORDERED, order)
+	if !order.IsUnordered() {
+		return errorsmod.Wrapf(channeltypes.ErrInvalidChannelOrdering, "expected %s or %s channel, got %s ", channeltypes.UNORDERED, channeltypes.UNORDERED_EXPIRING_RECEIPTS, order)
 	}
 
 	// Require portID is the portID transfer module is bound to
@@ -119,7 +119,7 @@ func (im IBCModule) OnChanOpenTry(
 }
 
 // OnChanOpenAck implements the IBCModule interface
-func (IBCModule) OnChanOpenAck(
+func (im IBCModule) OnChanOpenAck(
 	ctx context.Context,
 	portID,
 	channelID string,
@@ -130,15 +130,19 @@ func (IBCModule) OnChanOpenAck(
 		return errorsmod.Wrapf(types.ErrInvalidVersion, "invalid counterparty version: expected one of %s, got %s", types.SupportedVersions, counterpartyVersion)
 	}
 
+	im.keeper.ResolveCounterpartyAddressFormat(ctx, portID, channelID)
+
 	return nil
 }
 
 // OnChanOpenConfirm implements the IBCModule interface
-func (IBCModule) OnChanOpenConfirm(
+func (im IBCModule) OnChanOpenConfirm(
 	ctx context.Context,
 	portID,
 	channelID string,
 ) error {
+	im.keeper.ResolveCounterpartyAddressFormat(ctx, portID, channelID)
+
 	return nil
 }
 
@@ -199,7 +203,7 @@ func (im IBCModule) OnRecvPacket(
 
 	im.keeper.Logger(ctx).Info("successfully handled ICS-20 packet", "sequence", packet.Sequence)
 
-	if data.HasForwarding() {
+	if data.HasForwarding() || im.keeper.HasMemoForwarding(ctx, packet, data) {
 		// NOTE: acknowledgement will be written asynchronously
 		return nil
 	}
@@ -54,6 +54,11 @@ func (suite *TransferTestSuite) TestOnChanOpenInit() {
 				channel.Version = types.V1
 			}, nil, types.V1,
 		},
+		{
+			"success: UNORDERED_EXPIRING_RECEIPTS order", func() {
+				channel.Ordering = channeltypes.UNORDERED_EXPIRING_RECEIPTS
+			}, nil, types.V2,
+		},
 		{
 			"max channels reached", func() {
 				path.EndpointA.ChannelID = channeltypes.FormatChannelIdentifier(math.MaxUint32 + 1)
@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"strconv"
 
+	sdkmath "cosmossdk.io/math"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -139,6 +141,91 @@ func EmitDenomEvent(ctx context.Context, token types.Token) {
 	)
 }
 
+// EmitRateLimitExceededEvent emits a rate limit exceeded event when a transfer is rejected
+// because it would exceed the quota of a rate limit.
+func EmitRateLimitExceededEvent(ctx context.Context, rateLimit types.RateLimit, direction string, amount, threshold sdkmath.Int) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeRateLimit,
+			sdk.NewAttribute(types.AttributeKeyPortID, rateLimit.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, rateLimit.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyDenom, rateLimit.Denom),
+			sdk.NewAttribute(types.AttributeKeyFlowDirection, direction),
+			sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+			sdk.NewAttribute(types.AttributeKeyThreshold, threshold.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitForwardingFallbackEvent emits a forwarding fallback event when the tokens which could not be forwarded
+// to the next hop are delivered to the fallback receiver.
+func EmitForwardingFallbackEvent(ctx context.Context, fallback types.ForwardingFallback, tokens types.Tokens) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	tokensStr := mustMarshalJSON(tokens)
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeForwardingFallback,
+			sdk.NewAttribute(types.AttributeKeyReceiver, fallback.FallbackReceiver),
+			sdk.NewAttribute(types.AttributeKeyTokens, tokensStr),
+			sdk.NewAttribute(types.AttributeKeyPortID, fallback.PortId),
+			sdk.NewAttribute(types.AttributeKeyChannelID, fallback.ChannelId),
+			sdk.NewAttribute(types.AttributeKeyAckError, fallback.Error),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitDenomMigrationEvent emits a denomination migration event when the vouchers of a denomination
+// are migrated to another trace.
+func EmitDenomMigrationEvent(ctx context.Context, denomMigration types.DenomMigration, migrated sdk.Coin) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeDenomMigration,
+			sdk.NewAttribute(types.AttributeKeyOldDenom, mustMarshalJSON(denomMigration.OldDenom)),
+			sdk.NewAttribute(types.AttributeKeyNewDenom, mustMarshalJSON(denomMigration.NewDenom)),
+			sdk.NewAttribute(types.AttributeKeyAmount, migrated.String()),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
+// EmitProtocolFeeEvent emits a protocol fee event when a fee is charged on an outgoing transfer.
+// An empty recipient denotes the community pool.
+func EmitProtocolFeeEvent(ctx context.Context, sender, portID, channelID string, basisPoints uint32, fee sdk.Coin, recipient string) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+
+	sdkCtx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			types.EventTypeProtocolFee,
+			sdk.NewAttribute(types.AttributeKeySender, sender),
+			sdk.NewAttribute(types.AttributeKeyPortID, portID),
+			sdk.NewAttribute(types.AttributeKeyChannelID, channelID),
+			sdk.NewAttribute(types.AttributeKeyBasisPoints, strconv.FormatUint(uint64(basisPoints), 10)),
+			sdk.NewAttribute(types.AttributeKeyFee, fee.String()),
+			sdk.NewAttribute(types.AttributeKeyFeeRecipient, recipient),
+		),
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		),
+	})
+}
+
 // mustMarshalJSON json marshals the given type and panics on failure.
 func mustMarshalJSON(v any) string {
 	bz, err := json.Marshal(v)
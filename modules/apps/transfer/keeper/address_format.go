@@ -0,0 +1,154 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// WithAddressFormatResolver sets the resolver used to learn the address format of the counterparty chain of
+// the transfer channels when their handshake completes. This function must be called before the keeper is
+// passed to the IBC module.
+func (k *Keeper) WithAddressFormatResolver(resolver types.AddressFormatResolver) {
+	k.addressFormatResolver = resolver
+}
+
+// GetCounterpartyAddressFormat retrieves the address format of the counterparty chain of the provided channel end.
+func (k Keeper) GetCounterpartyAddressFormat(ctx context.Context, portID, channelID string) (types.AddressFormat, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.CounterpartyAddressFormatStoreKey(portID, channelID))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.AddressFormat{}, false
+	}
+
+	var counterpartyAddressFormat types.CounterpartyAddressFormat
+	k.cdc.MustUnmarshal(bz, &counterpartyAddressFormat)
+
+	return counterpartyAddressFormat.AddressFormat, true
+}
+
+// setCounterpartyAddressFormat stores the provided counterparty address format.
+func (k Keeper) setCounterpartyAddressFormat(ctx context.Context, counterpartyAddressFormat types.CounterpartyAddressFormat) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&counterpartyAddressFormat)
+	if err := store.Set(types.CounterpartyAddressFormatStoreKey(counterpartyAddressFormat.PortId, counterpartyAddressFormat.ChannelId), bz); err != nil {
+		panic(err)
+	}
+}
+
+// deleteCounterpartyAddressFormat removes the counterparty address format of the provided channel end.
+func (k Keeper) deleteCounterpartyAddressFormat(ctx context.Context, portID, channelID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.CounterpartyAddressFormatStoreKey(portID, channelID)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllCounterpartyAddressFormats returns all the counterparty address formats stored in state.
+func (k Keeper) GetAllCounterpartyAddressFormats(ctx context.Context) []types.CounterpartyAddressFormat {
+	var addressFormats []types.CounterpartyAddressFormat
+	k.IterateCounterpartyAddressFormats(ctx, func(addressFormat types.CounterpartyAddressFormat) bool {
+		addressFormats = append(addressFormats, addressFormat)
+		return false
+	})
+
+	return addressFormats
+}
+
+// IterateCounterpartyAddressFormats iterates over the counterparty address formats in the store and performs
+// a callback function.
+func (k Keeper) IterateCounterpartyAddressFormats(ctx context.Context, cb func(addressFormat types.CounterpartyAddressFormat) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.CounterpartyAddressFormatKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var addressFormat types.CounterpartyAddressFormat
+		k.cdc.MustUnmarshal(iterator.Value(), &addressFormat)
+
+		if cb(addressFormat) {
+			break
+		}
+	}
+}
+
+// ResolveCounterpartyAddressFormat learns the address format of the counterparty chain of the provided channel
+// end from the address format resolver, if any. An address format already set, e.g. by governance, is never
+// overwritten and invalid address formats returned by the resolver are ignored.
+func (k Keeper) ResolveCounterpartyAddressFormat(ctx context.Context, portID, channelID string) {
+	if k.addressFormatResolver == nil {
+		return
+	}
+
+	if _, found := k.GetCounterpartyAddressFormat(ctx, portID, channelID); found {
+		return
+	}
+
+	addressFormat, found := k.addressFormatResolver.ResolveAddressFormat(ctx, portID, channelID)
+	if !found {
+		return
+	}
+
+	if err := addressFormat.Validate(); err != nil {
+		k.Logger(ctx).Error("ignoring invalid counterparty address format", "port-id", portID, "channel-id", channelID, "error", err)
+		return
+	}
+
+	k.setCounterpartyAddressFormat(ctx, types.NewCounterpartyAddressFormat(portID, channelID, addressFormat))
+}
+
+// validateReceiverAddressFormat validates the receiver of a transfer sent over the provided channel end against
+// the address format of the counterparty chain. Receivers are not validated if no address format is known.
+func (k Keeper) validateReceiverAddressFormat(ctx context.Context, portID, channelID, receiver string) error {
+	addressFormat, found := k.GetCounterpartyAddressFormat(ctx, portID, channelID)
+	if !found {
+		return nil
+	}
+
+	if err := addressFormat.ValidateAddress(receiver); err != nil {
+		return errorsmod.Wrapf(err, "receiver does not match the address format of the counterparty of channel %s on port %s", channelID, portID)
+	}
+
+	return nil
+}
+
+// validateForwardReceiver validates the receiver of the tokens forwarded over the next hop against the address
+// format of the counterparty chain of the next hop. It allows to fail early, so that the error acknowledgement
+// written for the received packet refers to the forwarding hop.
+func (k Keeper) validateForwardReceiver(ctx context.Context, nextHop types.Hop, receiver string) error {
+	if err := k.validateReceiverAddressFormat(ctx, nextHop.PortId, nextHop.ChannelId, receiver); err != nil {
+		return errorsmod.Wrap(err, "invalid receiver of forwarded tokens")
+	}
+
+	return nil
+}
+
+// validateTransferReceiver validates the receiver of the provided transfer against the address format of the
+// counterparty chain of the source channel. For transfers forwarded over several hops the receiver is only
+// known to the last hop, and is validated by the chain forwarding the tokens over it against the address
+// format of the last hop (see validateForwardReceiver). The fallback receiver, which receives the tokens on
+// the counterparty chain if forwarding fails, is validated instead.
+func (k Keeper) validateTransferReceiver(ctx context.Context, msg *types.MsgTransfer) error {
+	if len(msg.Forwarding.GetHops()) == 0 {
+		return k.validateReceiverAddressFormat(ctx, msg.SourcePort, msg.SourceChannel, msg.Receiver)
+	}
+
+	if len(msg.Forwarding.GetFallbackReceivers()) == 0 {
+		return nil
+	}
+
+	if err := k.validateReceiverAddressFormat(ctx, msg.SourcePort, msg.SourceChannel, msg.Forwarding.GetFallbackReceivers()[0]); err != nil {
+		return errorsmod.Wrap(err, "invalid fallback receiver")
+	}
+
+	return nil
+}
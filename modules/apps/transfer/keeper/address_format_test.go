@@ -0,0 +1,296 @@
+package keeper_test
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// addressFormatResolver is a types.AddressFormatResolver returning a fixed address format.
+type addressFormatResolver struct {
+	addressFormat types.AddressFormat
+	found         bool
+}
+
+func (r addressFormatResolver) ResolveAddressFormat(_ context.Context, _, _ string) (types.AddressFormat, bool) {
+	return r.addressFormat, r.found
+}
+
+// TestReceiverAddressFormatValidation tests that the receivers of outgoing transfers are validated against the
+// address format of the counterparty chain.
+func (suite *KeeperTestSuite) TestReceiverAddressFormatValidation() {
+	var msg *types.MsgTransfer
+
+	testCases := []struct {
+		name          string
+		addressFormat *types.AddressFormat
+		malleate      func()
+		expError      error
+	}{
+		{
+			"success: no address format",
+			nil,
+			func() {
+				msg.Receiver = "0xdeadbeef"
+			},
+			nil,
+		},
+		{
+			"success: receiver matches bech32 address format",
+			&types.AddressFormat{Bech32Prefix: sdk.GetConfig().GetBech32AccountAddrPrefix()},
+			func() {},
+			nil,
+		},
+		{
+			"success: receiver matches hex address format",
+			&types.AddressFormat{HexLength: 4},
+			func() {
+				msg.Receiver = "0xdeadbeef"
+			},
+			nil,
+		},
+		{
+			"success: receiver is validated by the last hop when forwarding",
+			&types.AddressFormat{HexLength: 4},
+			func() {
+				msg.Forwarding = types.NewForwarding(false, types.NewHop(types.PortID, ibctesting.FirstChannelID))
+			},
+			nil,
+		},
+		{
+			"failure: receiver does not match bech32 address format",
+			&types.AddressFormat{Bech32Prefix: "osmo"},
+			func() {},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: receiver does not match hex address format",
+			&types.AddressFormat{HexLength: 20},
+			func() {
+				msg.Receiver = "0xdeadbeef"
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"failure: fallback receiver does not match address format when forwarding",
+			&types.AddressFormat{HexLength: 4},
+			func() {
+				msg.Forwarding = types.NewForwarding(false, types.NewHop(types.PortID, ibctesting.FirstChannelID))
+				msg.Forwarding.FallbackReceivers = []string{suite.chainB.SenderAccount.GetAddress().String()}
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			if tc.addressFormat != nil {
+				_, err := transferKeeper.SetCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgSetCounterpartyAddressFormat(transferKeeper.GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, *tc.addressFormat))
+				suite.Require().NoError(err)
+			}
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+				nil,
+			)
+
+			tc.malleate()
+
+			_, err := transferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestResolveCounterpartyAddressFormat() {
+	var (
+		resolver types.AddressFormatResolver
+		path     *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name             string
+		malleate         func()
+		expAddressFormat *types.AddressFormat
+	}{
+		{
+			"success: address format is resolved",
+			func() {},
+			&types.AddressFormat{Bech32Prefix: "osmo"},
+		},
+		{
+			"success: address format set by governance is kept",
+			func() {
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+				_, err := transferKeeper.SetCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgSetCounterpartyAddressFormat(transferKeeper.GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.NewHexAddressFormat(20)))
+				suite.Require().NoError(err)
+			},
+			&types.AddressFormat{HexLength: 20},
+		},
+		{
+			"no resolver",
+			func() {
+				resolver = nil
+			},
+			nil,
+		},
+		{
+			"address format cannot be resolved",
+			func() {
+				resolver = addressFormatResolver{}
+			},
+			nil,
+		},
+		{
+			"success: address format is resolved from the counterparty chain ID",
+			func() {
+				resolver = types.NewChainIDAddressFormatResolver(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper, map[string]types.AddressFormat{
+					suite.chainB.ChainID: types.NewBech32AddressFormat("osmo"),
+				})
+			},
+			&types.AddressFormat{Bech32Prefix: "osmo"},
+		},
+		{
+			"counterparty chain ID is unknown",
+			func() {
+				resolver = types.NewChainIDAddressFormatResolver(suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper, map[string]types.AddressFormat{
+					suite.chainC.ChainID: types.NewBech32AddressFormat("osmo"),
+				})
+			},
+			nil,
+		},
+		{
+			"invalid address format is ignored",
+			func() {
+				resolver = addressFormatResolver{addressFormat: types.NewBech32AddressFormat("OSMO"), found: true}
+			},
+			nil,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			resolver = addressFormatResolver{addressFormat: types.NewBech32AddressFormat("osmo"), found: true}
+
+			tc.malleate()
+
+			transferKeeper := &suite.chainA.GetSimApp().TransferKeeper
+			transferKeeper.WithAddressFormatResolver(resolver)
+			transferKeeper.ResolveCounterpartyAddressFormat(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			addressFormat, found := transferKeeper.GetCounterpartyAddressFormat(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			if tc.expAddressFormat == nil {
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+				suite.Require().Equal(*tc.expAddressFormat, addressFormat)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestMsgCounterpartyAddressFormat() {
+	var (
+		portID    string
+		channelID string
+		signer    string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				channelID = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+			signer = transferKeeper.GetAuthority()
+
+			tc.malleate()
+
+			addressFormat := types.NewBech32AddressFormat("osmo")
+			_, err := transferKeeper.SetCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgSetCounterpartyAddressFormat(signer, portID, channelID, addressFormat))
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				_, found := transferKeeper.GetCounterpartyAddressFormat(suite.chainA.GetContext(), portID, channelID)
+				suite.Require().False(found)
+				return
+			}
+
+			suite.Require().NoError(err)
+			storedAddressFormat, found := transferKeeper.GetCounterpartyAddressFormat(suite.chainA.GetContext(), portID, channelID)
+			suite.Require().True(found)
+			suite.Require().Equal(addressFormat, storedAddressFormat)
+
+			_, err = transferKeeper.RemoveCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgRemoveCounterpartyAddressFormat(signer, portID, channelID))
+			suite.Require().NoError(err)
+
+			_, found = transferKeeper.GetCounterpartyAddressFormat(suite.chainA.GetContext(), portID, channelID)
+			suite.Require().False(found)
+
+			_, err = transferKeeper.RemoveCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgRemoveCounterpartyAddressFormat(signer, portID, channelID))
+			suite.Require().ErrorIs(err, types.ErrAddressFormatNotFound)
+		})
+	}
+}
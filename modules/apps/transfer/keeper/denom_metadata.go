@@ -0,0 +1,96 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetDenomMetadataOverride retrieves the bank metadata override of the provided IBC denomination (ibc/{hash}).
+func (k Keeper) GetDenomMetadataOverride(ctx context.Context, denom string) (types.DenomMetadataOverride, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.DenomMetadataOverrideStoreKey(denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.DenomMetadataOverride{}, false
+	}
+
+	var override types.DenomMetadataOverride
+	k.cdc.MustUnmarshal(bz, &override)
+
+	return override, true
+}
+
+// setDenomMetadataOverride stores the provided denom metadata override, keyed by its IBC denomination.
+func (k Keeper) setDenomMetadataOverride(ctx context.Context, override types.DenomMetadataOverride) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&override)
+	if err := store.Set(types.DenomMetadataOverrideStoreKey(override.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllDenomMetadataOverrides returns all the denom metadata overrides stored in state.
+func (k Keeper) GetAllDenomMetadataOverrides(ctx context.Context) []types.DenomMetadataOverride {
+	var overrides []types.DenomMetadataOverride
+	k.IterateDenomMetadataOverrides(ctx, func(override types.DenomMetadataOverride) bool {
+		overrides = append(overrides, override)
+		return false
+	})
+
+	return overrides
+}
+
+// IterateDenomMetadataOverrides iterates over the denom metadata overrides in the store and performs a callback function.
+func (k Keeper) IterateDenomMetadataOverrides(ctx context.Context, cb func(override types.DenomMetadataOverride) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomMetadataOverrideKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var override types.DenomMetadataOverride
+		k.cdc.MustUnmarshal(iterator.Value(), &override)
+
+		if cb(override) {
+			break
+		}
+	}
+}
+
+// overrideDenomMetadata stores the provided denom metadata override and sets the bank metadata of its IBC
+// denomination accordingly. The metadata of the denomination is not generated again when its vouchers are
+// received afterwards.
+func (k Keeper) overrideDenomMetadata(ctx context.Context, override types.DenomMetadataOverride) {
+	k.setDenomMetadataOverride(ctx, override)
+
+	var description string
+	hash, err := types.ParseHexHash(strings.TrimPrefix(override.Denom, types.DenomPrefix+"/"))
+	if err != nil {
+		panic(err) // the override has been validated
+	}
+	if denom, found := k.GetDenom(ctx, hash); found {
+		description = fmt.Sprintf("IBC token from %s", denom.Path())
+	}
+
+	k.bankKeeper.SetDenomMetaData(ctx, override.Metadata(description))
+}
+
+// hasDenomMetadataOverride returns true if the bank metadata of the provided IBC denomination has been overridden.
+func (k Keeper) hasDenomMetadataOverride(ctx context.Context, denom string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(types.DenomMetadataOverrideStoreKey(denom))
+	if err != nil {
+		panic(err)
+	}
+
+	return has
+}
@@ -0,0 +1,170 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestMsgSetDenomMetadata() {
+	var (
+		signer   string
+		override types.DenomMetadataOverride
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: metadata of unknown denomination",
+			func() {
+				override.Denom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(types.PortID, "channel-7")).IBCDenom()
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			// receive vouchers on chain B, generating their metadata
+			denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			suite.receiveVouchers(path)
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			signer = transferKeeper.GetAuthority()
+			override = types.NewDenomMetadataOverride(denom.IBCDenom(), "Stake", "STK", "stake", 6, "https://example.com/stake.json")
+
+			tc.malleate()
+
+			_, err := transferKeeper.SetDenomMetadata(suite.chainB.GetContext(), types.NewMsgSetDenomMetadata(signer, override))
+
+			storedOverride, found := transferKeeper.GetDenomMetadataOverride(suite.chainB.GetContext(), override.Denom)
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().False(found)
+
+				metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denom.IBCDenom())
+				suite.Require().True(found)
+				suite.Require().Equal(metadataFromDenom(denom), metadata)
+				return
+			}
+
+			suite.Require().NoError(err)
+			suite.Require().True(found)
+			suite.Require().Equal(override, storedOverride)
+
+			var description string
+			if override.Denom == denom.IBCDenom() {
+				description = fmt.Sprintf("IBC token from %s", denom.Path())
+			}
+
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), override.Denom)
+			suite.Require().True(found)
+			suite.Require().Equal(override.Metadata(description), metadata)
+		})
+	}
+}
+
+// TestDenomMetadataOverrideNotOverwritten tests that overridden metadata is not generated again for the vouchers
+// received afterwards, nor when the genesis state is imported.
+func (suite *KeeperTestSuite) TestDenomMetadataOverrideNotOverwritten() {
+	var (
+		path  *ibctesting.Path
+		denom types.Denom
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+	}{
+		{
+			"vouchers are received",
+			func() {
+				suite.receiveVouchers(path)
+			},
+		},
+		{
+			"denomination is imported at genesis",
+			func() {
+				// the metadata is not looked up in the bank module on genesis import, only the override prevents
+				// the metadata from being generated again
+				suite.chainB.GetSimApp().TransferKeeper.InitGenesis(suite.chainB.GetContext(), types.GenesisState{
+					PortId: types.PortID,
+					Denoms: types.Denoms{denom},
+					Params: types.DefaultParams(),
+				})
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+			override := types.NewDenomMetadataOverride(denom.IBCDenom(), "Stake", "STK", "stake", 6, "")
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			_, err := transferKeeper.SetDenomMetadata(suite.chainB.GetContext(), types.NewMsgSetDenomMetadata(transferKeeper.GetAuthority(), override))
+			suite.Require().NoError(err)
+
+			tc.malleate()
+
+			metadata, found := suite.chainB.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainB.GetContext(), denom.IBCDenom())
+			suite.Require().True(found)
+			suite.Require().Equal(override.Metadata(""), metadata)
+		})
+	}
+}
+
+// receiveVouchers receives vouchers of the native denomination of chain A on chain B over the provided path.
+func (suite *KeeperTestSuite) receiveVouchers(path *ibctesting.Path) {
+	data := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{
+			{
+				Denom:  types.NewDenom(sdk.DefaultBondDenom),
+				Amount: ibctesting.DefaultCoinAmount.String(),
+			},
+		},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		"",
+		ibctesting.EmptyForwardingPacketData,
+	)
+	packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+	suite.Require().NoError(err)
+}
@@ -0,0 +1,264 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// GetDenomMigration retrieves the migration of the vouchers of the provided denomination (ibc/{hash}).
+func (k Keeper) GetDenomMigration(ctx context.Context, denom string) (types.DenomMigration, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.DenomMigrationStoreKey(denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.DenomMigration{}, false
+	}
+
+	var denomMigration types.DenomMigration
+	k.cdc.MustUnmarshal(bz, &denomMigration)
+
+	return denomMigration, true
+}
+
+// setDenomMigration stores the provided denom migration, keyed by the old denomination.
+func (k Keeper) setDenomMigration(ctx context.Context, denomMigration types.DenomMigration) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&denomMigration)
+	if err := store.Set(types.DenomMigrationStoreKey(denomMigration.OldDenom.IBCDenom()), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllDenomMigrations returns all the denom migrations stored in state.
+func (k Keeper) GetAllDenomMigrations(ctx context.Context) []types.DenomMigration {
+	var denomMigrations []types.DenomMigration
+	k.IterateDenomMigrations(ctx, func(denomMigration types.DenomMigration) bool {
+		denomMigrations = append(denomMigrations, denomMigration)
+		return false
+	})
+
+	return denomMigrations
+}
+
+// IterateDenomMigrations iterates over the denom migrations in the store and performs a callback function.
+func (k Keeper) IterateDenomMigrations(ctx context.Context, cb func(denomMigration types.DenomMigration) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.DenomMigrationKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var denomMigration types.DenomMigration
+		k.cdc.MustUnmarshal(iterator.Value(), &denomMigration)
+
+		if cb(denomMigration) {
+			break
+		}
+	}
+}
+
+// migratedCoin returns a coin with the same amount as the provided coin and the denomination its vouchers
+// have been migrated to. The coin is returned as is if the vouchers of its denomination have not been migrated.
+func (k Keeper) migratedCoin(ctx context.Context, coin sdk.Coin) sdk.Coin {
+	denomMigration, found := k.GetDenomMigration(ctx, coin.Denom)
+	if !found {
+		return coin
+	}
+
+	return sdk.NewCoin(denomMigration.NewDenom.IBCDenom(), coin.Amount)
+}
+
+// migrateDenomTrace migrates the vouchers of the old denomination held by the provided holders, or by up to
+// MaximumDenomMigrationHolders holders if none are provided, to the new denomination. The vouchers in escrow
+// are always migrated, along with the amounts in escrow for each channel end and the total escrow amount,
+// while the vouchers held by the transfer module account for in-flight forwarded packets are not. The migration
+// is recorded, so that the tokens unescrowed or refunded afterwards are of the new denomination. The amount
+// of vouchers of the new denomination minted is returned.
+func (k Keeper) migrateDenomTrace(ctx context.Context, denomMigration types.DenomMigration, holders []sdk.AccAddress) (sdk.Coin, error) {
+	oldDenom := denomMigration.OldDenom.IBCDenom()
+	newDenom := denomMigration.NewDenom.IBCDenom()
+
+	if !k.HasDenom(ctx, denomMigration.OldDenom.Hash()) {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomNotFound, "denom: %s", denomMigration.OldDenom.Path())
+	}
+
+	if existing, found := k.GetDenomMigration(ctx, oldDenom); found && existing.NewDenom.IBCDenom() != newDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomMigrated, "%s has already been migrated to %s", denomMigration.OldDenom.Path(), existing.NewDenom.Path())
+	}
+
+	if existing, found := k.GetDenomMigration(ctx, newDenom); found {
+		return sdk.Coin{}, errorsmod.Wrapf(types.ErrDenomMigrated, "%s has been migrated to %s", denomMigration.NewDenom.Path(), existing.NewDenom.Path())
+	}
+
+	if !k.HasDenom(ctx, denomMigration.NewDenom.Hash()) {
+		k.SetDenom(ctx, denomMigration.NewDenom)
+	}
+
+	if !k.bankKeeper.HasDenomMetaData(ctx, newDenom) {
+		k.setDenomMetadata(ctx, denomMigration.NewDenom)
+	}
+
+	migrated := sdk.NewCoin(newDenom, sdkmath.ZeroInt())
+
+	// migrate the vouchers in escrow together with their escrow accounting
+	escrowAddresses := make(map[string]bool)
+	var channelEscrows []types.ChannelEscrow
+	k.IterateChannelEscrows(ctx, func(channelEscrow types.ChannelEscrow) bool {
+		if channelEscrow.Amount.Denom == oldDenom {
+			channelEscrows = append(channelEscrows, channelEscrow)
+		}
+		return false
+	})
+
+	for _, channelEscrow := range channelEscrows {
+		escrowAddress := types.GetEscrowAddress(channelEscrow.PortId, channelEscrow.ChannelId)
+		escrowAddresses[escrowAddress.String()] = true
+
+		amount, err := k.migrateVouchers(ctx, escrowAddress, oldDenom, newDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		migrated = migrated.AddAmount(amount)
+
+		currentChannelEscrow := k.GetChannelEscrow(ctx, channelEscrow.PortId, channelEscrow.ChannelId, newDenom)
+		k.SetChannelEscrow(ctx, channelEscrow.PortId, channelEscrow.ChannelId, currentChannelEscrow.AddAmount(channelEscrow.Amount.Amount))
+		k.SetChannelEscrow(ctx, channelEscrow.PortId, channelEscrow.ChannelId, sdk.NewCoin(oldDenom, sdkmath.ZeroInt()))
+	}
+
+	totalEscrow := k.GetTotalEscrowForDenom(ctx, oldDenom)
+	k.SetTotalEscrowForDenom(ctx, k.GetTotalEscrowForDenom(ctx, newDenom).AddAmount(totalEscrow.Amount))
+	k.SetTotalEscrowForDenom(ctx, sdk.NewCoin(oldDenom, sdkmath.ZeroInt()))
+
+	if len(holders) == 0 {
+		var err error
+		holders, err = k.getDenomHolders(ctx, oldDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	for _, holder := range holders {
+		// the vouchers of the escrow addresses have already been migrated, and the vouchers of the module
+		// account are burned or escrowed in the old denomination when a forwarded packet is reverted
+		if escrowAddresses[holder.String()] || holder.Equals(moduleAddr) {
+			continue
+		}
+
+		amount, err := k.migrateVouchers(ctx, holder, oldDenom, newDenom)
+		if err != nil {
+			return sdk.Coin{}, err
+		}
+		migrated = migrated.AddAmount(amount)
+	}
+
+	// the migrations to the old denomination are updated to the new denomination, such that a
+	// coin is migrated at most once
+	var chainedMigrations []types.DenomMigration
+	k.IterateDenomMigrations(ctx, func(existing types.DenomMigration) bool {
+		if existing.NewDenom.IBCDenom() == oldDenom {
+			chainedMigrations = append(chainedMigrations, existing)
+		}
+		return false
+	})
+
+	for _, chainedMigration := range chainedMigrations {
+		k.setDenomMigration(ctx, types.NewDenomMigration(chainedMigration.OldDenom, denomMigration.NewDenom))
+	}
+
+	k.setDenomMigration(ctx, denomMigration)
+
+	events.EmitDenomMigrationEvent(ctx, denomMigration, migrated)
+
+	return migrated, nil
+}
+
+// migrateHolderDenomTrace migrates the vouchers of the old denomination held by the provided holder to the new
+// denomination. It allows holders to migrate their own vouchers, once the migration to the new denomination has
+// been recorded by the authority. The amount of vouchers of the new denomination minted is returned.
+func (k Keeper) migrateHolderDenomTrace(ctx context.Context, denomMigration types.DenomMigration, holder sdk.AccAddress) (sdk.Coin, error) {
+	oldDenom := denomMigration.OldDenom.IBCDenom()
+	newDenom := denomMigration.NewDenom.IBCDenom()
+
+	existing, found := k.GetDenomMigration(ctx, oldDenom)
+	if !found || existing.NewDenom.IBCDenom() != newDenom {
+		return sdk.Coin{}, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "migration of %s to %s has not been recorded by the authority", denomMigration.OldDenom.Path(), denomMigration.NewDenom.Path())
+	}
+
+	amount, err := k.migrateVouchers(ctx, holder, oldDenom, newDenom)
+	if err != nil {
+		return sdk.Coin{}, err
+	}
+
+	migrated := sdk.NewCoin(newDenom, amount)
+	events.EmitDenomMigrationEvent(ctx, denomMigration, migrated)
+
+	return migrated, nil
+}
+
+// migrateVouchers burns the balance of the old denomination of the holder and mints the same amount
+// of the new denomination to the holder. The migrated amount is returned.
+func (k Keeper) migrateVouchers(ctx context.Context, holder sdk.AccAddress, oldDenom, newDenom string) (sdkmath.Int, error) {
+	balance := k.bankKeeper.GetBalance(ctx, holder, oldDenom)
+	if balance.IsZero() {
+		return sdkmath.ZeroInt(), nil
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, holder, types.ModuleName, sdk.NewCoins(balance)); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrapf(err, "failed to migrate vouchers of %s", holder)
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(balance)); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrap(err, "failed to burn IBC tokens")
+	}
+
+	voucher := sdk.NewCoin(newDenom, balance.Amount)
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(voucher)); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrap(err, "failed to mint IBC tokens")
+	}
+
+	moduleAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
+	if err := k.bankKeeper.SendCoins(ctx, moduleAddr, holder, sdk.NewCoins(voucher)); err != nil {
+		return sdkmath.Int{}, errorsmod.Wrapf(err, "failed to send coins to holder %s", holder)
+	}
+
+	return balance.Amount, nil
+}
+
+// getDenomHolders returns up to MaximumDenomMigrationHolders addresses holding a balance of the provided
+// denomination. Since the balances of the returned holders are migrated, the next holders are returned
+// when the vouchers of the denomination are migrated again.
+func (k Keeper) getDenomHolders(ctx context.Context, denom string) ([]sdk.AccAddress, error) {
+	res, err := k.bankKeeper.DenomOwners(ctx, &banktypes.QueryDenomOwnersRequest{
+		Denom:      denom,
+		Pagination: &query.PageRequest{Limit: types.MaximumDenomMigrationHolders},
+	})
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to query holders of %s", denom)
+	}
+
+	holders := make([]sdk.AccAddress, 0, len(res.DenomOwners))
+	for _, owner := range res.DenomOwners {
+		holder, err := sdk.AccAddressFromBech32(owner.Address)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, holder)
+	}
+
+	return holders, nil
+}
@@ -0,0 +1,350 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var (
+	migratedHolderAmount = sdkmath.NewInt(50)
+	migratedOtherAmount  = sdkmath.NewInt(10)
+	migratedEscrowAmount = sdkmath.NewInt(40)
+)
+
+// setupDenomMigration receives vouchers of the native denomination of chainA on chainB, which are then split between
+// two holders and the escrow account of the channel from chainB to chainC. It returns the paths and the voucher denom.
+func (suite *KeeperTestSuite) setupDenomMigration() (*ibctesting.Path, *ibctesting.Path, types.Denom) {
+	pathAToB := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathAToB.Setup()
+
+	pathBToC := ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+	pathBToC.Setup()
+
+	ctx := suite.chainB.GetContext()
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+
+	packetData := types.NewFungibleTokenPacketDataV2(
+		[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: ibctesting.DefaultCoinAmount.String()}},
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(),
+		"",
+		ibctesting.EmptyForwardingPacketData,
+	)
+
+	packet := channeltypes.NewPacket(packetData.GetBytes(), 1, pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID, pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+	suite.Require().NoError(transferKeeper.OnRecvPacket(ctx, packet, packetData))
+
+	oldDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID))
+
+	err := suite.chainB.GetSimApp().BankKeeper.SendCoins(
+		ctx,
+		suite.chainB.SenderAccounts[0].SenderAccount.GetAddress(),
+		suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(),
+		sdk.NewCoins(sdk.NewCoin(oldDenom.IBCDenom(), migratedOtherAmount)),
+	)
+	suite.Require().NoError(err)
+
+	msgTransfer := types.NewMsgTransfer(
+		pathBToC.EndpointA.ChannelConfig.PortID,
+		pathBToC.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(oldDenom.IBCDenom(), migratedEscrowAmount)),
+		suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String(),
+		suite.chainC.SenderAccount.GetAddress().String(),
+		suite.chainC.GetTimeoutHeight(), 0,
+		"",
+		nil,
+	)
+
+	_, err = transferKeeper.Transfer(ctx, msgTransfer)
+	suite.Require().NoError(err)
+
+	return pathAToB, pathBToC, oldDenom
+}
+
+func (suite *KeeperTestSuite) TestMsgMigrateDenomTrace() {
+	var (
+		msg         *types.MsgMigrateDenomTrace
+		expMigrated sdkmath.Int
+	)
+
+	newDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-100"))
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: vouchers of all holders are migrated",
+			func() {},
+			nil,
+		},
+		{
+			"success: vouchers of provided holders and in escrow are migrated",
+			func() {
+				msg.Holders = []string{suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()}
+				expMigrated = migratedHolderAmount.Add(migratedEscrowAmount)
+			},
+			nil,
+		},
+		{
+			"success: migration is repeated for the remaining holders",
+			func() {
+				holdersMsg := *msg
+				holdersMsg.Holders = []string{suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()}
+
+				_, err := suite.chainB.GetSimApp().TransferKeeper.MigrateDenomTrace(suite.chainB.GetContext(), &holdersMsg)
+				suite.Require().NoError(err)
+
+				expMigrated = migratedOtherAmount
+			},
+			nil,
+		},
+		{
+			"success: vouchers held by the transfer module account are not migrated",
+			func() {
+				moduleAddr := suite.chainB.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+				err := suite.chainB.GetSimApp().BankKeeper.SendCoins(
+					suite.chainB.GetContext(),
+					suite.chainB.SenderAccounts[1].SenderAccount.GetAddress(),
+					moduleAddr,
+					sdk.NewCoins(sdk.NewCoin(msg.OldDenom.IBCDenom(), migratedOtherAmount)),
+				)
+				suite.Require().NoError(err)
+
+				expMigrated = migratedHolderAmount.Add(migratedEscrowAmount)
+			},
+			nil,
+		},
+		{
+			"success: holder migrates own vouchers after migration by the authority",
+			func() {
+				authorityMsg := *msg
+				authorityMsg.Holders = []string{suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()}
+
+				_, err := suite.chainB.GetSimApp().TransferKeeper.MigrateDenomTrace(suite.chainB.GetContext(), &authorityMsg)
+				suite.Require().NoError(err)
+
+				msg.Signer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+				expMigrated = migratedOtherAmount
+			},
+			nil,
+		},
+		{
+			"failure: holder migrates own vouchers before migration by the authority",
+			func() {
+				msg.Signer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: holder migrates vouchers of another holder",
+			func() {
+				authorityMsg := *msg
+				authorityMsg.Holders = []string{suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String()}
+
+				_, err := suite.chainB.GetSimApp().TransferKeeper.MigrateDenomTrace(suite.chainB.GetContext(), &authorityMsg)
+				suite.Require().NoError(err)
+
+				msg.Signer = suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String()
+				msg.Holders = []string{suite.chainB.SenderAccounts[2].SenderAccount.GetAddress().String()}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: invalid signer",
+			func() {
+				msg.Signer = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: old denom not found",
+			func() {
+				msg.OldDenom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-101"))
+			},
+			types.ErrDenomNotFound,
+		},
+		{
+			"failure: old denom already migrated to another denom",
+			func() {
+				otherMsg := *msg
+				otherMsg.NewDenom = types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-101"))
+
+				_, err := suite.chainB.GetSimApp().TransferKeeper.MigrateDenomTrace(suite.chainB.GetContext(), &otherMsg)
+				suite.Require().NoError(err)
+			},
+			types.ErrDenomMigrated,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			_, pathBToC, oldDenom := suite.setupDenomMigration()
+
+			msg = types.NewMsgMigrateDenomTrace(suite.chainB.GetSimApp().TransferKeeper.GetAuthority(), oldDenom, newDenom)
+			expMigrated = ibctesting.DefaultCoinAmount
+
+			tc.malleate()
+
+			ctx := suite.chainB.GetContext()
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+
+			res, err := transferKeeper.MigrateDenomTrace(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(sdk.NewCoin(newDenom.IBCDenom(), expMigrated), res.Migrated)
+
+				holder := suite.chainB.SenderAccounts[0].SenderAccount.GetAddress()
+				suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, holder, oldDenom.IBCDenom()).IsZero())
+				suite.Require().Equal(migratedHolderAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, holder, newDenom.IBCDenom()).Amount)
+
+				escrowAddress := types.GetEscrowAddress(pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID)
+				suite.Require().True(suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, oldDenom.IBCDenom()).IsZero())
+				suite.Require().Equal(migratedEscrowAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, escrowAddress, newDenom.IBCDenom()).Amount)
+
+				suite.Require().True(transferKeeper.GetChannelEscrow(ctx, pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID, oldDenom.IBCDenom()).IsZero())
+				suite.Require().Equal(migratedEscrowAmount, transferKeeper.GetChannelEscrow(ctx, pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID, newDenom.IBCDenom()).Amount)
+				suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, oldDenom.IBCDenom()).IsZero())
+				suite.Require().Equal(migratedEscrowAmount, transferKeeper.GetTotalEscrowForDenom(ctx, newDenom.IBCDenom()).Amount)
+
+				denomMigration, found := transferKeeper.GetDenomMigration(ctx, oldDenom.IBCDenom())
+				suite.Require().True(found)
+				suite.Require().Equal(types.NewDenomMigration(oldDenom, newDenom), denomMigration)
+
+				suite.Require().True(transferKeeper.HasDenom(ctx, newDenom.Hash()))
+				suite.Require().True(suite.chainB.GetSimApp().BankKeeper.HasDenomMetaData(ctx, newDenom.IBCDenom()))
+
+				_, broken := keeper.AllInvariants(&transferKeeper)(ctx)
+				suite.Require().False(broken)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestDenomMigrationRelay tests that the vouchers of a migrated denomination cannot be sent or received anymore, and
+// that the tokens unescrowed or refunded afterwards are of the denomination the vouchers have been migrated to.
+func (suite *KeeperTestSuite) TestDenomMigrationRelay() {
+	newDenom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, "channel-100"))
+
+	testCases := []struct {
+		name string
+		run  func(pathAToB, pathBToC *ibctesting.Path, oldDenom types.Denom) (sdk.AccAddress, error)
+	}{
+		{
+			"send of migrated denom fails",
+			func(_, pathBToC *ibctesting.Path, oldDenom types.Denom) (sdk.AccAddress, error) {
+				msgTransfer := types.NewMsgTransfer(
+					pathBToC.EndpointA.ChannelConfig.PortID,
+					pathBToC.EndpointA.ChannelID,
+					sdk.NewCoins(sdk.NewCoin(oldDenom.IBCDenom(), migratedOtherAmount)),
+					suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(),
+					suite.chainC.SenderAccount.GetAddress().String(),
+					suite.chainC.GetTimeoutHeight(), 0,
+					"",
+					nil,
+				)
+
+				_, err := suite.chainB.GetSimApp().TransferKeeper.Transfer(suite.chainB.GetContext(), msgTransfer)
+				return nil, err
+			},
+		},
+		{
+			"recv of vouchers of migrated denom fails",
+			func(pathAToB, _ *ibctesting.Path, _ types.Denom) (sdk.AccAddress, error) {
+				packetData := types.NewFungibleTokenPacketDataV2(
+					[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: migratedOtherAmount.String()}},
+					suite.chainA.SenderAccount.GetAddress().String(),
+					suite.chainB.SenderAccounts[1].SenderAccount.GetAddress().String(),
+					"",
+					ibctesting.EmptyForwardingPacketData,
+				)
+				packet := channeltypes.NewPacket(packetData.GetBytes(), 2, pathAToB.EndpointA.ChannelConfig.PortID, pathAToB.EndpointA.ChannelID, pathAToB.EndpointB.ChannelConfig.PortID, pathAToB.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+				return nil, suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, packetData)
+			},
+		},
+		{
+			"recv unescrows migrated vouchers",
+			func(_, pathBToC *ibctesting.Path, oldDenom types.Denom) (sdk.AccAddress, error) {
+				receiver := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+				denomOnC := types.NewDenom(oldDenom.Base, append([]types.Hop{types.NewHop(pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID)}, oldDenom.Trace...)...)
+
+				packetData := types.NewFungibleTokenPacketDataV2(
+					[]types.Token{{Denom: denomOnC, Amount: migratedEscrowAmount.String()}},
+					suite.chainC.SenderAccount.GetAddress().String(),
+					receiver.String(),
+					"",
+					ibctesting.EmptyForwardingPacketData,
+				)
+				packet := channeltypes.NewPacket(packetData.GetBytes(), 1, pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID, pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+				return receiver, suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, packetData)
+			},
+		},
+		{
+			"timeout refunds migrated vouchers",
+			func(_, pathBToC *ibctesting.Path, oldDenom types.Denom) (sdk.AccAddress, error) {
+				sender := suite.chainB.SenderAccounts[1].SenderAccount.GetAddress()
+
+				packetData := types.NewFungibleTokenPacketDataV2(
+					[]types.Token{{Denom: oldDenom, Amount: migratedEscrowAmount.String()}},
+					sender.String(),
+					suite.chainC.SenderAccount.GetAddress().String(),
+					"",
+					ibctesting.EmptyForwardingPacketData,
+				)
+				packet := channeltypes.NewPacket(packetData.GetBytes(), 1, pathBToC.EndpointA.ChannelConfig.PortID, pathBToC.EndpointA.ChannelID, pathBToC.EndpointB.ChannelConfig.PortID, pathBToC.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+				return sender, suite.chainB.GetSimApp().TransferKeeper.OnTimeoutPacket(suite.chainB.GetContext(), packet, packetData)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			pathAToB, pathBToC, oldDenom := suite.setupDenomMigration()
+
+			transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+			msg := types.NewMsgMigrateDenomTrace(transferKeeper.GetAuthority(), oldDenom, newDenom, suite.chainB.SenderAccounts[0].SenderAccount.GetAddress().String())
+			_, err := transferKeeper.MigrateDenomTrace(suite.chainB.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			receiver, err := tc.run(pathAToB, pathBToC, oldDenom)
+			if receiver == nil {
+				suite.Require().ErrorIs(err, types.ErrDenomMigrated)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			ctx := suite.chainB.GetContext()
+			suite.Require().Equal(migratedOtherAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, receiver, oldDenom.IBCDenom()).Amount)
+			suite.Require().Equal(migratedEscrowAmount, suite.chainB.GetSimApp().BankKeeper.GetBalance(ctx, receiver, newDenom.IBCDenom()).Amount)
+			suite.Require().True(transferKeeper.GetTotalEscrowForDenom(ctx, newDenom.IBCDenom()).IsZero())
+
+			_, broken := keeper.AllInvariants(&transferKeeper)(ctx)
+			suite.Require().False(broken)
+		})
+	}
+}
@@ -60,6 +60,21 @@ func (k Keeper) IsBlockedAddr(addr sdk.AccAddress) bool {
 }
 
 // CreatePacketDataBytesFromVersion is a wrapper around createPacketDataBytesFromVersion for testing purposes
-func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
-	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, hops)
+func CreatePacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop, fallbackReceivers []string) ([]byte, error) {
+	return createPacketDataBytesFromVersion(appVersion, sender, receiver, memo, tokens, hops, fallbackReceivers)
+}
+
+// CheckRateLimit is a wrapper around checkRateLimit for testing purposes.
+func (k Keeper) CheckRateLimit(ctx sdk.Context, portID, channelID, direction string, coin sdk.Coin) error {
+	return k.checkRateLimit(ctx, portID, channelID, direction, coin)
+}
+
+// RevertRateLimitFlow is a wrapper around revertRateLimitFlow for testing purposes.
+func (k Keeper) RevertRateLimitFlow(ctx sdk.Context, portID, channelID, direction string, coin sdk.Coin) {
+	k.revertRateLimitFlow(ctx, portID, channelID, direction, coin)
+}
+
+// SetDenomMigration is a wrapper around setDenomMigration for testing purposes.
+func (k Keeper) SetDenomMigration(ctx sdk.Context, denomMigration types.DenomMigration) {
+	k.setDenomMigration(ctx, denomMigration)
 }
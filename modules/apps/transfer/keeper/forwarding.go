@@ -7,6 +7,7 @@ import (
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -19,6 +20,26 @@ func (k Keeper) forwardPacket(ctx context.Context, data types.FungibleTokenPacke
 	if len(data.Forwarding.Hops) > 1 {
 		// remove the first hop since we are going to send to the first hop now and we want to propagate the rest of the hops to the receiver
 		nextForwardingPath = types.NewForwarding(false, data.Forwarding.Hops[1:]...)
+
+		// the fallback receivers are propagated along with the hops they belong to
+		if len(data.Forwarding.FallbackReceivers) > 1 {
+			nextForwardingPath.FallbackReceivers = data.Forwarding.FallbackReceivers[1:]
+		}
+	}
+
+	if err := k.validateForwardHop(ctx, data.Forwarding.Hops[0], receivedCoins); err != nil {
+		return err
+	}
+
+	// the receiver is only credited by the counterparty chain of the next hop if it is the last hop
+	if nextForwardingPath == nil {
+		if err := k.validateForwardReceiver(ctx, data.Forwarding.Hops[0], data.Receiver); err != nil {
+			return err
+		}
+	}
+
+	if err := k.checkForwardPolicy(ctx, packet, data.Forwarding.Hops[0], data.Receiver, receivedCoins); err != nil {
+		return err
 	}
 
 	// sending from module account (used as a temporary forward escrow) to the original receiver address.
@@ -45,6 +66,214 @@ func (k Keeper) forwardPacket(ctx context.Context, data types.FungibleTokenPacke
 	return nil
 }
 
+// forwardPacketWithMemo forwards the tokens received with an ics20-1 packet to the next hop according to the
+// packet-forward instructions found in the packet memo. The forwarded packet carries the next memo of the
+// instructions, which allows the tokens to be forwarded further by the chain at the next hop.
+func (k Keeper) forwardPacketWithMemo(ctx context.Context, metadata types.ForwardMetadata, packet channeltypes.Packet, receivedCoins sdk.Coins) error {
+	if err := k.validateForwardHop(ctx, metadata.Hop(), receivedCoins); err != nil {
+		return err
+	}
+
+	if err := k.validateForwardReceiver(ctx, metadata.Hop(), metadata.Receiver); err != nil {
+		return err
+	}
+
+	if err := k.checkForwardPolicy(ctx, packet, metadata.Hop(), metadata.Receiver, receivedCoins); err != nil {
+		return err
+	}
+
+	memo, err := metadata.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	// sending from module account (used as a temporary forward escrow) to the receiver of the next hop.
+	sender := k.authKeeper.GetModuleAddress(types.ModuleName)
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime() // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	timeoutTimestamp := uint64(blockTime.Add(metadata.GetTimeout()).UnixNano())
+
+	msg := types.NewMsgTransfer(
+		metadata.Port,
+		metadata.Channel,
+		receivedCoins,
+		sender.String(),
+		metadata.Receiver,
+		clienttypes.ZeroHeight(),
+		timeoutTimestamp,
+		memo,
+		nil,
+	)
+
+	resp, err := k.Transfer(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	k.setForwardedPacket(ctx, metadata.Port, metadata.Channel, resp.Sequence, packet)
+	return nil
+}
+
+// retryForwardedPacket resends the tokens of a timed out packet that was forwarded according to the packet-forward
+// instructions found in the memo of forwardedPacket, as long as the retries allowed by the instructions have not
+// been exhausted. It returns true if the tokens have been resent. The tokens of the timed out packet are expected
+// to have been refunded to the forwarding address beforehand.
+func (k Keeper) retryForwardedPacket(ctx context.Context, forwardedPacket, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) bool {
+	forwardedData, err := types.UnmarshalPacketData(forwardedPacket.GetData(), types.V1)
+	if err != nil {
+		return false
+	}
+
+	metadata, found, err := k.getForwardMetadata(ctx, forwardedPacket, forwardedData)
+	if err != nil || !found {
+		return false
+	}
+
+	retries := k.getForwardRetries(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, forwardedPacket.Sequence)
+	if retries >= uint64(metadata.Retries) {
+		return false
+	}
+
+	coins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
+			return false
+		}
+
+		coins = append(coins, coin)
+	}
+
+	// the tokens are resent on a cached context so that a failed attempt does not leave partial state changes
+	// behind, in which case the forwarded packet is reverted as if no retries had been allowed.
+	cacheCtx, writeFn := sdk.UnwrapSDKContext(ctx).CacheContext() // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	if err := k.forwardPacketWithMemo(cacheCtx, metadata, forwardedPacket, coins); err != nil {
+		k.Logger(ctx).Error("failed to retry forwarded packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", err.Error())
+		return false
+	}
+
+	writeFn()
+
+	k.deleteForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.setForwardRetries(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, forwardedPacket.Sequence, retries+1)
+
+	k.Logger(ctx).Info("retried forwarded packet", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "retry", retries+1)
+	return true
+}
+
+// fallbackForwardedPacket delivers the tokens which could not be forwarded to the next hop to the fallback receiver
+// set for this chain, and writes a successful acknowledgement carrying the outcome of the fallback for forwardedPacket.
+// The tokens of the forwarded packet are expected to have been refunded to the forwarding address beforehand. False is
+// returned if no fallback receiver is set or the tokens cannot be delivered to it, in which case the forwarded packet
+// must be reverted instead.
+func (k Keeper) fallbackForwardedPacket(ctx context.Context, forwardedPacket, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, forwardErr string) (bool, error) {
+	fallbackReceiver, found := k.getFallbackReceiver(ctx, forwardedPacket)
+	if !found {
+		return false, nil
+	}
+
+	coins := make(sdk.Coins, 0, len(data.Tokens))
+	for _, token := range data.Tokens {
+		coin, err := token.ToCoin()
+		if err != nil {
+			return false, err
+		}
+
+		coins = append(coins, coin)
+	}
+
+	// the tokens are delivered on a cached context so that a failed delivery does not leave partial state changes
+	// behind, in which case the forwarded packet is reverted as if no fallback receiver had been set.
+	cacheCtx, writeFn := sdk.UnwrapSDKContext(ctx).CacheContext() // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, fallbackReceiver, coins); err != nil {
+		k.Logger(ctx).Error("failed to deliver forwarded tokens to fallback receiver", "receiver", fallbackReceiver, "error", err.Error())
+		return false, nil
+	}
+
+	writeFn()
+
+	fallback := types.NewForwardingFallback(fallbackReceiver.String(), packet.SourcePort, packet.SourceChannel, forwardErr)
+	events.EmitForwardingFallbackEvent(ctx, fallback, data.Tokens)
+
+	forwardAck := channeltypes.NewResultAcknowledgement(fallback.GetBytes())
+	return true, k.acknowledgeForwardedPacket(ctx, forwardedPacket, packet, forwardAck)
+}
+
+// hasMemoForwarding returns true if the tokens of forwardedPacket have been forwarded according to the
+// packet-forward instructions of the memo rather than the forwarding packet data.
+func (k Keeper) hasMemoForwarding(ctx context.Context, forwardedPacket channeltypes.Packet) bool {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+	if !found {
+		return false
+	}
+
+	forwardedData, err := types.UnmarshalPacketData(forwardedPacket.GetData(), appVersion)
+	if err != nil {
+		return false
+	}
+
+	return !forwardedData.HasForwarding()
+}
+
+// getFallbackReceiver returns the fallback receiver set for this chain in the forwarding information of
+// forwardedPacket, either through the forwarding packet data or the packet-forward instructions of the memo.
+// False is returned if no valid fallback receiver is set.
+func (k Keeper) getFallbackReceiver(ctx context.Context, forwardedPacket channeltypes.Packet) (sdk.AccAddress, bool) {
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
+	if !found {
+		return nil, false
+	}
+
+	forwardedData, err := types.UnmarshalPacketData(forwardedPacket.GetData(), appVersion)
+	if err != nil {
+		return nil, false
+	}
+
+	fallbackReceiver := forwardedData.Forwarding.NextFallbackReceiver()
+	if !forwardedData.HasForwarding() {
+		metadata, found, err := k.getForwardMetadata(ctx, forwardedPacket, forwardedData)
+		if err != nil || !found {
+			return nil, false
+		}
+
+		fallbackReceiver = metadata.FallbackReceiver
+	}
+
+	if fallbackReceiver == "" {
+		return nil, false
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(fallbackReceiver)
+	if err != nil || k.isBlockedAddr(receiver) {
+		return nil, false
+	}
+
+	return receiver, true
+}
+
+// getForwardedPacketInfo returns the forwarded packet along with the commitment and status of the packet
+// sent to the next hop, and the number of times its tokens have been resent.
+func (k Keeper) getForwardedPacketInfo(ctx context.Context, forwardedPacket types.ForwardedPacket) types.ForwardedPacketInfo {
+	forwardKey := forwardedPacket.ForwardKey
+	commitment := k.channelKeeper.GetPacketCommitment(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence)
+
+	status := types.FORWARD_IN_FLIGHT
+	if len(commitment) == 0 {
+		status = types.FORWARD_COMMITMENT_NOT_FOUND
+	} else if channel, found := k.channelKeeper.GetChannel(ctx, forwardKey.PortId, forwardKey.ChannelId); found && channel.State == channeltypes.CLOSED {
+		status = types.FORWARD_CHANNEL_CLOSED
+	}
+
+	packet := forwardedPacket.Packet
+	return types.ForwardedPacketInfo{
+		ForwardKey:        forwardKey,
+		Packet:            packet,
+		ForwardCommitment: commitment,
+		Status:            status,
+		Retries:           k.getForwardRetries(ctx, packet.DestinationPort, packet.DestinationChannel, packet.Sequence),
+	}
+}
+
 // acknowledgeForwardedPacket writes the async acknowledgement for forwardedPacket
 func (k Keeper) acknowledgeForwardedPacket(ctx context.Context, forwardedPacket, packet channeltypes.Packet, ack channeltypes.Acknowledgement) error {
 	if err := k.ics4Wrapper.WriteAcknowledgement(ctx, forwardedPacket, ack); err != nil {
@@ -52,6 +281,7 @@ func (k Keeper) acknowledgeForwardedPacket(ctx context.Context, forwardedPacket,
 	}
 
 	k.deleteForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
+	k.deleteForwardRetries(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, forwardedPacket.Sequence)
 	return nil
 }
 
@@ -69,7 +299,6 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 	*/
 
 	forwardingAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
-	escrow := types.GetEscrowAddress(forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel)
 
 	// we can iterate over the received tokens of forwardedPacket by iterating over the sent tokens of failedPacketData
 	for _, token := range failedPacketData.Tokens {
@@ -79,6 +308,12 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 			return err
 		}
 
+		// the reverted tokens no longer count towards the inflow of the channel end they were received on
+		k.revertRateLimitFlow(ctx, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, types.FlowDirectionInflow, coin)
+
+		// the refunded tokens are of the denomination their vouchers have been migrated to, if any
+		coin = k.migratedCoin(ctx, coin)
+
 		// check if the token we received originated on the sender
 		// given that the packet is being reversed, we check the DestinationChannel and DestinationPort
 		// of the forwardedPacket to see if a hop was added to the trace during the receive step
@@ -88,7 +323,7 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 			}
 		} else {
 			// send it back to the escrow address
-			if err := k.escrowCoin(ctx, forwardingAddr, escrow, coin); err != nil {
+			if err := k.escrowCoin(ctx, forwardingAddr, forwardedPacket.DestinationPort, forwardedPacket.DestinationChannel, coin); err != nil {
 				return err
 			}
 		}
@@ -96,10 +331,47 @@ func (k Keeper) revertForwardedPacket(ctx context.Context, forwardedPacket chann
 	return nil
 }
 
+// validateForwardHop returns an error if the received coins may not be sent over the next hop. It allows
+// to fail early, so that the error acknowledgement written for the received packet refers to the forwarding hop.
+func (k Keeper) validateForwardHop(ctx context.Context, nextHop types.Hop, receivedCoins sdk.Coins) error {
+	params := k.GetParams(ctx)
+	for _, coin := range receivedCoins {
+		if !params.IsSendEnabled(nextHop.PortId, nextHop.ChannelId, coin.Denom) {
+			return errorsmod.Wrapf(types.ErrSendDisabled, "cannot forward %s over port %s and channel %s", coin.Denom, nextHop.PortId, nextHop.ChannelId)
+		}
+	}
+
+	return nil
+}
+
+// getForwardMetadata returns the packet-forward instructions found in the memo of a packet received over an
+// ics20-1 channel. The memo is only interpreted if memo forwarding is enabled in the module params. An error
+// is returned if the memo contains packet-forward instructions which are malformed.
+func (k Keeper) getForwardMetadata(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) (types.ForwardMetadata, bool, error) {
+	if data.HasForwarding() || !k.GetParams(ctx).MemoForwardingEnabled {
+		return types.ForwardMetadata{}, false, nil
+	}
+
+	appVersion, found := k.ics4Wrapper.GetAppVersion(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found || appVersion != types.V1 {
+		return types.ForwardMetadata{}, false, nil
+	}
+
+	return types.ParseForwardMetadata(data.Memo)
+}
+
+// HasMemoForwarding returns true if the tokens of the received packet are forwarded to the next hop according
+// to the packet-forward instructions found in the packet memo. The acknowledgement of such a packet is written
+// asynchronously once the forwarded packet is acknowledged or timed out.
+func (k Keeper) HasMemoForwarding(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) bool {
+	_, found, err := k.getForwardMetadata(ctx, packet, data)
+	return err == nil && found
+}
+
 // getReceiverFromPacketData returns either the sender specified in the packet data or the forwarding address
-// if there are still hops left to perform.
-func (k Keeper) getReceiverFromPacketData(data types.FungibleTokenPacketDataV2) (sdk.AccAddress, error) {
-	if data.HasForwarding() {
+// if there are still hops left to perform, either through the forwarding packet data or the packet memo.
+func (k Keeper) getReceiverFromPacketData(data types.FungibleTokenPacketDataV2, hasMemoForwarding bool) (sdk.AccAddress, error) {
+	if data.HasForwarding() || hasMemoForwarding {
 		// since data.Receiver can potentially be a non-CosmosSDK AccAddress, we return early if the packet should be forwarded
 		return k.authKeeper.GetModuleAddress(types.ModuleName), nil
 	}
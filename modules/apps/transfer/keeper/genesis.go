@@ -28,6 +28,26 @@ func (k Keeper) InitGenesis(ctx sdk.Context, state types.GenesisState) {
 		forwardKey := forwardPacketState.ForwardKey
 		k.setForwardedPacket(ctx, forwardKey.PortId, forwardKey.ChannelId, forwardKey.Sequence, forwardPacketState.Packet)
 	}
+
+	for _, rateLimit := range state.RateLimits {
+		k.setRateLimit(ctx, rateLimit)
+	}
+
+	for _, channelEscrow := range state.ChannelEscrows {
+		k.SetChannelEscrow(ctx, channelEscrow.PortId, channelEscrow.ChannelId, channelEscrow.Amount)
+	}
+
+	for _, denomMigration := range state.DenomMigrations {
+		k.setDenomMigration(ctx, denomMigration)
+	}
+
+	for _, addressFormat := range state.CounterpartyAddressFormats {
+		k.setCounterpartyAddressFormat(ctx, addressFormat)
+	}
+
+	for _, override := range state.DenomMetadataOverrides {
+		k.overrideDenomMetadata(ctx, override)
+	}
 }
 
 // ExportGenesis exports ibc-transfer module's portID and denom trace info into its genesis state.
@@ -38,5 +58,11 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		Params:           k.GetParams(ctx),
 		TotalEscrowed:    k.GetAllTotalEscrowed(ctx),
 		ForwardedPackets: k.getAllForwardedPackets(ctx),
+		RateLimits:       k.GetAllRateLimits(ctx),
+		ChannelEscrows:   k.GetAllChannelEscrows(ctx),
+		DenomMigrations:  k.GetAllDenomMigrations(ctx),
+
+		CounterpartyAddressFormats: k.GetAllCounterpartyAddressFormats(ctx),
+		DenomMetadataOverrides:     k.GetAllDenomMetadataOverrides(ctx),
 	}
 }
@@ -2,6 +2,7 @@ package keeper_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -31,7 +32,11 @@ func (suite *KeeperTestSuite) TestGenesis() {
 			{[]types.Hop{getHop(3), getHop(2), getHop(1), getHop(0)}, "1000000000000000"},
 			{[]types.Hop{getHop(4), getHop(3), getHop(2), getHop(1), getHop(0)}, "100000000000000000000"},
 		}
-		forwardPackets []types.ForwardedPacket
+		forwardPackets   []types.ForwardedPacket
+		rateLimits       []types.RateLimit
+		channelEscrows   []types.ChannelEscrow
+		denomMigrations  []types.DenomMigration
+		metadataOverride types.DenomMetadataOverride
 	)
 
 	for _, traceAndEscrowAmount := range traceAndEscrowAmounts {
@@ -57,11 +62,41 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		}
 	}
 
+	// Store rate limits on transfer/channel-1 and transfer/channel-2
+	for _, channelID := range []string{"channel-1", "channel-2"} {
+		quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.NewInt(200), 10, 20, time.Hour)
+		rateLimits = append(rateLimits, types.NewRateLimit(ibctesting.TransferPort, channelID, sdk.DefaultBondDenom, quota))
+
+		msg := types.NewMsgSetRateLimit(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), ibctesting.TransferPort, channelID, sdk.DefaultBondDenom, quota)
+		_, err := suite.chainA.GetSimApp().TransferKeeper.SetRateLimit(suite.chainA.GetContext(), msg)
+		suite.Require().NoError(err)
+	}
+
+	// Store channel escrows on transfer/channel-1 and transfer/channel-2
+	for _, channelID := range []string{"channel-1", "channel-2"} {
+		channelEscrows = append(channelEscrows, types.NewChannelEscrow(ibctesting.TransferPort, channelID, ibctesting.TestCoin))
+		suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, ibctesting.TestCoin)
+	}
+
+	// Store the migration of the vouchers of the first denomination to a new trace
+	denomMigrations = append(denomMigrations, types.NewDenomMigration(denoms[0], types.NewDenom("uatom", getHop(5))))
+	suite.chainA.GetSimApp().TransferKeeper.SetDenomMigration(suite.chainA.GetContext(), denomMigrations[0])
+
+	// Override the metadata of the second denomination
+	metadataOverride = types.NewDenomMetadataOverride(denoms[1].IBCDenom(), "Cosmos Hub Atom", "ATOM", "atom", 6, "")
+	msg := types.NewMsgSetDenomMetadata(suite.chainA.GetSimApp().TransferKeeper.GetAuthority(), metadataOverride)
+	_, err := suite.chainA.GetSimApp().TransferKeeper.SetDenomMetadata(suite.chainA.GetContext(), msg)
+	suite.Require().NoError(err)
+
 	genesis := suite.chainA.GetSimApp().TransferKeeper.ExportGenesis(suite.chainA.GetContext())
 
 	suite.Require().Equal(types.PortID, genesis.PortId)
 	suite.Require().Equal(denoms.Sort(), genesis.Denoms)
 	suite.Require().Equal(escrows.Sort(), genesis.TotalEscrowed)
+	suite.Require().Equal(rateLimits, genesis.RateLimits)
+	suite.Require().Equal(channelEscrows, genesis.ChannelEscrows)
+	suite.Require().Equal(denomMigrations, genesis.DenomMigrations)
+	suite.Require().Equal([]types.DenomMetadataOverride{metadataOverride}, genesis.DenomMetadataOverrides)
 
 	suite.Require().NotPanics(func() {
 		suite.chainA.GetSimApp().TransferKeeper.InitGenesis(suite.chainA.GetContext(), *genesis)
@@ -72,6 +107,19 @@ func (suite *KeeperTestSuite) TestGenesis() {
 		suite.Require().True(found)
 	}
 
+	metadata, found := suite.chainA.GetSimApp().BankKeeper.GetDenomMetaData(suite.chainA.GetContext(), denoms[1].IBCDenom())
+	suite.Require().True(found)
+	suite.Require().Equal(metadataOverride.Metadata(fmt.Sprintf("IBC token from %s", denoms[1].Path())), metadata)
+
 	storedForwardedPackets := suite.chainA.GetSimApp().TransferKeeper.GetAllForwardedPackets(suite.chainA.GetContext())
 	suite.Require().Equal(storedForwardedPackets, forwardPackets)
+
+	storedRateLimits := suite.chainA.GetSimApp().TransferKeeper.GetAllRateLimits(suite.chainA.GetContext())
+	suite.Require().Equal(rateLimits, storedRateLimits)
+
+	storedChannelEscrows := suite.chainA.GetSimApp().TransferKeeper.GetAllChannelEscrows(suite.chainA.GetContext())
+	suite.Require().Equal(channelEscrows, storedChannelEscrows)
+
+	storedDenomMigrations := suite.chainA.GetSimApp().TransferKeeper.GetAllDenomMigrations(suite.chainA.GetContext())
+	suite.Require().Equal(denomMigrations, storedDenomMigrations)
 }
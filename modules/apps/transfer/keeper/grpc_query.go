@@ -77,6 +77,132 @@ func (k Keeper) Denoms(ctx context.Context, req *types.QueryDenomsRequest) (*typ
 	}, nil
 }
 
+// ForwardedPackets implements the Query/ForwardedPackets gRPC method
+func (k Keeper) ForwardedPackets(ctx context.Context, req *types.QueryForwardedPacketsRequest) (*types.QueryForwardedPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	// the forwarded packets are filtered by channel end if either the port or channel identifier is provided
+	keyPrefix := types.ForwardedPacketKey
+	if req.PortId != "" || req.ChannelId != "" {
+		if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+			return nil, err
+		}
+
+		keyPrefix = types.PacketForwardPrefixKey(req.PortId, req.ChannelId)
+	}
+
+	var forwardedPackets []types.ForwardedPacketInfo
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), keyPrefix)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		// the prefix store strips the key prefix, which is added back to parse the forwarded packet
+		forwardedPacket, err := k.parseForwardedPacket([]byte(string(keyPrefix)+string(key)), value)
+		if err != nil {
+			return err
+		}
+
+		forwardedPackets = append(forwardedPackets, k.getForwardedPacketInfo(ctx, forwardedPacket))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryForwardedPacketsResponse{
+		ForwardedPackets: forwardedPackets,
+		Pagination:       pageRes,
+	}, nil
+}
+
+// ForwardedPacket implements the Query/ForwardedPacket gRPC method
+func (k Keeper) ForwardedPacket(ctx context.Context, req *types.QueryForwardedPacketRequest) (*types.QueryForwardedPacketResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	packet, found := k.getForwardedPacket(ctx, req.PortId, req.ChannelId, req.Sequence)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrForwardedPacketNotFound, "port ID (%s) channel ID (%s) sequence (%d)", req.PortId, req.ChannelId, req.Sequence).Error(),
+		)
+	}
+
+	forwardedPacket := types.ForwardedPacket{
+		ForwardKey: channeltypes.NewPacketID(req.PortId, req.ChannelId, req.Sequence),
+		Packet:     packet,
+	}
+
+	return &types.QueryForwardedPacketResponse{
+		ForwardedPacket: k.getForwardedPacketInfo(ctx, forwardedPacket),
+	}, nil
+}
+
+// UnwindRoute implements the Query/UnwindRoute gRPC method. The channel of the first hop is on this
+// chain and must be OPEN for the tokens to be unwound. The channels of the following hops are on the
+// chains along the route, so they cannot be validated by this chain and must be checked by querying
+// the unwind route of the tokens on those chains.
+func (k Keeper) UnwindRoute(ctx context.Context, req *types.QueryUnwindRouteRequest) (*types.QueryUnwindRouteResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, err := types.ParseHexHash(strings.TrimPrefix(req.Denom, "ibc/"))
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", hash.String(), err))
+	}
+
+	denom, found := k.GetDenom(ctx, hash)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrap(types.ErrDenomNotFound, req.Denom).Error(),
+		)
+	}
+
+	if denomMigration, found := k.GetDenomMigration(ctx, denom.IBCDenom()); found {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			errorsmod.Wrapf(types.ErrDenomMigrated, "%s has been migrated to %s", denom.Path(), denomMigration.NewDenom.Path()).Error(),
+		)
+	}
+
+	if denom.IsNative() {
+		return nil, status.Error(codes.InvalidArgument, errorsmod.Wrap(types.ErrInvalidForwarding, "cannot unwind a native token").Error())
+	}
+
+	// the tokens are unwound over the hops of their trace, as done by getUnwindHops
+	unwindHops := denom.Trace
+
+	firstHop := unwindHops[0]
+	channel, found := k.channelKeeper.GetChannel(ctx, firstHop.PortId, firstHop.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", firstHop.PortId, firstHop.ChannelId).Error(),
+		)
+	}
+
+	if channel.State != channeltypes.OPEN {
+		return nil, status.Error(
+			codes.FailedPrecondition,
+			errorsmod.Wrapf(channeltypes.ErrInvalidChannelState, "channel (%s) state is %s, expected %s", firstHop.ChannelId, channel.State, channeltypes.OPEN).Error(),
+		)
+	}
+
+	return &types.QueryUnwindRouteResponse{
+		Hops:      unwindHops,
+		BaseDenom: denom.Base,
+		LastHop:   unwindHops[len(unwindHops)-1],
+	}, nil
+}
+
 // Params implements the Query/Params gRPC method
 func (k Keeper) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
 	params := k.GetParams(ctx)
@@ -152,3 +278,251 @@ func (k Keeper) TotalEscrowForDenom(ctx context.Context, req *types.QueryTotalEs
 		Amount: amount,
 	}, nil
 }
+
+// RateLimits implements the RateLimits gRPC method.
+func (k Keeper) RateLimits(ctx context.Context, req *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var rateLimits []types.RateLimit
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RateLimitKey)
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var rateLimit types.RateLimit
+		if err := k.cdc.Unmarshal(value, &rateLimit); err != nil {
+			return err
+		}
+
+		rateLimits = append(rateLimits, rateLimit)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRateLimitsResponse{
+		RateLimits: rateLimits,
+		Pagination: pageRes,
+	}, nil
+}
+
+// RateLimit implements the RateLimit gRPC method.
+func (k Keeper) RateLimit(ctx context.Context, req *types.QueryRateLimitRequest) (*types.QueryRateLimitResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	rateLimit, found := k.GetRateLimit(ctx, req.PortId, req.ChannelId, req.Denom)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) denom (%s)", req.PortId, req.ChannelId, req.Denom).Error(),
+		)
+	}
+
+	return &types.QueryRateLimitResponse{
+		RateLimit: rateLimit,
+	}, nil
+}
+
+// TransferEnabled implements the TransferEnabled gRPC method.
+func (k Keeper) TransferEnabled(ctx context.Context, req *types.QueryTransferEnabledRequest) (*types.QueryTransferEnabledResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params := k.GetParams(ctx)
+
+	return &types.QueryTransferEnabledResponse{
+		SendEnabled:    params.IsSendEnabled(req.PortId, req.ChannelId, req.Denom),
+		ReceiveEnabled: params.IsReceiveEnabled(req.PortId, req.ChannelId, req.Denom),
+	}, nil
+}
+
+// ChannelEscrows implements the ChannelEscrows gRPC method.
+func (k Keeper) ChannelEscrows(ctx context.Context, req *types.QueryChannelEscrowsRequest) (*types.QueryChannelEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	var escrows sdk.Coins
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelEscrowPrefixKey(req.PortId, req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		amount := sdk.IntProto{}
+		if err := k.cdc.Unmarshal(value, &amount); err != nil {
+			return err
+		}
+
+		escrows = append(escrows, sdk.NewCoin(string(key), amount.Int))
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryChannelEscrowsResponse{
+		Escrows:    escrows,
+		Pagination: pageRes,
+	}, nil
+}
+
+// DenomChannelEscrows implements the DenomChannelEscrows gRPC method.
+func (k Keeper) DenomChannelEscrows(ctx context.Context, req *types.QueryDenomChannelEscrowsRequest) (*types.QueryDenomChannelEscrowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := sdk.ValidateDenom(req.Denom); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	var escrows []types.ChannelEscrow
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ChannelEscrowKey)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		// the prefix store strips the key prefix, which is added back to parse the channel escrow
+		channelEscrow, err := k.parseChannelEscrow([]byte(string(types.ChannelEscrowKey)+string(key)), value)
+		if err != nil {
+			return false, err
+		}
+
+		if channelEscrow.Amount.Denom != req.Denom {
+			return false, nil
+		}
+
+		if accumulate {
+			escrows = append(escrows, channelEscrow)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomChannelEscrowsResponse{
+		Escrows:    escrows,
+		Pagination: pageRes,
+	}, nil
+}
+
+// SimulateProtocolFee implements the SimulateProtocolFee gRPC method.
+func (k Keeper) SimulateProtocolFee(ctx context.Context, req *types.QuerySimulateProtocolFeeRequest) (*types.QuerySimulateProtocolFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(req.Sender)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := req.Token.Validate(); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if !req.Token.IsPositive() {
+		return nil, status.Errorf(codes.InvalidArgument, "token amount must be positive, got %s", req.Token)
+	}
+
+	basisPoints, fee := k.protocolFee(k.GetParams(ctx), req.PortId, req.ChannelId, sender, req.Token)
+
+	return &types.QuerySimulateProtocolFeeResponse{
+		BasisPoints: basisPoints,
+		Fee:         fee,
+		Sent:        req.Token.Sub(fee),
+	}, nil
+}
+
+// MemoKeys implements the MemoKeys gRPC method.
+func (k Keeper) MemoKeys(_ context.Context, req *types.QueryMemoKeysRequest) (*types.QueryMemoKeysResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	return &types.QueryMemoKeysResponse{
+		MemoKeys:          k.memoRegistry.MemoKeys(),
+		RejectUnknownKeys: k.memoRegistry.RejectUnknownKeys(),
+	}, nil
+}
+
+// CounterpartyAddressFormat implements the CounterpartyAddressFormat gRPC method.
+func (k Keeper) CounterpartyAddressFormat(ctx context.Context, req *types.QueryCounterpartyAddressFormatRequest) (*types.QueryCounterpartyAddressFormatResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	addressFormat, found := k.GetCounterpartyAddressFormat(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrAddressFormatNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryCounterpartyAddressFormatResponse{
+		AddressFormat: addressFormat,
+	}, nil
+}
+
+// DenomMetadataOrigin implements the DenomMetadataOrigin gRPC method.
+func (k Keeper) DenomMetadataOrigin(ctx context.Context, req *types.QueryDenomMetadataOriginRequest) (*types.QueryDenomMetadataOriginResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	hash, found := strings.CutPrefix(req.Denom, types.DenomPrefix+"/")
+	if !found {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("denomination %s must be in the format ibc/{hash}", req.Denom))
+	}
+
+	if _, err := types.ParseHexHash(hash); err != nil {
+		return nil, status.Error(codes.InvalidArgument, fmt.Sprintf("invalid denom trace hash: %s, error: %s", hash, err))
+	}
+
+	if override, found := k.GetDenomMetadataOverride(ctx, req.Denom); found {
+		return &types.QueryDenomMetadataOriginResponse{
+			Origin:   types.DenomMetadataOriginOverride,
+			Override: &override,
+		}, nil
+	}
+
+	origin := types.DenomMetadataOriginNone
+	if k.bankKeeper.HasDenomMetaData(ctx, req.Denom) {
+		origin = types.DenomMetadataOriginAuto
+	}
+
+	return &types.QueryDenomMetadataOriginResponse{
+		Origin: origin,
+	}, nil
+}
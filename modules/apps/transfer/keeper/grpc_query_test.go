@@ -3,6 +3,9 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"slices"
+	"strings"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -10,6 +13,8 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -400,3 +405,997 @@ func (suite *KeeperTestSuite) TestTotalEscrowForDenom() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestQueryRateLimits() {
+	var (
+		req           *types.QueryRateLimitsRequest
+		expRateLimits []types.RateLimit
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryRateLimitsRequest{}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+				quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+
+				for _, channelID := range []string{"channel-0", "channel-1"} {
+					_, err := transferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), ibctesting.TransferPort, channelID, sdk.DefaultBondDenom, quota))
+					suite.Require().NoError(err)
+
+					expRateLimits = append(expRateLimits, types.NewRateLimit(ibctesting.TransferPort, channelID, sdk.DefaultBondDenom, quota))
+				}
+
+				req = &types.QueryRateLimitsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expRateLimits = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.RateLimits(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expRateLimits, res.RateLimits)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryRateLimit() {
+	var req *types.QueryRateLimitRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				req.Denom = ibctesting.SecondaryDenom
+			},
+			errors.New("rate limit not found"),
+		},
+		{
+			"failure: empty channelID",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "??𓃠🐾??"
+			},
+			errors.New("invalid denom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+			_, err := transferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, quota))
+			suite.Require().NoError(err)
+
+			req = &types.QueryRateLimitRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := transferKeeper.RateLimit(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(types.NewRateLimit(ibctesting.TransferPort, ibctesting.FirstChannelID, sdk.DefaultBondDenom, quota), res.RateLimit)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryTransferEnabled() {
+	var (
+		req               *types.QueryTransferEnabledRequest
+		expSendEnabled    bool
+		expReceiveEnabled bool
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: default params",
+			func() {},
+			nil,
+		},
+		{
+			"success: denom override disables send",
+			func() {
+				params := types.DefaultParams()
+				params.DenomOverrides = []types.DenomOverride{types.NewDenomOverride(sdk.DefaultBondDenom, false, true)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expSendEnabled = false
+			},
+			nil,
+		},
+		{
+			"success: channel override disables receive",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelOverrides = []types.ChannelOverride{types.NewChannelOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, true, false)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expReceiveEnabled = false
+			},
+			nil,
+		},
+		{
+			"failure: empty channelID",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req.Denom = "??𓃠🐾??"
+			},
+			errors.New("invalid denom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryTransferEnabledRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Denom:     sdk.DefaultBondDenom,
+			}
+			expSendEnabled, expReceiveEnabled = true, true
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.TransferEnabled(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expSendEnabled, res.SendEnabled)
+				suite.Require().Equal(expReceiveEnabled, res.ReceiveEnabled)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryChannelEscrows() {
+	var (
+		req        *types.QueryChannelEscrowsRequest
+		expEscrows sdk.Coins
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryChannelEscrowsRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+				expEscrows = sdk.NewCoins(ibctesting.TestCoin, ibctesting.SecondaryTestCoin)
+
+				for _, coin := range expEscrows {
+					transferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, ibctesting.FirstChannelID, coin)
+				}
+
+				// escrow over a channel with a shared channel identifier prefix is not included
+				transferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-00", ibctesting.TestCoin)
+
+				req = &types.QueryChannelEscrowsRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: ibctesting.FirstChannelID,
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: empty channelID",
+			func() {
+				req = &types.QueryChannelEscrowsRequest{
+					PortId:    ibctesting.TransferPort,
+					ChannelId: "",
+				}
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expEscrows = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ChannelEscrows(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expEscrows, res.Escrows)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomChannelEscrows() {
+	var (
+		req        *types.QueryDenomChannelEscrowsRequest
+		expEscrows []types.ChannelEscrow
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryDenomChannelEscrowsRequest{
+					Denom: sdk.DefaultBondDenom,
+				}
+			},
+			nil,
+		},
+		{
+			"success",
+			func() {
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+
+				for _, channelID := range []string{"channel-0", "channel-1"} {
+					transferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, ibctesting.TestCoin)
+					transferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, channelID, ibctesting.SecondaryTestCoin)
+
+					expEscrows = append(expEscrows, types.NewChannelEscrow(ibctesting.TransferPort, channelID, ibctesting.TestCoin))
+				}
+
+				req = &types.QueryDenomChannelEscrowsRequest{
+					Denom: sdk.DefaultBondDenom,
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				req = &types.QueryDenomChannelEscrowsRequest{
+					Denom: "??𓃠🐾??",
+				}
+			},
+			errors.New("invalid denom"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expEscrows = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomChannelEscrows(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expEscrows, res.Escrows)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryForwardedPackets() {
+	var (
+		path                *ibctesting.Path
+		req                 *types.QueryForwardedPacketsRequest
+		expForwardedPackets []types.ForwardedPacketInfo
+	)
+
+	// setForwardedPacket stores a forwarded packet for the packet sent over the provided channel end with the given sequence
+	setForwardedPacket := func(portID, channelID string, sequence uint64) types.ForwardedPacketInfo {
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TransferPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 0)
+		suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), portID, channelID, sequence, packet)
+
+		return types.ForwardedPacketInfo{
+			ForwardKey: channeltypes.NewPacketID(portID, channelID, sequence),
+			Packet:     packet,
+			Status:     types.FORWARD_COMMITMENT_NOT_FOUND,
+		}
+	}
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"empty pagination",
+			func() {
+				req = &types.QueryForwardedPacketsRequest{}
+			},
+			nil,
+		},
+		{
+			"success: all channels",
+			func() {
+				expForwardedPackets = append(expForwardedPackets, setForwardedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1))
+				expForwardedPackets = append(expForwardedPackets, setForwardedPacket(ibctesting.TransferPort, "channel-1", 1))
+
+				// the forwarded packets are returned in the byte order of their store keys
+				slices.SortFunc(expForwardedPackets, func(a, b types.ForwardedPacketInfo) int {
+					return strings.Compare(a.ForwardKey.ChannelId, b.ForwardKey.ChannelId)
+				})
+
+				req = &types.QueryForwardedPacketsRequest{
+					Pagination: &query.PageRequest{
+						Limit:      5,
+						CountTotal: false,
+					},
+				}
+			},
+			nil,
+		},
+		{
+			"success: filtered by channel",
+			func() {
+				// sequence 47 is encoded with a slash in the store key
+				for _, sequence := range []uint64{1, 47} {
+					expForwardedPackets = append(expForwardedPackets, setForwardedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+				}
+
+				setForwardedPacket(ibctesting.TransferPort, "channel-1", 1)
+
+				req = &types.QueryForwardedPacketsRequest{
+					PortId:    path.EndpointA.ChannelConfig.PortID,
+					ChannelId: path.EndpointA.ChannelID,
+				}
+			},
+			nil,
+		},
+		{
+			"success: packet in flight",
+			func() {
+				forwardedPacket := setForwardedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+				forwardedPacket.ForwardCommitment = []byte("commitment")
+				forwardedPacket.Status = types.FORWARD_IN_FLIGHT
+				expForwardedPackets = append(expForwardedPackets, forwardedPacket)
+
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, forwardedPacket.ForwardCommitment)
+
+				req = &types.QueryForwardedPacketsRequest{}
+			},
+			nil,
+		},
+		{
+			"success: channel closed",
+			func() {
+				forwardedPacket := setForwardedPacket(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1)
+
+				forwardedPacket.ForwardCommitment = []byte("commitment")
+				forwardedPacket.Status = types.FORWARD_CHANNEL_CLOSED
+				expForwardedPackets = append(expForwardedPackets, forwardedPacket)
+
+				suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper.SetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, 1, forwardedPacket.ForwardCommitment)
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+
+				req = &types.QueryForwardedPacketsRequest{}
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: channel ID provided without port ID",
+			func() {
+				req = &types.QueryForwardedPacketsRequest{
+					ChannelId: ibctesting.FirstChannelID,
+				}
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+			expForwardedPackets = nil
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ForwardedPackets(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expForwardedPackets, res.ForwardedPackets)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryForwardedPacket() {
+	var (
+		req    *types.QueryForwardedPacketRequest
+		packet channeltypes.Packet
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: forwarded packet not found",
+			func() {
+				req.Sequence = 2
+			},
+			types.ErrForwardedPacketNotFound,
+		},
+		{
+			"failure: empty portID",
+			func() {
+				req.PortId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			packet = channeltypes.NewPacket(ibctesting.MockPacketData, 1, ibctesting.TransferPort, ibctesting.FirstChannelID, ibctesting.TransferPort, ibctesting.FirstChannelID, clienttypes.ZeroHeight(), 0)
+			suite.chainA.GetSimApp().TransferKeeper.SetForwardedPacket(suite.chainA.GetContext(), ibctesting.TransferPort, ibctesting.FirstChannelID, 1, packet)
+
+			req = &types.QueryForwardedPacketRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Sequence:  1,
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.ForwardedPacket(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(channeltypes.NewPacketID(ibctesting.TransferPort, ibctesting.FirstChannelID, 1), res.ForwardedPacket.ForwardKey)
+				suite.Require().Equal(packet, res.ForwardedPacket.Packet)
+				suite.Require().Equal(types.FORWARD_COMMITMENT_NOT_FOUND, res.ForwardedPacket.Status)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryUnwindRoute() {
+	var (
+		req   *types.QueryUnwindRouteRequest
+		path  *ibctesting.Path
+		denom types.Denom
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: ibc denom",
+			func() {},
+			nil,
+		},
+		{
+			"success: hex hash",
+			func() {
+				req.Denom = denom.Hash().String()
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req.Denom = "!@#!@#!"
+			},
+			errors.New("invalid denom trace hash"),
+		},
+		{
+			"failure: denom not found",
+			func() {
+				req.Denom = types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-100")).IBCDenom()
+			},
+			types.ErrDenomNotFound,
+		},
+		{
+			"failure: denom has been migrated",
+			func() {
+				newDenom := types.NewDenom(denom.Base, types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenomMigration(suite.chainA.GetContext(), types.NewDenomMigration(denom, newDenom))
+			},
+			types.ErrDenomMigrated,
+		},
+		{
+			"failure: channel of the first hop not found",
+			func() {
+				denom = types.NewDenom("uatom", types.NewHop(ibctesting.TransferPort, "channel-100"))
+				suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+
+				req.Denom = denom.IBCDenom()
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: channel of the first hop is not OPEN",
+			func() {
+				path.EndpointA.UpdateChannel(func(channel *channeltypes.Channel) { channel.State = channeltypes.CLOSED })
+			},
+			channeltypes.ErrInvalidChannelState,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			denom = types.NewDenom(
+				"uatom",
+				types.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+				types.NewHop(ibctesting.TransferPort, "channel-7"),
+			)
+			suite.chainA.GetSimApp().TransferKeeper.SetDenom(suite.chainA.GetContext(), denom)
+
+			req = &types.QueryUnwindRouteRequest{
+				Denom: denom.IBCDenom(),
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.UnwindRoute(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(denom.Trace, res.Hops)
+				suite.Require().Equal("uatom", res.BaseDenom)
+				suite.Require().Equal(types.NewHop(ibctesting.TransferPort, "channel-7"), res.LastHop)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQuerySimulateProtocolFee() {
+	var (
+		req            *types.QuerySimulateProtocolFeeRequest
+		expBasisPoints uint32
+		expFee         sdkmath.Int
+	)
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: default basis points",
+			func() {},
+			nil,
+		},
+		{
+			"success: channel and denom override",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ProtocolFee.FeeOverrides = []types.FeeOverride{types.NewFeeOverride(req.PortId, req.ChannelId, sdk.DefaultBondDenom, 10)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expBasisPoints = 10
+				expFee = sdkmath.NewInt(1_000)
+			},
+			nil,
+		},
+		{
+			"success: exempt sender",
+			func() {
+				params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+				params.ProtocolFee.ExemptSenders = []string{req.Sender}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				expBasisPoints = 0
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: nil request",
+			func() {
+				req = nil
+			},
+			errors.New("empty request"),
+		},
+		{
+			"failure: invalid channel identifier",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+		{
+			"failure: invalid sender",
+			func() {
+				req.Sender = "invalid"
+			},
+			errors.New("decoding bech32 failed"),
+		},
+		{
+			"failure: zero amount",
+			func() {
+				req.Token = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.ZeroInt())
+			},
+			errors.New("token amount must be positive"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.ProtocolFee = types.NewProtocolFee(250, "", nil, nil)
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			req = &types.QuerySimulateProtocolFeeRequest{
+				PortId:    ibctesting.TransferPort,
+				ChannelId: ibctesting.FirstChannelID,
+				Sender:    suite.chainA.SenderAccount.GetAddress().String(),
+				Token:     sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000)),
+			}
+			expBasisPoints = 250
+			expFee = sdkmath.NewInt(25_000)
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.SimulateProtocolFee(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(res)
+				suite.Require().Equal(expBasisPoints, res.BasisPoints)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, expFee), res.Fee)
+				suite.Require().Equal(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000).Sub(expFee)), res.Sent)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryMemoKeys() {
+	ctx := suite.chainA.GetContext()
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.MemoKeys(ctx, nil)
+	suite.Require().Error(err)
+
+	registry := types.NewMemoRegistry(true)
+	registry.MustRegisterMemoKey("wasm", "wasm", `{"type":"object"}`)
+	suite.chainA.GetSimApp().TransferKeeper.WithMemoRegistry(registry)
+
+	res, err := suite.chainA.GetSimApp().TransferKeeper.MemoKeys(ctx, &types.QueryMemoKeysRequest{})
+	suite.Require().NoError(err)
+	suite.Require().True(res.RejectUnknownKeys)
+	suite.Require().Equal([]types.MemoKey{
+		{Key: types.ForwardMemoKey, Owner: types.ModuleName, Schema: types.ForwardMemoSchema},
+		{Key: "wasm", Owner: "wasm", Schema: `{"type":"object"}`},
+	}, res.MemoKeys)
+}
+
+func (suite *KeeperTestSuite) TestQueryCounterpartyAddressFormat() {
+	var req *types.QueryCounterpartyAddressFormatRequest
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: address format not found",
+			func() {
+				req.ChannelId = ibctesting.InvalidID
+			},
+			errors.New("address format not found"),
+		},
+		{
+			"failure: empty channelID",
+			func() {
+				req.ChannelId = ""
+			},
+			errors.New("identifier cannot be blank"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			addressFormat := types.NewHexAddressFormat(20)
+			_, err := transferKeeper.SetCounterpartyAddressFormat(suite.chainA.GetContext(), types.NewMsgSetCounterpartyAddressFormat(transferKeeper.GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, addressFormat))
+			suite.Require().NoError(err)
+
+			req = &types.QueryCounterpartyAddressFormatRequest{
+				PortId:    path.EndpointA.ChannelConfig.PortID,
+				ChannelId: path.EndpointA.ChannelID,
+			}
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := transferKeeper.CounterpartyAddressFormat(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(addressFormat, res.AddressFormat)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestQueryDenomMetadataOrigin() {
+	var (
+		req       *types.QueryDenomMetadataOriginRequest
+		expOrigin types.DenomMetadataOrigin
+		override  *types.DenomMetadataOverride
+	)
+
+	denom := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(ibctesting.TransferPort, ibctesting.FirstChannelID))
+
+	testCases := []struct {
+		msg      string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: no metadata",
+			func() {
+				expOrigin = types.DenomMetadataOriginNone
+			},
+			nil,
+		},
+		{
+			"success: generated metadata",
+			func() {
+				suite.chainA.GetSimApp().BankKeeper.SetDenomMetaData(suite.chainA.GetContext(), metadataFromDenom(denom))
+				expOrigin = types.DenomMetadataOriginAuto
+			},
+			nil,
+		},
+		{
+			"success: overridden metadata",
+			func() {
+				metadataOverride := types.NewDenomMetadataOverride(denom.IBCDenom(), "Stake", "STK", "stake", 6, "")
+				transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+				_, err := transferKeeper.SetDenomMetadata(suite.chainA.GetContext(), types.NewMsgSetDenomMetadata(transferKeeper.GetAuthority(), metadataOverride))
+				suite.Require().NoError(err)
+
+				expOrigin = types.DenomMetadataOriginOverride
+				override = &metadataOverride
+			},
+			nil,
+		},
+		{
+			"failure: native denomination",
+			func() {
+				req.Denom = sdk.DefaultBondDenom
+			},
+			errors.New("must be in the format ibc/{hash}"),
+		},
+		{
+			"failure: invalid hash",
+			func() {
+				req.Denom = "ibc/invalid"
+			},
+			errors.New("invalid denom trace hash"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			req = &types.QueryDenomMetadataOriginRequest{
+				Denom: denom.IBCDenom(),
+			}
+			override = nil
+
+			tc.malleate()
+			ctx := suite.chainA.GetContext()
+
+			res, err := suite.chainA.GetSimApp().TransferKeeper.DenomMetadataOrigin(ctx, req)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(expOrigin, res.Origin)
+				suite.Require().Equal(override, res.Override)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr, err.Error())
+			}
+		})
+	}
+}
@@ -12,17 +12,31 @@ import (
 func RegisterInvariants(ir sdk.InvariantRegistry, k *Keeper) {
 	ir.RegisterRoute(types.ModuleName, "total-escrow-per-denom",
 		TotalEscrowPerDenomInvariants(k))
+	ir.RegisterRoute(types.ModuleName, "denom-migrations",
+		DenomMigrationsInvariant(k))
 }
 
 // AllInvariants runs all invariants of the transfer module.
 func AllInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
-		return TotalEscrowPerDenomInvariants(k)(ctx)
+		res, stop := TotalEscrowPerDenomInvariants(k)(ctx)
+		if stop {
+			return res, stop
+		}
+
+		return DenomMigrationsInvariant(k)(ctx)
 	}
 }
 
 // TotalEscrowPerDenomInvariants checks that the total amount escrowed for
-// each denom is not smaller than the amount stored in the state entry.
+// each denom is not smaller than the amount stored in the state entry, that
+// the amounts in escrow per channel end add up to at least the stored amount
+// and that the amount in escrow per channel end is not greater than the balance
+// of the escrow account of the channel end.
+//
+// NOTE: the amounts in escrow per channel end may add up to more than the stored
+// amount, since the migration setting them for existing channels uses the balances
+// of the escrow accounts, which include the tokens sent directly to them.
 func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
 	return func(ctx sdk.Context) (string, bool) {
 		var actualTotalEscrowed sdk.Coins
@@ -46,6 +60,75 @@ func TotalEscrowPerDenomInvariants(k *Keeper) sdk.Invariant {
 				fmt.Sprintf("found denom(s) with total escrow amount lower than expected:\nactual total escrowed: %s\nexpected total escrowed: %s", actualTotalEscrowed, expectedTotalEscrowed)), true
 		}
 
+		var channelEscrowed sdk.Coins
+		for _, channelEscrow := range k.GetAllChannelEscrows(ctx) {
+			// the amount in escrow per channel end must be backed by the balance of the escrow account of the channel end
+			escrowAddress := types.GetEscrowAddress(channelEscrow.PortId, channelEscrow.ChannelId)
+			if balance := k.bankKeeper.GetBalance(ctx, escrowAddress, channelEscrow.Amount.Denom); balance.IsLT(channelEscrow.Amount) {
+				return sdk.FormatInvariant(
+					types.ModuleName,
+					"total escrow per denom invariance",
+					fmt.Sprintf("found channel escrow amount %s for port %s and channel %s greater than the escrow account balance %s", channelEscrow.Amount, channelEscrow.PortId, channelEscrow.ChannelId, balance)), true
+			}
+
+			channelEscrowed = channelEscrowed.Add(channelEscrow.Amount)
+		}
+
+		// the amounts in escrow per channel end must add up to at least the total escrow amount of each denomination
+		if !channelEscrowed.IsAllGTE(expectedTotalEscrowed) {
+			return sdk.FormatInvariant(
+				types.ModuleName,
+				"total escrow per denom invariance",
+				fmt.Sprintf("found denom(s) with channel escrow amounts adding up to less than the total escrow amount:\nchannel escrowed: %s\nexpected total escrowed: %s", channelEscrowed, expectedTotalEscrowed)), true
+		}
+
+		return "", false
+	}
+}
+
+// DenomMigrationsInvariant checks that no vouchers of a migrated denomination are in escrow, neither
+// in the escrow accounting nor in the balances of the escrow accounts, and that the denomination the
+// vouchers are migrated to has not been migrated itself.
+func DenomMigrationsInvariant(k *Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		portID := k.GetPort(ctx)
+		transferChannels := k.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+
+		for _, denomMigration := range k.GetAllDenomMigrations(ctx) {
+			oldDenom := denomMigration.OldDenom.IBCDenom()
+
+			if totalEscrow := k.GetTotalEscrowForDenom(ctx, oldDenom); !totalEscrow.IsZero() {
+				return sdk.FormatInvariant(
+					types.ModuleName,
+					"denom migrations invariance",
+					fmt.Sprintf("found total escrow amount %s of migrated denom %s", totalEscrow, denomMigration.OldDenom.Path())), true
+			}
+
+			for _, channel := range transferChannels {
+				if channelEscrow := k.GetChannelEscrow(ctx, channel.PortId, channel.ChannelId, oldDenom); !channelEscrow.IsZero() {
+					return sdk.FormatInvariant(
+						types.ModuleName,
+						"denom migrations invariance",
+						fmt.Sprintf("found amount %s of migrated denom %s in escrow for port %s and channel %s", channelEscrow, denomMigration.OldDenom.Path(), channel.PortId, channel.ChannelId)), true
+				}
+
+				escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+				if balance := k.bankKeeper.GetBalance(ctx, escrowAddress, oldDenom); !balance.IsZero() {
+					return sdk.FormatInvariant(
+						types.ModuleName,
+						"denom migrations invariance",
+						fmt.Sprintf("found balance %s of migrated denom %s in escrow account of port %s and channel %s", balance, denomMigration.OldDenom.Path(), channel.PortId, channel.ChannelId)), true
+				}
+			}
+
+			if _, found := k.GetDenomMigration(ctx, denomMigration.NewDenom.IBCDenom()); found {
+				return sdk.FormatInvariant(
+					types.ModuleName,
+					"denom migrations invariance",
+					fmt.Sprintf("found migrated denom %s migrated to denom %s which has been migrated itself", denomMigration.OldDenom.Path(), denomMigration.NewDenom.Path())), true
+			}
+		}
+
 		return "", false
 	}
 }
@@ -4,6 +4,7 @@ import (
 	sdkmath "cosmossdk.io/math"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktestutil "github.com/cosmos/cosmos-sdk/x/bank/testutil"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -11,6 +12,8 @@ import (
 )
 
 func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
+	var path *ibctesting.Path
+
 	testCases := []struct {
 		name            string
 		coinsToTransfer sdk.Coins
@@ -39,6 +42,35 @@ func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
 			},
 			false,
 		},
+		{
+			"success with tokens sent to the escrow account before migrating channel escrows",
+			sdk.NewCoins(ibctesting.TestCoin),
+			func() {
+				escrowAddress := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(ibctesting.TestCoin)))
+
+				migrator := keeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+				suite.Require().NoError(migrator.MigrateChannelEscrows(suite.chainA.GetContext()))
+			},
+			true,
+		},
+		{
+			"fails with broken invariant: channel escrow is not backed by the escrow account balance",
+			sdk.NewCoins(ibctesting.TestCoin),
+			func() {
+				// set amount for denom in escrow over an unrelated channel end
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), ibctesting.TransferPort, "channel-100", ibctesting.TestCoin)
+			},
+			false,
+		},
+		{
+			"fails with broken invariant: channel escrow adds up to less than total escrow",
+			sdk.NewCoins(ibctesting.TestCoin),
+			func() {
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.OneInt()))
+			},
+			false,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -46,7 +78,7 @@ func (suite *KeeperTestSuite) TestTotalEscrowPerDenomInvariant() {
 
 		suite.Run(tc.name, func() {
 			suite.SetupTest() // reset
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			msg := types.NewMsgTransfer(
@@ -34,9 +34,22 @@ type Keeper struct {
 
 	ics4Wrapper   porttypes.ICS4Wrapper
 	channelKeeper types.ChannelKeeper
+	clientKeeper  types.ClientKeeper
 	authKeeper    types.AccountKeeper
 	bankKeeper    types.BankKeeper
 
+	// the policies screening the transfers, checked in order
+	transferPolicies types.TransferPolicies
+
+	// the keeper used to send protocol fees to the community pool, may be nil
+	distributionKeeper types.DistributionKeeper
+
+	// the memo keys understood by the chain, memos are not validated if nil
+	memoRegistry *types.MemoRegistry
+
+	// the resolver of the counterparty address formats learned during the channel handshake, may be nil
+	addressFormatResolver types.AddressFormatResolver
+
 	// the address capable of executing a MsgUpdateParams message. Typically, this
 	// should be the x/gov module account.
 	authority string
@@ -49,6 +62,7 @@ func NewKeeper(
 	legacySubspace types.ParamSubspace,
 	ics4Wrapper porttypes.ICS4Wrapper,
 	channelKeeper types.ChannelKeeper,
+	clientKeeper types.ClientKeeper,
 	authKeeper types.AccountKeeper,
 	bankKeeper types.BankKeeper,
 	authority string,
@@ -68,6 +82,7 @@ func NewKeeper(
 		legacySubspace: legacySubspace,
 		ics4Wrapper:    ics4Wrapper,
 		channelKeeper:  channelKeeper,
+		clientKeeper:   clientKeeper,
 		authKeeper:     authKeeper,
 		bankKeeper:     bankKeeper,
 		authority:      authority,
@@ -81,6 +96,13 @@ func (k *Keeper) WithICS4Wrapper(wrapper porttypes.ICS4Wrapper) {
 	k.ics4Wrapper = wrapper
 }
 
+// WithMemoRegistry sets the registry of the memo keys understood by the chain. The memos of the
+// transfers sent and received are validated against it. This function must be called before the
+// keeper is passed to the IBC module.
+func (k *Keeper) WithMemoRegistry(registry *types.MemoRegistry) {
+	k.memoRegistry = registry
+}
+
 // GetICS4Wrapper returns the ICS4Wrapper.
 func (k Keeper) GetICS4Wrapper() porttypes.ICS4Wrapper {
 	return k.ics4Wrapper
@@ -195,8 +217,15 @@ func (k Keeper) IterateDenoms(ctx context.Context, cb func(denom types.Denom) bo
 	}
 }
 
-// setDenomMetadata sets an IBC token's denomination metadata
+// setDenomMetadata sets an IBC token's denomination metadata. The metadata is not set if it
+// has been overridden by governance. While the metadata of received vouchers is only set if the
+// bank module holds no metadata for them, the metadata of denominations imported at genesis or
+// migrated is set unconditionally and relies on this check to preserve the overrides.
 func (k Keeper) setDenomMetadata(ctx context.Context, denom types.Denom) {
+	if k.hasDenomMetadataOverride(ctx, denom.IBCDenom()) {
+		return
+	}
+
 	metadata := banktypes.Metadata{
 		Description: fmt.Sprintf("IBC token from %s", denom.Path()),
 		DenomUnits: []*banktypes.DenomUnit{
@@ -299,6 +328,98 @@ func (k Keeper) IterateTokensInEscrow(ctx context.Context, storeprefix []byte, c
 	}
 }
 
+// GetChannelEscrow gets the amount of the provided denomination in escrow for the given channel end.
+// The returned coin has a zero amount if no amount is in escrow for the channel end.
+func (k Keeper) GetChannelEscrow(ctx context.Context, portID, channelID, denom string) sdk.Coin {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ChannelEscrowStoreKey(portID, channelID, denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return sdk.NewCoin(denom, sdkmath.ZeroInt())
+	}
+
+	amount := sdk.IntProto{}
+	k.cdc.MustUnmarshal(bz, &amount)
+
+	return sdk.NewCoin(denom, amount.Int)
+}
+
+// SetChannelEscrow stores the amount of source chain tokens in escrow for the given channel end.
+// Amount is stored in state if and only if it is not equal to zero. The function will panic
+// if the amount is negative.
+func (k Keeper) SetChannelEscrow(ctx context.Context, portID, channelID string, coin sdk.Coin) {
+	if coin.Amount.IsNegative() {
+		panic(fmt.Errorf("amount cannot be negative: %s", coin.Amount))
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	key := types.ChannelEscrowStoreKey(portID, channelID, coin.Denom)
+
+	if coin.Amount.IsZero() {
+		if err := store.Delete(key); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	bz := k.cdc.MustMarshal(&sdk.IntProto{Int: coin.Amount})
+	if err := store.Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllChannelEscrows returns the amounts in escrow for all the channel ends and denominations.
+func (k Keeper) GetAllChannelEscrows(ctx context.Context) []types.ChannelEscrow {
+	var channelEscrows []types.ChannelEscrow
+	k.IterateChannelEscrows(ctx, func(channelEscrow types.ChannelEscrow) bool {
+		channelEscrows = append(channelEscrows, channelEscrow)
+		return false
+	})
+
+	return channelEscrows
+}
+
+// IterateChannelEscrows iterates over the amounts in escrow for each channel end and denomination
+// and performs a callback function.
+func (k Keeper) IterateChannelEscrows(ctx context.Context, cb func(channelEscrow types.ChannelEscrow) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.ChannelEscrowKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		channelEscrow, err := k.parseChannelEscrow(iterator.Key(), iterator.Value())
+		if err != nil {
+			panic(err)
+		}
+
+		if cb(channelEscrow) {
+			break
+		}
+	}
+}
+
+// parseChannelEscrow parses a channel escrow from its store key and value.
+// The key consists of types.ChannelEscrowKey/portID/channelID/denom.
+func (k Keeper) parseChannelEscrow(key, value []byte) (types.ChannelEscrow, error) {
+	// the denomination may contain slashes, hence the key is split in at most 4 parts
+	parts := strings.SplitN(string(key), "/", 4)
+	if len(parts) != 4 {
+		return types.ChannelEscrow{}, fmt.Errorf("key path should always have 4 elements")
+	}
+	if parts[0] != string(types.ChannelEscrowKey) {
+		return types.ChannelEscrow{}, fmt.Errorf("key path does not start with expected prefix: %s", types.ChannelEscrowKey)
+	}
+
+	amount := sdk.IntProto{}
+	if err := k.cdc.Unmarshal(value, &amount); err != nil {
+		return types.ChannelEscrow{}, err
+	}
+
+	return types.NewChannelEscrow(parts[1], parts[2], sdk.NewCoin(parts[3], amount.Int)), nil
+}
+
 // setForwardedPacket sets the forwarded packet in the store.
 func (k Keeper) setForwardedPacket(ctx context.Context, portID, channelID string, sequence uint64, packet channeltypes.Packet) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -335,6 +456,39 @@ func (k Keeper) deleteForwardedPacket(ctx context.Context, portID, channelID str
 	}
 }
 
+// getForwardRetries returns the number of times the tokens of the packet received on the provided
+// port and channel with the given sequence have been resent to the next hop.
+func (k Keeper) getForwardRetries(ctx context.Context, portID, channelID string, sequence uint64) uint64 {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.PacketForwardRetriesKey(portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return sdk.BigEndianToUint64(bz)
+}
+
+// setForwardRetries sets the number of times the tokens of the packet received on the provided
+// port and channel with the given sequence have been resent to the next hop.
+func (k Keeper) setForwardRetries(ctx context.Context, portID, channelID string, sequence, retries uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(types.PacketForwardRetriesKey(portID, channelID, sequence), sdk.Uint64ToBigEndian(retries)); err != nil {
+		panic(err)
+	}
+}
+
+// deleteForwardRetries deletes the number of retries of the packet received on the provided port
+// and channel with the given sequence.
+func (k Keeper) deleteForwardRetries(ctx context.Context, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.PacketForwardRetriesKey(portID, channelID, sequence)); err != nil {
+		panic(err)
+	}
+}
+
 // getAllForwardedPackets gets all forward packets stored in state.
 func (k Keeper) getAllForwardedPackets(ctx context.Context) []types.ForwardedPacket {
 	var packets []types.ForwardedPacket
@@ -353,36 +507,53 @@ func (k Keeper) iterateForwardedPackets(ctx context.Context, cb func(packet type
 
 	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
 	for ; iterator.Valid(); iterator.Next() {
-		var forwardPacket types.ForwardedPacket
-		k.cdc.MustUnmarshal(iterator.Value(), &forwardPacket.Packet)
-
-		// Iterator key consists of types.ForwardedPacketKey/portID/channelID/sequence
-		parts := strings.Split(string(iterator.Key()), "/")
-		if len(parts) != 4 {
-			panic(fmt.Errorf("key path should always have 4 elements"))
-		}
-		if parts[0] != string(types.ForwardedPacketKey) {
-			panic(fmt.Errorf("key path does not start with expected prefix: %s", types.ForwardedPacketKey))
-		}
-
-		portID, channelID := parts[1], parts[2]
-		if err := host.PortIdentifierValidator(portID); err != nil {
-			panic(fmt.Errorf("port identifier validation failed while parsing forward key path"))
-		}
-		if err := host.ChannelIdentifierValidator(channelID); err != nil {
-			panic(fmt.Errorf("channel identifier validation failed while parsing forward key path"))
+		forwardPacket, err := k.parseForwardedPacket(iterator.Key(), iterator.Value())
+		if err != nil {
+			panic(err)
 		}
 
-		forwardPacket.ForwardKey.Sequence = sdk.BigEndianToUint64([]byte(parts[3]))
-		forwardPacket.ForwardKey.ChannelId = channelID
-		forwardPacket.ForwardKey.PortId = portID
-
 		if cb(forwardPacket) {
 			break
 		}
 	}
 }
 
+// parseForwardedPacket parses a forwarded packet from its store key and value.
+// The key consists of types.ForwardedPacketKey/portID/channelID/sequence.
+func (k Keeper) parseForwardedPacket(key, value []byte) (types.ForwardedPacket, error) {
+	// the big endian encoded sequence may contain slashes, hence it is taken from the end of the key
+	if len(key) < 8 {
+		return types.ForwardedPacket{}, fmt.Errorf("key path should always end with the packet sequence")
+	}
+	sequence := sdk.BigEndianToUint64(key[len(key)-8:])
+
+	parts := strings.Split(string(key[:len(key)-8]), "/")
+	if len(parts) != 4 || parts[3] != "" {
+		return types.ForwardedPacket{}, fmt.Errorf("key path should always have 4 elements")
+	}
+	if parts[0] != string(types.ForwardedPacketKey) {
+		return types.ForwardedPacket{}, fmt.Errorf("key path does not start with expected prefix: %s", types.ForwardedPacketKey)
+	}
+
+	portID, channelID := parts[1], parts[2]
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return types.ForwardedPacket{}, fmt.Errorf("port identifier validation failed while parsing forward key path")
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return types.ForwardedPacket{}, fmt.Errorf("channel identifier validation failed while parsing forward key path")
+	}
+
+	var packet channeltypes.Packet
+	if err := k.cdc.Unmarshal(value, &packet); err != nil {
+		return types.ForwardedPacket{}, err
+	}
+
+	return types.ForwardedPacket{
+		ForwardKey: channeltypes.NewPacketID(portID, channelID, sequence),
+		Packet:     packet,
+	}, nil
+}
+
 // IsBlockedAddr checks if the given address is allowed to send or receive tokens.
 // The module account is always allowed to send and receive tokens.
 func (k Keeper) isBlockedAddr(addr sdk.AccAddress) bool {
@@ -0,0 +1,190 @@
+package keeper_test
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestMemoValidationOnSend tests that the memos of outgoing transfers are validated against the memo registry.
+func (suite *KeeperTestSuite) TestMemoValidationOnSend() {
+	var (
+		registry *types.MemoRegistry
+		memo     string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: plain text memo",
+			func() {
+				memo = "hello"
+			},
+			nil,
+		},
+		{
+			"success: valid registered key",
+			func() {
+				memo = fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"channel-1"}}`, ibctesting.TestAccAddress)
+			},
+			nil,
+		},
+		{
+			"success: unknown keys may be understood by the counterparty",
+			func() {
+				memo = `{"wasm":{"contract":"cosmos1"}}`
+			},
+			nil,
+		},
+		{
+			"success: memos are not validated without registry",
+			func() {
+				registry = nil
+				memo = `{"forward":`
+			},
+			nil,
+		},
+		{
+			"failure: malformed memo",
+			func() {
+				memo = `{"forward":`
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid value of registered key",
+			func() {
+				memo = `{"forward":{"receiver":"cosmos1"}}`
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			registry = types.NewMemoRegistry(true)
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.WithMemoRegistry(registry)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				memo,
+				nil,
+			)
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestMemoValidationOnRecv tests that the memos of received transfers are validated against the memo registry.
+func (suite *KeeperTestSuite) TestMemoValidationOnRecv() {
+	var (
+		registry *types.MemoRegistry
+		memo     string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: registered key",
+			func() {
+				registry.MustRegisterMemoKey("wasm", "wasm", `{"type":"object"}`)
+				memo = `{"wasm":{"contract":"cosmos1"}}`
+			},
+			nil,
+		},
+		{
+			"success: unknown key is allowed",
+			func() {
+				registry = types.NewMemoRegistry(false)
+				memo = `{"wasm":{"contract":"cosmos1"}}`
+			},
+			nil,
+		},
+		{
+			"failure: unknown key is rejected",
+			func() {
+				memo = `{"wasm":{"contract":"cosmos1"}}`
+			},
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: invalid value of registered key",
+			func() {
+				memo = `{"forward":"channel-1"}`
+			},
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			registry = types.NewMemoRegistry(true)
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().TransferKeeper.WithMemoRegistry(registry)
+
+			data := types.NewFungibleTokenPacketDataV2(
+				[]types.Token{
+					{
+						Denom:  types.NewDenom(sdk.DefaultBondDenom),
+						Amount: ibctesting.DefaultCoinAmount.String(),
+					},
+				},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				memo,
+				ibctesting.EmptyForwardingPacketData,
+			)
+			packet := channeltypes.NewPacket(data.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, data)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
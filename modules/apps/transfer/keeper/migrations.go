@@ -114,6 +114,44 @@ func (m Migrator) MigrateDenomTraceToDenom(ctx sdk.Context) error {
 	return nil
 }
 
+// MigrateParamsOverrides migrates the transfer module's parameters to include the denom and
+// channel overrides. Stored parameters decode with empty overrides, hence the migration only
+// ensures the stored parameters are valid and persists them in the new format.
+func (m Migrator) MigrateParamsOverrides(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated transfer app params to include denom and channel overrides")
+	return nil
+}
+
+// MigrateChannelEscrows sets the amount of tokens in escrow per channel end and denomination
+// from the balances of the escrow accounts of the transfer channels. The balances of the escrow
+// accounts may include tokens sent directly to the escrow addresses, which are not accounted for
+// in the total escrow amounts, hence the channel escrow amounts may add up to more than the total
+// escrow amount of a denomination.
+func (m Migrator) MigrateChannelEscrows(ctx sdk.Context) error {
+	var numEscrows int
+	portID := m.keeper.GetPort(ctx)
+
+	transferChannels := m.keeper.channelKeeper.GetAllChannelsWithPortPrefix(ctx, portID)
+	for _, channel := range transferChannels {
+		escrowAddress := types.GetEscrowAddress(channel.PortId, channel.ChannelId)
+		escrowBalances := m.keeper.bankKeeper.GetAllBalances(ctx, escrowAddress)
+
+		for _, escrowBalance := range escrowBalances {
+			m.keeper.SetChannelEscrow(ctx, channel.PortId, channel.ChannelId, escrowBalance)
+			numEscrows++
+		}
+	}
+
+	m.keeper.Logger(ctx).Info("successfully set channel escrows", "number of channel escrows", numEscrows)
+	return nil
+}
+
 // setDenomTrace sets a new {trace hash -> denom trace} pair to the store.
 func (k Keeper) setDenomTrace(ctx context.Context, denomTrace internaltypes.DenomTrace) {
 	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.DenomTraceKey)
@@ -49,6 +49,58 @@ func (suite *KeeperTestSuite) TestMigratorMigrateParams() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratorMigrateParamsOverrides() {
+	testCases := []struct {
+		msg      string
+		params   transfertypes.Params
+		expError bool
+	}{
+		{
+			"success: default params",
+			transfertypes.DefaultParams(),
+			false,
+		},
+		{
+			"success: params with overrides",
+			transfertypes.Params{
+				SendEnabled:      true,
+				ReceiveEnabled:   false,
+				DenomOverrides:   []transfertypes.DenomOverride{transfertypes.NewDenomOverride(sdk.DefaultBondDenom, false, true)},
+				ChannelOverrides: []transfertypes.ChannelOverride{transfertypes.NewChannelOverride(ibctesting.TransferPort, ibctesting.FirstChannelID, true, true)},
+			},
+			false,
+		},
+		{
+			"failure: invalid overrides",
+			transfertypes.Params{
+				DenomOverrides: []transfertypes.DenomOverride{transfertypes.NewDenomOverride("", false, true)},
+			},
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(fmt.Sprintf("case %s", tc.msg), func() {
+			suite.SetupTest() // reset
+
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), tc.params)
+
+			migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+			err := migrator.MigrateParamsOverrides(suite.chainA.GetContext())
+			if tc.expError {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			suite.Require().Equal(tc.params, params)
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateDenomTraceToDenom() {
 	testCases := []struct {
 		msg            string
@@ -304,6 +356,35 @@ func (suite *KeeperTestSuite) TestMigrateTotalEscrowForDenom() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestMigratorMigrateChannelEscrows() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	extraPath := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	extraPath.Setup()
+
+	voucherDenom := transfertypes.NewDenom(sdk.DefaultBondDenom, transfertypes.NewHop(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	voucherCoin := sdk.NewCoin(voucherDenom.IBCDenom(), sdkmath.NewInt(100))
+
+	escrowAddress := transfertypes.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	extraEscrowAddress := transfertypes.GetEscrowAddress(extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID)
+
+	// funds the escrow accounts to have balance
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrowAddress, sdk.NewCoins(ibctesting.TestCoin, voucherCoin)))
+	suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, extraEscrowAddress, sdk.NewCoins(ibctesting.TestCoin)))
+
+	migrator := transferkeeper.NewMigrator(suite.chainA.GetSimApp().TransferKeeper)
+	suite.Require().NoError(migrator.MigrateChannelEscrows(suite.chainA.GetContext()))
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	suite.Require().Equal(ibctesting.TestCoin, transferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	suite.Require().Equal(voucherCoin, transferKeeper.GetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, voucherDenom.IBCDenom()))
+	suite.Require().Equal(ibctesting.TestCoin, transferKeeper.GetChannelEscrow(suite.chainA.GetContext(), extraPath.EndpointA.ChannelConfig.PortID, extraPath.EndpointA.ChannelID, sdk.DefaultBondDenom))
+	suite.Require().Len(transferKeeper.GetAllChannelEscrows(suite.chainA.GetContext()), 3)
+}
+
 func (suite *KeeperTestSuite) TestMigratorMigrateMetadata() {
 	var (
 		denomTraces      []internaltransfertypes.DenomTrace
@@ -9,6 +9,8 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
@@ -18,10 +20,6 @@ var _ types.MsgServer = (*Keeper)(nil)
 func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.MsgTransferResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	if !k.GetParams(ctx).SendEnabled {
-		return nil, types.ErrSendDisabled
-	}
-
 	sender, err := sdk.AccAddressFromBech32(msg.Sender)
 	if err != nil {
 		return nil, err
@@ -44,9 +42,21 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 		}
 	}
 
+	if err := k.validateTransferReceiver(ctx, msg); err != nil {
+		return nil, err
+	}
+
+	timeoutHeight, timeoutTimestamp := msg.TimeoutHeight, msg.TimeoutTimestamp
+	if msg.RelativeTimeout != nil {
+		timeoutHeight, timeoutTimestamp, err = k.resolveRelativeTimeout(ctx, msg.SourcePort, msg.SourceChannel, *msg.RelativeTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	sequence, err := k.sendTransfer(
-		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, msg.TimeoutHeight, msg.TimeoutTimestamp,
-		msg.Memo, msg.Forwarding.GetHops())
+		ctx, msg.SourcePort, msg.SourceChannel, coins, sender, msg.Receiver, timeoutHeight, timeoutTimestamp,
+		msg.Memo, msg.Forwarding.GetHops(), msg.Forwarding.GetFallbackReceivers())
 	if err != nil {
 		return nil, err
 	}
@@ -56,6 +66,22 @@ func (k Keeper) Transfer(goCtx context.Context, msg *types.MsgTransfer) (*types.
 	return &types.MsgTransferResponse{Sequence: sequence}, nil
 }
 
+// MultiTransfer defines an rpc handler method for MsgMultiTransfer. The transfers are executed in order as
+// individual MsgTransfer, and the message fails if any of them fails.
+func (k Keeper) MultiTransfer(goCtx context.Context, msg *types.MsgMultiTransfer) (*types.MsgMultiTransferResponse, error) {
+	sequences := make([]uint64, 0, len(msg.Transfers))
+	for i, msgTransfer := range msg.MsgTransfers() {
+		res, err := k.Transfer(goCtx, msgTransfer)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to execute transfer %d", i)
+		}
+
+		sequences = append(sequences, res.Sequence)
+	}
+
+	return &types.MsgMultiTransferResponse{Sequences: sequences}, nil
+}
+
 // UpdateParams defines an rpc handler method for MsgUpdateParams. Updates the ibc-transfer module's parameters.
 func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
@@ -68,6 +94,117 @@ func (k Keeper) UpdateParams(goCtx context.Context, msg *types.MsgUpdateParams)
 	return &types.MsgUpdateParamsResponse{}, nil
 }
 
+// SetRateLimit defines an rpc handler method for MsgSetRateLimit. It creates or updates the rate limit of a
+// denomination over a channel end. The flow of an existing rate limit is reset.
+func (k Keeper) SetRateLimit(goCtx context.Context, msg *types.MsgSetRateLimit) (*types.MsgSetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	k.setRateLimit(ctx, types.NewRateLimit(msg.PortId, msg.ChannelId, msg.Denom, msg.Quota))
+
+	return &types.MsgSetRateLimitResponse{}, nil
+}
+
+// RemoveRateLimit defines an rpc handler method for MsgRemoveRateLimit.
+func (k Keeper) RemoveRateLimit(goCtx context.Context, msg *types.MsgRemoveRateLimit) (*types.MsgRemoveRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom); !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) denom (%s)", msg.PortId, msg.ChannelId, msg.Denom)
+	}
+
+	k.deleteRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom)
+
+	return &types.MsgRemoveRateLimitResponse{}, nil
+}
+
+// ResetRateLimit defines an rpc handler method for MsgResetRateLimit. It clears the flow of the rate limit
+// so that a new window is started on the next transfer.
+func (k Keeper) ResetRateLimit(goCtx context.Context, msg *types.MsgResetRateLimit) (*types.MsgResetRateLimitResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	rateLimit, found := k.GetRateLimit(ctx, msg.PortId, msg.ChannelId, msg.Denom)
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrRateLimitNotFound, "port ID (%s) channel ID (%s) denom (%s)", msg.PortId, msg.ChannelId, msg.Denom)
+	}
+
+	k.setRateLimit(ctx, types.NewRateLimit(rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom, rateLimit.Quota))
+
+	return &types.MsgResetRateLimitResponse{}, nil
+}
+
+// MigrateDenomTrace defines an rpc handler method for MsgMigrateDenomTrace. It migrates the vouchers of
+// the old denomination to the new denomination. Signers other than the authority may only migrate their
+// own vouchers, once the migration has been recorded by the authority.
+func (k Keeper) MigrateDenomTrace(goCtx context.Context, msg *types.MsgMigrateDenomTrace) (*types.MsgMigrateDenomTraceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	denomMigration := types.NewDenomMigration(msg.OldDenom, msg.NewDenom)
+
+	if k.GetAuthority() != msg.Signer {
+		if len(msg.Holders) > 1 || (len(msg.Holders) == 1 && msg.Holders[0] != msg.Signer) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+		}
+
+		signer, err := sdk.AccAddressFromBech32(msg.Signer)
+		if err != nil {
+			return nil, err
+		}
+
+		migrated, err := k.migrateHolderDenomTrace(ctx, denomMigration, signer)
+		if err != nil {
+			return nil, err
+		}
+
+		return &types.MsgMigrateDenomTraceResponse{Migrated: migrated}, nil
+	}
+
+	holders := make([]sdk.AccAddress, 0, len(msg.Holders))
+	for _, holder := range msg.Holders {
+		holderAddr, err := sdk.AccAddressFromBech32(holder)
+		if err != nil {
+			return nil, err
+		}
+
+		holders = append(holders, holderAddr)
+	}
+
+	migrated, err := k.migrateDenomTrace(ctx, denomMigration, holders)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMigrateDenomTraceResponse{Migrated: migrated}, nil
+}
+
+// resolveRelativeTimeout returns the absolute timeout height and timestamp of the relative timeout, computed from the
+// latest height of the client of the provided channel and the timestamp of its consensus state at that height.
+func (k Keeper) resolveRelativeTimeout(ctx sdk.Context, portID, channelID string, relativeTimeout types.RelativeTimeout) (clienttypes.Height, uint64, error) {
+	clientID, _, err := k.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, clientID)
+	if latestHeight.IsZero() {
+		return clienttypes.ZeroHeight(), 0, errorsmod.Wrapf(clienttypes.ErrInvalidHeight, "latest height of client (%s) is zero", clientID)
+	}
+
+	latestTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, clientID, latestHeight)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	return relativeTimeout.AbsoluteTimeouts(latestHeight, latestTimestamp)
+}
+
 // unwindHops unwinds the hops present in the tokens denomination and returns the message modified to reflect
 // the unwound path to take. It assumes that only a single token is present (as this is verified in ValidateBasic)
 // in the tokens list and ensures that the token is not native to the chain.
@@ -82,6 +219,11 @@ func (k Keeper) unwindHops(ctx sdk.Context, msg *types.MsgTransfer) (*types.MsgT
 	msg.Forwarding.Hops = append(unwindHops[1:], msg.Forwarding.Hops...)
 	msg.Forwarding.Unwind = false
 
+	// No fallback receivers are set on the chains of the unwound hops.
+	if len(msg.Forwarding.FallbackReceivers) > 0 {
+		msg.Forwarding.FallbackReceivers = append(make([]string, len(unwindHops)-1), msg.Forwarding.FallbackReceivers...)
+	}
+
 	// Message is validate again, this would only fail if hops now exceeds maximum allowed.
 	if err := msg.ValidateBasic(); err != nil {
 		return nil, err
@@ -122,3 +264,50 @@ func (k Keeper) getUnwindHops(ctx sdk.Context, coins sdk.Coins) ([]types.Hop, er
 
 	return unwindTrace, nil
 }
+
+// SetCounterpartyAddressFormat defines an rpc handler method for MsgSetCounterpartyAddressFormat.
+func (k Keeper) SetCounterpartyAddressFormat(goCtx context.Context, msg *types.MsgSetCounterpartyAddressFormat) (*types.MsgSetCounterpartyAddressFormatResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if !k.channelKeeper.HasChannel(ctx, msg.PortId, msg.ChannelId) {
+		return nil, errorsmod.Wrapf(channeltypes.ErrChannelNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	k.setCounterpartyAddressFormat(ctx, types.NewCounterpartyAddressFormat(msg.PortId, msg.ChannelId, msg.AddressFormat))
+
+	return &types.MsgSetCounterpartyAddressFormatResponse{}, nil
+}
+
+// RemoveCounterpartyAddressFormat defines an rpc handler method for MsgRemoveCounterpartyAddressFormat.
+func (k Keeper) RemoveCounterpartyAddressFormat(goCtx context.Context, msg *types.MsgRemoveCounterpartyAddressFormat) (*types.MsgRemoveCounterpartyAddressFormatResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if _, found := k.GetCounterpartyAddressFormat(ctx, msg.PortId, msg.ChannelId); !found {
+		return nil, errorsmod.Wrapf(types.ErrAddressFormatNotFound, "port ID (%s) channel ID (%s)", msg.PortId, msg.ChannelId)
+	}
+
+	k.deleteCounterpartyAddressFormat(ctx, msg.PortId, msg.ChannelId)
+
+	return &types.MsgRemoveCounterpartyAddressFormatResponse{}, nil
+}
+
+// SetDenomMetadata defines an rpc handler method for MsgSetDenomMetadata. It overrides the bank metadata
+// of the vouchers of the provided IBC denominations.
+func (k Keeper) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDenomMetadata) (*types.MsgSetDenomMetadataResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	for _, override := range msg.Overrides {
+		k.overrideDenomMetadata(ctx, override)
+	}
+
+	return &types.MsgSetDenomMetadataResponse{}, nil
+}
@@ -3,9 +3,12 @@ package keeper_test
 import (
 	"encoding/json"
 	"errors"
+	"math"
 	"strings"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
 
@@ -246,6 +249,174 @@ func (suite *KeeperTestSuite) TestUpdateParams() {
 	}
 }
 
+// TestMsgMultiTransfer tests MultiTransfer rpc handler
+func (suite *KeeperTestSuite) TestMsgMultiTransfer() {
+	var (
+		msg   *types.MsgMultiTransfer
+		path1 *ibctesting.Path
+		path2 *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: a transfer fails",
+			func() {
+				msg.Transfers[1].SourceChannel = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: sender balance is insufficient for all the transfers",
+			func() {
+				balance := suite.chainA.GetSimApp().BankKeeper.GetBalance(suite.chainA.GetContext(), suite.chainA.SenderAccount.GetAddress(), sdk.DefaultBondDenom)
+				msg.Transfers[1].Tokens = sdk.NewCoins(balance)
+			},
+			sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path1 = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path1.Setup()
+
+			path2 = ibctesting.NewTransferPath(suite.chainA, suite.chainC)
+			path2.Setup()
+
+			msg = types.NewMsgMultiTransfer(
+				suite.chainA.SenderAccount.GetAddress().String(),
+				[]types.TransferEntry{
+					types.NewTransferEntry(path1.EndpointA.ChannelConfig.PortID, path1.EndpointA.ChannelID, suite.chainB.SenderAccount.GetAddress().String(), sdk.NewCoins(ibctesting.TestCoin), ""),
+					types.NewTransferEntry(path2.EndpointA.ChannelConfig.PortID, path2.EndpointA.ChannelID, suite.chainC.SenderAccount.GetAddress().String(), sdk.NewCoins(ibctesting.TestCoin), "memo"),
+				},
+				clienttypes.ZeroHeight(), suite.chainB.GetTimeoutTimestamp(),
+				nil,
+			)
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			res, err := suite.chainA.GetSimApp().TransferKeeper.MultiTransfer(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal([]uint64{1, 1}, res.Sequences)
+
+				packets, err := ibctesting.ParsePacketsFromEvents(channeltypes.EventTypeSendPacket, ctx.EventManager().Events().ToABCIEvents())
+				suite.Require().NoError(err)
+				suite.Require().Len(packets, 2)
+				suite.Require().Equal(path1.EndpointA.ChannelID, packets[0].SourceChannel)
+				suite.Require().Equal(path2.EndpointA.ChannelID, packets[1].SourceChannel)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+				suite.Require().Nil(res)
+			}
+		})
+	}
+}
+
+// TestMsgTransferRelativeTimeout tests that the Transfer rpc handler resolves relative timeouts
+// against the client of the source channel.
+func (suite *KeeperTestSuite) TestMsgTransferRelativeTimeout() {
+	var (
+		msg  *types.MsgTransfer
+		path *ibctesting.Path
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: height and timestamp offsets",
+			func() {},
+			nil,
+		},
+		{
+			"success: only timestamp offset",
+			func() {
+				msg.RelativeTimeout.HeightOffset = 0
+			},
+			nil,
+		},
+		{
+			"failure: channel does not exist",
+			func() {
+				msg.SourceChannel = "channel-100"
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: height offset overflows",
+			func() {
+				msg.RelativeTimeout.HeightOffset = math.MaxUint64
+			},
+			types.ErrInvalidPacketTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				clienttypes.ZeroHeight(), 0,
+				"",
+				nil,
+			)
+			msg.RelativeTimeout = types.NewRelativeTimeout(100, uint64(time.Hour.Nanoseconds()))
+
+			tc.malleate()
+
+			ctx := suite.chainA.GetContext()
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				clientKeeper := suite.chainA.GetSimApp().IBCKeeper.ClientKeeper
+				latestHeight := clientKeeper.GetClientLatestHeight(ctx, path.EndpointA.ClientID)
+				latestTimestamp, err := clientKeeper.GetClientTimestampAtHeight(ctx, path.EndpointA.ClientID, latestHeight)
+				suite.Require().NoError(err)
+
+				expTimeoutHeight, expTimeoutTimestamp, err := msg.RelativeTimeout.AbsoluteTimeouts(latestHeight, latestTimestamp)
+				suite.Require().NoError(err)
+
+				packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+				suite.Require().NoError(err)
+				suite.Require().Equal(expTimeoutHeight, packet.TimeoutHeight)
+				suite.Require().Equal(expTimeoutTimestamp, packet.TimeoutTimestamp)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestUnwindHops() {
 	var msg *types.MsgTransfer
 	var path *ibctesting.Path
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// WithTransferPolicies sets the policies screening the transfers of the keeper, which are checked in the order
+// provided. This function must be called before the keeper is passed to the transfer IBC module, as the module
+// holds a copy of the keeper.
+func (k *Keeper) WithTransferPolicies(policies ...types.TransferPolicy) {
+	k.transferPolicies = types.NewTransferPolicies(policies...)
+}
+
+// checkSendPolicy returns an error if the outgoing transfer is rejected by the transfer policies.
+func (k Keeper) checkSendPolicy(ctx context.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, receiver string, tokens types.Tokens) error {
+	if err := k.transferPolicies.CheckSend(ctx, sourcePort, sourceChannel, sender, receiver, tokens); err != nil {
+		return policyError(err)
+	}
+
+	return nil
+}
+
+// checkRecvPolicy returns an error if the received transfer is rejected by the transfer policies.
+func (k Keeper) checkRecvPolicy(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, receiver sdk.AccAddress) error {
+	if err := k.transferPolicies.CheckRecv(ctx, packet, data, receiver); err != nil {
+		return policyError(err)
+	}
+
+	return nil
+}
+
+// checkForwardPolicy returns an error if forwarding the tokens of the received packet is rejected by the transfer policies.
+func (k Keeper) checkForwardPolicy(ctx context.Context, packet channeltypes.Packet, nextHop types.Hop, receiver string, coins sdk.Coins) error {
+	if err := k.transferPolicies.CheckForward(ctx, packet, nextHop, receiver, coins); err != nil {
+		return policyError(err)
+	}
+
+	return nil
+}
+
+// policyError wraps an error returned by the transfer policies. Errors which are not registered are wrapped with
+// ErrTransferRejected, so that the packets rejected by a policy are acknowledged with a typed error acknowledgement.
+func policyError(err error) error {
+	if codespace, _, _ := errorsmod.ABCIInfo(err, false); codespace == errorsmod.UndefinedCodespace {
+		return errorsmod.Wrap(types.ErrTransferRejected, err.Error())
+	}
+
+	return errorsmod.Wrap(err, "transfer rejected by policy")
+}
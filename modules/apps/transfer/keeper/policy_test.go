@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var _ types.TransferPolicy = (*mockTransferPolicy)(nil)
+
+// mockTransferPolicy rejects the transfers with the errors it is configured with.
+type mockTransferPolicy struct {
+	sendErr    error
+	recvErr    error
+	forwardErr error
+}
+
+func (p mockTransferPolicy) CheckSend(_ context.Context, _, _ string, _ sdk.AccAddress, _ string, _ types.Tokens) error {
+	return p.sendErr
+}
+
+func (p mockTransferPolicy) CheckRecv(_ context.Context, _ channeltypes.Packet, _ types.FungibleTokenPacketDataV2, _ sdk.AccAddress) error {
+	return p.recvErr
+}
+
+func (p mockTransferPolicy) CheckForward(_ context.Context, _ channeltypes.Packet, _ types.Hop, _ string, _ sdk.Coins) error {
+	return p.forwardErr
+}
+
+// TestTransferPoliciesOnSend tests that outgoing transfers are screened by the transfer policies.
+func (suite *KeeperTestSuite) TestTransferPoliciesOnSend() {
+	var policies []types.TransferPolicy
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no policies",
+			func() {},
+			nil,
+		},
+		{
+			"success: all policies accept the transfer",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{}, mockTransferPolicy{}}
+			},
+			nil,
+		},
+		{
+			"failure: unregistered error is wrapped",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{sendErr: errors.New("sender is sanctioned")}}
+			},
+			types.ErrTransferRejected,
+		},
+		{
+			"failure: registered error is preserved",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{sendErr: ibcerrors.ErrUnauthorized}}
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: second policy rejects the transfer",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{}, mockTransferPolicy{sendErr: errors.New("denom is denied")}}
+			},
+			types.ErrTransferRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			policies = nil
+
+			tc.malleate()
+
+			suite.chainA.GetSimApp().TransferKeeper.WithTransferPolicies(policies...)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(ibctesting.TestCoin),
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+				nil,
+			)
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(suite.chainA.GetContext(), msg)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestTransferPoliciesOnRecv tests that received and forwarded transfers are screened by the transfer policies,
+// and that the packets rejected are acknowledged with a typed error acknowledgement.
+func (suite *KeeperTestSuite) TestTransferPoliciesOnRecv() {
+	var (
+		policies   []types.TransferPolicy
+		packetData types.FungibleTokenPacketDataV2
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: all policies accept the transfer",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{forwardErr: errors.New("not forwarded")}}
+			},
+			nil,
+		},
+		{
+			"failure: receive is rejected",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{recvErr: errors.New("receiver is sanctioned")}}
+			},
+			types.ErrTransferRejected,
+		},
+		{
+			"failure: forward is rejected",
+			func() {
+				policies = []types.TransferPolicy{mockTransferPolicy{forwardErr: errors.New("next hop is denied")}}
+				packetData.Forwarding = types.NewForwardingPacketData("", types.NewHop(ibctesting.TransferPort, "channel-100"))
+			},
+			types.ErrTransferRejected,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			policies = nil
+			packetData = types.NewFungibleTokenPacketDataV2(
+				[]types.Token{{Denom: types.NewDenom(sdk.DefaultBondDenom), Amount: ibctesting.DefaultCoinAmount.String()}},
+				suite.chainA.SenderAccount.GetAddress().String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				"",
+				ibctesting.EmptyForwardingPacketData,
+			)
+
+			tc.malleate()
+
+			suite.chainB.GetSimApp().TransferKeeper.WithTransferPolicies(policies...)
+
+			packet := channeltypes.NewPacket(packetData.GetBytes(), 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+			err := suite.chainB.GetSimApp().TransferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, packetData)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				ack := channeltypes.NewErrorAcknowledgement(err)
+				suite.Require().Contains(ack.GetError(), fmt.Sprintf("ABCI code: %d", types.ErrTransferRejected.ABCICode()))
+			}
+		})
+	}
+}
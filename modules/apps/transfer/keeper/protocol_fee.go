@@ -0,0 +1,64 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// WithDistributionKeeper sets the distribution keeper used to send the protocol fees to the community pool.
+// It must be set if the protocol fee has no fee recipient configured.
+func (k *Keeper) WithDistributionKeeper(distributionKeeper types.DistributionKeeper) {
+	k.distributionKeeper = distributionKeeper
+}
+
+// protocolFee returns the basis points and the fee charged on the provided coin sent by the sender over the
+// given channel end. Tokens forwarded by the transfer module account and tokens sent by exempt senders are
+// not charged.
+func (k Keeper) protocolFee(params types.Params, portID, channelID string, sender sdk.AccAddress, coin sdk.Coin) (uint32, sdk.Coin) {
+	if sender.Equals(k.authKeeper.GetModuleAddress(types.ModuleName)) || params.ProtocolFee.IsExempt(sender.String()) {
+		return 0, sdk.NewCoin(coin.Denom, sdkmath.ZeroInt())
+	}
+
+	basisPoints := params.ProtocolFee.BasisPointsFor(portID, channelID, coin.Denom)
+	return basisPoints, types.ComputeFee(coin, basisPoints)
+}
+
+// chargeProtocolFee sends the protocol fee charged on the provided coin from the sender to the fee recipient,
+// or to the community pool if no fee recipient is configured. The coin net of the fee, which is the amount
+// actually transferred, is returned.
+func (k Keeper) chargeProtocolFee(ctx context.Context, params types.Params, portID, channelID string, sender sdk.AccAddress, coin sdk.Coin) (sdk.Coin, error) {
+	basisPoints, fee := k.protocolFee(params, portID, channelID, sender, coin)
+	if fee.IsZero() {
+		return coin, nil
+	}
+
+	recipient := params.ProtocolFee.FeeRecipient
+	if recipient == "" {
+		if k.distributionKeeper == nil {
+			return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidProtocolFee, "distribution keeper must be set to send protocol fees to the community pool")
+		}
+
+		if err := k.distributionKeeper.FundCommunityPool(ctx, sdk.NewCoins(fee), sender); err != nil {
+			return sdk.Coin{}, err
+		}
+	} else {
+		if k.authKeeper.GetModuleAddress(recipient) == nil {
+			return sdk.Coin{}, errorsmod.Wrapf(types.ErrInvalidProtocolFee, "fee recipient module account %s does not exist", recipient)
+		}
+
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, recipient, sdk.NewCoins(fee)); err != nil {
+			return sdk.Coin{}, err
+		}
+	}
+
+	events.EmitProtocolFeeEvent(ctx, sender.String(), portID, channelID, basisPoints, fee, recipient)
+
+	return coin.Sub(fee), nil
+}
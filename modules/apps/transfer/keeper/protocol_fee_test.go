@@ -0,0 +1,256 @@
+package keeper_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestProtocolFeeOnSend tests that the protocol fee is charged on outgoing transfers and that only the
+// amount net of the fee is escrowed and sent in the packet.
+func (suite *KeeperTestSuite) TestProtocolFeeOnSend() {
+	var (
+		path        *ibctesting.Path
+		protocolFee types.ProtocolFee
+		expFee      sdkmath.Int
+		expModule   string
+	)
+
+	amount := sdkmath.NewInt(1_000_000)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: no protocol fee",
+			func() {
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"success: fee sent to module account",
+			func() {
+				protocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, nil, nil)
+				expFee = sdkmath.NewInt(25_000)
+			},
+			nil,
+		},
+		{
+			"success: fee sent to community pool",
+			func() {
+				protocolFee = types.NewProtocolFee(250, "", nil, nil)
+				expFee = sdkmath.NewInt(25_000)
+				expModule = distrtypes.ModuleName
+			},
+			nil,
+		},
+		{
+			"success: channel override takes precedence over default",
+			func() {
+				protocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, []types.FeeOverride{
+					types.NewFeeOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, "", 10),
+				}, nil)
+				expFee = sdkmath.NewInt(1_000)
+			},
+			nil,
+		},
+		{
+			"success: exempt sender is not charged",
+			func() {
+				protocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, nil, []string{suite.chainA.SenderAccount.GetAddress().String()})
+				expFee = sdkmath.ZeroInt()
+			},
+			nil,
+		},
+		{
+			"failure: fee recipient module account does not exist",
+			func() {
+				protocolFee = types.NewProtocolFee(250, "unknown", nil, nil)
+			},
+			types.ErrInvalidProtocolFee,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			protocolFee = types.ProtocolFee{}
+			expModule = authtypes.FeeCollectorName
+
+			tc.malleate()
+
+			params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+			params.ProtocolFee = protocolFee
+			suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			ctx := suite.chainA.GetContext()
+			sender := suite.chainA.SenderAccount.GetAddress()
+			escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			recipient := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(expModule)
+			bankKeeper := suite.chainA.GetSimApp().BankKeeper
+
+			preSenderBalance := bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+			preRecipientBalance := bankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom)
+
+			msg := types.NewMsgTransfer(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+				sender.String(),
+				suite.chainB.SenderAccount.GetAddress().String(),
+				suite.chainB.GetTimeoutHeight(), 0,
+				"",
+				nil,
+			)
+
+			_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+
+			if tc.expError != nil {
+				suite.Require().ErrorIs(err, tc.expError)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			expSent := amount.Sub(expFee)
+			suite.Require().Equal(preSenderBalance.Amount.Sub(amount), bankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(preRecipientBalance.Amount.Add(expFee), bankKeeper.GetBalance(ctx, recipient, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(expSent, bankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom).Amount)
+			suite.Require().Equal(expSent, suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom).Amount)
+
+			packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+			suite.Require().NoError(err)
+
+			data, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+			suite.Require().NoError(err)
+			suite.Require().Equal(expSent.String(), data.Tokens[0].Amount)
+		})
+	}
+}
+
+// TestProtocolFeeRefund tests that the timeout of a transfer charged a protocol fee only refunds the
+// amount net of the fee.
+func (suite *KeeperTestSuite) TestProtocolFeeRefund() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	params.ProtocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, nil, nil)
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx := suite.chainA.GetContext()
+	sender := suite.chainA.SenderAccount.GetAddress()
+	amount := sdkmath.NewInt(1_000_000)
+	preSenderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, amount)),
+		sender.String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"",
+		nil,
+	)
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+	suite.Require().NoError(err)
+
+	packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+	suite.Require().NoError(err)
+
+	data, err := types.UnmarshalPacketData(packet.GetData(), types.V2)
+	suite.Require().NoError(err)
+
+	err = suite.chainA.GetSimApp().TransferKeeper.OnTimeoutPacket(ctx, packet, data)
+	suite.Require().NoError(err)
+
+	// only the protocol fee is lost by the sender
+	postSenderBalance := suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, sender, sdk.DefaultBondDenom)
+	suite.Require().Equal(preSenderBalance.Amount.Sub(sdkmath.NewInt(25_000)), postSenderBalance.Amount)
+	suite.Require().True(suite.chainA.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(ctx, sdk.DefaultBondDenom).Amount.IsZero())
+}
+
+// TestProtocolFeeNotChargedOnForward tests that tokens forwarded by the transfer module account are not
+// charged the protocol fee.
+func (suite *KeeperTestSuite) TestProtocolFeeNotChargedOnForward() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	params.ProtocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, nil, nil)
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx := suite.chainA.GetContext()
+	moduleAddr := suite.chainA.GetSimApp().AccountKeeper.GetModuleAddress(types.ModuleName)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000))
+	suite.Require().NoError(suite.chainA.GetSimApp().BankKeeper.SendCoins(ctx, suite.chainA.SenderAccount.GetAddress(), moduleAddr, sdk.NewCoins(coin)))
+
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(coin),
+		moduleAddr.String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"",
+		nil,
+	)
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+	suite.Require().NoError(err)
+
+	escrow := types.GetEscrowAddress(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().Equal(coin, suite.chainA.GetSimApp().BankKeeper.GetBalance(ctx, escrow, sdk.DefaultBondDenom))
+}
+
+// TestProtocolFeeEvent tests that the protocol fee charged is emitted in an event.
+func (suite *KeeperTestSuite) TestProtocolFeeEvent() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	params := suite.chainA.GetSimApp().TransferKeeper.GetParams(suite.chainA.GetContext())
+	params.ProtocolFee = types.NewProtocolFee(250, authtypes.FeeCollectorName, nil, nil)
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	ctx := suite.chainA.GetContext()
+	msg := types.NewMsgTransfer(
+		path.EndpointA.ChannelConfig.PortID,
+		path.EndpointA.ChannelID,
+		sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(1_000_000))),
+		suite.chainA.SenderAccount.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		suite.chainB.GetTimeoutHeight(), 0,
+		"",
+		nil,
+	)
+
+	_, err := suite.chainA.GetSimApp().TransferKeeper.Transfer(ctx, msg)
+	suite.Require().NoError(err)
+
+	expEvent := sdk.NewEvent(
+		types.EventTypeProtocolFee,
+		sdk.NewAttribute(types.AttributeKeySender, suite.chainA.SenderAccount.GetAddress().String()),
+		sdk.NewAttribute(types.AttributeKeyPortID, path.EndpointA.ChannelConfig.PortID),
+		sdk.NewAttribute(types.AttributeKeyChannelID, path.EndpointA.ChannelID),
+		sdk.NewAttribute(types.AttributeKeyBasisPoints, "250"),
+		sdk.NewAttribute(types.AttributeKeyFee, sdk.NewInt64Coin(sdk.DefaultBondDenom, 25_000).String()),
+		sdk.NewAttribute(types.AttributeKeyFeeRecipient, authtypes.FeeCollectorName),
+	)
+	suite.Require().Contains(ctx.EventManager().Events(), expEvent)
+}
@@ -0,0 +1,136 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/internal/events"
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+// GetRateLimit retrieves the rate limit of the provided denomination over the given channel end.
+func (k Keeper) GetRateLimit(ctx context.Context, portID, channelID, denom string) (types.RateLimit, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.RateLimitStoreKey(portID, channelID, denom))
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return types.RateLimit{}, false
+	}
+
+	var rateLimit types.RateLimit
+	k.cdc.MustUnmarshal(bz, &rateLimit)
+
+	return rateLimit, true
+}
+
+// setRateLimit stores the provided rate limit.
+func (k Keeper) setRateLimit(ctx context.Context, rateLimit types.RateLimit) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := k.cdc.MustMarshal(&rateLimit)
+	if err := store.Set(types.RateLimitStoreKey(rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom), bz); err != nil {
+		panic(err)
+	}
+}
+
+// deleteRateLimit removes the rate limit of the provided denomination over the given channel end.
+func (k Keeper) deleteRateLimit(ctx context.Context, portID, channelID, denom string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.RateLimitStoreKey(portID, channelID, denom)); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllRateLimits returns all the rate limits stored in state.
+func (k Keeper) GetAllRateLimits(ctx context.Context) []types.RateLimit {
+	var rateLimits []types.RateLimit
+	k.IterateRateLimits(ctx, func(rateLimit types.RateLimit) bool {
+		rateLimits = append(rateLimits, rateLimit)
+		return false
+	})
+
+	return rateLimits
+}
+
+// IterateRateLimits iterates over the rate limits in the store and performs a callback function.
+func (k Keeper) IterateRateLimits(ctx context.Context, cb func(rateLimit types.RateLimit) bool) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, types.RateLimitKey)
+
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+	for ; iterator.Valid(); iterator.Next() {
+		var rateLimit types.RateLimit
+		k.cdc.MustUnmarshal(iterator.Value(), &rateLimit)
+
+		if cb(rateLimit) {
+			break
+		}
+	}
+}
+
+// checkRateLimit records the flow of the provided coin in the given direction over the channel end.
+// If no rate limit is configured for the coin denomination over the channel end, this is a no-op.
+// If the window of the rate limit has elapsed, a new window is started with a fresh supply snapshot.
+// An error is returned if the flow would exceed the quota of the rate limit.
+func (k Keeper) checkRateLimit(ctx context.Context, portID, channelID, direction string, coin sdk.Coin) error {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+	if !found {
+		return nil
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime() // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	if !blockTime.Before(rateLimit.Flow.WindowEnd) {
+		supply := k.bankKeeper.GetSupply(ctx, coin.Denom).Amount
+		rateLimit.Flow = types.NewFlow(supply, blockTime.Add(rateLimit.Quota.Window))
+	}
+
+	amount := rateLimit.Flow.Amount(direction).Add(coin.Amount)
+	if threshold, ok := rateLimit.Quota.Threshold(direction, rateLimit.Flow.Supply); ok && amount.GT(threshold) {
+		events.EmitRateLimitExceededEvent(ctx, rateLimit, direction, amount, threshold)
+		return errorsmod.Wrapf(
+			types.ErrRateLimitExceeded, "%s of %s over port %s and channel %s would amount to %s, exceeding the threshold of %s",
+			direction, coin.Denom, portID, channelID, amount, threshold,
+		)
+	}
+
+	setFlowAmount(&rateLimit.Flow, direction, amount)
+	k.setRateLimit(ctx, rateLimit)
+
+	return nil
+}
+
+// revertRateLimitFlow deducts the provided coin from the flow recorded in the given direction over the channel
+// end. It is used when a transfer that was accounted for is refunded or reverted. The flow is only deducted
+// while the window is still ongoing and will never become negative.
+func (k Keeper) revertRateLimitFlow(ctx context.Context, portID, channelID, direction string, coin sdk.Coin) {
+	rateLimit, found := k.GetRateLimit(ctx, portID, channelID, coin.Denom)
+	if !found {
+		return
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime() // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	if !blockTime.Before(rateLimit.Flow.WindowEnd) {
+		return
+	}
+
+	amount := sdkmath.MaxInt(rateLimit.Flow.Amount(direction).Sub(coin.Amount), sdkmath.ZeroInt())
+	setFlowAmount(&rateLimit.Flow, direction, amount)
+	k.setRateLimit(ctx, rateLimit)
+}
+
+// setFlowAmount sets the amount of the flow in the given direction.
+func setFlowAmount(flow *types.Flow, direction string, amount sdkmath.Int) {
+	if direction == types.FlowDirectionInflow {
+		flow.Inflow = amount
+		return
+	}
+
+	flow.Outflow = amount
+}
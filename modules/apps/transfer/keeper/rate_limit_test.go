@@ -0,0 +1,270 @@
+package keeper_test
+
+import (
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *KeeperTestSuite) TestCheckRateLimit() {
+	var (
+		quota     types.Quota
+		direction string
+		coin      sdk.Coin
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: outflow within quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: outflow matches quota",
+			func() {
+				coin.Amount = quota.MaxOutflow
+			},
+			nil,
+		},
+		{
+			"success: inflow without inflow threshold",
+			func() {
+				direction = types.FlowDirectionInflow
+				coin.Amount = quota.MaxOutflow.AddRaw(1)
+			},
+			nil,
+		},
+		{
+			"success: no rate limit configured for denom",
+			func() {
+				coin = sdk.NewCoin(ibctesting.SecondaryDenom, quota.MaxOutflow.AddRaw(1))
+			},
+			nil,
+		},
+		{
+			"failure: outflow exceeds quota",
+			func() {
+				coin.Amount = quota.MaxOutflow.AddRaw(1)
+			},
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"failure: inflow exceeds quota",
+			func() {
+				quota.MaxInflow = sdkmath.NewInt(10)
+				direction = types.FlowDirectionInflow
+				coin.Amount = quota.MaxInflow.AddRaw(1)
+			},
+			types.ErrRateLimitExceeded,
+		},
+		{
+			"failure: outflow exceeds percentage of supply",
+			func() {
+				supply := suite.chainA.GetSimApp().BankKeeper.GetSupply(suite.chainA.GetContext(), sdk.DefaultBondDenom).Amount
+
+				quota.MaxOutflow = sdkmath.ZeroInt()
+				quota.MaxPercentOutflow = 1
+				coin.Amount = supply.QuoRaw(100).AddRaw(1)
+			},
+			types.ErrRateLimitExceeded,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			quota = types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+			direction = types.FlowDirectionOutflow
+			coin = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+
+			tc.malleate()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			msg := types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota)
+			_, err := transferKeeper.SetRateLimit(suite.chainA.GetContext(), msg)
+			suite.Require().NoError(err)
+
+			ctx := suite.chainA.GetContext()
+			err = transferKeeper.CheckRateLimit(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, direction, coin)
+
+			rateLimit, found := transferKeeper.GetRateLimit(ctx, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			suite.Require().True(found)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				if coin.Denom == sdk.DefaultBondDenom {
+					suite.Require().Equal(coin.Amount, rateLimit.Flow.Amount(direction))
+					suite.Require().Equal(ctx.BlockTime().Add(quota.Window), rateLimit.Flow.WindowEnd)
+				}
+			} else {
+				suite.Require().ErrorIs(err, tc.expError)
+
+				// the event emitted on a rate limit hit contains the rejected flow
+				found := false
+				for _, event := range ctx.EventManager().Events() {
+					if event.Type == types.EventTypeRateLimit {
+						found = true
+					}
+				}
+				suite.Require().True(found)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestRateLimitWindow() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+	portID, channelID := path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+	quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+	coin := sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(100))
+
+	_, err := transferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), portID, channelID, sdk.DefaultBondDenom, quota))
+	suite.Require().NoError(err)
+
+	ctx := suite.chainA.GetContext()
+	suite.Require().NoError(transferKeeper.CheckRateLimit(ctx, portID, channelID, types.FlowDirectionOutflow, coin))
+	suite.Require().ErrorIs(transferKeeper.CheckRateLimit(ctx, portID, channelID, types.FlowDirectionOutflow, coin), types.ErrRateLimitExceeded)
+
+	// refunded tokens are deducted from the flow of the ongoing window
+	transferKeeper.RevertRateLimitFlow(ctx, portID, channelID, types.FlowDirectionOutflow, sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(40)))
+	rateLimit, found := transferKeeper.GetRateLimit(ctx, portID, channelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(sdkmath.NewInt(60), rateLimit.Flow.Outflow)
+
+	// the flow never becomes negative
+	transferKeeper.RevertRateLimitFlow(ctx, portID, channelID, types.FlowDirectionOutflow, coin)
+	rateLimit, found = transferKeeper.GetRateLimit(ctx, portID, channelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().True(rateLimit.Flow.Outflow.IsZero())
+
+	suite.Require().NoError(transferKeeper.CheckRateLimit(ctx, portID, channelID, types.FlowDirectionOutflow, coin))
+
+	// a new window is started once the current window has elapsed
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(quota.Window))
+	suite.Require().NoError(transferKeeper.CheckRateLimit(ctx, portID, channelID, types.FlowDirectionOutflow, coin))
+
+	rateLimit, found = transferKeeper.GetRateLimit(ctx, portID, channelID, sdk.DefaultBondDenom)
+	suite.Require().True(found)
+	suite.Require().Equal(coin.Amount, rateLimit.Flow.Outflow)
+	suite.Require().Equal(ctx.BlockTime().Add(quota.Window), rateLimit.Flow.WindowEnd)
+}
+
+func (suite *KeeperTestSuite) TestOnRecvPacketRateLimited() {
+	path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	receiver := suite.chainB.SenderAccount.GetAddress().String()
+	coin := ibctesting.TestCoin
+
+	denom := types.NewDenom(coin.Denom, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	quota := types.NewQuota(sdkmath.ZeroInt(), coin.Amount.SubRaw(1), 0, 0, time.Hour)
+
+	transferKeeper := suite.chainB.GetSimApp().TransferKeeper
+	_, err := transferKeeper.SetRateLimit(suite.chainB.GetContext(), types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, denom.IBCDenom(), quota))
+	suite.Require().NoError(err)
+
+	token := types.Token{Denom: types.NewDenom(coin.Denom), Amount: coin.Amount.String()}
+	packetData := types.NewFungibleTokenPacketDataV2([]types.Token{token}, suite.chainA.SenderAccount.GetAddress().String(), receiver, "", ibctesting.EmptyForwardingPacketData)
+	packet := channeltypes.NewPacket(packetData.GetBytes(), uint64(1), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, clienttypes.NewHeight(1, 100), 0)
+
+	err = transferKeeper.OnRecvPacket(suite.chainB.GetContext(), packet, packetData)
+	suite.Require().ErrorIs(err, types.ErrRateLimitExceeded)
+
+	balance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), suite.chainB.SenderAccount.GetAddress(), denom.IBCDenom())
+	suite.Require().True(balance.IsZero())
+}
+
+func (suite *KeeperTestSuite) TestMsgRateLimit() {
+	var (
+		portID    string
+		channelID string
+		signer    string
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: rate limit not found",
+			func() {
+				channelID = ibctesting.InvalidID
+			},
+			types.ErrRateLimitNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			transferKeeper := suite.chainA.GetSimApp().TransferKeeper
+			quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+			_, err := transferKeeper.SetRateLimit(suite.chainA.GetContext(), types.NewMsgSetRateLimit(transferKeeper.GetAuthority(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom, quota))
+			suite.Require().NoError(err)
+
+			err = transferKeeper.CheckRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, types.FlowDirectionOutflow, ibctesting.TestCoin)
+			suite.Require().NoError(err)
+
+			portID, channelID = path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID
+			signer = transferKeeper.GetAuthority()
+
+			tc.malleate()
+
+			_, resetErr := transferKeeper.ResetRateLimit(suite.chainA.GetContext(), types.NewMsgResetRateLimit(signer, portID, channelID, sdk.DefaultBondDenom))
+			_, removeErr := transferKeeper.RemoveRateLimit(suite.chainA.GetContext(), types.NewMsgRemoveRateLimit(signer, portID, channelID, sdk.DefaultBondDenom))
+
+			rateLimit, found := transferKeeper.GetRateLimit(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.DefaultBondDenom)
+			if tc.expError == nil {
+				suite.Require().NoError(resetErr)
+				suite.Require().NoError(removeErr)
+				suite.Require().False(found)
+			} else {
+				suite.Require().ErrorIs(resetErr, tc.expError)
+				suite.Require().ErrorIs(removeErr, tc.expError)
+				suite.Require().True(found)
+				suite.Require().Equal(ibctesting.TestCoin.Amount, rateLimit.Flow.Outflow)
+			}
+		})
+	}
+}
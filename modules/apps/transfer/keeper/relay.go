@@ -62,6 +62,7 @@ func (k Keeper) sendTransfer(
 	timeoutTimestamp uint64,
 	memo string,
 	hops []types.Hop,
+	fallbackReceivers []string,
 ) (uint64, error) {
 	channel, found := k.channelKeeper.GetChannel(ctx, sourcePort, sourceChannel)
 	if !found {
@@ -85,12 +86,30 @@ func (k Keeper) sendTransfer(
 		}
 	}
 
+	// the memo is interpreted by the counterparty chain, which may understand keys unknown to this chain
+	if err := k.memoRegistry.ValidateMemo(memo, true); err != nil {
+		return 0, err
+	}
+
 	destinationPort := channel.Counterparty.PortId
 	destinationChannel := channel.Counterparty.ChannelId
 
 	// begin createOutgoingPacket logic
 	// See spec for this logic: https://github.com/cosmos/ibc/tree/master/spec/app/ics-020-fungible-token-transfer#packet-relay
 
+	params := k.GetParams(ctx)
+
+	// all coins are checked before any of them is charged, rate limited, escrowed or burned
+	for _, coin := range coins {
+		if denomMigration, found := k.GetDenomMigration(ctx, coin.Denom); found {
+			return 0, errorsmod.Wrapf(types.ErrDenomMigrated, "%s has been migrated to %s", coin.Denom, denomMigration.NewDenom.IBCDenom())
+		}
+
+		if !params.IsSendEnabled(sourcePort, sourceChannel, coin.Denom) {
+			return 0, errorsmod.Wrapf(types.ErrSendDisabled, "%s over port %s and channel %s", coin.Denom, sourcePort, sourceChannel)
+		}
+	}
+
 	tokens := make([]types.Token, 0, len(coins))
 
 	for _, coin := range coins {
@@ -99,6 +118,17 @@ func (k Keeper) sendTransfer(
 			coin.Amount = k.bankKeeper.GetBalance(ctx, sender, coin.Denom).Amount
 		}
 
+		// the protocol fee is charged upfront, only the remaining amount is escrowed or burned and sent in the packet
+		var err error
+		coin, err = k.chargeProtocolFee(ctx, params, sourcePort, sourceChannel, sender, coin)
+		if err != nil {
+			return 0, err
+		}
+
+		if err := k.checkRateLimit(ctx, sourcePort, sourceChannel, types.FlowDirectionOutflow, coin); err != nil {
+			return 0, err
+		}
+
 		token, err := k.tokenFromCoin(ctx, coin)
 		if err != nil {
 			return 0, err
@@ -127,9 +157,8 @@ func (k Keeper) sendTransfer(
 				panic(fmt.Errorf("cannot burn coins after a successful send to a module account: %v", err))
 			}
 		} else {
-			// obtain the escrow address for the source channel end
-			escrowAddress := types.GetEscrowAddress(sourcePort, sourceChannel)
-			if err := k.escrowCoin(ctx, sender, escrowAddress, coin); err != nil {
+			// escrow the coin for the source channel end
+			if err := k.escrowCoin(ctx, sender, sourcePort, sourceChannel, coin); err != nil {
 				return 0, err
 			}
 		}
@@ -137,7 +166,11 @@ func (k Keeper) sendTransfer(
 		tokens = append(tokens, token)
 	}
 
-	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, hops)
+	if err := k.checkSendPolicy(ctx, sourcePort, sourceChannel, sender, receiver, tokens); err != nil {
+		return 0, err
+	}
+
+	packetDataBytes, err := createPacketDataBytesFromVersion(appVersion, sender.String(), receiver, memo, tokens, hops, fallbackReceivers)
 	if err != nil {
 		return 0, err
 	}
@@ -162,18 +195,24 @@ func (k Keeper) sendTransfer(
 // unescrowed and sent to the receiving address.
 //
 // In the case of packet forwarding, the packet is sent on the next hop as specified
-// in the packet's ForwardingPacketData.
+// in the packet's ForwardingPacketData. If memo forwarding is enabled, the tokens of packets
+// received over ics20-1 channels are sent on the next hop as specified in the packet memo.
 func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	// validate packet data upon receiving
 	if err := data.ValidateBasic(); err != nil {
 		return errorsmod.Wrapf(err, "error validating ICS-20 transfer packet data")
 	}
 
-	if !k.GetParams(ctx).ReceiveEnabled {
-		return types.ErrReceiveDisabled
+	if err := k.memoRegistry.ValidateMemo(data.Memo, false); err != nil {
+		return err
+	}
+
+	forwardMetadata, hasMemoForwarding, err := k.getForwardMetadata(ctx, packet, data)
+	if err != nil {
+		return err
 	}
 
-	receiver, err := k.getReceiverFromPacketData(data)
+	receiver, err := k.getReceiverFromPacketData(data, hasMemoForwarding)
 	if err != nil {
 		return err
 	}
@@ -182,6 +221,11 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", receiver)
 	}
 
+	if err := k.checkRecvPolicy(ctx, packet, data, receiver); err != nil {
+		return err
+	}
+
+	params := k.GetParams(ctx)
 	receivedCoins := make(sdk.Coins, 0, len(data.Tokens))
 	for _, token := range data.Tokens {
 		// parse the transfer amount
@@ -203,10 +247,18 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 			// remove prefix added by sender chain
 			token.Denom.Trace = token.Denom.Trace[1:]
 
-			coin := sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)
+			// the vouchers in escrow are of the denomination they have been migrated to, if any
+			coin := k.migratedCoin(ctx, sdk.NewCoin(token.Denom.IBCDenom(), transferAmount))
 
-			escrowAddress := types.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
-			if err := k.unescrowCoin(ctx, escrowAddress, receiver, coin); err != nil {
+			if !params.IsReceiveEnabled(packet.GetDestPort(), packet.GetDestChannel(), coin.Denom) {
+				return types.ErrReceiveDisabled
+			}
+
+			if err := k.checkRateLimit(ctx, packet.GetDestPort(), packet.GetDestChannel(), types.FlowDirectionInflow, coin); err != nil {
+				return err
+			}
+
+			if err := k.unescrowCoin(ctx, packet.GetDestPort(), packet.GetDestChannel(), receiver, coin); err != nil {
 				return err
 			}
 
@@ -219,6 +271,19 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 			trace := []types.Hop{types.NewHop(packet.DestinationPort, packet.DestinationChannel)}
 			token.Denom.Trace = append(trace, token.Denom.Trace...)
 
+			// vouchers of a migrated denomination could not be sent anymore, so the tokens are refunded on the sender chain
+			if denomMigration, found := k.GetDenomMigration(ctx, token.Denom.IBCDenom()); found {
+				return errorsmod.Wrapf(types.ErrDenomMigrated, "%s has been migrated to %s", token.Denom.IBCDenom(), denomMigration.NewDenom.IBCDenom())
+			}
+
+			if !params.IsReceiveEnabled(packet.GetDestPort(), packet.GetDestChannel(), token.Denom.IBCDenom()) {
+				return types.ErrReceiveDisabled
+			}
+
+			if err := k.checkRateLimit(ctx, packet.GetDestPort(), packet.GetDestChannel(), types.FlowDirectionInflow, sdk.NewCoin(token.Denom.IBCDenom(), transferAmount)); err != nil {
+				return err
+			}
+
 			if !k.HasDenom(ctx, token.Denom.Hash()) {
 				k.SetDenom(ctx, token.Denom)
 			}
@@ -256,6 +321,11 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 		if err := k.forwardPacket(ctx, data, packet, receivedCoins); err != nil {
 			return err
 		}
+	} else if hasMemoForwarding {
+		// the tokens received over an ics20-1 channel are forwarded according to the instructions of the memo.
+		if err := k.forwardPacketWithMemo(ctx, forwardMetadata, packet, receivedCoins); err != nil {
+			return err
+		}
 	}
 
 	telemetry.ReportOnRecvPacket(packet, data.Tokens)
@@ -272,15 +342,23 @@ func (k Keeper) OnRecvPacket(ctx context.Context, packet channeltypes.Packet, da
 //
 // If forwarding is used and the acknowledgement was a success, a successful acknowledgement is written
 // for the forwarded packet. Otherwise, if the acknowledgement failed, after refunding the sender, the
-// tokens of the forwarded packet that were received are in turn either refunded or burned.
+// tokens of the forwarded packet that were received are either delivered to the fallback receiver set
+// for this chain, or in turn refunded or burned.
 func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2, ack channeltypes.Acknowledgement) error {
 	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 
 	switch ack.Response.(type) {
 	case *channeltypes.Acknowledgement_Result:
 		if isForwarded {
-			// Write a successful async ack for the forwardedPacket
+			// Write a successful async ack for the forwardedPacket. The result of the acknowledgement is passed on
+			// for tokens forwarded according to the packet memo, such that the outcome of a fallback on a later hop
+			// reaches the original sender. The acknowledgement of tokens forwarded through the forwarding packet data
+			// is left unchanged.
 			forwardAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			if k.hasMemoForwarding(ctx, forwardedPacket) {
+				forwardAck = ack
+			}
+
 			return k.acknowledgeForwardedPacket(ctx, forwardedPacket, packet, forwardAck)
 		}
 
@@ -294,7 +372,13 @@ func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes
 		}
 		if isForwarded {
 			// the forwarded packet has failed, thus the funds have been refunded to the intermediate address.
-			// we must revert the changes that came from successfully receiving the tokens on our chain
+			// the tokens are delivered to the fallback receiver on our chain if one is set.
+			delivered, err := k.fallbackForwardedPacket(ctx, forwardedPacket, packet, data, ack.GetError())
+			if err != nil || delivered {
+				return err
+			}
+
+			// otherwise we must revert the changes that came from successfully receiving the tokens on our chain
 			// before propagating the error acknowledgement back to original sender chain
 			if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
 				return err
@@ -315,8 +399,9 @@ func (k Keeper) OnAcknowledgementPacket(ctx context.Context, packet channeltypes
 // If no forwarding occurs, it refunds the tokens to the sender.
 //
 // If forwarding is used and the chain acted as a middle hop on a multihop transfer, after refunding
-// the tokens to the sender, the tokens of the forwarded packet that were received are in turn
-// either refunded or burned.
+// the tokens to the sender, the tokens of the forwarded packet that were received are either delivered
+// to the fallback receiver set for this chain, or in turn refunded or burned. Tokens forwarded according
+// to the packet memo are first resent to the next hop for as many times as the memo allows.
 func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet, data types.FungibleTokenPacketDataV2) error {
 	if err := k.refundPacketTokens(ctx, packet, data); err != nil {
 		return err
@@ -324,6 +409,18 @@ func (k Keeper) OnTimeoutPacket(ctx context.Context, packet channeltypes.Packet,
 
 	forwardedPacket, isForwarded := k.getForwardedPacket(ctx, packet.SourcePort, packet.SourceChannel, packet.Sequence)
 	if isForwarded {
+		// tokens forwarded according to the instructions of the packet memo are resent to the next hop
+		// until the retries allowed by the instructions are exhausted.
+		if k.retryForwardedPacket(ctx, forwardedPacket, packet, data) {
+			return nil
+		}
+
+		// the tokens are delivered to the fallback receiver on this chain if one is set, instead of being reverted.
+		delivered, err := k.fallbackForwardedPacket(ctx, forwardedPacket, packet, data, "packet timed out")
+		if err != nil || delivered {
+			return err
+		}
+
 		if err := k.revertForwardedPacket(ctx, forwardedPacket, data); err != nil {
 			return err
 		}
@@ -350,9 +447,6 @@ func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Pack
 		return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "%s is not allowed to receive funds", sender)
 	}
 
-	// escrow address for unescrowing tokens back to sender
-	escrowAddress := types.GetEscrowAddress(packet.GetSourcePort(), packet.GetSourceChannel())
-
 	moduleAccountAddr := k.authKeeper.GetModuleAddress(types.ModuleName)
 	for _, token := range data.Tokens {
 		coin, err := token.ToCoin()
@@ -360,6 +454,12 @@ func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Pack
 			return err
 		}
 
+		// the refunded tokens no longer count towards the outflow of the source channel end
+		k.revertRateLimitFlow(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), types.FlowDirectionOutflow, coin)
+
+		// the tokens are refunded in the denomination their vouchers have been migrated to, if any
+		coin = k.migratedCoin(ctx, coin)
+
 		// if the token we must refund is prefixed by the source port and channel
 		// then the tokens were burnt when the packet was sent and we must mint new tokens
 		if token.Denom.HasPrefix(packet.GetSourcePort(), packet.GetSourceChannel()) {
@@ -374,7 +474,8 @@ func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Pack
 				panic(fmt.Errorf("unable to send coins from module to account despite previously minting coins to module account: %v", err))
 			}
 		} else {
-			if err := k.unescrowCoin(ctx, escrowAddress, sender, coin); err != nil {
+			// unescrow the tokens back to sender from the source channel end
+			if err := k.unescrowCoin(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), sender, coin); err != nil {
 				return err
 			}
 		}
@@ -383,9 +484,11 @@ func (k Keeper) refundPacketTokens(ctx context.Context, packet channeltypes.Pack
 	return nil
 }
 
-// escrowCoin will send the given coin from the provided sender to the escrow address. It will also
-// update the total escrowed amount by adding the escrowed coin's amount to the current total escrow.
-func (k Keeper) escrowCoin(ctx context.Context, sender, escrowAddress sdk.AccAddress, coin sdk.Coin) error {
+// escrowCoin will send the given coin from the provided sender to the escrow address of the channel end.
+// It will also update the total escrowed amount and the amount escrowed for the channel end by adding the
+// escrowed coin's amount to them.
+func (k Keeper) escrowCoin(ctx context.Context, sender sdk.AccAddress, portID, channelID string, coin sdk.Coin) error {
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
 	if err := k.bankKeeper.SendCoins(ctx, sender, escrowAddress, sdk.NewCoins(coin)); err != nil {
 		// failure is expected for insufficient balances
 		return err
@@ -396,12 +499,18 @@ func (k Keeper) escrowCoin(ctx context.Context, sender, escrowAddress sdk.AccAdd
 	newTotalEscrow := currentTotalEscrow.Add(coin)
 	k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 
+	// track the amount in escrow for the channel end to allow for per channel reconciliation
+	currentChannelEscrow := k.GetChannelEscrow(ctx, portID, channelID, coin.GetDenom())
+	k.SetChannelEscrow(ctx, portID, channelID, currentChannelEscrow.Add(coin))
+
 	return nil
 }
 
-// unescrowCoin will send the given coin from the escrow address to the provided receiver. It will also
-// update the total escrow by deducting the unescrowed coin's amount from the current total escrow.
-func (k Keeper) unescrowCoin(ctx context.Context, escrowAddress, receiver sdk.AccAddress, coin sdk.Coin) error {
+// unescrowCoin will send the given coin from the escrow address of the channel end to the provided receiver.
+// It will also update the total escrow and the amount escrowed for the channel end by deducting the unescrowed
+// coin's amount from them.
+func (k Keeper) unescrowCoin(ctx context.Context, portID, channelID string, receiver sdk.AccAddress, coin sdk.Coin) error {
+	escrowAddress := types.GetEscrowAddress(portID, channelID)
 	if err := k.bankKeeper.SendCoins(ctx, escrowAddress, receiver, sdk.NewCoins(coin)); err != nil {
 		// NOTE: this error is only expected to occur given an unexpected bug or a malicious
 		// counterparty module. The bug may occur in bank or any part of the code that allows
@@ -415,6 +524,10 @@ func (k Keeper) unescrowCoin(ctx context.Context, escrowAddress, receiver sdk.Ac
 	newTotalEscrow := currentTotalEscrow.Sub(coin)
 	k.SetTotalEscrowForDenom(ctx, newTotalEscrow)
 
+	// track the amount in escrow for the channel end to allow for per channel reconciliation
+	currentChannelEscrow := k.GetChannelEscrow(ctx, portID, channelID, coin.GetDenom())
+	k.SetChannelEscrow(ctx, portID, channelID, currentChannelEscrow.Sub(coin))
+
 	return nil
 }
 
@@ -448,7 +561,7 @@ func (k Keeper) tokenFromCoin(ctx sdk.Context, coin sdk.Coin) (types.Token, erro
 }
 
 // createPacketDataBytesFromVersion creates the packet data bytes to be sent based on the application version.
-func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop) ([]byte, error) {
+func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string, tokens types.Tokens, hops []types.Hop, fallbackReceivers []string) ([]byte, error) {
 	switch appVersion {
 	case types.V1:
 		// Sanity check, tokens must always be of length 1 if using app version V1.
@@ -469,6 +582,7 @@ func createPacketDataBytesFromVersion(appVersion, sender, receiver, memo string,
 		var forwardingPacketData types.ForwardingPacketData
 		if len(hops) > 0 {
 			forwardingPacketData = types.NewForwardingPacketData(memo, hops...)
+			forwardingPacketData.FallbackReceivers = fallbackReceivers
 			memo = ""
 		}
 
@@ -18,6 +18,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 )
 
@@ -496,6 +497,7 @@ func (suite *ForwardingTestSuite) TestSuccessfulUnwind() {
 	err = suite.chainA.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainA.GetContext(), types.ModuleName, escrowAddressAtoB, sdk.NewCoins(coinOnA))
 	suite.Require().NoError(err)
 	suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coinOnA)
+	suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, coinOnA)
 
 	coinOnB := sdk.NewCoin(denomAB.IBCDenom(), amount)
 	err = suite.chainB.GetSimApp().BankKeeper.MintCoins(suite.chainB.GetContext(), types.ModuleName, sdk.NewCoins(coinOnB))
@@ -506,6 +508,7 @@ func (suite *ForwardingTestSuite) TestSuccessfulUnwind() {
 	err = suite.chainB.GetSimApp().BankKeeper.SendCoinsFromModuleToAccount(suite.chainB.GetContext(), types.ModuleName, escrowAddressBtoC, sdk.NewCoins(coinOnB))
 	suite.Require().NoError(err)
 	suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coinOnB)
+	suite.chainB.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainB.GetContext(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, coinOnB)
 	suite.chainB.GetSimApp().TransferKeeper.SetDenom(suite.chainB.GetContext(), denomAB)
 
 	coinOnC := sdk.NewCoin(denomABC.IBCDenom(), amount)
@@ -1020,6 +1023,106 @@ func (suite *ForwardingTestSuite) TestAcknowledgementFailureWithMiddleChainAsNot
 	suite.assertAmountOnChain(suite.chainC, balance, balanceOnCBefore.Amount, coinOnC.GetDenom())
 }
 
+// TestAcknowledgementFailureWithFallbackReceiver tests the scenario in which a packet goes from
+// C to A, using B as a forwarding hop with a fallback receiver set on B. The ack fails on A and we
+// verify that the funds are delivered to the fallback receiver on B, with a successful ack carrying
+// the outcome of the fallback back to C.
+func (suite *ForwardingTestSuite) TestAcknowledgementFailureWithFallbackReceiver() {
+	amount := sdkmath.NewInt(100)
+
+	pathAtoB, pathBtoC := suite.setupForwardingPaths()
+
+	coinOnC := ibctesting.TestCoin
+	sender := suite.chainC.SenderAccounts[0].SenderAccount
+	receiver := suite.chainA.SenderAccounts[0].SenderAccount
+	fallbackReceiver := suite.chainB.SenderAccounts[1].SenderAccount
+
+	forwarding := types.NewForwarding(false, types.NewHop(
+		pathAtoB.EndpointB.ChannelConfig.PortID,
+		pathAtoB.EndpointB.ChannelID,
+	))
+	forwarding.FallbackReceivers = []string{fallbackReceiver.GetAddress().String()}
+
+	forwardTransfer := types.NewMsgTransfer(
+		pathBtoC.EndpointB.ChannelConfig.PortID,
+		pathBtoC.EndpointB.ChannelID,
+		sdk.NewCoins(coinOnC),
+		sender.GetAddress().String(),
+		receiver.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		suite.chainA.GetTimeoutTimestamp(),
+		"",
+		forwarding,
+	)
+
+	balanceOnCBefore := suite.chainC.GetSimApp().BankKeeper.GetBalance(suite.chainC.GetContext(), sender.GetAddress(), coinOnC.GetDenom())
+
+	result, err := suite.chainC.SendMsgs(forwardTransfer)
+	suite.Require().NoError(err) // message committed
+
+	packetFromCtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathBtoC.EndpointA.RecvPacketWithResult(packetFromCtoB)
+	suite.Require().NoError(err)
+
+	denomOnB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+	suite.assertAmountOnChain(suite.chainB, escrow, amount, denomOnB.IBCDenom())
+
+	packetFromBtoA, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	// turn off receive on chain A to trigger an error
+	suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), types.Params{
+		SendEnabled:    true,
+		ReceiveEnabled: false,
+	})
+
+	err = pathAtoB.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointA.RecvPacketWithResult(packetFromBtoA)
+	suite.Require().NoError(err)
+
+	ack, err := ibctesting.ParseAckFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointB.AcknowledgePacketWithResult(packetFromBtoA, ack)
+	suite.Require().NoError(err)
+
+	// the tokens are delivered to the fallback receiver on B instead of being burned
+	suite.assertAmountOnChain(suite.chainB, escrow, sdkmath.ZeroInt(), denomOnB.IBCDenom())
+	fallbackBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), fallbackReceiver.GetAddress(), denomOnB.IBCDenom())
+	suite.Require().Equal(amount, fallbackBalance.Amount)
+
+	// the acknowledgement written on B is successful and carries the outcome of the fallback
+	storedAck, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, packetFromCtoB.GetSequence())
+	suite.Require().True(found)
+
+	fallback := types.NewForwardingFallback(fallbackReceiver.GetAddress().String(), pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID, "ABCI code: 8: error handling packet: see events for details")
+	fallbackAck := channeltypes.NewResultAcknowledgement(fallback.GetBytes())
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(fallbackAck.Acknowledgement()), storedAck)
+
+	ack, err = ibctesting.ParseAckFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointB.AcknowledgePacket(packetFromCtoB, ack)
+	suite.Require().NoError(err)
+
+	// the tokens are not refunded to the sender on C
+	suite.assertAmountOnChain(suite.chainC, escrow, amount, coinOnC.GetDenom())
+	suite.assertAmountOnChain(suite.chainC, balance, balanceOnCBefore.Amount.Sub(amount), coinOnC.GetDenom())
+}
+
 // TestOnTimeoutPacketForwarding tests the scenario in which a packet goes from
 // A to C, using B as a forwarding hop. The packet times out when going to C
 // from B and we verify that funds are properly returned to A.
@@ -1433,6 +1536,373 @@ func (suite *ForwardingTestSuite) TestMultihopForwardingErrorAcknowledgement() {
 	suite.Require().Equal(expected, ackStr)
 }
 
+// setupMemoForwardingPaths creates ics20-1 paths between chains A and B and between chains B and C and
+// enables memo forwarding on chain B.
+func (suite *ForwardingTestSuite) setupMemoForwardingPaths() (pathAtoB, pathBtoC *ibctesting.Path) {
+	pathAtoB = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+	pathBtoC = ibctesting.NewTransferPath(suite.chainB, suite.chainC)
+
+	for _, path := range []*ibctesting.Path{pathAtoB, pathBtoC} {
+		path.EndpointA.ChannelConfig.Version = types.V1
+		path.EndpointB.ChannelConfig.Version = types.V1
+		path.Setup()
+	}
+
+	params := suite.chainB.GetSimApp().TransferKeeper.GetParams(suite.chainB.GetContext())
+	params.MemoForwardingEnabled = true
+	suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+
+	return pathAtoB, pathBtoC
+}
+
+// TestSuccessfulMemoForward tests a successful transfer from A to C through B over ics20-1 channels,
+// where the forwarding instructions are provided in the packet memo.
+func (suite *ForwardingTestSuite) TestSuccessfulMemoForward() {
+	amount := sdkmath.NewInt(100)
+
+	pathAtoB, pathBtoC := suite.setupMemoForwardingPaths()
+
+	sender := suite.chainA.SenderAccounts[0].SenderAccount
+	receiver := suite.chainC.SenderAccounts[0].SenderAccount
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","next":{"final":"memo"}}}`, receiver.GetAddress(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+
+	transferMsg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		sender.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		suite.chainA.GetTimeoutTimestamp(),
+		memo,
+		nil,
+	)
+
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+	suite.Require().NoError(err)
+
+	// the acknowledgement is written asynchronously
+	_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packetFromAtoB.DestinationPort, packetFromAtoB.DestinationChannel, packetFromAtoB.Sequence)
+	suite.Require().False(found)
+
+	denomAB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+	suite.assertAmountOnChain(suite.chainB, escrow, amount, denomAB.IBCDenom())
+
+	packetFromBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	forwardedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel, packetFromBtoC.Sequence)
+	suite.Require().True(found, "Chain B should have stored the forwarded packet")
+	suite.Require().Equal(packetFromAtoB, forwardedPacket)
+
+	// the forwarded packet carries the next memo
+	forwardedData, err := types.UnmarshalPacketData(packetFromBtoC.GetData(), types.V1)
+	suite.Require().NoError(err)
+	suite.Require().Equal(receiver.GetAddress().String(), forwardedData.Receiver)
+	suite.Require().Equal(`{"final":"memo"}`, forwardedData.Memo)
+
+	err = pathBtoC.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	_, err = pathBtoC.EndpointB.RecvPacketWithResult(packetFromBtoC)
+	suite.Require().NoError(err)
+
+	denomABC := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID), types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+	suite.assertAmountOnChain(suite.chainC, balance, amount, denomABC.IBCDenom())
+
+	successAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+
+	err = pathBtoC.EndpointA.UpdateClient()
+	suite.Require().NoError(err)
+
+	err = pathBtoC.EndpointA.AcknowledgePacket(packetFromBtoC, successAck.Acknowledgement())
+	suite.Require().NoError(err)
+
+	ackOnB := suite.chainB.GetAcknowledgement(packetFromAtoB)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(successAck.Acknowledgement()), ackOnB)
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel, packetFromBtoC.Sequence)
+	suite.Require().False(found, "Chain B should have deleted the forwarded packet")
+}
+
+// TestForwardedPacketAcknowledgementResult tests that the result of a successful acknowledgement of a forwarded
+// packet is passed on to the previous hop for tokens forwarded according to the packet memo only, while the
+// acknowledgement of tokens forwarded through the forwarding packet data is left unchanged.
+func (suite *ForwardingTestSuite) TestForwardedPacketAcknowledgementResult() {
+	testCases := []struct {
+		name           string
+		memoForwarding bool
+	}{
+		{
+			"forwarding packet data",
+			false,
+		},
+		{
+			"memo forwarding",
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			var (
+				pathAtoB, pathBtoC *ibctesting.Path
+				transferMsg        *types.MsgTransfer
+			)
+
+			sender := suite.chainA.SenderAccounts[0].SenderAccount
+			receiver := suite.chainC.SenderAccounts[0].SenderAccount
+
+			if tc.memoForwarding {
+				pathAtoB, pathBtoC = suite.setupMemoForwardingPaths()
+				memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, receiver.GetAddress(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+				transferMsg = types.NewMsgTransfer(
+					pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoins(ibctesting.TestCoin),
+					sender.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+					clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), memo, nil,
+				)
+			} else {
+				pathAtoB, pathBtoC = suite.setupForwardingPaths()
+				forwarding := types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+				transferMsg = types.NewMsgTransfer(
+					pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoins(ibctesting.TestCoin),
+					sender.GetAddress().String(), receiver.GetAddress().String(),
+					clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), "", forwarding,
+				)
+			}
+
+			result, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err) // message committed
+
+			packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = pathAtoB.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+			suite.Require().NoError(err)
+
+			packetFromBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			version, found := suite.chainB.GetSimApp().IBCKeeper.ChannelKeeper.GetAppVersion(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel)
+			suite.Require().True(found)
+
+			data, err := types.UnmarshalPacketData(packetFromBtoC.GetData(), version)
+			suite.Require().NoError(err)
+
+			// the acknowledgement of the packet forwarded to C carries the outcome of a fallback on a later hop
+			fallback := types.NewForwardingFallback(receiver.GetAddress().String(), pathBtoC.EndpointB.ChannelConfig.PortID, pathBtoC.EndpointB.ChannelID, "packet timed out")
+			fallbackAck := channeltypes.NewResultAcknowledgement(fallback.GetBytes())
+
+			err = suite.chainB.GetSimApp().TransferKeeper.OnAcknowledgementPacket(suite.chainB.GetContext(), packetFromBtoC, data, fallbackAck)
+			suite.Require().NoError(err)
+
+			expAck := channeltypes.NewResultAcknowledgement([]byte{byte(1)})
+			if tc.memoForwarding {
+				expAck = fallbackAck
+			}
+
+			ackOnB := suite.chainB.GetAcknowledgement(packetFromAtoB)
+			suite.Require().Equal(channeltypes.CommitAcknowledgement(expAck.Acknowledgement()), ackOnB)
+		})
+	}
+}
+
+// TestForwardReceiverAddressFormatValidation tests that the receiver of forwarded tokens is validated against the
+// address format of the counterparty chain of the last hop, for tokens forwarded both through the forwarding
+// packet data and according to the packet memo.
+func (suite *ForwardingTestSuite) TestForwardReceiverAddressFormatValidation() {
+	testCases := []struct {
+		name           string
+		memoForwarding bool
+		addressFormat  types.AddressFormat
+		expPass        bool
+	}{
+		{
+			"success: forwarding packet data",
+			false,
+			types.NewBech32AddressFormat(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			true,
+		},
+		{
+			"success: memo forwarding",
+			true,
+			types.NewBech32AddressFormat(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+			true,
+		},
+		{
+			"failure: forwarding packet data receiver does not match address format",
+			false,
+			types.NewBech32AddressFormat("osmo"),
+			false,
+		},
+		{
+			"failure: memo forwarding receiver does not match address format",
+			true,
+			types.NewHexAddressFormat(20),
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			var (
+				pathAtoB, pathBtoC *ibctesting.Path
+				transferMsg        *types.MsgTransfer
+			)
+
+			sender := suite.chainA.SenderAccounts[0].SenderAccount
+			receiver := suite.chainC.SenderAccounts[0].SenderAccount
+
+			if tc.memoForwarding {
+				pathAtoB, pathBtoC = suite.setupMemoForwardingPaths()
+				memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, receiver.GetAddress(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+				transferMsg = types.NewMsgTransfer(
+					pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoins(ibctesting.TestCoin),
+					sender.GetAddress().String(), suite.chainB.SenderAccount.GetAddress().String(),
+					clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), memo, nil,
+				)
+			} else {
+				pathAtoB, pathBtoC = suite.setupForwardingPaths()
+				forwarding := types.NewForwarding(false, types.NewHop(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID))
+				transferMsg = types.NewMsgTransfer(
+					pathAtoB.EndpointA.ChannelConfig.PortID, pathAtoB.EndpointA.ChannelID, sdk.NewCoins(ibctesting.TestCoin),
+					sender.GetAddress().String(), receiver.GetAddress().String(),
+					clienttypes.ZeroHeight(), suite.chainA.GetTimeoutTimestamp(), "", forwarding,
+				)
+			}
+
+			// the address format of chain C is known to chain B
+			transferKeeperB := suite.chainB.GetSimApp().TransferKeeper
+			_, err := transferKeeperB.SetCounterpartyAddressFormat(suite.chainB.GetContext(), types.NewMsgSetCounterpartyAddressFormat(transferKeeperB.GetAuthority(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID, tc.addressFormat))
+			suite.Require().NoError(err)
+
+			result, err := suite.chainA.SendMsgs(transferMsg)
+			suite.Require().NoError(err) // message committed
+
+			packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+			suite.Require().NoError(err)
+
+			err = pathAtoB.EndpointB.UpdateClient()
+			suite.Require().NoError(err)
+
+			_, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+			suite.Require().NoError(err)
+
+			ackOnB, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packetFromAtoB.DestinationPort, packetFromAtoB.DestinationChannel, packetFromAtoB.Sequence)
+			if tc.expPass {
+				// the acknowledgement is written asynchronously once the tokens are forwarded
+				suite.Require().False(found)
+			} else {
+				suite.Require().True(found)
+
+				errorAck := channeltypes.NewErrorAcknowledgement(ibcerrors.ErrInvalidAddress)
+				suite.Require().Equal(channeltypes.CommitAcknowledgement(errorAck.Acknowledgement()), ackOnB)
+			}
+		})
+	}
+}
+
+// TestMemoForwardTimeoutRetries tests that tokens forwarded according to the packet memo are resent
+// when the forwarded packet times out, until the retries allowed by the memo are exhausted.
+func (suite *ForwardingTestSuite) TestMemoForwardTimeoutRetries() {
+	amount := sdkmath.NewInt(100)
+
+	pathAtoB, pathBtoC := suite.setupMemoForwardingPaths()
+
+	sender := suite.chainA.SenderAccounts[0].SenderAccount
+	receiver := suite.chainC.SenderAccounts[0].SenderAccount
+	memo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"1m","retries":1}}`, receiver.GetAddress(), pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+
+	transferMsg := types.NewMsgTransfer(
+		pathAtoB.EndpointA.ChannelConfig.PortID,
+		pathAtoB.EndpointA.ChannelID,
+		sdk.NewCoins(ibctesting.TestCoin),
+		sender.GetAddress().String(),
+		suite.chainB.SenderAccount.GetAddress().String(),
+		clienttypes.ZeroHeight(),
+		suite.chainA.GetTimeoutTimestamp(),
+		memo,
+		nil,
+	)
+
+	result, err := suite.chainA.SendMsgs(transferMsg)
+	suite.Require().NoError(err) // message committed
+
+	packetFromAtoB, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	err = pathAtoB.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	result, err = pathAtoB.EndpointB.RecvPacketWithResult(packetFromAtoB)
+	suite.Require().NoError(err)
+
+	packetFromBtoC, err := ibctesting.ParsePacketFromEvents(result.Events)
+	suite.Require().NoError(err)
+
+	denomAB := types.NewDenom(sdk.DefaultBondDenom, types.NewHop(pathAtoB.EndpointB.ChannelConfig.PortID, pathAtoB.EndpointB.ChannelID))
+
+	cbs, ok := suite.chainB.App.GetIBCKeeper().PortKeeper.Route(pathBtoC.EndpointA.ChannelConfig.PortID)
+	suite.Require().True(ok)
+
+	// the first timeout resends the tokens to the next hop
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), pathBtoC.EndpointA.GetChannel().Version, packetFromBtoC, nil)
+	suite.Require().NoError(err)
+
+	_, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), packetFromBtoC.SourcePort, packetFromBtoC.SourceChannel, packetFromBtoC.Sequence)
+	suite.Require().False(found, "Chain B should have deleted the timed out forwarded packet")
+
+	retriedPacket := packetFromBtoC
+	retriedPacket.Sequence = packetFromBtoC.Sequence + 1
+
+	forwardedPacket, found := suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence)
+	suite.Require().True(found, "Chain B should have stored the retried forwarded packet")
+	suite.Require().Equal(packetFromAtoB, forwardedPacket)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packetFromAtoB.DestinationPort, packetFromAtoB.DestinationChannel, packetFromAtoB.Sequence)
+	suite.Require().False(found, "Chain B should not have written an acknowledgement while retrying")
+
+	escrowAddress := types.GetEscrowAddress(pathBtoC.EndpointA.ChannelConfig.PortID, pathBtoC.EndpointA.ChannelID)
+	escrowBalance := suite.chainB.GetSimApp().BankKeeper.GetBalance(suite.chainB.GetContext(), escrowAddress, denomAB.IBCDenom())
+	suite.Require().Equal(amount, escrowBalance.Amount)
+
+	// the second timeout exhausts the retries and reverts the forwarded packet
+	err = cbs.OnTimeoutPacket(suite.chainB.GetContext(), pathBtoC.EndpointA.GetChannel().Version, retriedPacket, nil)
+	suite.Require().NoError(err)
+
+	_, found = suite.chainB.GetSimApp().TransferKeeper.GetForwardedPacket(suite.chainB.GetContext(), retriedPacket.SourcePort, retriedPacket.SourceChannel, retriedPacket.Sequence)
+	suite.Require().False(found, "Chain B should have deleted the forwarded packet")
+
+	ack := internaltypes.NewForwardTimeoutAcknowledgement(retriedPacket)
+	ackOnB := suite.chainB.GetAcknowledgement(packetFromAtoB)
+	suite.Require().Equal(channeltypes.CommitAcknowledgement(ack.Acknowledgement()), ackOnB)
+
+	// the vouchers received on B have been burned
+	suite.assertAmountOnChain(suite.chainB, escrow, sdkmath.ZeroInt(), denomAB.IBCDenom())
+	totalSupply := suite.chainB.GetSimApp().BankKeeper.GetSupply(suite.chainB.GetContext(), denomAB.IBCDenom())
+	suite.Require().True(totalSupply.Amount.IsZero())
+}
+
 func parseAckFromTransferEvents(events []abci.Event) (string, error) {
 	for _, ev := range events {
 		if ev.Type == types.EventTypePacket {
@@ -123,6 +123,33 @@ func (suite *KeeperTestSuite) TestSendTransfer() {
 			},
 			nil,
 		},
+		{
+			"successful transfer with send disabled and channel override enabling it",
+			func() {
+				params := types.NewParams(false, true)
+				params.ChannelOverrides = []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, true, true)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			nil,
+		},
+		{
+			"failure: denom override disables send",
+			func() {
+				params := types.DefaultParams()
+				params.DenomOverrides = []types.DenomOverride{types.NewDenomOverride(coins[1].Denom, false, true)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrSendDisabled,
+		},
+		{
+			"failure: channel override disables send",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelOverrides = []types.ChannelOverride{types.NewChannelOverride(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, false, true)}
+				suite.chainA.GetSimApp().TransferKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrSendDisabled,
+		},
 		{
 			"failure: source channel not found",
 			func() {
@@ -316,6 +343,10 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 	// check total amount in escrow of sent token on sending chain
 	totalEscrow := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(defaultAmount, totalEscrow.Amount)
+
+	// check amount in escrow of sent token over the sending channel end
+	channelEscrow := suite.chainB.GetSimApp().TransferKeeper.GetChannelEscrow(suite.chainB.GetContext(), path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID, coin.GetDenom())
+	suite.Require().Equal(defaultAmount, channelEscrow.Amount)
 }
 
 // TestOnRecvPacket_ReceiverIsNotSource tests receiving on chainB a coin that
@@ -323,7 +354,10 @@ func (suite *KeeperTestSuite) TestSendTransferSetsTotalEscrowAmountForSourceIBCT
 // loop since setup is intensive for all cases. The malleate function allows
 // for testing invalid cases.
 func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
-	var packetData types.FungibleTokenPacketDataV2
+	var (
+		packetData types.FungibleTokenPacketDataV2
+		path       *ibctesting.Path
+	)
 
 	testCases := []struct {
 		msg      string
@@ -373,6 +407,26 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 			},
 			types.ErrReceiveDisabled,
 		},
+		{
+			"failure: channel override disables receive",
+			func() {
+				params := types.DefaultParams()
+				params.ChannelOverrides = []types.ChannelOverride{types.NewChannelOverride(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, true, false)}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrReceiveDisabled,
+		},
+		{
+			"failure: denom override disables receive",
+			func() {
+				denom := types.NewDenom(packetData.Tokens[0].Denom.Base, types.NewHop(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+				params := types.DefaultParams()
+				params.DenomOverrides = []types.DenomOverride{types.NewDenomOverride(denom.IBCDenom(), true, false)}
+				suite.chainB.GetSimApp().TransferKeeper.SetParams(suite.chainB.GetContext(), params)
+			},
+			types.ErrReceiveDisabled,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -381,7 +435,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacket_ReceiverIsNotSource() {
 		suite.Run(fmt.Sprintf("Case %s", tc.msg), func() {
 			suite.SetupTest() // reset
 
-			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path = ibctesting.NewTransferPath(suite.chainA, suite.chainB)
 			path.Setup()
 
 			receiver := suite.chainB.SenderAccount.GetAddress().String() // must be explicitly changed in malleate
@@ -640,6 +694,7 @@ func (suite *KeeperTestSuite) TestOnRecvPacketSetsTotalEscrowAmountForSourceIBCT
 	)
 
 	suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coin)
+	suite.chainB.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainB.GetContext(), path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID, coin)
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(defaultAmount, totalEscrowChainB.Amount)
 
@@ -692,6 +747,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacket() {
 
 				// set escrow amount that would have been stored after successful execution of MsgTransfer
 				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), sdk.NewCoin(sdk.DefaultBondDenom, amount))
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sdk.NewCoin(sdk.DefaultBondDenom, amount))
 			},
 			nil,
 		},
@@ -849,6 +905,7 @@ func (suite *KeeperTestSuite) TestOnAcknowledgementPacketSetsTotalEscrowAmountFo
 	)
 
 	suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coin)
+	suite.chainB.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainB.GetContext(), path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID, coin)
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(defaultAmount, totalEscrowChainB.Amount)
 
@@ -892,6 +949,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacket() {
 				suite.Require().NoError(banktestutil.FundAccount(suite.chainA.GetContext(), suite.chainA.GetSimApp().BankKeeper, escrow, sdk.NewCoins(coin)))
 				// set escrow amount that would have been stored after successful execution of MsgTransfer
 				suite.chainA.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainA.GetContext(), coin)
+				suite.chainA.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, coin)
 			},
 			nil,
 		},
@@ -1074,6 +1132,7 @@ func (suite *KeeperTestSuite) TestOnTimeoutPacketSetsTotalEscrowAmountForSourceI
 	)
 
 	suite.chainB.GetSimApp().TransferKeeper.SetTotalEscrowForDenom(suite.chainB.GetContext(), coin)
+	suite.chainB.GetSimApp().TransferKeeper.SetChannelEscrow(suite.chainB.GetContext(), path2.EndpointB.ChannelConfig.PortID, path2.EndpointB.ChannelID, coin)
 	totalEscrowChainB := suite.chainB.GetSimApp().TransferKeeper.GetTotalEscrowForDenom(suite.chainB.GetContext(), coin.GetDenom())
 	suite.Require().Equal(defaultAmount, totalEscrowChainB.Amount)
 
@@ -1284,7 +1343,7 @@ func (suite *KeeperTestSuite) TestCreatePacketDataBytesFromVersion() {
 
 			tc.malleate()
 
-			bz, err := transferkeeper.CreatePacketDataBytesFromVersion(tc.appVersion, sender, receiver, "", tokens, nil)
+			bz, err := transferkeeper.CreatePacketDataBytesFromVersion(tc.appVersion, sender, receiver, "", tokens, nil, nil)
 
 			tc.expResult(bz, err)
 		})
@@ -140,6 +140,14 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 5, m.MigrateDenomTraceToDenom); err != nil {
 		panic(fmt.Errorf("failed to migrate transfer app from version 5 to 6 (migrate DenomTrace to Denom): %v", err))
 	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 6, m.MigrateParamsOverrides); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 6 to 7 (params overrides migration): %v", err))
+	}
+
+	if err := cfg.RegisterMigration(types.ModuleName, 7, m.MigrateChannelEscrows); err != nil {
+		panic(fmt.Errorf("failed to migrate transfer app from version 7 to 8 (channel escrow migration): %v", err))
+	}
 }
 
 // InitGenesis performs genesis initialization for the ibc-transfer module. It returns
@@ -158,7 +166,7 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 }
 
 // ConsensusVersion implements AppModule/ConsensusVersion defining the current version of transfer.
-func (AppModule) ConsensusVersion() uint64 { return 6 }
+func (AppModule) ConsensusVersion() uint64 { return 8 }
 
 // AppModuleSimulation functions
 
@@ -23,6 +23,12 @@ func NewDecodeStore() func(kvA, kvB kv.Pair) string {
 			types.ModuleCdc.MustUnmarshal(kvB.Value, &denomB)
 			return fmt.Sprintf("Denom A: %s\nDenom B: %s", denomA.IBCDenom(), denomB.IBCDenom())
 
+		case bytes.Equal(kvA.Key[:1], types.RateLimitKey):
+			var rateLimitA, rateLimitB types.RateLimit
+			types.ModuleCdc.MustUnmarshal(kvA.Value, &rateLimitA)
+			types.ModuleCdc.MustUnmarshal(kvB.Value, &rateLimitB)
+			return fmt.Sprintf("RateLimit A: %s\nRateLimit B: %s", rateLimitA.String(), rateLimitB.String())
+
 		default:
 			panic(fmt.Errorf("invalid %s key prefix %X", types.ModuleName, kvA.Key[:1]))
 		}
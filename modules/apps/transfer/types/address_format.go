@@ -0,0 +1,157 @@
+package types
+
+import (
+	"context"
+	"encoding/hex"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/types/bech32"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+const (
+	// MaxBech32PrefixLength is the maximum length of the human readable part of a bech32 address.
+	MaxBech32PrefixLength = 83
+	// MaxHexAddressLength is the maximum number of bytes of a hex encoded address.
+	MaxHexAddressLength = 255
+	// MaxBech32AddressLength is the maximum number of bytes of a bech32 encoded address.
+	MaxBech32AddressLength = 255
+)
+
+// NewBech32AddressFormat creates a new AddressFormat for bech32 addresses with the provided human readable part.
+func NewBech32AddressFormat(prefix string) AddressFormat {
+	return AddressFormat{Bech32Prefix: prefix}
+}
+
+// NewHexAddressFormat creates a new AddressFormat for hex encoded addresses of the provided number of bytes.
+func NewHexAddressFormat(length uint32) AddressFormat {
+	return AddressFormat{HexLength: length}
+}
+
+// Validate performs a basic validation of the address format. Exactly one of the bech32 prefix and the hex
+// length must be set.
+func (af AddressFormat) Validate() error {
+	switch {
+	case af.Bech32Prefix != "" && af.HexLength != 0:
+		return errorsmod.Wrap(ErrInvalidAddressFormat, "bech32 prefix and hex length cannot be both set")
+	case af.Bech32Prefix != "":
+		if len(af.Bech32Prefix) > MaxBech32PrefixLength {
+			return errorsmod.Wrapf(ErrInvalidAddressFormat, "bech32 prefix length must not exceed %d", MaxBech32PrefixLength)
+		}
+
+		for _, c := range af.Bech32Prefix {
+			// bech32 prefixes consist of lowercase US-ASCII characters in the [33, 126] range
+			if c < 33 || c > 126 || (c >= 'A' && c <= 'Z') {
+				return errorsmod.Wrapf(ErrInvalidAddressFormat, "invalid bech32 prefix %s", af.Bech32Prefix)
+			}
+		}
+	case af.HexLength != 0:
+		if af.HexLength > MaxHexAddressLength {
+			return errorsmod.Wrapf(ErrInvalidAddressFormat, "hex length must not exceed %d", MaxHexAddressLength)
+		}
+	default:
+		return errorsmod.Wrap(ErrInvalidAddressFormat, "either the bech32 prefix or the hex length must be set")
+	}
+
+	return nil
+}
+
+// ValidateAddress validates that the provided address conforms to the address format.
+func (af AddressFormat) ValidateAddress(address string) error {
+	if af.Bech32Prefix != "" {
+		prefix, bz, err := bech32.DecodeAndConvert(address)
+		if err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "%s is not a valid bech32 address: %v", address, err)
+		}
+
+		if prefix != af.Bech32Prefix {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "expected bech32 prefix %s, got %s", af.Bech32Prefix, prefix)
+		}
+
+		if len(bz) == 0 || len(bz) > MaxBech32AddressLength {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid address length %d", len(bz))
+		}
+
+		return nil
+	}
+
+	trimmed := strings.TrimPrefix(strings.TrimPrefix(address, "0x"), "0X")
+	bz, err := hex.DecodeString(trimmed)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "%s is not a valid hex address: %v", address, err)
+	}
+
+	if len(bz) != int(af.HexLength) {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "expected hex address of %d bytes, got %d", af.HexLength, len(bz))
+	}
+
+	return nil
+}
+
+var _ AddressFormatResolver = (*ChainIDAddressFormatResolver)(nil)
+
+// ChainIDAddressFormatResolver is an AddressFormatResolver learning the address format of the counterparty chain
+// of a channel from the chain ID of the client of the channel's connection. The address formats of the known
+// counterparty chains are provided by their chain IDs at app wiring time. The address format of counterparty
+// chains whose client state does not expose a chain ID, or whose chain ID is not known, is not resolved.
+type ChainIDAddressFormatResolver struct {
+	channelKeeper  ChannelKeeper
+	addressFormats map[string]AddressFormat
+}
+
+// NewChainIDAddressFormatResolver creates a new ChainIDAddressFormatResolver instance resolving the address
+// formats of the counterparty chains with the provided chain IDs. It panics if any address format is invalid.
+func NewChainIDAddressFormatResolver(channelKeeper ChannelKeeper, addressFormats map[string]AddressFormat) ChainIDAddressFormatResolver {
+	for chainID, addressFormat := range addressFormats {
+		if err := addressFormat.Validate(); err != nil {
+			panic(errorsmod.Wrapf(err, "invalid address format for chain %s", chainID))
+		}
+	}
+
+	return ChainIDAddressFormatResolver{
+		channelKeeper:  channelKeeper,
+		addressFormats: addressFormats,
+	}
+}
+
+// ResolveAddressFormat implements AddressFormatResolver.
+func (r ChainIDAddressFormatResolver) ResolveAddressFormat(ctx context.Context, portID, channelID string) (AddressFormat, bool) {
+	_, clientState, err := r.channelKeeper.GetChannelClientState(ctx, portID, channelID)
+	if err != nil {
+		return AddressFormat{}, false
+	}
+
+	chainIDClientState, ok := clientState.(interface{ GetChainID() string })
+	if !ok {
+		return AddressFormat{}, false
+	}
+
+	addressFormat, found := r.addressFormats[chainIDClientState.GetChainID()]
+	return addressFormat, found
+}
+
+// NewCounterpartyAddressFormat creates a new CounterpartyAddressFormat instance.
+func NewCounterpartyAddressFormat(portID, channelID string, addressFormat AddressFormat) CounterpartyAddressFormat {
+	return CounterpartyAddressFormat{
+		PortId:        portID,
+		ChannelId:     channelID,
+		AddressFormat: addressFormat,
+	}
+}
+
+// Validate performs a basic validation of the counterparty address format.
+func (caf CounterpartyAddressFormat) Validate() error {
+	if err := host.PortIdentifierValidator(caf.PortId); err != nil {
+		return err
+	}
+
+	if err := host.ChannelIdentifierValidator(caf.ChannelId); err != nil {
+		return err
+	}
+
+	return caf.AddressFormat.Validate()
+}
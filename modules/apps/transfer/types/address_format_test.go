@@ -0,0 +1,89 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestAddressFormatValidate(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addressFormat types.AddressFormat
+		expError      error
+	}{
+		{"success: bech32", types.NewBech32AddressFormat("cosmos"), nil},
+		{"success: hex", types.NewHexAddressFormat(20), nil},
+		{"failure: empty", types.AddressFormat{}, types.ErrInvalidAddressFormat},
+		{"failure: both set", types.AddressFormat{Bech32Prefix: "cosmos", HexLength: 20}, types.ErrInvalidAddressFormat},
+		{"failure: uppercase bech32 prefix", types.NewBech32AddressFormat("Cosmos"), types.ErrInvalidAddressFormat},
+		{"failure: bech32 prefix with whitespace", types.NewBech32AddressFormat("cos mos"), types.ErrInvalidAddressFormat},
+		{"failure: bech32 prefix too long", types.NewBech32AddressFormat(strings.Repeat("a", types.MaxBech32PrefixLength+1)), types.ErrInvalidAddressFormat},
+		{"failure: hex length too long", types.NewHexAddressFormat(types.MaxHexAddressLength + 1), types.ErrInvalidAddressFormat},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.addressFormat.Validate()
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestAddressFormatValidateAddress(t *testing.T) {
+	testCases := []struct {
+		name          string
+		addressFormat types.AddressFormat
+		address       string
+		expError      error
+	}{
+		{"success: bech32", types.NewBech32AddressFormat("cosmos"), ibctesting.TestAccAddress, nil},
+		{"success: hex", types.NewHexAddressFormat(20), "0x52908400098527886E0F7030069857D2E4169EE7", nil},
+		{"success: hex without prefix", types.NewHexAddressFormat(4), "deadbeef", nil},
+		{"failure: bech32 prefix mismatch", types.NewBech32AddressFormat("osmo"), ibctesting.TestAccAddress, ibcerrors.ErrInvalidAddress},
+		{"failure: invalid bech32", types.NewBech32AddressFormat("cosmos"), "cosmos1invalid", ibcerrors.ErrInvalidAddress},
+		{"failure: hex address for bech32 format", types.NewBech32AddressFormat("cosmos"), "0xdeadbeef", ibcerrors.ErrInvalidAddress},
+		{"failure: invalid hex", types.NewHexAddressFormat(4), "0xdeadbeeg", ibcerrors.ErrInvalidAddress},
+		{"failure: hex length mismatch", types.NewHexAddressFormat(20), "0xdeadbeef", ibcerrors.ErrInvalidAddress},
+		{"failure: bech32 address for hex format", types.NewHexAddressFormat(20), ibctesting.TestAccAddress, ibcerrors.ErrInvalidAddress},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.addressFormat.ValidateAddress(tc.address)
+
+			if tc.expError == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestCounterpartyAddressFormatValidate(t *testing.T) {
+	require.NoError(t, types.NewCounterpartyAddressFormat(types.PortID, ibctesting.FirstChannelID, types.NewHexAddressFormat(20)).Validate())
+	require.Error(t, types.NewCounterpartyAddressFormat(invalidPort, ibctesting.FirstChannelID, types.NewHexAddressFormat(20)).Validate())
+	require.Error(t, types.NewCounterpartyAddressFormat(types.PortID, invalidChannel, types.NewHexAddressFormat(20)).Validate())
+	require.ErrorIs(t, types.NewCounterpartyAddressFormat(types.PortID, ibctesting.FirstChannelID, types.AddressFormat{}).Validate(), types.ErrInvalidAddressFormat)
+}
+
+func TestNewChainIDAddressFormatResolver(t *testing.T) {
+	require.NotPanics(t, func() {
+		types.NewChainIDAddressFormatResolver(nil, map[string]types.AddressFormat{"osmosis-1": types.NewBech32AddressFormat("osmo")})
+	})
+
+	require.Panics(t, func() {
+		types.NewChainIDAddressFormatResolver(nil, map[string]types.AddressFormat{"osmosis-1": types.NewBech32AddressFormat("OSMO")})
+	})
+}
@@ -10,15 +10,20 @@ import (
 	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -41,6 +46,13 @@ type Allocation struct {
 	AllowedPacketData []string `protobuf:"bytes,5,rep,name=allowed_packet_data,json=allowedPacketData,proto3" json:"allowed_packet_data,omitempty"`
 	// Forwarding options that are allowed.
 	AllowedForwarding []AllowedForwarding `protobuf:"bytes,6,rep,name=allowed_forwarding,json=allowedForwarding,proto3" json:"allowed_forwarding"`
+	// optional maximum relative timeout. If set, transfers must use a relative timeout, with a non-zero
+	// offset not exceeding each non-zero offset of the maximum.
+	MaxRelativeTimeout *RelativeTimeout `protobuf:"bytes,7,opt,name=max_relative_timeout,json=maxRelativeTimeout,proto3" json:"max_relative_timeout,omitempty"`
+	// optional block time at which the allocation expires. An expired allocation can no longer be used.
+	Expiration *time.Time `protobuf:"bytes,8,opt,name=expiration,proto3,stdtime" json:"expiration,omitempty"`
+	// optional spend limit resetting every period, applied in addition to the total spend limit.
+	PeriodicSpendLimit *PeriodicSpendLimit `protobuf:"bytes,9,opt,name=periodic_spend_limit,json=periodicSpendLimit,proto3" json:"periodic_spend_limit,omitempty"`
 }
 
 func (m *Allocation) Reset()         { *m = Allocation{} }
@@ -118,6 +130,100 @@ func (m *Allocation) GetAllowedForwarding() []AllowedForwarding {
 	return nil
 }
 
+func (m *Allocation) GetMaxRelativeTimeout() *RelativeTimeout {
+	if m != nil {
+		return m.MaxRelativeTimeout
+	}
+	return nil
+}
+
+func (m *Allocation) GetExpiration() *time.Time {
+	if m != nil {
+		return m.Expiration
+	}
+	return nil
+}
+
+func (m *Allocation) GetPeriodicSpendLimit() *PeriodicSpendLimit {
+	if m != nil {
+		return m.PeriodicSpendLimit
+	}
+	return nil
+}
+
+// PeriodicSpendLimit defines the maximum amount of tokens which can be transferred within a recurring period.
+type PeriodicSpendLimit struct {
+	// duration of a period
+	Period time.Duration `protobuf:"bytes,1,opt,name=period,proto3,stdduration" json:"period"`
+	// maximum amount of tokens which can be transferred within a period
+	PeriodSpendLimit github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,2,rep,name=period_spend_limit,json=periodSpendLimit,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_spend_limit"`
+	// amount of tokens left to be transferred within the current period
+	PeriodCanSpend github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,3,rep,name=period_can_spend,json=periodCanSpend,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"period_can_spend"`
+	// block time at which the current period ends and the amount left is reset to the period spend limit
+	PeriodReset time.Time `protobuf:"bytes,4,opt,name=period_reset,json=periodReset,proto3,stdtime" json:"period_reset"`
+}
+
+func (m *PeriodicSpendLimit) Reset()         { *m = PeriodicSpendLimit{} }
+func (m *PeriodicSpendLimit) String() string { return proto.CompactTextString(m) }
+func (*PeriodicSpendLimit) ProtoMessage()    {}
+func (*PeriodicSpendLimit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{1}
+}
+func (m *PeriodicSpendLimit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PeriodicSpendLimit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PeriodicSpendLimit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PeriodicSpendLimit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PeriodicSpendLimit.Merge(m, src)
+}
+func (m *PeriodicSpendLimit) XXX_Size() int {
+	return m.Size()
+}
+func (m *PeriodicSpendLimit) XXX_DiscardUnknown() {
+	xxx_messageInfo_PeriodicSpendLimit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PeriodicSpendLimit proto.InternalMessageInfo
+
+func (m *PeriodicSpendLimit) GetPeriod() time.Duration {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *PeriodicSpendLimit) GetPeriodSpendLimit() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodSpendLimit
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodCanSpend() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.PeriodCanSpend
+	}
+	return nil
+}
+
+func (m *PeriodicSpendLimit) GetPeriodReset() time.Time {
+	if m != nil {
+		return m.PeriodReset
+	}
+	return time.Time{}
+}
+
 // AllowedForwarding defines which options are allowed for forwarding.
 type AllowedForwarding struct {
 	// a list of allowed source port ID/channel ID pairs through which the packet is allowed to be forwarded until final
@@ -129,7 +235,7 @@ func (m *AllowedForwarding) Reset()         { *m = AllowedForwarding{} }
 func (m *AllowedForwarding) String() string { return proto.CompactTextString(m) }
 func (*AllowedForwarding) ProtoMessage()    {}
 func (*AllowedForwarding) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{1}
+	return fileDescriptor_b1a28b55d17325aa, []int{2}
 }
 func (m *AllowedForwarding) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -176,7 +282,7 @@ func (m *TransferAuthorization) Reset()         { *m = TransferAuthorization{} }
 func (m *TransferAuthorization) String() string { return proto.CompactTextString(m) }
 func (*TransferAuthorization) ProtoMessage()    {}
 func (*TransferAuthorization) Descriptor() ([]byte, []int) {
-	return fileDescriptor_b1a28b55d17325aa, []int{2}
+	return fileDescriptor_b1a28b55d17325aa, []int{3}
 }
 func (m *TransferAuthorization) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -212,10 +318,60 @@ func (m *TransferAuthorization) GetAllocations() []Allocation {
 	return nil
 }
 
+// MultiTransferAuthorization allows the grantee to spend up to spend_limit coins from
+// the granter's account for the ibc transfers of a MsgMultiTransfer on specific channels.
+// Each transfer must be permitted by the allocation of its channel.
+type MultiTransferAuthorization struct {
+	// port and channel amounts
+	Allocations []Allocation `protobuf:"bytes,1,rep,name=allocations,proto3" json:"allocations"`
+}
+
+func (m *MultiTransferAuthorization) Reset()         { *m = MultiTransferAuthorization{} }
+func (m *MultiTransferAuthorization) String() string { return proto.CompactTextString(m) }
+func (*MultiTransferAuthorization) ProtoMessage()    {}
+func (*MultiTransferAuthorization) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b1a28b55d17325aa, []int{4}
+}
+func (m *MultiTransferAuthorization) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultiTransferAuthorization) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultiTransferAuthorization.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultiTransferAuthorization) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultiTransferAuthorization.Merge(m, src)
+}
+func (m *MultiTransferAuthorization) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultiTransferAuthorization) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultiTransferAuthorization.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultiTransferAuthorization proto.InternalMessageInfo
+
+func (m *MultiTransferAuthorization) GetAllocations() []Allocation {
+	if m != nil {
+		return m.Allocations
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Allocation)(nil), "ibc.applications.transfer.v1.Allocation")
+	proto.RegisterType((*PeriodicSpendLimit)(nil), "ibc.applications.transfer.v1.PeriodicSpendLimit")
 	proto.RegisterType((*AllowedForwarding)(nil), "ibc.applications.transfer.v1.AllowedForwarding")
 	proto.RegisterType((*TransferAuthorization)(nil), "ibc.applications.transfer.v1.TransferAuthorization")
+	proto.RegisterType((*MultiTransferAuthorization)(nil), "ibc.applications.transfer.v1.MultiTransferAuthorization")
 }
 
 func init() {
@@ -223,38 +379,53 @@ func init() {
 }
 
 var fileDescriptor_b1a28b55d17325aa = []byte{
-	// 496 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x4f, 0x8b, 0xd3, 0x40,
-	0x18, 0xc6, 0x9b, 0x6d, 0x5d, 0xe8, 0x14, 0x85, 0x8d, 0x0a, 0xd9, 0x45, 0xd3, 0x5a, 0x50, 0x02,
-	0xd2, 0x19, 0xab, 0x07, 0x51, 0x4f, 0xed, 0x8a, 0x78, 0xd8, 0x43, 0x2d, 0x9e, 0xbc, 0x84, 0xc9,
-	0x64, 0xb6, 0x19, 0x76, 0x9a, 0x37, 0x64, 0x26, 0x5d, 0xdc, 0x4f, 0xa1, 0x5f, 0xc3, 0xb3, 0x1f,
-	0x62, 0xf1, 0xb4, 0x47, 0x4f, 0x2a, 0xed, 0x87, 0xf0, 0x2a, 0x99, 0x99, 0xd6, 0xca, 0x42, 0x3d,
-	0xb5, 0xf3, 0xbc, 0xbf, 0xf7, 0x5f, 0x9e, 0x17, 0x45, 0x22, 0x61, 0x84, 0x16, 0x85, 0x14, 0x8c,
-	0x6a, 0x01, 0xb9, 0x22, 0xba, 0xa4, 0xb9, 0x3a, 0xe5, 0x25, 0x59, 0x0c, 0x09, 0xad, 0x74, 0x76,
-	0x81, 0x8b, 0x12, 0x34, 0xf8, 0xf7, 0x44, 0xc2, 0xf0, 0x36, 0x89, 0xd7, 0x24, 0x5e, 0x0c, 0x8f,
-	0x0e, 0x19, 0xa8, 0x39, 0xa8, 0xd8, 0xb0, 0xc4, 0x3e, 0x6c, 0xe2, 0xd1, 0x9d, 0x19, 0xcc, 0xc0,
-	0xea, 0xf5, 0x3f, 0xa7, 0x86, 0x96, 0x21, 0x09, 0x55, 0x9c, 0x2c, 0x86, 0x09, 0xd7, 0x74, 0x48,
-	0x18, 0x88, 0xdc, 0xc5, 0x1f, 0xef, 0x1c, 0x6c, 0xd3, 0xda, 0xc0, 0xfd, 0xdf, 0x7b, 0x08, 0x8d,
-	0xa4, 0x04, 0x8b, 0xfa, 0x5d, 0xd4, 0x51, 0x50, 0x95, 0x8c, 0xc7, 0x05, 0x94, 0x3a, 0xf0, 0x7a,
-	0x5e, 0xd4, 0x9e, 0x22, 0x2b, 0x4d, 0xa0, 0xd4, 0xfe, 0x43, 0x74, 0xcb, 0x01, 0x2c, 0xa3, 0x79,
-	0xce, 0x65, 0xb0, 0x67, 0x98, 0x9b, 0x56, 0x3d, 0xb6, 0xa2, 0x2f, 0x51, 0x47, 0x15, 0x3c, 0x4f,
-	0x63, 0x29, 0xe6, 0x42, 0x07, 0xcd, 0x5e, 0x33, 0xea, 0x3c, 0x3d, 0xc4, 0x6e, 0xbb, 0x7a, 0x72,
-	0xec, 0x26, 0xc7, 0xc7, 0x20, 0xf2, 0xf1, 0x93, 0xcb, 0x1f, 0xdd, 0xc6, 0x97, 0x9f, 0xdd, 0x68,
-	0x26, 0x74, 0x56, 0x25, 0x98, 0xc1, 0xdc, 0x7d, 0x0a, 0xf7, 0x33, 0x50, 0xe9, 0x19, 0xd1, 0x1f,
-	0x0b, 0xae, 0x4c, 0x82, 0x9a, 0x22, 0x53, 0xff, 0xa4, 0x2e, 0xef, 0xdf, 0x47, 0x88, 0x4a, 0x09,
-	0xe7, 0xb1, 0x14, 0x4a, 0x07, 0xad, 0x5e, 0x33, 0x6a, 0x4f, 0xdb, 0x46, 0x39, 0x11, 0x4a, 0xfb,
-	0x18, 0xdd, 0x36, 0x0f, 0x9e, 0xc6, 0x05, 0x65, 0x67, 0x5c, 0xc7, 0x29, 0xd5, 0x34, 0xb8, 0x61,
-	0xb8, 0x03, 0x17, 0x9a, 0x98, 0xc8, 0x6b, 0xaa, 0xa9, 0x9f, 0x22, 0x7f, 0xcd, 0x9f, 0x42, 0x79,
-	0x4e, 0xcb, 0x54, 0xe4, 0xb3, 0x60, 0xdf, 0xec, 0x40, 0xf0, 0x2e, 0x33, 0xf1, 0xc8, 0xe6, 0xbd,
-	0xd9, 0xa4, 0x8d, 0x5b, 0xf5, 0x66, 0x9b, 0x2e, 0x7f, 0x03, 0xfd, 0x09, 0x3a, 0xb8, 0x46, 0xfb,
-	0xaf, 0x50, 0x2b, 0x83, 0x42, 0x05, 0x9e, 0x69, 0xf6, 0x60, 0x77, 0xb3, 0xb7, 0x50, 0xb8, 0xf2,
-	0x26, 0xa9, 0xff, 0xd9, 0x43, 0x77, 0xdf, 0xbb, 0xf8, 0xa8, 0xd2, 0x19, 0x94, 0xe2, 0xc2, 0xda,
-	0x3a, 0x41, 0x1d, 0xba, 0x31, 0x79, 0x5d, 0x3d, 0xfa, 0xff, 0x2a, 0x56, 0x77, 0x4d, 0xb6, 0x4b,
-	0xbc, 0x7c, 0xf4, 0xed, 0xeb, 0xa0, 0xef, 0xec, 0xb4, 0xb7, 0xbe, 0xf6, 0xf3, 0x9f, 0xce, 0xe3,
-	0x77, 0x97, 0xcb, 0xd0, 0xbb, 0x5a, 0x86, 0xde, 0xaf, 0x65, 0xe8, 0x7d, 0x5a, 0x85, 0x8d, 0xab,
-	0x55, 0xd8, 0xf8, 0xbe, 0x0a, 0x1b, 0x1f, 0x9e, 0x5f, 0xb7, 0x5a, 0x24, 0x6c, 0x30, 0x03, 0xb2,
-	0x78, 0x41, 0xe6, 0x90, 0x56, 0x92, 0xab, 0xfa, 0x8c, 0xb7, 0xce, 0xd7, 0xf8, 0x9f, 0xec, 0x9b,
-	0xcb, 0x7d, 0xf6, 0x27, 0x00, 0x00, 0xff, 0xff, 0x66, 0x4e, 0x45, 0x23, 0x81, 0x03, 0x00, 0x00,
+	// 724 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x4f, 0x6f, 0xd3, 0x3e,
+	0x18, 0x6e, 0xd6, 0xfe, 0xf6, 0x5b, 0x5d, 0x98, 0x98, 0x19, 0x52, 0x56, 0x41, 0x5b, 0x2a, 0x81,
+	0x2a, 0xa1, 0xda, 0xeb, 0x38, 0x20, 0xd8, 0x85, 0x75, 0x13, 0x70, 0x18, 0x52, 0x09, 0x3b, 0x71,
+	0x89, 0x9c, 0xc4, 0x6b, 0xad, 0x25, 0x71, 0x14, 0x3b, 0xdd, 0x9f, 0xcf, 0xc0, 0x61, 0x5c, 0x10,
+	0x9f, 0x81, 0x33, 0x1f, 0x62, 0xe2, 0xb4, 0x23, 0x27, 0x86, 0xb6, 0xef, 0x81, 0x50, 0x6c, 0xb7,
+	0x74, 0xab, 0xd4, 0x5d, 0x40, 0xe2, 0xd4, 0xe6, 0x7d, 0x9f, 0xc7, 0xcf, 0xfb, 0xe7, 0xb1, 0x41,
+	0x8b, 0x79, 0x3e, 0x26, 0x49, 0x12, 0x32, 0x9f, 0x48, 0xc6, 0x63, 0x81, 0x65, 0x4a, 0x62, 0xb1,
+	0x4b, 0x53, 0x3c, 0xec, 0x60, 0x92, 0xc9, 0xc1, 0x11, 0x4a, 0x52, 0x2e, 0x39, 0xbc, 0xcb, 0x3c,
+	0x1f, 0x4d, 0x22, 0xd1, 0x08, 0x89, 0x86, 0x9d, 0xea, 0x8a, 0xcf, 0x45, 0xc4, 0x85, 0xab, 0xb0,
+	0x58, 0x7f, 0x68, 0x62, 0x75, 0xb9, 0xcf, 0xfb, 0x5c, 0xc7, 0xf3, 0x7f, 0x26, 0x5a, 0xd3, 0x18,
+	0xec, 0x11, 0x41, 0xf1, 0xb0, 0xe3, 0x51, 0x49, 0x3a, 0xd8, 0xe7, 0x2c, 0x1e, 0xe5, 0xfb, 0x9c,
+	0xf7, 0x43, 0x8a, 0xd5, 0x97, 0x97, 0xed, 0xe2, 0x20, 0x4b, 0x95, 0xae, 0xc9, 0xd7, 0xaf, 0xe6,
+	0x25, 0x8b, 0xa8, 0x90, 0x24, 0x4a, 0x0c, 0xe0, 0xd1, 0xcc, 0xce, 0xc6, 0xb5, 0x2b, 0x70, 0xf3,
+	0x67, 0x09, 0x80, 0x8d, 0x30, 0xe4, 0x1a, 0x0a, 0xeb, 0xa0, 0x22, 0x78, 0x96, 0xfa, 0xd4, 0x4d,
+	0x78, 0x2a, 0x6d, 0xab, 0x61, 0xb5, 0xca, 0x0e, 0xd0, 0xa1, 0x1e, 0x4f, 0x25, 0x7c, 0x00, 0x16,
+	0x0d, 0xc0, 0x1f, 0x90, 0x38, 0xa6, 0xa1, 0x3d, 0xa7, 0x30, 0x37, 0x75, 0x74, 0x53, 0x07, 0x61,
+	0x08, 0x2a, 0x22, 0xa1, 0x71, 0xe0, 0x86, 0x2c, 0x62, 0xd2, 0x2e, 0x36, 0x8a, 0xad, 0xca, 0xda,
+	0x0a, 0x32, 0xe3, 0xc9, 0x5b, 0x47, 0xa6, 0x75, 0xb4, 0xc9, 0x59, 0xdc, 0x5d, 0x3d, 0xf9, 0x5e,
+	0x2f, 0x7c, 0x3e, 0xab, 0xb7, 0xfa, 0x4c, 0x0e, 0x32, 0x0f, 0xf9, 0x3c, 0x32, 0xb3, 0x34, 0x3f,
+	0x6d, 0x11, 0xec, 0x61, 0x79, 0x98, 0x50, 0xa1, 0x08, 0xc2, 0x01, 0xea, 0xfc, 0xed, 0xfc, 0x78,
+	0x78, 0x0f, 0x00, 0x12, 0x86, 0x7c, 0xdf, 0x0d, 0x99, 0x90, 0x76, 0xa9, 0x51, 0x6c, 0x95, 0x9d,
+	0xb2, 0x8a, 0x6c, 0x33, 0x21, 0x21, 0x02, 0xb7, 0xd5, 0x07, 0x0d, 0xdc, 0x84, 0xf8, 0x7b, 0x54,
+	0xba, 0x01, 0x91, 0xc4, 0xfe, 0x4f, 0xe1, 0x96, 0x4c, 0xaa, 0xa7, 0x32, 0x5b, 0x44, 0x12, 0x18,
+	0x00, 0x38, 0xc2, 0xef, 0xf2, 0x74, 0x9f, 0xa4, 0x01, 0x8b, 0xfb, 0xf6, 0xbc, 0xea, 0x01, 0xa3,
+	0x59, 0x6e, 0x40, 0x1b, 0x9a, 0xf7, 0x62, 0x4c, 0xeb, 0x96, 0xf2, 0xce, 0xc6, 0x2a, 0xbf, 0x13,
+	0xd0, 0x05, 0xcb, 0x11, 0x39, 0x70, 0x53, 0x1a, 0x12, 0xc9, 0x86, 0xd4, 0xcd, 0xd7, 0xc8, 0x33,
+	0x69, 0xff, 0xdf, 0xb0, 0x5a, 0x95, 0xb5, 0xf6, 0x6c, 0x1d, 0xc7, 0xb0, 0x76, 0x34, 0xc9, 0x81,
+	0x11, 0x39, 0xb8, 0x12, 0x83, 0xcf, 0x01, 0xa0, 0x07, 0x09, 0xd3, 0xe6, 0xb1, 0x17, 0xd4, 0xb1,
+	0x55, 0xa4, 0xdd, 0x83, 0x46, 0xee, 0x41, 0x3b, 0x23, 0xf7, 0x74, 0x4b, 0xc7, 0x67, 0x75, 0xcb,
+	0x99, 0xe0, 0x40, 0x0f, 0x2c, 0x27, 0x34, 0x65, 0x3c, 0x60, 0xbe, 0x3b, 0xb9, 0xce, 0xb2, 0x3a,
+	0x6b, 0x75, 0x76, 0x89, 0x3d, 0xc3, 0x7c, 0x3b, 0xde, 0x93, 0x03, 0x93, 0xa9, 0x58, 0xf3, 0x7d,
+	0x11, 0xc0, 0x69, 0x28, 0x5c, 0x07, 0xf3, 0x1a, 0xac, 0x3c, 0x98, 0x7b, 0xe7, 0x6a, 0xe1, 0x5b,
+	0xe6, 0x5a, 0x74, 0x17, 0xf2, 0x09, 0x7f, 0xca, 0x6b, 0x37, 0x14, 0x78, 0x08, 0x8c, 0xd2, 0xa5,
+	0xaa, 0xe7, 0xfe, 0xbc, 0x09, 0x6f, 0x69, 0x99, 0x89, 0xba, 0x33, 0x60, 0x62, 0xae, 0x4f, 0x62,
+	0x2d, 0xff, 0x37, 0xdc, 0xbf, 0xa8, 0x45, 0x36, 0x49, 0xac, 0xb4, 0xe1, 0x4b, 0x70, 0xc3, 0xc8,
+	0xa6, 0x54, 0xd0, 0xfc, 0x0e, 0x5c, 0xb7, 0x6d, 0x35, 0x35, 0xb5, 0xf1, 0x8a, 0x66, 0x3a, 0x39,
+	0xb1, 0xd9, 0x03, 0x4b, 0x53, 0x1e, 0x86, 0xeb, 0xa0, 0x34, 0xe0, 0x89, 0xb0, 0x2d, 0xd5, 0xc8,
+	0xfd, 0xd9, 0x7b, 0x7f, 0xc5, 0x13, 0x63, 0x7a, 0x45, 0x6a, 0x7e, 0xb0, 0xc0, 0x9d, 0x1d, 0x93,
+	0xdf, 0xc8, 0xe4, 0x80, 0xa7, 0xec, 0x48, 0xdb, 0xab, 0x07, 0x2a, 0x64, 0xfc, 0xf4, 0x8c, 0x4e,
+	0x6f, 0x5d, 0x7f, 0xc1, 0x74, 0xdc, 0x88, 0x4c, 0x1e, 0xf1, 0xec, 0xe1, 0xd7, 0x2f, 0xed, 0xa6,
+	0x19, 0xb3, 0x7e, 0xc2, 0x47, 0x73, 0xbe, 0xa4, 0xdc, 0xfc, 0x68, 0x81, 0xea, 0xeb, 0x2c, 0x94,
+	0xec, 0x1f, 0x2b, 0xac, 0xfb, 0xe6, 0xe4, 0xbc, 0x66, 0x9d, 0x9e, 0xd7, 0xac, 0x1f, 0xe7, 0x35,
+	0xeb, 0xf8, 0xa2, 0x56, 0x38, 0xbd, 0xa8, 0x15, 0xbe, 0x5d, 0xd4, 0x0a, 0xef, 0x9e, 0x4c, 0x7b,
+	0x83, 0x79, 0x7e, 0xbb, 0xcf, 0xf1, 0xf0, 0x29, 0x8e, 0x78, 0x90, 0x85, 0x54, 0xe4, 0xaf, 0xfe,
+	0xc4, 0x6b, 0xaf, 0x0c, 0xe3, 0xcd, 0xab, 0xe5, 0x3f, 0xfe, 0x35, 0x00, 0xc8, 0x95, 0x80, 0x3d,
+	0xf1, 0x06, 0x00, 0x00,
 }
 
 func (m *Allocation) Marshal() (dAtA []byte, err error) {
@@ -277,6 +448,40 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PeriodicSpendLimit != nil {
+		{
+			size, err := m.PeriodicSpendLimit.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.Expiration != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Expiration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintAuthz(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.MaxRelativeTimeout != nil {
+		{
+			size, err := m.MaxRelativeTimeout.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintAuthz(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x3a
+	}
 	if len(m.AllowedForwarding) > 0 {
 		for iNdEx := len(m.AllowedForwarding) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -340,6 +545,73 @@ func (m *Allocation) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PeriodicSpendLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PeriodicSpendLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PeriodicSpendLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.PeriodReset, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintAuthz(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if len(m.PeriodCanSpend) > 0 {
+		for iNdEx := len(m.PeriodCanSpend) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodCanSpend[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.PeriodSpendLimit) > 0 {
+		for iNdEx := len(m.PeriodSpendLimit) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PeriodSpendLimit[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	n5, err5 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.Period, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period):])
+	if err5 != nil {
+		return 0, err5
+	}
+	i -= n5
+	i = encodeVarintAuthz(dAtA, i, uint64(n5))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *AllowedForwarding) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -414,6 +686,43 @@ func (m *TransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *MultiTransferAuthorization) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultiTransferAuthorization) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultiTransferAuthorization) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for iNdEx := len(m.Allocations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Allocations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuthz(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthz(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthz(v)
 	base := offset
@@ -463,6 +772,43 @@ func (m *Allocation) Size() (n int) {
 			n += 1 + l + sovAuthz(uint64(l))
 		}
 	}
+	if m.MaxRelativeTimeout != nil {
+		l = m.MaxRelativeTimeout.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.Expiration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Expiration)
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	if m.PeriodicSpendLimit != nil {
+		l = m.PeriodicSpendLimit.Size()
+		n += 1 + l + sovAuthz(uint64(l))
+	}
+	return n
+}
+
+func (m *PeriodicSpendLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.Period)
+	n += 1 + l + sovAuthz(uint64(l))
+	if len(m.PeriodSpendLimit) > 0 {
+		for _, e := range m.PeriodSpendLimit {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	if len(m.PeriodCanSpend) > 0 {
+		for _, e := range m.PeriodCanSpend {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.PeriodReset)
+	n += 1 + l + sovAuthz(uint64(l))
 	return n
 }
 
@@ -496,6 +842,21 @@ func (m *TransferAuthorization) Size() (n int) {
 	return n
 }
 
+func (m *MultiTransferAuthorization) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Allocations) > 0 {
+		for _, e := range m.Allocations {
+			l = e.Size()
+			n += 1 + l + sovAuthz(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovAuthz(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -727,32 +1088,324 @@ func (m *Allocation) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipAuthz(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRelativeTimeout", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthAuthz
 			}
-			if (iNdEx + skippy) > l {
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *AllowedForwarding) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
+			if m.MaxRelativeTimeout == nil {
+				m.MaxRelativeTimeout = &RelativeTimeout{}
+			}
+			if err := m.MaxRelativeTimeout.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiration == nil {
+				m.Expiration = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Expiration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodicSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PeriodicSpendLimit == nil {
+				m.PeriodicSpendLimit = &PeriodicSpendLimit{}
+			}
+			if err := m.PeriodicSpendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PeriodicSpendLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PeriodicSpendLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.Period, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodSpendLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodSpendLimit = append(m.PeriodSpendLimit, types.Coin{})
+			if err := m.PeriodSpendLimit[len(m.PeriodSpendLimit)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodCanSpend", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodCanSpend = append(m.PeriodCanSpend, types.Coin{})
+			if err := m.PeriodCanSpend[len(m.PeriodCanSpend)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodReset", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.PeriodReset, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *AllowedForwarding) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
 		var wire uint64
 		for shift := uint(0); ; shift += 7 {
 			if shift >= 64 {
@@ -916,6 +1569,90 @@ func (m *TransferAuthorization) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MultiTransferAuthorization) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthz
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultiTransferAuthorization: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultiTransferAuthorization: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Allocations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthz
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Allocations = append(m.Allocations, Allocation{})
+			if err := m.Allocations[len(m.Allocations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthz(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthz
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipAuthz(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
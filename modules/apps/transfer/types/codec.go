@@ -13,16 +13,30 @@ import (
 // on the provided LegacyAmino codec. These types are used for Amino JSON serialization.
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	legacy.RegisterAminoMsg(cdc, &MsgTransfer{}, "cosmos-sdk/MsgTransfer")
+	legacy.RegisterAminoMsg(cdc, &MsgMultiTransfer{}, "cosmos-sdk/MsgMultiTransfer")
 }
 
 // RegisterInterfaces register the ibc transfer module interfaces to protobuf
 // Any.
 func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	registry.RegisterImplementations((*sdk.Msg)(nil), &MsgTransfer{}, &MsgUpdateParams{})
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgTransfer{},
+		&MsgMultiTransfer{},
+		&MsgUpdateParams{},
+		&MsgSetRateLimit{},
+		&MsgRemoveRateLimit{},
+		&MsgResetRateLimit{},
+		&MsgMigrateDenomTrace{},
+		&MsgSetCounterpartyAddressFormat{},
+		&MsgRemoveCounterpartyAddressFormat{},
+		&MsgSetDenomMetadata{},
+	)
 
 	registry.RegisterImplementations(
 		(*authz.Authorization)(nil),
 		&TransferAuthorization{},
+		&MultiTransferAuthorization{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
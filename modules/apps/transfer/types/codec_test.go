@@ -39,16 +39,31 @@ func (suite *TypesTestSuite) TestCodecTypeRegistration() {
 			sdk.MsgTypeURL(&types.MsgTransfer{}),
 			nil,
 		},
+		{
+			"success: MsgMultiTransfer",
+			sdk.MsgTypeURL(&types.MsgMultiTransfer{}),
+			nil,
+		},
 		{
 			"success: MsgUpdateParams",
 			sdk.MsgTypeURL(&types.MsgUpdateParams{}),
 			nil,
 		},
+		{
+			"success: MsgMigrateDenomTrace",
+			sdk.MsgTypeURL(&types.MsgMigrateDenomTrace{}),
+			nil,
+		},
 		{
 			"success: TransferAuthorization",
 			sdk.MsgTypeURL(&types.TransferAuthorization{}),
 			nil,
 		},
+		{
+			"success: MultiTransferAuthorization",
+			sdk.MsgTypeURL(&types.MultiTransferAuthorization{}),
+			nil,
+		},
 		{
 			"type not registered on codec",
 			"ibc.invalid.MsgTypeURL",
@@ -224,3 +224,37 @@ func ParseHexHash(hexHash string) (cmtbytes.HexBytes, error) {
 
 	return hash, nil
 }
+
+// NewDenomMigration creates a new DenomMigration instance.
+func NewDenomMigration(oldDenom, newDenom Denom) DenomMigration {
+	return DenomMigration{
+		OldDenom: oldDenom,
+		NewDenom: newDenom,
+	}
+}
+
+// Validate performs a basic validation of the DenomMigration fields. Both denominations must be
+// vouchers with the same base denomination and a different trace.
+func (dm DenomMigration) Validate() error {
+	if err := dm.OldDenom.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid old denomination")
+	}
+
+	if err := dm.NewDenom.Validate(); err != nil {
+		return errorsmod.Wrap(err, "invalid new denomination")
+	}
+
+	if dm.OldDenom.IsNative() || dm.NewDenom.IsNative() {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "cannot migrate native denomination: %s to %s", dm.OldDenom.Path(), dm.NewDenom.Path())
+	}
+
+	if dm.OldDenom.Base != dm.NewDenom.Base {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "base denominations must be equal: %s != %s", dm.OldDenom.Base, dm.NewDenom.Base)
+	}
+
+	if dm.OldDenom.Path() == dm.NewDenom.Path() {
+		return errorsmod.Wrapf(ErrInvalidDenomForTransfer, "denominations must have different traces: %s", dm.OldDenom.Path())
+	}
+
+	return nil
+}
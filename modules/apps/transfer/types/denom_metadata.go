@@ -0,0 +1,101 @@
+package types
+
+import (
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MaximumDenomMetadataURILength is the maximum length of the URI of a denom metadata override in bytes (value chosen arbitrarily).
+const MaximumDenomMetadataURILength = 512
+
+// NewDenomMetadataOverride creates a new DenomMetadataOverride instance.
+func NewDenomMetadataOverride(denom, name, symbol, display string, decimals uint32, uri string) DenomMetadataOverride {
+	return DenomMetadataOverride{
+		Denom:    denom,
+		Name:     name,
+		Symbol:   symbol,
+		Display:  display,
+		Decimals: decimals,
+		Uri:      uri,
+	}
+}
+
+// Validate performs a basic validation of the denom metadata override: the denomination must be an IBC
+// denomination in its canonical ibc/{HASH} form, with the hash hex encoded in uppercase, and the bank
+// metadata built from the override must be valid.
+func (o DenomMetadataOverride) Validate() error {
+	hash, found := strings.CutPrefix(o.Denom, DenomPrefix+"/")
+	if !found {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "denomination %s must be in the format ibc/{hash}", o.Denom)
+	}
+
+	hashBytes, err := ParseHexHash(hash)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "invalid denom trace hash %s: %s", hash, err)
+	}
+
+	// IBC denominations hold the uppercase hex encoding of the hash, the override would never apply otherwise
+	if hash != hashBytes.String() {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "denomination %s must hold the uppercase hex encoding of the denom trace hash", o.Denom)
+	}
+
+	if len(o.Uri) > MaximumDenomMetadataURILength {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "uri length must not exceed %d bytes", MaximumDenomMetadataURILength)
+	}
+
+	if err := o.Metadata("").Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidDenomMetadata, "denomination %s: %s", o.Denom, err)
+	}
+
+	return nil
+}
+
+// Metadata returns the bank metadata of the IBC denomination described by the override. The IBC denomination
+// is the base denomination unit, and the display denomination unit is added with the override decimals as
+// exponent if the decimals are not zero.
+func (o DenomMetadataOverride) Metadata(description string) banktypes.Metadata {
+	denomUnits := []*banktypes.DenomUnit{
+		{
+			Denom:    o.Denom,
+			Exponent: 0,
+		},
+	}
+
+	if o.Decimals != 0 {
+		denomUnits = append(denomUnits, &banktypes.DenomUnit{
+			Denom:    o.Display,
+			Exponent: o.Decimals,
+		})
+	}
+
+	return banktypes.Metadata{
+		Description: description,
+		DenomUnits:  denomUnits,
+		Base:        o.Denom,
+		Display:     o.Display,
+		Name:        o.Name,
+		Symbol:      o.Symbol,
+		URI:         o.Uri,
+	}
+}
+
+// ValidateDenomMetadataOverrides validates the provided denom metadata overrides and checks that no
+// denomination is overridden twice.
+func ValidateDenomMetadataOverrides(overrides []DenomMetadataOverride) error {
+	seenDenoms := make(map[string]bool, len(overrides))
+	for i, override := range overrides {
+		if err := override.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid denom metadata override %d", i)
+		}
+
+		if seenDenoms[override.Denom] {
+			return errorsmod.Wrapf(ErrInvalidDenomMetadata, "duplicate denom metadata override for %s", override.Denom)
+		}
+		seenDenoms[override.Denom] = true
+	}
+
+	return nil
+}
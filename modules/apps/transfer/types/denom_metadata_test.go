@@ -0,0 +1,71 @@
+package types_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+)
+
+var atomIBCDenom = types.NewDenom("uatom", types.NewHop(types.PortID, "channel-0")).IBCDenom()
+
+func TestDenomMetadataOverrideValidate(t *testing.T) {
+	testCases := []struct {
+		name     string
+		override types.DenomMetadataOverride
+		expErr   bool
+	}{
+		{"success: with decimals", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "atom", 6, "https://cosmos.network"), false},
+		{"success: without decimals", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", atomIBCDenom, 0, ""), false},
+		{"failure: native denom", types.NewDenomMetadataOverride("uatom", "Cosmos Hub Atom", "ATOM", "atom", 6, ""), true},
+		{"failure: invalid hash", types.NewDenomMetadataOverride("ibc/invalid", "Cosmos Hub Atom", "ATOM", "atom", 6, ""), true},
+		{"failure: lowercase hash", types.NewDenomMetadataOverride(strings.ToLower(atomIBCDenom), "Cosmos Hub Atom", "ATOM", "atom", 6, ""), true},
+		{"failure: empty name", types.NewDenomMetadataOverride(atomIBCDenom, "", "ATOM", "atom", 6, ""), true},
+		{"failure: empty symbol", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", " ", "atom", 6, ""), true},
+		{"failure: invalid display", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "", 6, ""), true},
+		{"failure: display unit without decimals", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "atom", 0, ""), true},
+		{"failure: uri too long", types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "atom", 6, strings.Repeat("a", types.MaximumDenomMetadataURILength+1)), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.override.Validate()
+			if tc.expErr {
+				require.ErrorIs(t, err, types.ErrInvalidDenomMetadata)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestDenomMetadataOverrideMetadata(t *testing.T) {
+	override := types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "atom", 6, "https://cosmos.network")
+
+	require.Equal(t, banktypes.Metadata{
+		Description: "IBC token from transfer/channel-0/uatom",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: atomIBCDenom, Exponent: 0},
+			{Denom: "atom", Exponent: 6},
+		},
+		Base:    atomIBCDenom,
+		Display: "atom",
+		Name:    "Cosmos Hub Atom",
+		Symbol:  "ATOM",
+		URI:     "https://cosmos.network",
+	}, override.Metadata("IBC token from transfer/channel-0/uatom"))
+}
+
+func TestValidateDenomMetadataOverrides(t *testing.T) {
+	override := types.NewDenomMetadataOverride(atomIBCDenom, "Cosmos Hub Atom", "ATOM", "atom", 6, "")
+
+	require.NoError(t, types.ValidateDenomMetadataOverrides(nil))
+	require.NoError(t, types.ValidateDenomMetadataOverrides([]types.DenomMetadataOverride{override}))
+	require.ErrorIs(t, types.ValidateDenomMetadataOverrides([]types.DenomMetadataOverride{override, override}), types.ErrInvalidDenomMetadata)
+}
@@ -19,4 +19,14 @@ var (
 	ErrInvalidForwarding       = errorsmod.Register(ModuleName, 12, "invalid token forwarding")
 	ErrForwardedPacketTimedOut = errorsmod.Register(ModuleName, 13, "forwarded packet timed out")
 	ErrForwardedPacketFailed   = errorsmod.Register(ModuleName, 14, "forwarded packet failed")
+	ErrRateLimitExceeded       = errorsmod.Register(ModuleName, 15, "rate limit exceeded")
+	ErrRateLimitNotFound       = errorsmod.Register(ModuleName, 16, "rate limit not found")
+	ErrInvalidRateLimit        = errorsmod.Register(ModuleName, 17, "invalid rate limit")
+	ErrForwardedPacketNotFound = errorsmod.Register(ModuleName, 18, "forwarded packet not found")
+	ErrTransferRejected        = errorsmod.Register(ModuleName, 19, "transfer rejected by policy")
+	ErrDenomMigrated           = errorsmod.Register(ModuleName, 20, "denomination has been migrated")
+	ErrInvalidProtocolFee      = errorsmod.Register(ModuleName, 21, "invalid protocol fee")
+	ErrInvalidAddressFormat    = errorsmod.Register(ModuleName, 22, "invalid address format")
+	ErrAddressFormatNotFound   = errorsmod.Register(ModuleName, 23, "address format not found")
+	ErrInvalidDenomMetadata    = errorsmod.Register(ModuleName, 24, "invalid denom metadata")
 )
@@ -0,0 +1,39 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+// NewChannelEscrow creates a new ChannelEscrow instance.
+func NewChannelEscrow(portID, channelID string, amount sdk.Coin) ChannelEscrow {
+	return ChannelEscrow{
+		PortId:    portID,
+		ChannelId: channelID,
+		Amount:    amount,
+	}
+}
+
+// Validate performs a basic validation of the ChannelEscrow fields.
+func (ce ChannelEscrow) Validate() error {
+	if err := host.PortIdentifierValidator(ce.PortId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel escrow port ID %s", ce.PortId)
+	}
+	if err := host.ChannelIdentifierValidator(ce.ChannelId); err != nil {
+		return errorsmod.Wrapf(err, "invalid channel escrow channel ID %s", ce.ChannelId)
+	}
+
+	if err := ce.Amount.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid channel escrow amount: %s", err)
+	}
+
+	if !ce.Amount.IsPositive() {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "channel escrow amount must be positive: %s", ce.Amount)
+	}
+
+	return nil
+}
@@ -2,11 +2,15 @@ package types
 
 // IBC transfer events
 const (
-	EventTypeTimeout      = "timeout"
-	EventTypePacket       = "fungible_token_packet"
-	EventTypeTransfer     = "ibc_transfer"
-	EventTypeChannelClose = "channel_closed"
-	EventTypeDenom        = "denomination"
+	EventTypeTimeout            = "timeout"
+	EventTypePacket             = "fungible_token_packet"
+	EventTypeTransfer           = "ibc_transfer"
+	EventTypeChannelClose       = "channel_closed"
+	EventTypeDenom              = "denomination"
+	EventTypeRateLimit          = "rate_limit_exceeded"
+	EventTypeForwardingFallback = "forwarding_fallback"
+	EventTypeDenomMigration     = "denomination_migration"
+	EventTypeProtocolFee        = "protocol_fee"
 
 	AttributeKeySender         = "sender"
 	AttributeKeyReceiver       = "receiver"
@@ -20,4 +24,14 @@ const (
 	AttributeKeyAckError       = "error"
 	AttributeKeyMemo           = "memo"
 	AttributeKeyForwardingHops = "forwarding_hops"
+	AttributeKeyPortID         = "port_id"
+	AttributeKeyChannelID      = "channel_id"
+	AttributeKeyFlowDirection  = "direction"
+	AttributeKeyAmount         = "amount"
+	AttributeKeyThreshold      = "threshold"
+	AttributeKeyOldDenom       = "old_denom"
+	AttributeKeyNewDenom       = "new_denom"
+	AttributeKeyFee            = "fee"
+	AttributeKeyFeeRecipient   = "fee_recipient"
+	AttributeKeyBasisPoints    = "basis_points"
 )
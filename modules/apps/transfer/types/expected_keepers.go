@@ -7,6 +7,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramtypes "github.com/cosmos/cosmos-sdk/x/params/types"
 
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcexported "github.com/cosmos/ibc-go/v9/modules/core/exported"
@@ -31,6 +32,20 @@ type BankKeeper interface {
 	SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata)
 	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+	DenomOwners(ctx context.Context, req *banktypes.QueryDenomOwnersRequest) (*banktypes.QueryDenomOwnersResponse, error)
+}
+
+// DistributionKeeper defines the expected distribution keeper used to fund the community pool
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
+
+// AddressFormatResolver defines the expected interface used to learn the address format of the counterparty
+// chain of a channel while the channel handshake completes, e.g. from the chain registry or the counterparty
+// client state. The second return value is false if the address format cannot be determined.
+type AddressFormatResolver interface {
+	ResolveAddressFormat(ctx context.Context, portID, channelID string) (AddressFormat, bool)
 }
 
 // ChannelKeeper defines the expected IBC channel keeper
@@ -39,11 +54,14 @@ type ChannelKeeper interface {
 	GetNextSequenceSend(ctx context.Context, portID, channelID string) (uint64, bool)
 	GetAllChannelsWithPortPrefix(ctx context.Context, portPrefix string) []channeltypes.IdentifiedChannel
 	HasChannel(ctx context.Context, portID, channelID string) bool
+	GetPacketCommitment(ctx context.Context, portID, channelID string, sequence uint64) []byte
+	GetChannelClientState(ctx context.Context, portID, channelID string) (string, ibcexported.ClientState, error)
 }
 
 // ClientKeeper defines the expected IBC client keeper
 type ClientKeeper interface {
-	GetClientConsensusState(ctx sdk.Context, clientID string) (connection ibcexported.ConsensusState, found bool)
+	GetClientLatestHeight(ctx context.Context, clientID string) clienttypes.Height
+	GetClientTimestampAtHeight(ctx context.Context, clientID string, height ibcexported.Height) (uint64, error)
 }
 
 // ConnectionKeeper defines the expected IBC connection keeper
@@ -0,0 +1,190 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"strings"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// ForwardMemoKey is the key of the memo JSON object under which the packet-forward instructions are found.
+	ForwardMemoKey = "forward"
+
+	// DefaultForwardTimeout is the timeout relative to the block time used for a forwarded packet
+	// when no timeout is specified in the packet-forward instructions.
+	DefaultForwardTimeout = 10 * time.Minute
+
+	// MaximumForwardRetries is the maximum number of times a timed out forwarded packet may be resent.
+	MaximumForwardRetries = 10
+)
+
+// ForwardMetadata defines the packet-forward instructions of a single hop as found under the
+// "forward" key of the memo of an ics20-1 packet:
+//
+//	{"forward":{"receiver":"...","port":"transfer","channel":"channel-1","timeout":"10m","retries":2,"fallback_receiver":"...","next":{...}}}
+//
+// The timeout may be provided either as a duration string or as an amount of nanoseconds. The optional
+// fallback receiver on this chain receives the tokens if they cannot be forwarded. The next field holds
+// the memo of the forwarded packet and may be provided either as a JSON object or as a string.
+type ForwardMetadata struct {
+	Receiver         string          `json:"receiver"`
+	Port             string          `json:"port"`
+	Channel          string          `json:"channel"`
+	Timeout          time.Duration   `json:"timeout,omitempty"`
+	Retries          uint8           `json:"retries,omitempty"`
+	FallbackReceiver string          `json:"fallback_receiver,omitempty"`
+	Next             json.RawMessage `json:"next,omitempty"`
+}
+
+// forwardMemo is used to decode the packet-forward instructions from a memo.
+type forwardMemo struct {
+	Forward *ForwardMetadata `json:"forward"`
+}
+
+// UnmarshalJSON implements json.Unmarshaler. It allows the timeout to be provided either
+// as a duration string or as an amount of nanoseconds.
+func (fm *ForwardMetadata) UnmarshalJSON(bz []byte) error {
+	// the alias type does not implement json.Unmarshaler, avoiding infinite recursion
+	type forwardMetadataAlias ForwardMetadata
+	aux := struct {
+		*forwardMetadataAlias
+		Timeout json.RawMessage `json:"timeout,omitempty"`
+	}{forwardMetadataAlias: (*forwardMetadataAlias)(fm)}
+
+	if err := json.Unmarshal(bz, &aux); err != nil {
+		return err
+	}
+
+	if len(aux.Timeout) == 0 {
+		return nil
+	}
+
+	var timeout string
+	if err := json.Unmarshal(aux.Timeout, &timeout); err == nil {
+		duration, err := time.ParseDuration(timeout)
+		if err != nil {
+			return err
+		}
+
+		fm.Timeout = duration
+		return nil
+	}
+
+	var nanoseconds int64
+	if err := json.Unmarshal(aux.Timeout, &nanoseconds); err != nil {
+		return err
+	}
+
+	fm.Timeout = time.Duration(nanoseconds)
+	return nil
+}
+
+// ParseForwardMetadata parses the packet-forward instructions from the provided memo. False is returned
+// if the memo is not a JSON object or does not contain the "forward" key. An error is returned if the
+// forward instructions are present but malformed.
+func ParseForwardMetadata(memo string) (ForwardMetadata, bool, error) {
+	if !strings.Contains(memo, ForwardMemoKey) {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var jsonObject map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(memo), &jsonObject); err != nil {
+		return ForwardMetadata{}, false, nil
+	}
+
+	if _, found := jsonObject[ForwardMemoKey]; !found {
+		return ForwardMetadata{}, false, nil
+	}
+
+	var fwdMemo forwardMemo
+	if err := json.Unmarshal([]byte(memo), &fwdMemo); err != nil {
+		return ForwardMetadata{}, false, errorsmod.Wrapf(ErrInvalidForwarding, "cannot unmarshal forward memo: %s", err)
+	}
+
+	if fwdMemo.Forward == nil {
+		return ForwardMetadata{}, false, errorsmod.Wrap(ErrInvalidForwarding, "forward memo cannot be null")
+	}
+
+	if err := fwdMemo.Forward.Validate(); err != nil {
+		return ForwardMetadata{}, false, err
+	}
+
+	return *fwdMemo.Forward, true, nil
+}
+
+// Validate performs a basic validation of the ForwardMetadata fields.
+func (fm ForwardMetadata) Validate() error {
+	if strings.TrimSpace(fm.Receiver) == "" {
+		return errorsmod.Wrap(ErrInvalidForwarding, "forward receiver cannot be empty")
+	}
+
+	if err := NewHop(fm.Port, fm.Channel).Validate(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "invalid forward hop: %s", err)
+	}
+
+	if fm.Timeout < 0 {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "forward timeout cannot be negative: %s", fm.Timeout)
+	}
+
+	if fm.Retries > MaximumForwardRetries {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "forward retries cannot exceed %d", MaximumForwardRetries)
+	}
+
+	if len(fm.FallbackReceiver) > MaximumReceiverLength {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "fallback receiver address must not exceed %d bytes", MaximumReceiverLength)
+	}
+
+	nextMemo, err := fm.NextMemo()
+	if err != nil {
+		return err
+	}
+
+	if len(nextMemo) > MaximumMemoLength {
+		return errorsmod.Wrapf(ErrInvalidMemo, "next memo length cannot exceed %d", MaximumMemoLength)
+	}
+
+	return nil
+}
+
+// Hop returns the hop over which the tokens are forwarded.
+func (fm ForwardMetadata) Hop() Hop {
+	return NewHop(fm.Port, fm.Channel)
+}
+
+// GetTimeout returns the timeout of the forwarded packet relative to the block time.
+// The DefaultForwardTimeout is returned if no timeout has been specified.
+func (fm ForwardMetadata) GetTimeout() time.Duration {
+	if fm.Timeout == 0 {
+		return DefaultForwardTimeout
+	}
+
+	return fm.Timeout
+}
+
+// NextMemo returns the memo to be used for the forwarded packet. The next field may either be
+// a JSON string, which is used as is, or a JSON object, which is used in its compacted form.
+func (fm ForwardMetadata) NextMemo() (string, error) {
+	if len(fm.Next) == 0 || string(fm.Next) == "null" {
+		return "", nil
+	}
+
+	var memo string
+	if err := json.Unmarshal(fm.Next, &memo); err == nil {
+		return memo, nil
+	}
+
+	var nextObject map[string]json.RawMessage
+	if err := json.Unmarshal(fm.Next, &nextObject); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidMemo, "next memo must be a JSON object or string: %s", err)
+	}
+
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, fm.Next); err != nil {
+		return "", errorsmod.Wrapf(ErrInvalidMemo, "cannot compact next memo: %s", err)
+	}
+
+	return buf.String(), nil
+}
@@ -0,0 +1,173 @@
+package types_test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestParseForwardMetadata(t *testing.T) {
+	testCases := []struct {
+		name        string
+		memo        string
+		expFound    bool
+		expMetadata types.ForwardMetadata
+		expNextMemo string
+		expError    error
+	}{
+		{
+			"empty memo",
+			"",
+			false,
+			types.ForwardMetadata{},
+			"",
+			nil,
+		},
+		{
+			"memo is not a JSON object",
+			"forward to a friend",
+			false,
+			types.ForwardMetadata{},
+			"",
+			nil,
+		},
+		{
+			"memo without forward key",
+			`{"wasm":{"contract":"forward"}}`,
+			false,
+			types.ForwardMetadata{},
+			"",
+			nil,
+		},
+		{
+			"success: minimal forward memo",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			true,
+			types.ForwardMetadata{Receiver: receiver, Port: types.PortID, Channel: ibctesting.FirstChannelID},
+			"",
+			nil,
+		},
+		{
+			"success: forward memo with fallback receiver",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","fallback_receiver":"%s"}}`, receiver, types.PortID, ibctesting.FirstChannelID, ibctesting.TestAccAddress),
+			true,
+			types.ForwardMetadata{Receiver: receiver, Port: types.PortID, Channel: ibctesting.FirstChannelID, FallbackReceiver: ibctesting.TestAccAddress},
+			"",
+			nil,
+		},
+		{
+			"success: forward memo with duration string timeout, retries and next object",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"1m","retries":2,"next":{"forward": {"receiver":"next"}}}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			true,
+			types.ForwardMetadata{Receiver: receiver, Port: types.PortID, Channel: ibctesting.FirstChannelID, Timeout: time.Minute, Retries: 2},
+			`{"forward":{"receiver":"next"}}`,
+			nil,
+		},
+		{
+			"success: forward memo with nanoseconds timeout and next string",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":60000000000,"next":"{\"final\":\"memo\"}"}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			true,
+			types.ForwardMetadata{Receiver: receiver, Port: types.PortID, Channel: ibctesting.FirstChannelID, Timeout: time.Minute},
+			`{"final":"memo"}`,
+			nil,
+		},
+		{
+			"failure: null forward memo",
+			`{"forward":null}`,
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: invalid timeout",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"soon"}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: empty receiver",
+			fmt.Sprintf(`{"forward":{"port":"%s","channel":"%s"}}`, types.PortID, ibctesting.FirstChannelID),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: invalid channel",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`, receiver, types.PortID, invalidChannel),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: negative timeout",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","timeout":"-1m"}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: too many retries",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","retries":%d}}`, receiver, types.PortID, ibctesting.FirstChannelID, types.MaximumForwardRetries+1),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidForwarding,
+		},
+		{
+			"failure: next is neither an object nor a string",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","next":5}}`, receiver, types.PortID, ibctesting.FirstChannelID),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidMemo,
+		},
+		{
+			"failure: next memo too long",
+			fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","next":"%s"}}`, receiver, types.PortID, ibctesting.FirstChannelID, strings.Repeat("a", types.MaximumMemoLength+1)),
+			false,
+			types.ForwardMetadata{},
+			"",
+			types.ErrInvalidMemo,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			metadata, found, err := types.ParseForwardMetadata(tc.memo)
+
+			require.ErrorIs(t, err, tc.expError)
+			require.Equal(t, tc.expFound, found)
+			if !tc.expFound {
+				return
+			}
+
+			require.Equal(t, tc.expMetadata.Receiver, metadata.Receiver)
+			require.Equal(t, tc.expMetadata.Hop(), metadata.Hop())
+			require.Equal(t, tc.expMetadata.Timeout, metadata.Timeout)
+			require.Equal(t, tc.expMetadata.Retries, metadata.Retries)
+
+			nextMemo, err := metadata.NextMemo()
+			require.NoError(t, err)
+			require.Equal(t, tc.expNextMemo, nextMemo)
+		})
+	}
+}
+
+func TestForwardMetadataGetTimeout(t *testing.T) {
+	require.Equal(t, types.DefaultForwardTimeout, types.ForwardMetadata{}.GetTimeout())
+	require.Equal(t, time.Minute, types.ForwardMetadata{Timeout: time.Minute}.GetTimeout())
+}
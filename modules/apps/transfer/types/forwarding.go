@@ -1,6 +1,8 @@
 package types
 
 import (
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	errorsmod "cosmossdk.io/errors"
@@ -24,6 +26,10 @@ func (f Forwarding) Validate() error {
 		return errorsmod.Wrapf(ErrInvalidForwarding, "invalid hops in forwarding")
 	}
 
+	if err := validateFallbackReceivers(f.FallbackReceivers, len(f.Hops)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -49,9 +55,23 @@ func (fpd ForwardingPacketData) Validate() error {
 		return errorsmod.Wrap(ErrInvalidForwarding, "memo specified when forwarding packet data hops is empty")
 	}
 
+	if err := validateFallbackReceivers(fpd.FallbackReceivers, len(fpd.Hops)); err != nil {
+		return err
+	}
+
 	return nil
 }
 
+// NextFallbackReceiver returns the fallback receiver on the chain of the first hop. An empty
+// string is returned if no fallback receiver is set for the hop.
+func (fpd ForwardingPacketData) NextFallbackReceiver() string {
+	if len(fpd.FallbackReceivers) == 0 {
+		return ""
+	}
+
+	return fpd.FallbackReceivers[0]
+}
+
 // NewHop creates a Hop with the given port ID and channel ID.
 func NewHop(portID, channelID string) Hop {
 	return Hop{portID, channelID}
@@ -91,3 +111,44 @@ func validateHops(hops []Hop) error {
 
 	return nil
 }
+
+// validateFallbackReceivers performs a basic validation of the fallback receivers.
+// If fallback receivers are set, there must be exactly one for each hop.
+func validateFallbackReceivers(fallbackReceivers []string, numHops int) error {
+	if len(fallbackReceivers) == 0 {
+		return nil
+	}
+
+	if len(fallbackReceivers) != numHops {
+		return errorsmod.Wrapf(ErrInvalidForwarding, "number of fallback receivers (%d) must match the number of hops (%d)", len(fallbackReceivers), numHops)
+	}
+
+	for _, fallbackReceiver := range fallbackReceivers {
+		if len(fallbackReceiver) > MaximumReceiverLength {
+			return errorsmod.Wrapf(ErrInvalidForwarding, "fallback receiver address must not exceed %d bytes", MaximumReceiverLength)
+		}
+	}
+
+	return nil
+}
+
+// NewForwardingFallback creates a new ForwardingFallback instance.
+func NewForwardingFallback(fallbackReceiver, portID, channelID, forwardErr string) ForwardingFallback {
+	return ForwardingFallback{
+		FallbackReceiver: fallbackReceiver,
+		PortId:           portID,
+		ChannelId:        channelID,
+		Error:            forwardErr,
+	}
+}
+
+// GetBytes is a helper for serialising a ForwardingFallback. It uses JSON to serialise the
+// fallback, such that it can be read from the result of the acknowledgement.
+func (ff ForwardingFallback) GetBytes() []byte {
+	bz, err := json.Marshal(ff)
+	if err != nil {
+		panic(errors.New("cannot marshal ForwardingFallback into bytes"))
+	}
+
+	return bz
+}
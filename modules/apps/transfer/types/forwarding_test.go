@@ -91,6 +91,21 @@ func TestForwarding_Validate(t *testing.T) {
 			types.NewForwarding(true, types.NewHop(types.PortID, types.PortID)),
 			nil,
 		},
+		{
+			"valid forwarding with fallback receivers",
+			&types.Forwarding{Hops: []types.Hop{validHop, validHop}, FallbackReceivers: []string{ibctesting.TestAccAddress, ""}},
+			nil,
+		},
+		{
+			"invalid forwarding with fallback receivers not matching hops",
+			&types.Forwarding{Hops: []types.Hop{validHop, validHop}, FallbackReceivers: []string{ibctesting.TestAccAddress}},
+			types.ErrInvalidForwarding,
+		},
+		{
+			"invalid forwarding with too long fallback receiver",
+			&types.Forwarding{Hops: []types.Hop{validHop}, FallbackReceivers: []string{ibctesting.GenerateString(types.MaximumReceiverLength + 1)}},
+			types.ErrInvalidForwarding,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -154,6 +169,16 @@ func TestForwardingPacketData_Validate(t *testing.T) {
 			types.NewForwardingPacketData("memo"),
 			types.ErrInvalidForwarding,
 		},
+		{
+			"valid forwarding with fallback receivers",
+			types.ForwardingPacketData{Hops: []types.Hop{validHop}, FallbackReceivers: []string{ibctesting.TestAccAddress}},
+			nil,
+		},
+		{
+			"invalid forwarding with fallback receivers and empty hops",
+			types.ForwardingPacketData{FallbackReceivers: []string{ibctesting.TestAccAddress}},
+			types.ErrInvalidForwarding,
+		},
 		{
 			"invalid forwarding with too short hop port ID",
 			types.NewForwardingPacketData(
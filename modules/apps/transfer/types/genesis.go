@@ -1,9 +1,12 @@
 package types
 
 import (
+	errorsmod "cosmossdk.io/errors"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // NewGenesisState creates a new ibc-transfer GenesisState instance.
@@ -35,5 +38,68 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Denoms.Validate(); err != nil {
 		return err
 	}
-	return gs.TotalEscrowed.Validate() // will fail if there are duplicates for any denom
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+	if err := gs.TotalEscrowed.Validate(); err != nil { // will fail if there are duplicates for any denom
+		return err
+	}
+
+	seenRateLimits := make(map[string]bool)
+	for i, rateLimit := range gs.RateLimits {
+		if err := rateLimit.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid rate limit %d", i)
+		}
+
+		key := string(RateLimitStoreKey(rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom))
+		if seenRateLimits[key] {
+			return errorsmod.Wrapf(ErrInvalidRateLimit, "duplicate rate limit for port %s, channel %s and denom %s", rateLimit.PortId, rateLimit.ChannelId, rateLimit.Denom)
+		}
+		seenRateLimits[key] = true
+	}
+
+	seenChannelEscrows := make(map[string]bool)
+	for i, channelEscrow := range gs.ChannelEscrows {
+		if err := channelEscrow.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel escrow %d", i)
+		}
+
+		key := string(ChannelEscrowStoreKey(channelEscrow.PortId, channelEscrow.ChannelId, channelEscrow.Amount.Denom))
+		if seenChannelEscrows[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate channel escrow for port %s, channel %s and denom %s", channelEscrow.PortId, channelEscrow.ChannelId, channelEscrow.Amount.Denom)
+		}
+		seenChannelEscrows[key] = true
+	}
+
+	seenDenomMigrations := make(map[string]bool)
+	for i, denomMigration := range gs.DenomMigrations {
+		if err := denomMigration.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid denom migration %d", i)
+		}
+
+		oldDenom := denomMigration.OldDenom.IBCDenom()
+		if seenDenomMigrations[oldDenom] {
+			return errorsmod.Wrapf(ErrDenomMigrated, "duplicate denom migration for %s", denomMigration.OldDenom.Path())
+		}
+		seenDenomMigrations[oldDenom] = true
+	}
+
+	seenAddressFormats := make(map[string]bool)
+	for i, addressFormat := range gs.CounterpartyAddressFormats {
+		if err := addressFormat.Validate(); err != nil {
+			return errorsmod.Wrapf(err, "invalid counterparty address format %d", i)
+		}
+
+		key := string(CounterpartyAddressFormatStoreKey(addressFormat.PortId, addressFormat.ChannelId))
+		if seenAddressFormats[key] {
+			return errorsmod.Wrapf(ErrInvalidAddressFormat, "duplicate counterparty address format for port %s and channel %s", addressFormat.PortId, addressFormat.ChannelId)
+		}
+		seenAddressFormats[key] = true
+	}
+
+	if err := ValidateDenomMetadataOverrides(gs.DenomMetadataOverrides); err != nil {
+		return err
+	}
+
+	return nil
 }
@@ -37,6 +37,16 @@ type GenesisState struct {
 	// forwarded_packets contains the forwarded packets stored as part of the
 	// packet forwarding lifecycle
 	ForwardedPackets []ForwardedPacket `protobuf:"bytes,5,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// rate_limits contains the configured rate limits along with the flow of their current window
+	RateLimits []RateLimit `protobuf:"bytes,6,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+	// channel_escrows contains the amount of tokens escrowed by the transfer module for each channel end
+	ChannelEscrows []ChannelEscrow `protobuf:"bytes,7,rep,name=channel_escrows,json=channelEscrows,proto3" json:"channel_escrows"`
+	// denom_migrations contains the denominations whose vouchers have been migrated to another trace
+	DenomMigrations []DenomMigration `protobuf:"bytes,8,rep,name=denom_migrations,json=denomMigrations,proto3" json:"denom_migrations"`
+	// counterparty_address_formats contains the formats of the addresses of the chains at the other end of the channels
+	CounterpartyAddressFormats []CounterpartyAddressFormat `protobuf:"bytes,9,rep,name=counterparty_address_formats,json=counterpartyAddressFormats,proto3" json:"counterparty_address_formats"`
+	// denom_metadata_overrides contains the bank metadata of IBC denominations overridden by governance
+	DenomMetadataOverrides []DenomMetadataOverride `protobuf:"bytes,10,rep,name=denom_metadata_overrides,json=denomMetadataOverrides,proto3" json:"denom_metadata_overrides"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -107,6 +117,41 @@ func (m *GenesisState) GetForwardedPackets() []ForwardedPacket {
 	return nil
 }
 
+func (m *GenesisState) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetChannelEscrows() []ChannelEscrow {
+	if m != nil {
+		return m.ChannelEscrows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMigrations() []DenomMigration {
+	if m != nil {
+		return m.DenomMigrations
+	}
+	return nil
+}
+
+func (m *GenesisState) GetCounterpartyAddressFormats() []CounterpartyAddressFormat {
+	if m != nil {
+		return m.CounterpartyAddressFormats
+	}
+	return nil
+}
+
+func (m *GenesisState) GetDenomMetadataOverrides() []DenomMetadataOverride {
+	if m != nil {
+		return m.DenomMetadataOverrides
+	}
+	return nil
+}
+
 // ForwardedPacket defines the genesis type necessary to retrieve and store forwarded packets.
 type ForwardedPacket struct {
 	ForwardKey types1.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
@@ -170,38 +215,49 @@ func init() {
 }
 
 var fileDescriptor_62efebb47a9093ed = []byte{
-	// 485 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0x9b, 0x6d, 0x04, 0xe1, 0xc2, 0x80, 0x08, 0x89, 0x30, 0x20, 0x2b, 0x83, 0x43, 0x04,
-	0xaa, 0x4d, 0xc3, 0x01, 0xed, 0x5a, 0x06, 0x68, 0xda, 0x65, 0x94, 0x1b, 0x97, 0xe2, 0xd8, 0x6e,
-	0x66, 0xb5, 0xc9, 0x8b, 0x6c, 0xaf, 0x53, 0xbf, 0x04, 0x42, 0x7c, 0x0c, 0x3e, 0xc9, 0x8e, 0x3b,
-	0x72, 0x02, 0xd4, 0x7e, 0x11, 0x64, 0xc7, 0x85, 0x01, 0x52, 0x4e, 0x7d, 0xcf, 0xfd, 0xff, 0xff,
-	0xcf, 0xfe, 0xe5, 0xa1, 0xa7, 0x32, 0x67, 0x84, 0xd6, 0xf5, 0x4c, 0x32, 0x6a, 0x24, 0x54, 0x9a,
-	0x18, 0x45, 0x2b, 0x3d, 0x11, 0x8a, 0xcc, 0x33, 0x52, 0x88, 0x4a, 0x68, 0xa9, 0x71, 0xad, 0xc0,
-	0x40, 0xf4, 0x40, 0xe6, 0x0c, 0x5f, 0xd6, 0xe2, 0xb5, 0x16, 0xcf, 0xb3, 0x9d, 0x67, 0x2d, 0x49,
-	0x83, 0xdf, 0x75, 0x13, 0xb5, 0x93, 0xb6, 0x8e, 0x35, 0x30, 0x15, 0x95, 0x57, 0x3e, 0xb2, 0x4a,
-	0x06, 0x4a, 0x10, 0x76, 0x42, 0xab, 0x4a, 0xcc, 0x6c, 0x9a, 0x2f, 0xbd, 0x24, 0x61, 0xa0, 0x4b,
-	0xd0, 0x24, 0xa7, 0x5a, 0x90, 0xf9, 0x20, 0x17, 0x86, 0x0e, 0x08, 0x03, 0xb9, 0x8e, 0xb8, 0x53,
-	0x40, 0x01, 0xae, 0x24, 0xb6, 0x6a, 0x4e, 0xf7, 0x3e, 0x6d, 0xa2, 0xeb, 0x6f, 0x9b, 0xf7, 0xbd,
-	0x37, 0xd4, 0x88, 0xe8, 0x2e, 0xba, 0x5a, 0x83, 0x32, 0x63, 0xc9, 0xe3, 0xa0, 0x17, 0xa4, 0xd7,
-	0x46, 0xa1, 0x6d, 0x0f, 0x79, 0x74, 0x84, 0x42, 0x2e, 0x2a, 0x28, 0x75, 0xbc, 0xd1, 0xdb, 0x4c,
-	0xbb, 0xd9, 0x63, 0xdc, 0x06, 0x02, 0x1f, 0x58, 0xed, 0x70, 0xfb, 0xfc, 0xfb, 0x6e, 0xe7, 0xeb,
-	0x8f, 0xdd, 0xd0, 0xb5, 0x7a, 0xe4, 0x23, 0xa2, 0x21, 0x0a, 0x6b, 0xaa, 0x68, 0xa9, 0xe3, 0xcd,
-	0x5e, 0x90, 0x76, 0xb3, 0x27, 0x6d, 0x61, 0x03, 0x7c, 0xec, 0xb4, 0xc3, 0x2d, 0x9b, 0x36, 0xf2,
-	0xce, 0x48, 0xa1, 0x6d, 0x03, 0x86, 0xce, 0xc6, 0x42, 0x33, 0x05, 0x67, 0x82, 0xc7, 0x5b, 0xee,
-	0x62, 0xf7, 0x70, 0x43, 0x02, 0x5b, 0x12, 0xd8, 0x93, 0xc0, 0xaf, 0x40, 0x56, 0xc3, 0xe7, 0xfe,
-	0x3a, 0x69, 0x21, 0xcd, 0xc9, 0x69, 0x8e, 0x19, 0x94, 0xc4, 0x63, 0x6b, 0x7e, 0xfa, 0x9a, 0x4f,
-	0x89, 0x59, 0xd4, 0x42, 0x3b, 0x83, 0x1e, 0xdd, 0x70, 0x23, 0x5e, 0xfb, 0x09, 0xd1, 0x47, 0x74,
-	0x7b, 0x02, 0xea, 0x8c, 0x2a, 0x2e, 0xf8, 0xb8, 0xa6, 0x6c, 0x2a, 0x8c, 0x8e, 0xaf, 0xb8, 0xb1,
-	0xfd, 0x76, 0x1e, 0x6f, 0xd6, 0xb6, 0x63, 0xe7, 0xf2, 0x6f, 0xb9, 0x35, 0xf9, 0xfb, 0x58, 0xef,
-	0x7d, 0x09, 0xd0, 0xcd, 0x7f, 0xb4, 0xd1, 0x01, 0xea, 0x7a, 0xdd, 0x78, 0x2a, 0x16, 0xee, 0xbb,
-	0x74, 0xb3, 0x87, 0x6e, 0x9e, 0xdd, 0x09, 0xbc, 0x5e, 0x04, 0x47, 0xca, 0x3a, 0x0e, 0xb9, 0xcf,
-	0x47, 0xde, 0x77, 0x24, 0x16, 0xd1, 0xbe, 0x65, 0x6e, 0xff, 0x8d, 0x37, 0x5c, 0xc0, 0xfd, 0x96,
-	0x80, 0x3f, 0xa8, 0x5d, 0xf7, 0xee, 0x7c, 0x99, 0x04, 0x17, 0xcb, 0x24, 0xf8, 0xb9, 0x4c, 0x82,
-	0xcf, 0xab, 0xa4, 0x73, 0xb1, 0x4a, 0x3a, 0xdf, 0x56, 0x49, 0xe7, 0xc3, 0xcb, 0xff, 0x49, 0xca,
-	0x9c, 0xf5, 0x0b, 0x20, 0xf3, 0x7d, 0x52, 0x02, 0x3f, 0x9d, 0x09, 0x6d, 0x57, 0xfc, 0xd2, 0x6a,
-	0x3b, 0xbc, 0x79, 0xe8, 0xf6, 0xef, 0xc5, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xfb, 0x97, 0x02,
-	0x93, 0x7b, 0x03, 0x00, 0x00,
+	// 659 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x94, 0xdd, 0x52, 0x13, 0x31,
+	0x14, 0xc7, 0xbb, 0x80, 0x45, 0x52, 0x05, 0xdc, 0x71, 0x74, 0x45, 0x5c, 0x10, 0x9d, 0xb1, 0x23,
+	0x76, 0xd7, 0x96, 0x0b, 0x86, 0x4b, 0x0b, 0xe2, 0x30, 0xf8, 0x81, 0xf5, 0x8e, 0x19, 0x67, 0xcd,
+	0x26, 0x69, 0xc9, 0xb4, 0xbb, 0xd9, 0xc9, 0x09, 0x65, 0x7a, 0xe5, 0x23, 0xe8, 0xf8, 0x18, 0x3e,
+	0x09, 0x97, 0x5c, 0x7a, 0xa5, 0x0e, 0xbc, 0x88, 0x93, 0x6c, 0x56, 0x8a, 0x1f, 0xab, 0x57, 0x4d,
+	0xb2, 0xff, 0xff, 0xef, 0x24, 0xa7, 0xe7, 0x1c, 0xf4, 0x90, 0xc7, 0x24, 0xc4, 0x59, 0x36, 0xe0,
+	0x04, 0x2b, 0x2e, 0x52, 0x08, 0x95, 0xc4, 0x29, 0x74, 0x99, 0x0c, 0x87, 0xad, 0xb0, 0xc7, 0x52,
+	0x06, 0x1c, 0x82, 0x4c, 0x0a, 0x25, 0xdc, 0x45, 0x1e, 0x93, 0x60, 0x5c, 0x1b, 0x14, 0xda, 0x60,
+	0xd8, 0x5a, 0x58, 0x2d, 0x21, 0x35, 0x7f, 0xae, 0x73, 0xd4, 0x42, 0xa3, 0x54, 0x2c, 0xb1, 0x62,
+	0xd1, 0x80, 0x27, 0x5c, 0x59, 0x79, 0xbd, 0xf4, 0x96, 0x4a, 0xf4, 0x59, 0x6a, 0x95, 0x77, 0xb5,
+	0x92, 0x08, 0xc9, 0x42, 0x72, 0x80, 0xd3, 0x94, 0x0d, 0x34, 0xcf, 0x2e, 0xad, 0xc4, 0x27, 0x02,
+	0x12, 0x01, 0x61, 0x8c, 0x81, 0x85, 0xc3, 0x66, 0xcc, 0x14, 0x6e, 0x86, 0x44, 0xf0, 0x02, 0x71,
+	0xbd, 0x27, 0x7a, 0xc2, 0x2c, 0x43, 0xbd, 0xca, 0x4f, 0x57, 0x3e, 0x4c, 0xa3, 0x2b, 0xcf, 0xf2,
+	0x74, 0xbc, 0x51, 0x58, 0x31, 0xf7, 0x26, 0x9a, 0xce, 0x84, 0x54, 0x11, 0xa7, 0x9e, 0xb3, 0xec,
+	0xd4, 0x67, 0x3a, 0x55, 0xbd, 0xdd, 0xa1, 0xee, 0x2e, 0xaa, 0x52, 0x96, 0x8a, 0x04, 0xbc, 0x89,
+	0xe5, 0xc9, 0x7a, 0xad, 0x75, 0x2f, 0x28, 0xcb, 0x5b, 0xb0, 0xa5, 0xb5, 0xed, 0xd9, 0xe3, 0xaf,
+	0x4b, 0x95, 0xcf, 0xdf, 0x96, 0xaa, 0x66, 0x0b, 0x1d, 0x8b, 0x70, 0xdb, 0xa8, 0x9a, 0x61, 0x89,
+	0x13, 0xf0, 0x26, 0x97, 0x9d, 0x7a, 0xad, 0x75, 0xbf, 0x0c, 0xd6, 0x0c, 0xf6, 0x8c, 0xb6, 0x3d,
+	0xa5, 0x69, 0x1d, 0xeb, 0x74, 0x25, 0x9a, 0x55, 0x42, 0xe1, 0x41, 0xc4, 0x80, 0x48, 0x71, 0xc4,
+	0xa8, 0x37, 0x65, 0x2e, 0x76, 0x2b, 0xc8, 0x33, 0x11, 0xe8, 0x4c, 0x04, 0x36, 0x13, 0xc1, 0xa6,
+	0xe0, 0x69, 0xfb, 0xb1, 0xbd, 0x4e, 0xbd, 0xc7, 0xd5, 0xc1, 0x61, 0x1c, 0x10, 0x91, 0x84, 0x36,
+	0x6d, 0xf9, 0x4f, 0x03, 0x68, 0x3f, 0x54, 0xa3, 0x8c, 0x81, 0x31, 0x40, 0xe7, 0xaa, 0x09, 0xf1,
+	0xd4, 0x46, 0x70, 0xdf, 0xa1, 0x6b, 0x5d, 0x21, 0x8f, 0xb0, 0xa4, 0x8c, 0x46, 0x19, 0x26, 0x7d,
+	0xa6, 0xc0, 0xbb, 0x64, 0xc2, 0x36, 0xca, 0xf3, 0xb1, 0x5d, 0xd8, 0xf6, 0x8c, 0xcb, 0xbe, 0x65,
+	0xbe, 0x7b, 0xf1, 0x18, 0xdc, 0x97, 0xa8, 0x76, 0x5e, 0x27, 0xe0, 0x55, 0x0d, 0xfb, 0x41, 0x79,
+	0x7a, 0x3a, 0x58, 0xb1, 0xe7, 0x5a, 0x6f, 0xa9, 0x48, 0x16, 0x07, 0xe0, 0xee, 0xa3, 0x39, 0x5b,
+	0x27, 0x36, 0x4f, 0xe0, 0x4d, 0x1b, 0xe6, 0x6a, 0x39, 0x73, 0x33, 0x37, 0xe5, 0x2f, 0xb7, 0xdc,
+	0x59, 0x32, 0x7e, 0x08, 0xee, 0x5b, 0x34, 0x6f, 0xfe, 0xcf, 0x28, 0xe1, 0x3d, 0x99, 0x33, 0xbc,
+	0xcb, 0x06, 0xfe, 0xe8, 0x3f, 0x8a, 0xe3, 0x45, 0x61, 0xb2, 0xf4, 0x39, 0x7a, 0xe1, 0x14, 0xdc,
+	0xf7, 0x68, 0x91, 0x88, 0xc3, 0x54, 0x31, 0x99, 0x61, 0xa9, 0x46, 0x11, 0xa6, 0x54, 0x32, 0x80,
+	0xa8, 0x2b, 0x64, 0x82, 0x15, 0x78, 0x33, 0x26, 0xd4, 0xfa, 0x3f, 0xde, 0x31, 0x46, 0x78, 0x92,
+	0x03, 0xb6, 0x8d, 0xdf, 0x46, 0x5d, 0x20, 0x7f, 0x13, 0x80, 0x0b, 0xc8, 0xb3, 0xef, 0x63, 0x0a,
+	0x53, 0xac, 0x70, 0x24, 0x86, 0x4c, 0x4a, 0x4e, 0x19, 0x78, 0xc8, 0x04, 0x5f, 0x2b, 0x0f, 0x9e,
+	0xbf, 0xd3, 0x9a, 0x5f, 0x59, 0xaf, 0x0d, 0x7c, 0x83, 0xfe, 0xe9, 0x23, 0xac, 0x7c, 0x72, 0xd0,
+	0xdc, 0x2f, 0xc5, 0xe2, 0x6e, 0xa1, 0x9a, 0x2d, 0x94, 0xa8, 0xcf, 0x46, 0xa6, 0x31, 0x6b, 0xad,
+	0x3b, 0x26, 0xb6, 0x1e, 0x0a, 0x41, 0x31, 0x09, 0x4c, 0xab, 0x68, 0xc7, 0x0e, 0x2d, 0x4a, 0xc1,
+	0xfa, 0x76, 0xd9, 0xc8, 0xdd, 0xd0, 0x4d, 0xa7, 0xbf, 0x7a, 0x13, 0x06, 0x70, 0xbb, 0x04, 0x70,
+	0xde, 0x6b, 0x66, 0xf7, 0xfa, 0xf8, 0xd4, 0x77, 0x4e, 0x4e, 0x7d, 0xe7, 0xfb, 0xa9, 0xef, 0x7c,
+	0x3c, 0xf3, 0x2b, 0x27, 0x67, 0x7e, 0xe5, 0xcb, 0x99, 0x5f, 0xd9, 0x5f, 0xff, 0xbd, 0x95, 0x78,
+	0x4c, 0x1a, 0x3d, 0x11, 0x0e, 0x37, 0xc2, 0x44, 0xd0, 0xc3, 0x01, 0x03, 0x3d, 0xe3, 0xc6, 0x66,
+	0x9b, 0xe9, 0xaf, 0xb8, 0x6a, 0x06, 0xd0, 0xda, 0x8f, 0x01, 0x00, 0x9c, 0x3c, 0x1d, 0xcd, 0xab,
+	0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -224,6 +280,76 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DenomMetadataOverrides) > 0 {
+		for iNdEx := len(m.DenomMetadataOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMetadataOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.CounterpartyAddressFormats) > 0 {
+		for iNdEx := len(m.CounterpartyAddressFormats) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.CounterpartyAddressFormats[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.DenomMigrations) > 0 {
+		for iNdEx := len(m.DenomMigrations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomMigrations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.ChannelEscrows) > 0 {
+		for iNdEx := len(m.ChannelEscrows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelEscrows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if len(m.ForwardedPackets) > 0 {
 		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -370,6 +496,36 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ChannelEscrows) > 0 {
+		for _, e := range m.ChannelEscrows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMigrations) > 0 {
+		for _, e := range m.DenomMigrations {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.CounterpartyAddressFormats) > 0 {
+		for _, e := range m.CounterpartyAddressFormats {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.DenomMetadataOverrides) > 0 {
+		for _, e := range m.DenomMetadataOverrides {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -588,6 +744,176 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelEscrows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelEscrows = append(m.ChannelEscrows, ChannelEscrow{})
+			if err := m.ChannelEscrows[len(m.ChannelEscrows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMigrations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMigrations = append(m.DenomMigrations, DenomMigration{})
+			if err := m.DenomMigrations[len(m.DenomMigrations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CounterpartyAddressFormats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CounterpartyAddressFormats = append(m.CounterpartyAddressFormats, CounterpartyAddressFormat{})
+			if err := m.CounterpartyAddressFormats[len(m.CounterpartyAddressFormats)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomMetadataOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomMetadataOverrides = append(m.DenomMetadataOverrides, DenomMetadataOverride{})
+			if err := m.DenomMetadataOverrides[len(m.DenomMetadataOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -2,14 +2,29 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 func TestValidateGenesis(t *testing.T) {
+	rateLimit := types.NewRateLimit(types.PortID, "channel-0", "uatom", types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour))
+	channelEscrow := types.NewChannelEscrow(types.PortID, "channel-0", sdk.NewCoin("uatom", sdkmath.NewInt(100)))
+	denomMigration := types.NewDenomMigration(
+		types.NewDenom("uatom", types.NewHop(types.PortID, "channel-0")),
+		types.NewDenom("uatom", types.NewHop(types.PortID, "channel-1")),
+	)
+	addressFormat := types.NewCounterpartyAddressFormat(types.PortID, "channel-0", types.NewBech32AddressFormat("osmo"))
+	metadataOverride := types.NewDenomMetadataOverride(denomMigration.OldDenom.IBCDenom(), "Cosmos Hub Atom", "ATOM", "atom", 6, "")
+
 	testCases := []struct {
 		name     string
 		genState *types.GenesisState
@@ -34,6 +49,126 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"valid genesis with rate limit",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{rateLimit},
+			},
+			nil,
+		},
+		{
+			"invalid rate limit",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{types.NewRateLimit(types.PortID, "channel-0", "uatom", types.Quota{})},
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"duplicate rate limit",
+			&types.GenesisState{
+				PortId:     "portidone",
+				RateLimits: []types.RateLimit{rateLimit, rateLimit},
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"valid genesis with channel escrow",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{channelEscrow},
+			},
+			nil,
+		},
+		{
+			"invalid channel escrow: zero amount",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{types.NewChannelEscrow(types.PortID, "channel-0", sdk.NewCoin("uatom", sdkmath.ZeroInt()))},
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"invalid channel escrow: invalid channel ID",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{types.NewChannelEscrow(types.PortID, "(INVALIDCHANNEL)", channelEscrow.Amount)},
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate channel escrow",
+			&types.GenesisState{
+				PortId:         "portidone",
+				ChannelEscrows: []types.ChannelEscrow{channelEscrow, channelEscrow},
+			},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"valid denom migration",
+			&types.GenesisState{
+				PortId:          "portidone",
+				DenomMigrations: []types.DenomMigration{denomMigration},
+			},
+			nil,
+		},
+		{
+			"invalid denom migration: native denom",
+			&types.GenesisState{
+				PortId:          "portidone",
+				DenomMigrations: []types.DenomMigration{types.NewDenomMigration(types.NewDenom("uatom"), denomMigration.NewDenom)},
+			},
+			types.ErrInvalidDenomForTransfer,
+		},
+		{
+			"duplicate denom migration",
+			&types.GenesisState{
+				PortId:          "portidone",
+				DenomMigrations: []types.DenomMigration{denomMigration, denomMigration},
+			},
+			types.ErrDenomMigrated,
+		},
+		{
+			"valid counterparty address format",
+			&types.GenesisState{
+				PortId:                     "portidone",
+				CounterpartyAddressFormats: []types.CounterpartyAddressFormat{addressFormat},
+			},
+			nil,
+		},
+		{
+			"invalid counterparty address format",
+			&types.GenesisState{
+				PortId:                     "portidone",
+				CounterpartyAddressFormats: []types.CounterpartyAddressFormat{types.NewCounterpartyAddressFormat(types.PortID, "channel-0", types.AddressFormat{})},
+			},
+			types.ErrInvalidAddressFormat,
+		},
+		{
+			"duplicate counterparty address format",
+			&types.GenesisState{
+				PortId:                     "portidone",
+				CounterpartyAddressFormats: []types.CounterpartyAddressFormat{addressFormat, addressFormat},
+			},
+			types.ErrInvalidAddressFormat,
+		},
+		{
+			"valid denom metadata override",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				DenomMetadataOverrides: []types.DenomMetadataOverride{metadataOverride},
+			},
+			nil,
+		},
+		{
+			"duplicate denom metadata override",
+			&types.GenesisState{
+				PortId:                 "portidone",
+				DenomMetadataOverrides: []types.DenomMetadataOverride{metadataOverride, metadataOverride},
+			},
+			types.ErrInvalidDenomMetadata,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -55,6 +55,18 @@ var (
 	DenomKey = []byte{0x03}
 	// ForwardedPacketKey defines the key to store the forwarded packet in store
 	ForwardedPacketKey = []byte{0x04}
+	// RateLimitKey defines the key to store the rate limits in store
+	RateLimitKey = []byte{0x05}
+	// ForwardRetriesKey defines the key to store the number of retries of a memo forwarded packet in store
+	ForwardRetriesKey = []byte{0x06}
+	// ChannelEscrowKey defines the key to store the amount of tokens in escrow for each channel end in store
+	ChannelEscrowKey = []byte{0x07}
+	// DenomMigrationKey defines the key to store the migrations of the vouchers of a denomination in store
+	DenomMigrationKey = []byte{0x08}
+	// CounterpartyAddressFormatKey defines the key to store the format of the addresses of the counterparty chain of each channel end in store
+	CounterpartyAddressFormatKey = []byte{0x09}
+	// DenomMetadataOverrideKey defines the key to store the bank metadata overrides of IBC denominations in store
+	DenomMetadataOverrideKey = []byte{0x0A}
 
 	// SupportedVersions defines all versions that are supported by the module
 	SupportedVersions = []string{V2, V1}
@@ -87,3 +99,51 @@ func TotalEscrowForDenomKey(denom string) []byte {
 func PacketForwardKey(portID, channelID string, sequence uint64) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardedPacketKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
 }
+
+// PacketForwardPrefixKey returns the store key prefix under which the forwarded packets are stored
+// for the provided portID and channelID.
+func PacketForwardPrefixKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ForwardedPacketKey, portID, channelID))
+}
+
+// RateLimitStoreKey returns the store key under which the rate limit is stored
+// for the provided portID, channelID and denom.
+func RateLimitStoreKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", RateLimitKey, portID, channelID, denom))
+}
+
+// PacketForwardRetriesKey returns the store key under which the number of retries is stored for
+// the packet received on the provided portID and channelID with the given sequence.
+func PacketForwardRetriesKey(portID, channelID string, sequence uint64) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ForwardRetriesKey, portID, channelID, sdk.Uint64ToBigEndian(sequence)))
+}
+
+// ChannelEscrowStoreKey returns the store key under which the amount of the provided denom
+// in escrow is stored for the provided portID and channelID.
+func ChannelEscrowStoreKey(portID, channelID, denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/%s", ChannelEscrowKey, portID, channelID, denom))
+}
+
+// ChannelEscrowPrefixKey returns the store key prefix under which the amounts in escrow
+// are stored for the provided portID and channelID.
+func ChannelEscrowPrefixKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s/", ChannelEscrowKey, portID, channelID))
+}
+
+// DenomMigrationStoreKey returns the store key under which the migration of the vouchers
+// of the provided denomination is stored.
+func DenomMigrationStoreKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", DenomMigrationKey, denom))
+}
+
+// CounterpartyAddressFormatStoreKey returns the store key under which the format of the addresses
+// of the chain at the other end of the provided channel end is stored.
+func CounterpartyAddressFormatStoreKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s/%s", CounterpartyAddressFormatKey, portID, channelID))
+}
+
+// DenomMetadataOverrideStoreKey returns the store key under which the bank metadata override of the
+// provided IBC denomination (ibc/{hash}) is stored.
+func DenomMetadataOverrideStoreKey(denom string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", DenomMetadataOverrideKey, denom))
+}
@@ -0,0 +1,154 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strings"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+// ForwardMemoSchema is the JSON schema of the packet-forward instructions found under the ForwardMemoKey of the memo.
+const ForwardMemoSchema = `{
+  "type": "object",
+  "properties": {
+    "receiver": {"type": "string"},
+    "port": {"type": "string"},
+    "channel": {"type": "string"},
+    "timeout": {"type": ["string", "integer"]},
+    "retries": {"type": "integer"},
+    "fallback_receiver": {"type": "string"},
+    "next": {"type": ["object", "string"]}
+  },
+  "required": ["receiver", "port", "channel"],
+  "additionalProperties": false
+}`
+
+// MemoRegistry holds the top-level keys of the transfer memo understood by the chain. Middlewares
+// interpreting the memo declare their keys and the JSON schemas of their values at wiring time, and
+// the memos of the transfers sent and received are validated against them.
+//
+// Memos which are not JSON objects are treated as plain text and are not validated.
+type MemoRegistry struct {
+	keys              map[string]MemoKey
+	schemas           map[string]*MemoSchema
+	rejectUnknownKeys bool
+}
+
+// NewMemoRegistry creates a new MemoRegistry holding the memo keys interpreted by the transfer
+// application itself. If rejectUnknownKeys is true, received packets with a memo holding keys
+// which have not been registered are rejected.
+func NewMemoRegistry(rejectUnknownKeys bool) *MemoRegistry {
+	registry := &MemoRegistry{
+		keys:              make(map[string]MemoKey),
+		schemas:           make(map[string]*MemoSchema),
+		rejectUnknownKeys: rejectUnknownKeys,
+	}
+
+	registry.MustRegisterMemoKey(ModuleName, ForwardMemoKey, ForwardMemoSchema)
+
+	return registry
+}
+
+// RegisterMemoKey registers a top-level memo key interpreted by the provided owner, along with the
+// JSON schema its value must conform to. An error is returned if the key is already registered or
+// if the schema is invalid.
+func (r *MemoRegistry) RegisterMemoKey(owner, key, schema string) error {
+	if strings.TrimSpace(key) == "" {
+		return errorsmod.Wrap(ErrInvalidMemo, "memo key cannot be empty")
+	}
+
+	if strings.TrimSpace(owner) == "" {
+		return errorsmod.Wrapf(ErrInvalidMemo, "owner of memo key %s cannot be empty", key)
+	}
+
+	if registered, found := r.keys[key]; found {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo key %s is already registered by %s", key, registered.Owner)
+	}
+
+	memoSchema, err := ParseMemoSchema(schema)
+	if err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo key %s: %s", key, err)
+	}
+
+	r.keys[key] = MemoKey{Key: key, Owner: owner, Schema: schema}
+	r.schemas[key] = memoSchema
+
+	return nil
+}
+
+// MustRegisterMemoKey calls RegisterMemoKey and panics on error. It is meant to be used at wiring time.
+func (r *MemoRegistry) MustRegisterMemoKey(owner, key, schema string) {
+	if err := r.RegisterMemoKey(owner, key, schema); err != nil {
+		panic(err)
+	}
+}
+
+// MemoKeys returns the registered memo keys sorted by key. No keys are returned for a nil registry.
+func (r *MemoRegistry) MemoKeys() []MemoKey {
+	if r == nil {
+		return nil
+	}
+
+	memoKeys := make([]MemoKey, 0, len(r.keys))
+	for _, memoKey := range r.keys {
+		memoKeys = append(memoKeys, memoKey)
+	}
+
+	sort.Slice(memoKeys, func(i, j int) bool { return memoKeys[i].Key < memoKeys[j].Key })
+
+	return memoKeys
+}
+
+// RejectUnknownKeys returns true if received packets with a memo holding unregistered keys are rejected.
+func (r *MemoRegistry) RejectUnknownKeys() bool {
+	return r != nil && r.rejectUnknownKeys
+}
+
+// ValidateMemo validates the memo of a transfer against the registered memo keys. A memo which is a
+// JSON object must be well formed and the values of its registered keys must conform to their schemas.
+// Keys which are not registered are rejected if allowUnknownKeys is false and the registry was created
+// to reject unknown keys. No validation is performed by a nil registry.
+func (r *MemoRegistry) ValidateMemo(memo string, allowUnknownKeys bool) error {
+	if r == nil {
+		return nil
+	}
+
+	trimmed := strings.TrimSpace(memo)
+	if !strings.HasPrefix(trimmed, "{") {
+		return nil
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader([]byte(trimmed)))
+	var values map[string]json.RawMessage
+	if err := decoder.Decode(&values); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMemo, "memo is not a valid JSON object: %s", err)
+	}
+
+	if decoder.More() {
+		return errorsmod.Wrap(ErrInvalidMemo, "memo is not a valid JSON object: unexpected data after the object")
+	}
+
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		schema, found := r.schemas[key]
+		if !found {
+			if r.rejectUnknownKeys && !allowUnknownKeys {
+				return errorsmod.Wrapf(ErrInvalidMemo, "unknown memo key %s", key)
+			}
+			continue
+		}
+
+		if err := schema.ValidateValue(values[key]); err != nil {
+			return errorsmod.Wrapf(ErrInvalidMemo, "invalid value for memo key %s: %s", key, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,117 @@
+package types_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+const wasmMemoSchema = `{
+  "type": "object",
+  "properties": {
+    "contract": {"type": "string"},
+    "msg": {"type": "object"},
+    "funds": {"type": "array", "items": {"type": "object", "required": ["denom", "amount"]}}
+  },
+  "required": ["contract", "msg"]
+}`
+
+func TestParseMemoSchema(t *testing.T) {
+	testCases := []struct {
+		name   string
+		schema string
+		expErr bool
+	}{
+		{"forward memo schema", types.ForwardMemoSchema, false},
+		{"nested schema", wasmMemoSchema, false},
+		{"annotations are ignored", `{"$schema":"https://json-schema.org/draft/2020-12/schema","title":"memo","description":"a memo"}`, false},
+		{"invalid json", `{"type":`, true},
+		{"unsupported keyword", `{"type":"string","maxLength":10}`, true},
+		{"unsupported type", `{"type":"decimal"}`, true},
+		{"unsupported nested type", `{"type":"object","properties":{"amount":{"type":"decimal"}}}`, true},
+		{"invalid type keyword", `{"type":5}`, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := types.ParseMemoSchema(tc.schema)
+			if tc.expErr {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMemoRegistryRegisterMemoKey(t *testing.T) {
+	registry := types.NewMemoRegistry(false)
+
+	require.NoError(t, registry.RegisterMemoKey("wasm", "wasm", wasmMemoSchema))
+	require.ErrorIs(t, registry.RegisterMemoKey("other", "wasm", wasmMemoSchema), types.ErrInvalidMemo, "duplicate key")
+	require.ErrorIs(t, registry.RegisterMemoKey(types.ModuleName, types.ForwardMemoKey, types.ForwardMemoSchema), types.ErrInvalidMemo, "transfer keys are registered")
+	require.ErrorIs(t, registry.RegisterMemoKey("wasm", " ", wasmMemoSchema), types.ErrInvalidMemo, "empty key")
+	require.ErrorIs(t, registry.RegisterMemoKey("", "hooks", wasmMemoSchema), types.ErrInvalidMemo, "empty owner")
+	require.ErrorIs(t, registry.RegisterMemoKey("hooks", "hooks", `{"type":"decimal"}`), types.ErrInvalidMemo, "invalid schema")
+
+	require.Equal(t, []types.MemoKey{
+		{Key: types.ForwardMemoKey, Owner: types.ModuleName, Schema: types.ForwardMemoSchema},
+		{Key: "wasm", Owner: "wasm", Schema: wasmMemoSchema},
+	}, registry.MemoKeys())
+
+	var nilRegistry *types.MemoRegistry
+	require.Empty(t, nilRegistry.MemoKeys())
+	require.False(t, nilRegistry.RejectUnknownKeys())
+	require.NoError(t, nilRegistry.ValidateMemo("{malformed", false))
+}
+
+func TestMemoRegistryValidateMemo(t *testing.T) {
+	forwardMemo := fmt.Sprintf(`{"forward":{"receiver":"%s","port":"transfer","channel":"%s","timeout":"10m","retries":2,"next":{"wasm":{}}}}`, ibctesting.TestAccAddress, ibctesting.FirstChannelID)
+
+	testCases := []struct {
+		name              string
+		memo              string
+		rejectUnknownKeys bool
+		allowUnknownKeys  bool
+		expErr            error
+	}{
+		{"empty memo", "", true, false, nil},
+		{"plain text memo", "hello", true, false, nil},
+		{"valid forward memo", forwardMemo, true, false, nil},
+		{"forward timeout in nanoseconds", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","timeout":600000000000}}`, true, false, nil},
+		{"valid wasm memo", `{"wasm":{"contract":"cosmos1","msg":{},"funds":[{"denom":"uatom","amount":"1"}]}}`, true, false, nil},
+		{"unknown key allowed", `{"hooks":{}}`, false, false, nil},
+		{"unknown key allowed on send", `{"hooks":{}}`, true, true, nil},
+		{"unknown key rejected", `{"hooks":{}}`, true, false, types.ErrInvalidMemo},
+		{"malformed json object", `{"forward":`, false, true, types.ErrInvalidMemo},
+		{"trailing data", `{"wasm":{"contract":"cosmos1","msg":{}}} {}`, false, true, types.ErrInvalidMemo},
+		{"missing required property", `{"forward":{"port":"transfer","channel":"channel-0"}}`, false, true, types.ErrInvalidMemo},
+		{"unknown property", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","retry":1}}`, false, true, types.ErrInvalidMemo},
+		{"invalid property type", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","retries":"2"}}`, false, true, types.ErrInvalidMemo},
+		{"non integer number", `{"forward":{"receiver":"cosmos1","port":"transfer","channel":"channel-0","retries":1.5}}`, false, true, types.ErrInvalidMemo},
+		{"invalid value type", `{"wasm":"contract"}`, false, true, types.ErrInvalidMemo},
+		{"invalid array item", `{"wasm":{"contract":"cosmos1","msg":{},"funds":[{"denom":"uatom"}]}}`, false, true, types.ErrInvalidMemo},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			registry := types.NewMemoRegistry(tc.rejectUnknownKeys)
+			registry.MustRegisterMemoKey("wasm", "wasm", wasmMemoSchema)
+
+			err := registry.ValidateMemo(tc.memo, tc.allowUnknownKeys)
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
@@ -0,0 +1,186 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+)
+
+// memoSchemaTypes are the JSON types which may be used in the type keyword of a memo schema.
+var memoSchemaTypes = []string{"object", "array", "string", "number", "integer", "boolean", "null"}
+
+// MemoSchema defines a JSON schema the value held under a memo key must conform to. Only a subset
+// of the JSON schema keywords is supported: type, properties, required, additionalProperties and
+// items. The $schema, title and description annotations are accepted and ignored, any other
+// keyword is rejected when the schema is parsed.
+type MemoSchema struct {
+	Schema               string                 `json:"$schema,omitempty"`
+	Title                string                 `json:"title,omitempty"`
+	Description          string                 `json:"description,omitempty"`
+	Type                 memoSchemaType         `json:"type,omitempty"`
+	Properties           map[string]*MemoSchema `json:"properties,omitempty"`
+	Required             []string               `json:"required,omitempty"`
+	AdditionalProperties *bool                  `json:"additionalProperties,omitempty"`
+	Items                *MemoSchema            `json:"items,omitempty"`
+}
+
+// memoSchemaType holds the types allowed by the type keyword, which may be either a single type
+// or an array of types.
+type memoSchemaType []string
+
+// UnmarshalJSON implements json.Unmarshaler.
+func (t *memoSchemaType) UnmarshalJSON(bz []byte) error {
+	var single string
+	if err := json.Unmarshal(bz, &single); err == nil {
+		*t = memoSchemaType{single}
+		return nil
+	}
+
+	var multiple []string
+	if err := json.Unmarshal(bz, &multiple); err != nil {
+		return fmt.Errorf("type must be a string or an array of strings: %w", err)
+	}
+
+	*t = multiple
+	return nil
+}
+
+// ParseMemoSchema parses and validates the provided JSON schema.
+func ParseMemoSchema(schema string) (*MemoSchema, error) {
+	decoder := json.NewDecoder(strings.NewReader(schema))
+	decoder.DisallowUnknownFields()
+
+	var memoSchema MemoSchema
+	if err := decoder.Decode(&memoSchema); err != nil {
+		return nil, fmt.Errorf("invalid memo schema: %w", err)
+	}
+
+	if err := memoSchema.validate(); err != nil {
+		return nil, fmt.Errorf("invalid memo schema: %w", err)
+	}
+
+	return &memoSchema, nil
+}
+
+// validate checks that the types used by the schema and its subschemas are supported.
+func (s *MemoSchema) validate() error {
+	for _, t := range s.Type {
+		if !slices.Contains(memoSchemaTypes, t) {
+			return fmt.Errorf("unsupported type %q", t)
+		}
+	}
+
+	for name, property := range s.Properties {
+		if property == nil {
+			return fmt.Errorf("property %q has no schema", name)
+		}
+
+		if err := property.validate(); err != nil {
+			return fmt.Errorf("property %q: %w", name, err)
+		}
+	}
+
+	if s.Items != nil {
+		if err := s.Items.validate(); err != nil {
+			return fmt.Errorf("items: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// ValidateValue checks that the provided raw JSON value conforms to the schema.
+func (s *MemoSchema) ValidateValue(bz json.RawMessage) error {
+	decoder := json.NewDecoder(bytes.NewReader(bz))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return err
+	}
+
+	return s.validateValue("", value)
+}
+
+// validateValue checks that the decoded JSON value found at the provided path conforms to the schema.
+func (s *MemoSchema) validateValue(path string, value any) error {
+	if len(s.Type) > 0 && !slices.Contains(s.Type, jsonType(value)) {
+		// integers are numbers as well
+		if !(jsonType(value) == "integer" && slices.Contains(s.Type, "number")) {
+			return fmt.Errorf("%s: expected %s, got %s", pathOrRoot(path), strings.Join(s.Type, " or "), jsonType(value))
+		}
+	}
+
+	switch v := value.(type) {
+	case map[string]any:
+		for _, name := range s.Required {
+			if _, found := v[name]; !found {
+				return fmt.Errorf("%s: missing required property %q", pathOrRoot(path), name)
+			}
+		}
+
+		names := make([]string, 0, len(v))
+		for name := range v {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+
+		for _, name := range names {
+			property, found := s.Properties[name]
+			if !found {
+				if s.AdditionalProperties != nil && !*s.AdditionalProperties {
+					return fmt.Errorf("%s: unknown property %q", pathOrRoot(path), name)
+				}
+				continue
+			}
+
+			if err := property.validateValue(path+"."+name, v[name]); err != nil {
+				return err
+			}
+		}
+	case []any:
+		if s.Items == nil {
+			return nil
+		}
+
+		for i, item := range v {
+			if err := s.Items.validateValue(fmt.Sprintf("%s[%d]", path, i), item); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// jsonType returns the JSON schema type of a value decoded with json.Decoder.UseNumber.
+func jsonType(value any) string {
+	switch v := value.(type) {
+	case map[string]any:
+		return "object"
+	case []any:
+		return "array"
+	case string:
+		return "string"
+	case json.Number:
+		if _, err := v.Int64(); err == nil {
+			return "integer"
+		}
+		return "number"
+	case bool:
+		return "boolean"
+	default:
+		return "null"
+	}
+}
+
+// pathOrRoot returns the provided path, or a placeholder for the root of the value if it is empty.
+func pathOrRoot(path string) string {
+	if path == "" {
+		return "value"
+	}
+	return "value" + path
+}
@@ -16,6 +16,9 @@ const (
 	MaximumReceiverLength = 2048  // maximum length of the receiver address in bytes (value chosen arbitrarily)
 	MaximumMemoLength     = 32768 // maximum length of the memo in bytes (value chosen arbitrarily)
 	MaximumTokensLength   = 100   // maximum number of tokens that can be transferred in a single message (value chosen arbitrarily)
+
+	MaximumMultiTransferEntries  = 500 // maximum number of transfers in a single MsgMultiTransfer (value chosen arbitrarily)
+	MaximumDenomMigrationHolders = 100 // maximum number of holders whose vouchers are migrated by a single MsgMigrateDenomTrace (value chosen arbitrarily)
 )
 
 var (
@@ -23,6 +26,27 @@ var (
 	_ sdk.Msg              = (*MsgTransfer)(nil)
 	_ sdk.HasValidateBasic = (*MsgUpdateParams)(nil)
 	_ sdk.HasValidateBasic = (*MsgTransfer)(nil)
+
+	_ sdk.Msg              = (*MsgMultiTransfer)(nil)
+	_ sdk.HasValidateBasic = (*MsgMultiTransfer)(nil)
+
+	_ sdk.Msg              = (*MsgSetRateLimit)(nil)
+	_ sdk.Msg              = (*MsgRemoveRateLimit)(nil)
+	_ sdk.Msg              = (*MsgResetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveRateLimit)(nil)
+	_ sdk.HasValidateBasic = (*MsgResetRateLimit)(nil)
+
+	_ sdk.Msg              = (*MsgMigrateDenomTrace)(nil)
+	_ sdk.HasValidateBasic = (*MsgMigrateDenomTrace)(nil)
+
+	_ sdk.Msg              = (*MsgSetCounterpartyAddressFormat)(nil)
+	_ sdk.Msg              = (*MsgRemoveCounterpartyAddressFormat)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetCounterpartyAddressFormat)(nil)
+	_ sdk.HasValidateBasic = (*MsgRemoveCounterpartyAddressFormat)(nil)
+
+	_ sdk.Msg              = (*MsgSetDenomMetadata)(nil)
+	_ sdk.HasValidateBasic = (*MsgSetDenomMetadata)(nil)
 )
 
 // NewMsgUpdateParams creates a new MsgUpdateParams instance
@@ -40,7 +64,7 @@ func (msg MsgUpdateParams) ValidateBasic() error {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
 	}
 
-	return nil
+	return msg.Params.Validate()
 }
 
 // NewMsgTransfer creates a new MsgTransfer instance
@@ -77,6 +101,10 @@ func (msg MsgTransfer) ValidateBasic() error {
 		return err
 	}
 
+	if err := msg.validateRelativeTimeout(); err != nil {
+		return err
+	}
+
 	if len(msg.Tokens) == 0 && !isValidIBCCoin(msg.Token) {
 		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "either token or token array must be filled")
 	}
@@ -99,6 +127,8 @@ func (msg MsgTransfer) ValidateBasic() error {
 	if len(msg.Receiver) > MaximumReceiverLength {
 		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "recipient address must not exceed %d bytes", MaximumReceiverLength)
 	}
+	// NOTE: the content of the memo is validated against the memo keys registered on the chain by
+	// the transfer keeper when the transfer is sent, since the registry is set at app wiring time.
 	if len(msg.Memo) > MaximumMemoLength {
 		return errorsmod.Wrapf(ErrInvalidMemo, "memo must not exceed %d bytes", MaximumMemoLength)
 	}
@@ -127,6 +157,28 @@ func (msg MsgTransfer) validateForwarding() error {
 		return errorsmod.Wrapf(ErrInvalidPacketTimeout, "timeout height must be zero if forwarding path hops is not empty: %s, %s", msg.TimeoutHeight, msg.Forwarding.GetHops())
 	}
 
+	if msg.RelativeTimeout.GetHeightOffset() != 0 {
+		// when forwarding, the relative timeout height must not be set either
+		return errorsmod.Wrapf(ErrInvalidPacketTimeout, "relative timeout height offset must be zero if forwarding path hops is not empty: %d, %s", msg.RelativeTimeout.GetHeightOffset(), msg.Forwarding.GetHops())
+	}
+
+	return nil
+}
+
+// validateRelativeTimeout ensures that the relative timeout, if set, is valid and not combined with absolute timeouts.
+func (msg MsgTransfer) validateRelativeTimeout() error {
+	if msg.RelativeTimeout == nil {
+		return nil
+	}
+
+	if err := msg.RelativeTimeout.Validate(); err != nil {
+		return err
+	}
+
+	if !msg.TimeoutHeight.IsZero() || msg.TimeoutTimestamp != 0 {
+		return errorsmod.Wrapf(ErrInvalidPacketTimeout, "timeout height and timestamp must be zero if a relative timeout is set: %s, %d", msg.TimeoutHeight, msg.TimeoutTimestamp)
+	}
+
 	return nil
 }
 
@@ -199,3 +251,230 @@ func validateIBCCoin(coin sdk.Coin) error {
 
 	return nil
 }
+
+// NewTransferEntry creates a new TransferEntry instance
+func NewTransferEntry(sourcePort, sourceChannel, receiver string, tokens sdk.Coins, memo string) TransferEntry {
+	return TransferEntry{
+		SourcePort:    sourcePort,
+		SourceChannel: sourceChannel,
+		Receiver:      receiver,
+		Tokens:        tokens,
+		Memo:          memo,
+	}
+}
+
+// NewMsgMultiTransfer creates a new MsgMultiTransfer instance
+func NewMsgMultiTransfer(
+	sender string,
+	transfers []TransferEntry,
+	timeoutHeight clienttypes.Height, timeoutTimestamp uint64,
+	relativeTimeout *RelativeTimeout,
+) *MsgMultiTransfer {
+	return &MsgMultiTransfer{
+		Sender:           sender,
+		Transfers:        transfers,
+		TimeoutHeight:    timeoutHeight,
+		TimeoutTimestamp: timeoutTimestamp,
+		RelativeTimeout:  relativeTimeout,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgMultiTransfer fields. Each transfer
+// must pass the validation of the MsgTransfer it corresponds to.
+func (msg MsgMultiTransfer) ValidateBasic() error {
+	if len(msg.Transfers) == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidRequest, "transfers cannot be empty")
+	}
+
+	if len(msg.Transfers) > MaximumMultiTransferEntries {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of transfers must not exceed %d", MaximumMultiTransferEntries)
+	}
+
+	for i, msgTransfer := range msg.MsgTransfers() {
+		if err := msgTransfer.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid transfer %d", i)
+		}
+	}
+
+	return nil
+}
+
+// MsgTransfers returns the MsgTransfer corresponding to each transfer of the MsgMultiTransfer,
+// in order. The timeouts of the MsgMultiTransfer are applied to all of them.
+func (msg MsgMultiTransfer) MsgTransfers() []*MsgTransfer {
+	msgTransfers := make([]*MsgTransfer, 0, len(msg.Transfers))
+	for _, transfer := range msg.Transfers {
+		msgTransfer := NewMsgTransfer(
+			transfer.SourcePort, transfer.SourceChannel, transfer.Tokens, msg.Sender, transfer.Receiver,
+			msg.TimeoutHeight, msg.TimeoutTimestamp, transfer.Memo, nil,
+		)
+		msgTransfer.RelativeTimeout = msg.RelativeTimeout
+
+		msgTransfers = append(msgTransfers, msgTransfer)
+	}
+
+	return msgTransfers
+}
+
+// NewMsgSetRateLimit creates a new MsgSetRateLimit instance
+func NewMsgSetRateLimit(signer, portID, channelID, denom string, quota Quota) *MsgSetRateLimit {
+	return &MsgSetRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetRateLimit fields.
+func (msg MsgSetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := ValidateRateLimitIdentifiers(msg.PortId, msg.ChannelId, msg.Denom); err != nil {
+		return err
+	}
+
+	return msg.Quota.Validate()
+}
+
+// NewMsgRemoveRateLimit creates a new MsgRemoveRateLimit instance
+func NewMsgRemoveRateLimit(signer, portID, channelID, denom string) *MsgRemoveRateLimit {
+	return &MsgRemoveRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRemoveRateLimit fields.
+func (msg MsgRemoveRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateRateLimitIdentifiers(msg.PortId, msg.ChannelId, msg.Denom)
+}
+
+// NewMsgResetRateLimit creates a new MsgResetRateLimit instance
+func NewMsgResetRateLimit(signer, portID, channelID, denom string) *MsgResetRateLimit {
+	return &MsgResetRateLimit{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgResetRateLimit fields.
+func (msg MsgResetRateLimit) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return ValidateRateLimitIdentifiers(msg.PortId, msg.ChannelId, msg.Denom)
+}
+
+// NewMsgMigrateDenomTrace creates a new MsgMigrateDenomTrace instance
+func NewMsgMigrateDenomTrace(signer string, oldDenom, newDenom Denom, holders ...string) *MsgMigrateDenomTrace {
+	return &MsgMigrateDenomTrace{
+		Signer:   signer,
+		OldDenom: oldDenom,
+		NewDenom: newDenom,
+		Holders:  holders,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgMigrateDenomTrace fields.
+func (msg MsgMigrateDenomTrace) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := NewDenomMigration(msg.OldDenom, msg.NewDenom).Validate(); err != nil {
+		return err
+	}
+
+	if len(msg.Holders) > MaximumDenomMigrationHolders {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "number of holders must not exceed %d", MaximumDenomMigrationHolders)
+	}
+
+	seenHolders := make(map[string]bool, len(msg.Holders))
+	for _, holder := range msg.Holders {
+		if _, err := sdk.AccAddressFromBech32(holder); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid holder address %s: %v", holder, err)
+		}
+
+		if seenHolders[holder] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate holder %s", holder)
+		}
+		seenHolders[holder] = true
+	}
+
+	return nil
+}
+
+// NewMsgSetCounterpartyAddressFormat creates a new MsgSetCounterpartyAddressFormat instance
+func NewMsgSetCounterpartyAddressFormat(signer, portID, channelID string, addressFormat AddressFormat) *MsgSetCounterpartyAddressFormat {
+	return &MsgSetCounterpartyAddressFormat{
+		Signer:        signer,
+		PortId:        portID,
+		ChannelId:     channelID,
+		AddressFormat: addressFormat,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetCounterpartyAddressFormat fields.
+func (msg MsgSetCounterpartyAddressFormat) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return NewCounterpartyAddressFormat(msg.PortId, msg.ChannelId, msg.AddressFormat).Validate()
+}
+
+// NewMsgRemoveCounterpartyAddressFormat creates a new MsgRemoveCounterpartyAddressFormat instance
+func NewMsgRemoveCounterpartyAddressFormat(signer, portID, channelID string) *MsgRemoveCounterpartyAddressFormat {
+	return &MsgRemoveCounterpartyAddressFormat{
+		Signer:    signer,
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgRemoveCounterpartyAddressFormat fields.
+func (msg MsgRemoveCounterpartyAddressFormat) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return err
+	}
+
+	return host.ChannelIdentifierValidator(msg.ChannelId)
+}
+
+// NewMsgSetDenomMetadata creates a new MsgSetDenomMetadata instance
+func NewMsgSetDenomMetadata(signer string, overrides ...DenomMetadataOverride) *MsgSetDenomMetadata {
+	return &MsgSetDenomMetadata{
+		Signer:    signer,
+		Overrides: overrides,
+	}
+}
+
+// ValidateBasic performs a basic check of the MsgSetDenomMetadata fields.
+func (msg MsgSetDenomMetadata) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Signer); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Overrides) == 0 {
+		return errorsmod.Wrap(ErrInvalidDenomMetadata, "overrides cannot be empty")
+	}
+
+	return ValidateDenomMetadataOverrides(msg.Overrides)
+}
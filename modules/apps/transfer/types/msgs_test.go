@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -81,7 +82,7 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"multidenom: invalid ibc denom", types.NewMsgTransfer(validPort, validChannel, coins.Add(invalidIBCCoins...), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: zero coins", types.NewMsgTransfer(validPort, validChannel, zeroCoins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
 		{"multidenom: too many coins", types.NewMsgTransfer(validPort, validChannel, make([]sdk.Coin, types.MaximumTokensLength+1), sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), ibcerrors.ErrInvalidCoins},
-		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil}, ibcerrors.ErrInvalidCoins},
+		{"multidenom: both token and tokens are set", &types.MsgTransfer{validPort, validChannel, coin, sender, receiver, clienttypes.ZeroHeight(), 100, "", coins, nil, nil}, ibcerrors.ErrInvalidCoins},
 		{"timeout height must be zero if forwarding path hops is not empty", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, timeoutHeight, 100, "memo", types.NewForwarding(false, validHop)), types.ErrInvalidPacketTimeout},
 		{"invalid forwarding info port", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(invalidPort, validChannel))), types.ErrInvalidForwarding},
 		{"invalid forwarding info channel", types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, types.NewHop(validPort, invalidChannel))), types.ErrInvalidForwarding},
@@ -90,6 +91,12 @@ func TestMsgTransferValidation(t *testing.T) {
 		{"invalid channelID when forwarding is set but unwind is not", types.NewMsgTransfer(validPort, "", coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(false, validHop)), host.ErrInvalidID},
 		{"unwind specified but source port is not empty", types.NewMsgTransfer(validPort, "", sdk.NewCoins(coin), sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(true)), types.ErrInvalidForwarding},
 		{"unwind specified but source channel is not empty", types.NewMsgTransfer("", validChannel, sdk.NewCoins(coin), sender, receiver, clienttypes.ZeroHeight(), 100, "", types.NewForwarding(true)), types.ErrInvalidForwarding},
+		{"valid msg with relative timeout", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 0, "", nil), types.NewRelativeTimeout(10, 100)), nil},
+		{"valid msg with relative timeout timestamp when forwarding", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 0, "", types.NewForwarding(false, validHop)), types.NewRelativeTimeout(0, 100)), nil},
+		{"relative timeout offsets are both zero", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 0, "", nil), types.NewRelativeTimeout(0, 0)), types.ErrInvalidPacketTimeout},
+		{"relative timeout with absolute timeout height", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, timeoutHeight, 0, "", nil), types.NewRelativeTimeout(10, 0)), types.ErrInvalidPacketTimeout},
+		{"relative timeout with absolute timeout timestamp", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 100, "", nil), types.NewRelativeTimeout(0, 100)), types.ErrInvalidPacketTimeout},
+		{"relative timeout height offset must be zero if forwarding path hops is not empty", withRelativeTimeout(types.NewMsgTransfer(validPort, validChannel, coins, sender, receiver, clienttypes.ZeroHeight(), 0, "", types.NewForwarding(false, validHop)), types.NewRelativeTimeout(10, 100)), types.ErrInvalidPacketTimeout},
 	}
 
 	for _, tc := range testCases {
@@ -106,6 +113,45 @@ func TestMsgTransferValidation(t *testing.T) {
 	}
 }
 
+// TestMsgMultiTransferValidation tests ValidateBasic for MsgMultiTransfer
+func TestMsgMultiTransferValidation(t *testing.T) {
+	validTransfer := types.NewTransferEntry(validPort, validChannel, receiver, coins, "")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgMultiTransfer
+		expError error
+	}{
+		{"valid msg", types.NewMsgMultiTransfer(sender, []types.TransferEntry{validTransfer, types.NewTransferEntry(validPort, "channel-1", receiver, ibcCoins, "memo")}, clienttypes.ZeroHeight(), 100, nil), nil},
+		{"valid msg with relative timeout", types.NewMsgMultiTransfer(sender, []types.TransferEntry{validTransfer}, clienttypes.ZeroHeight(), 0, types.NewRelativeTimeout(10, 0)), nil},
+		{"empty transfers", types.NewMsgMultiTransfer(sender, nil, clienttypes.ZeroHeight(), 100, nil), ibcerrors.ErrInvalidRequest},
+		{"too many transfers", types.NewMsgMultiTransfer(sender, make([]types.TransferEntry, types.MaximumMultiTransferEntries+1), clienttypes.ZeroHeight(), 100, nil), ibcerrors.ErrInvalidRequest},
+		{"missing sender address", types.NewMsgMultiTransfer(emptyAddr, []types.TransferEntry{validTransfer}, clienttypes.ZeroHeight(), 100, nil), ibcerrors.ErrInvalidAddress},
+		{"invalid channel id of a transfer", types.NewMsgMultiTransfer(sender, []types.TransferEntry{validTransfer, types.NewTransferEntry(validPort, invalidChannel, receiver, coins, "")}, clienttypes.ZeroHeight(), 100, nil), host.ErrInvalidID},
+		{"missing recipient address of a transfer", types.NewMsgMultiTransfer(sender, []types.TransferEntry{types.NewTransferEntry(validPort, validChannel, "", coins, "")}, clienttypes.ZeroHeight(), 100, nil), ibcerrors.ErrInvalidAddress},
+		{"relative timeout with absolute timeout timestamp", types.NewMsgMultiTransfer(sender, []types.TransferEntry{validTransfer}, clienttypes.ZeroHeight(), 100, types.NewRelativeTimeout(10, 0)), types.ErrInvalidPacketTimeout},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+// withRelativeTimeout sets the relative timeout of the provided MsgTransfer and returns it.
+func withRelativeTimeout(msg *types.MsgTransfer, relativeTimeout *types.RelativeTimeout) *types.MsgTransfer {
+	msg.RelativeTimeout = relativeTimeout
+	return msg
+}
+
 // TestMsgTransferGetSigners tests GetSigners for MsgTransfer
 func TestMsgTransferGetSigners(t *testing.T) {
 	addr := sdk.AccAddress(secp256k1.GenPrivKey().PubKey().Address())
@@ -173,3 +219,132 @@ func TestMsgUpdateParamsGetSigners(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgRateLimitValidateBasic(t *testing.T) {
+	quota := types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour)
+
+	testCases := []struct {
+		name     string
+		msg      sdk.HasValidateBasic
+		expError error
+	}{
+		{"success: set rate limit", types.NewMsgSetRateLimit(ibctesting.TestAccAddress, validPort, validChannel, coin.Denom, quota), nil},
+		{"success: remove rate limit", types.NewMsgRemoveRateLimit(ibctesting.TestAccAddress, validPort, validChannel, coin.Denom), nil},
+		{"success: reset rate limit", types.NewMsgResetRateLimit(ibctesting.TestAccAddress, validPort, validChannel, coin.Denom), nil},
+		{"failure: set rate limit with invalid signer", types.NewMsgSetRateLimit(invalidAddress, validPort, validChannel, coin.Denom, quota), ibcerrors.ErrInvalidAddress},
+		{"failure: set rate limit with invalid port", types.NewMsgSetRateLimit(ibctesting.TestAccAddress, invalidPort, validChannel, coin.Denom, quota), host.ErrInvalidID},
+		{"failure: set rate limit with invalid quota", types.NewMsgSetRateLimit(ibctesting.TestAccAddress, validPort, validChannel, coin.Denom, types.Quota{}), types.ErrInvalidRateLimit},
+		{"failure: remove rate limit with empty signer", types.NewMsgRemoveRateLimit(emptyAddr, validPort, validChannel, coin.Denom), ibcerrors.ErrInvalidAddress},
+		{"failure: remove rate limit with invalid channel", types.NewMsgRemoveRateLimit(ibctesting.TestAccAddress, validPort, invalidChannel, coin.Denom), host.ErrInvalidID},
+		{"failure: reset rate limit with invalid denom", types.NewMsgResetRateLimit(ibctesting.TestAccAddress, validPort, validChannel, invalidDenomCoins[0].Denom), types.ErrInvalidRateLimit},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgMigrateDenomTraceValidateBasic(t *testing.T) {
+	oldDenom := types.NewDenom("uatom", types.NewHop(types.PortID, "channel-0"))
+	newDenom := types.NewDenom("uatom", types.NewHop(types.PortID, "channel-1"))
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgMigrateDenomTrace
+		expError error
+	}{
+		{"success: all holders", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, newDenom), nil},
+		{"success: provided holders", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, newDenom, sender, receiver), nil},
+		{"failure: invalid signer", types.NewMsgMigrateDenomTrace(invalidAddress, oldDenom, newDenom), ibcerrors.ErrInvalidAddress},
+		{"failure: invalid old denom", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, types.NewDenom(""), newDenom), types.ErrInvalidDenomForTransfer},
+		{"failure: invalid new denom trace", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, types.NewDenom("uatom", types.NewHop(types.PortID, invalidChannel))), host.ErrInvalidID},
+		{"failure: native new denom", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, types.NewDenom("uatom")), types.ErrInvalidDenomForTransfer},
+		{"failure: different base denoms", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, types.NewDenom("uosmo", types.NewHop(types.PortID, "channel-1"))), types.ErrInvalidDenomForTransfer},
+		{"failure: same traces", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, oldDenom), types.ErrInvalidDenomForTransfer},
+		{"failure: invalid holder", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, newDenom, invalidAddress), ibcerrors.ErrInvalidAddress},
+		{"failure: duplicate holder", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, newDenom, sender, sender), ibcerrors.ErrInvalidRequest},
+		{"failure: too many holders", types.NewMsgMigrateDenomTrace(ibctesting.TestAccAddress, oldDenom, newDenom, make([]string, types.MaximumDenomMigrationHolders+1)...), ibcerrors.ErrInvalidRequest},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgCounterpartyAddressFormatValidateBasic(t *testing.T) {
+	addressFormat := types.NewBech32AddressFormat("osmo")
+
+	testCases := []struct {
+		name     string
+		msg      sdk.HasValidateBasic
+		expError error
+	}{
+		{"success: set address format", types.NewMsgSetCounterpartyAddressFormat(ibctesting.TestAccAddress, validPort, validChannel, addressFormat), nil},
+		{"success: remove address format", types.NewMsgRemoveCounterpartyAddressFormat(ibctesting.TestAccAddress, validPort, validChannel), nil},
+		{"failure: set address format with invalid signer", types.NewMsgSetCounterpartyAddressFormat(invalidAddress, validPort, validChannel, addressFormat), ibcerrors.ErrInvalidAddress},
+		{"failure: set address format with invalid port", types.NewMsgSetCounterpartyAddressFormat(ibctesting.TestAccAddress, invalidPort, validChannel, addressFormat), host.ErrInvalidID},
+		{"failure: set address format with empty address format", types.NewMsgSetCounterpartyAddressFormat(ibctesting.TestAccAddress, validPort, validChannel, types.AddressFormat{}), types.ErrInvalidAddressFormat},
+		{"failure: remove address format with empty signer", types.NewMsgRemoveCounterpartyAddressFormat(emptyAddr, validPort, validChannel), ibcerrors.ErrInvalidAddress},
+		{"failure: remove address format with invalid channel", types.NewMsgRemoveCounterpartyAddressFormat(ibctesting.TestAccAddress, validPort, invalidChannel), host.ErrInvalidID},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestMsgSetDenomMetadataValidateBasic(t *testing.T) {
+	override := types.NewDenomMetadataOverride(types.NewDenom("uatom", types.NewHop(types.PortID, "channel-0")).IBCDenom(), "Cosmos Hub Atom", "ATOM", "atom", 6, "")
+
+	testCases := []struct {
+		name     string
+		msg      *types.MsgSetDenomMetadata
+		expError error
+	}{
+		{"success", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, override), nil},
+		{"failure: invalid signer", types.NewMsgSetDenomMetadata(invalidAddress, override), ibcerrors.ErrInvalidAddress},
+		{"failure: no overrides", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress), types.ErrInvalidDenomMetadata},
+		{"failure: invalid override", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, types.DenomMetadataOverride{Denom: override.Denom}), types.ErrInvalidDenomMetadata},
+		{"failure: duplicate override", types.NewMsgSetDenomMetadata(ibctesting.TestAccAddress, override, override), types.ErrInvalidDenomMetadata},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
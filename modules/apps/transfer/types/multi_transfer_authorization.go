@@ -0,0 +1,72 @@
+package types
+
+import (
+	"context"
+	"slices"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+var _ authz.Authorization = (*MultiTransferAuthorization)(nil)
+
+// NewMultiTransferAuthorization creates a new MultiTransferAuthorization object.
+func NewMultiTransferAuthorization(allocations ...Allocation) *MultiTransferAuthorization {
+	return &MultiTransferAuthorization{
+		Allocations: allocations,
+	}
+}
+
+// MsgTypeURL implements Authorization.MsgTypeURL.
+func (MultiTransferAuthorization) MsgTypeURL() string {
+	return sdk.MsgTypeURL(&MsgMultiTransfer{})
+}
+
+// Accept implements Authorization.Accept. Each transfer of the MsgMultiTransfer is accepted in order
+// as a MsgTransfer by a TransferAuthorization holding the allocations left by the previous transfers.
+func (a MultiTransferAuthorization) Accept(ctx context.Context, msg proto.Message) (authz.AcceptResponse, error) {
+	msgMultiTransfer, ok := msg.(*MsgMultiTransfer)
+	if !ok {
+		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidType, "type mismatch")
+	}
+
+	// the allocations are cloned as they are updated in place when accepting each transfer
+	transferAuthz := NewTransferAuthorization(slices.Clone(a.Allocations)...)
+	for i, msgTransfer := range msgMultiTransfer.MsgTransfers() {
+		res, err := transferAuthz.Accept(ctx, msgTransfer)
+		if err != nil {
+			return authz.AcceptResponse{}, errorsmod.Wrapf(err, "transfer %d", i)
+		}
+
+		switch {
+		case res.Delete:
+			transferAuthz.Allocations = nil
+		case res.Updated != nil:
+			updated, ok := res.Updated.(*TransferAuthorization)
+			if !ok {
+				return authz.AcceptResponse{}, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "expected %T, got %T", &TransferAuthorization{}, res.Updated)
+			}
+
+			transferAuthz = updated
+		}
+	}
+
+	if len(transferAuthz.Allocations) == 0 {
+		return authz.AcceptResponse{Accept: true, Delete: true}, nil
+	}
+
+	return authz.AcceptResponse{Accept: true, Delete: false, Updated: &MultiTransferAuthorization{
+		Allocations: transferAuthz.Allocations,
+	}}, nil
+}
+
+// ValidateBasic implements Authorization.ValidateBasic.
+func (a MultiTransferAuthorization) ValidateBasic() error {
+	return NewTransferAuthorization(a.Allocations...).ValidateBasic()
+}
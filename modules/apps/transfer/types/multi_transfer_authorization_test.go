@@ -0,0 +1,156 @@
+package types_test
+
+import (
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func (suite *TypesTestSuite) TestMultiTransferAuthorizationAccept() {
+	var (
+		msgMultiTransfer   *types.MsgMultiTransfer
+		multiTransferAuthz types.MultiTransferAuthorization
+		halfCoin           = sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))
+	)
+
+	testCases := []struct {
+		name         string
+		malleate     func()
+		assertResult func(res authz.AcceptResponse, err error)
+	}{
+		{
+			"success: spend limit is spent by the transfers",
+			func() {},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+				suite.Require().Nil(res.Updated)
+			},
+		},
+		{
+			"success: spend limit updated",
+			func() {
+				msgMultiTransfer.Transfers = msgMultiTransfer.Transfers[:1]
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.MultiTransferAuthorization)
+				suite.Require().True(ok)
+
+				suite.Require().Equal(sdk.NewCoins(halfCoin), updatedAuthz.Allocations[0].SpendLimit)
+			},
+		},
+		{
+			"success: allocations of multiple channels",
+			func() {
+				multiTransferAuthz.Allocations = append(multiTransferAuthz.Allocations, types.Allocation{
+					SourcePort:    ibctesting.TransferPort,
+					SourceChannel: "channel-1",
+					SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+				})
+
+				msgMultiTransfer.Transfers[1].SourceChannel = "channel-1"
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.MultiTransferAuthorization)
+				suite.Require().True(ok)
+
+				suite.Require().Len(updatedAuthz.Allocations, 2)
+				suite.Require().Equal(sdk.NewCoins(halfCoin), updatedAuthz.Allocations[0].SpendLimit)
+				suite.Require().Equal(sdk.NewCoins(halfCoin), updatedAuthz.Allocations[1].SpendLimit)
+			},
+		},
+		{
+			"failure: transfers exceed the spend limit together",
+			func() {
+				msgMultiTransfer.Transfers = append(msgMultiTransfer.Transfers, msgMultiTransfer.Transfers[0])
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: no allocation for the channel of a transfer",
+			func() {
+				msgMultiTransfer.Transfers[1].SourceChannel = "channel-1"
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrNotFound)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: receiver of a transfer is not allowed",
+			func() {
+				msgMultiTransfer.Transfers[1].Receiver = suite.chainB.SenderAccount.GetAddress().String()
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: spend limit of a transfer is exceeded",
+			func() {
+				msgMultiTransfer.Transfers[1].Tokens = sdk.NewCoins(ibctesting.TestCoin)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewTransferPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			multiTransferAuthz = *types.NewMultiTransferAuthorization(types.Allocation{
+				SourcePort:    path.EndpointA.ChannelConfig.PortID,
+				SourceChannel: path.EndpointA.ChannelID,
+				SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+				AllowList:     []string{ibctesting.TestAccAddress},
+			})
+
+			transfer := types.NewTransferEntry(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, ibctesting.TestAccAddress, sdk.NewCoins(halfCoin), "")
+			msgMultiTransfer = types.NewMsgMultiTransfer(
+				suite.chainA.SenderAccount.GetAddress().String(),
+				[]types.TransferEntry{transfer, transfer},
+				suite.chainB.GetTimeoutHeight(), 0,
+				nil,
+			)
+
+			tc.malleate()
+
+			res, err := multiTransferAuthz.Accept(suite.chainA.GetContext(), msgMultiTransfer)
+			tc.assertResult(res, err)
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMultiTransferAuthorizationMsgTypeURL() {
+	var multiTransferAuthz types.MultiTransferAuthorization
+	suite.Require().Equal(sdk.MsgTypeURL(&types.MsgMultiTransfer{}), multiTransferAuthz.MsgTypeURL(), "invalid type url for multi transfer authorization")
+}
@@ -199,6 +199,9 @@ type ForwardingPacketData struct {
 	DestinationMemo string `protobuf:"bytes,1,opt,name=destination_memo,json=destinationMemo,proto3" json:"destination_memo,omitempty"`
 	// optional intermediate path through which packet will be forwarded.
 	Hops []Hop `protobuf:"bytes,2,rep,name=hops,proto3" json:"hops"`
+	// optional receivers on the chains of the intermediate hops, which receive the tokens if they cannot be
+	// forwarded any further than that chain.
+	FallbackReceivers []string `protobuf:"bytes,3,rep,name=fallback_receivers,json=fallbackReceivers,proto3" json:"fallback_receivers,omitempty"`
 }
 
 func (m *ForwardingPacketData) Reset()         { *m = ForwardingPacketData{} }
@@ -248,10 +251,93 @@ func (m *ForwardingPacketData) GetHops() []Hop {
 	return nil
 }
 
+func (m *ForwardingPacketData) GetFallbackReceivers() []string {
+	if m != nil {
+		return m.FallbackReceivers
+	}
+	return nil
+}
+
+// ForwardingFallback defines the result of the acknowledgement written for a packet whose tokens could not
+// be forwarded any further than an intermediate chain, and were delivered to the fallback receiver on that
+// chain instead.
+type ForwardingFallback struct {
+	// the fallback receiver on the intermediate chain which received the tokens
+	FallbackReceiver string `protobuf:"bytes,1,opt,name=fallback_receiver,json=fallbackReceiver,proto3" json:"fallback_receiver,omitempty"`
+	// the port identifier of the hop over which the tokens could not be forwarded
+	PortId string `protobuf:"bytes,2,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// the channel identifier of the hop over which the tokens could not be forwarded
+	ChannelId string `protobuf:"bytes,3,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// the reason for which the tokens could not be forwarded
+	Error string `protobuf:"bytes,4,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ForwardingFallback) Reset()         { *m = ForwardingFallback{} }
+func (m *ForwardingFallback) String() string { return proto.CompactTextString(m) }
+func (*ForwardingFallback) ProtoMessage()    {}
+func (*ForwardingFallback) Descriptor() ([]byte, []int) {
+	return fileDescriptor_653ca2ce9a5ca313, []int{3}
+}
+func (m *ForwardingFallback) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ForwardingFallback) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ForwardingFallback.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ForwardingFallback) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardingFallback.Merge(m, src)
+}
+func (m *ForwardingFallback) XXX_Size() int {
+	return m.Size()
+}
+func (m *ForwardingFallback) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardingFallback.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ForwardingFallback proto.InternalMessageInfo
+
+func (m *ForwardingFallback) GetFallbackReceiver() string {
+	if m != nil {
+		return m.FallbackReceiver
+	}
+	return ""
+}
+
+func (m *ForwardingFallback) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ForwardingFallback) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ForwardingFallback) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*FungibleTokenPacketData)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketData")
 	proto.RegisterType((*FungibleTokenPacketDataV2)(nil), "ibc.applications.transfer.v2.FungibleTokenPacketDataV2")
 	proto.RegisterType((*ForwardingPacketData)(nil), "ibc.applications.transfer.v2.ForwardingPacketData")
+	proto.RegisterType((*ForwardingFallback)(nil), "ibc.applications.transfer.v2.ForwardingFallback")
 }
 
 func init() {
@@ -259,34 +345,39 @@ func init() {
 }
 
 var fileDescriptor_653ca2ce9a5ca313 = []byte{
-	// 418 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x92, 0x41, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x93, 0x34, 0x5b, 0x74, 0xf6, 0xa0, 0x0c, 0x45, 0x63, 0x91, 0xb8, 0xd6, 0x4b, 0x17,
-	0x71, 0x86, 0x8d, 0x07, 0x11, 0x4f, 0x2e, 0xb2, 0x78, 0x11, 0x74, 0x11, 0x11, 0x2f, 0x32, 0x99,
-	0xcc, 0x66, 0x87, 0x36, 0xf3, 0xc2, 0xcc, 0x24, 0xe2, 0x45, 0xfc, 0x06, 0xfa, 0xb1, 0x7a, 0xec,
-	0xd1, 0x93, 0x48, 0xfb, 0x45, 0x24, 0x93, 0xd8, 0xe6, 0x60, 0x73, 0x7b, 0xef, 0x9f, 0xff, 0xfb,
-	0xf3, 0x9b, 0x97, 0x87, 0x4e, 0x65, 0xca, 0x29, 0x2b, 0xcb, 0xa5, 0xe4, 0xcc, 0x4a, 0x50, 0x86,
-	0x5a, 0xcd, 0x94, 0xb9, 0x12, 0x9a, 0xd6, 0x09, 0x2d, 0x19, 0x5f, 0x08, 0x4b, 0x4a, 0x0d, 0x16,
-	0xf0, 0x7d, 0x99, 0x72, 0xd2, 0xb7, 0x92, 0x7f, 0x56, 0x52, 0x27, 0xd3, 0xf9, 0x60, 0x90, 0x85,
-	0x85, 0x50, 0x6d, 0xce, 0x74, 0x92, 0x43, 0x0e, 0xae, 0xa4, 0x4d, 0xd5, 0xa9, 0x8f, 0x07, 0xe6,
-	0xcf, 0x76, 0x75, 0x6b, 0x9e, 0xfd, 0xf0, 0xd1, 0xdd, 0x8b, 0x4a, 0xe5, 0x32, 0x5d, 0x8a, 0xf7,
-	0x4d, 0xf4, 0x5b, 0x07, 0xfa, 0x8a, 0x59, 0x86, 0x27, 0xe8, 0x28, 0x13, 0x0a, 0x8a, 0xc8, 0x3f,
-	0xf1, 0xe7, 0x37, 0x2f, 0xdb, 0x06, 0xdf, 0x41, 0x63, 0x56, 0x40, 0xa5, 0x6c, 0x14, 0x38, 0xb9,
-	0xeb, 0x1a, 0xdd, 0x08, 0x95, 0x09, 0x1d, 0x8d, 0x5a, 0xbd, 0xed, 0xf0, 0x14, 0xdd, 0xd0, 0x82,
-	0x0b, 0x59, 0x0b, 0x1d, 0x85, 0xee, 0xcb, 0xae, 0xc7, 0x18, 0x85, 0x85, 0x28, 0x20, 0x3a, 0x72,
-	0xba, 0xab, 0x67, 0xdf, 0x03, 0x74, 0xef, 0x00, 0xd1, 0x87, 0x04, 0xbf, 0x44, 0x63, 0xb7, 0x01,
-	0x13, 0xf9, 0x27, 0xa3, 0xf9, 0x71, 0xf2, 0x88, 0x0c, 0xed, 0x92, 0xb8, 0x80, 0xf3, 0x70, 0xf5,
-	0xfb, 0x81, 0x77, 0xd9, 0x0d, 0xf6, 0x40, 0x83, 0x83, 0xa0, 0xa3, 0x03, 0xa0, 0xe1, 0x1e, 0x14,
-	0x7f, 0x44, 0xe8, 0x0a, 0xf4, 0x17, 0xa6, 0x33, 0xa9, 0x72, 0xf7, 0x84, 0xe3, 0x24, 0x19, 0xc6,
-	0xb9, 0xd8, 0xf9, 0xf7, 0x8f, 0xea, 0xe8, 0x7a, 0x59, 0xb3, 0x6f, 0x68, 0xf2, 0x3f, 0x27, 0x3e,
-	0x45, 0xb7, 0x33, 0x61, 0xac, 0x54, 0x2e, 0xfa, 0xb3, 0x23, 0x6a, 0xff, 0xcd, 0xad, 0x9e, 0xfe,
-	0xa6, 0x81, 0x7b, 0x81, 0xc2, 0x6b, 0x28, 0x4d, 0x14, 0xb8, 0x2d, 0x3d, 0x1c, 0xc2, 0x3a, 0x23,
-	0xaf, 0xa1, 0xec, 0x28, 0xdc, 0xd0, 0xf9, 0xbb, 0xd5, 0x26, 0xf6, 0xd7, 0x9b, 0xd8, 0xff, 0xb3,
-	0x89, 0xfd, 0x9f, 0xdb, 0xd8, 0x5b, 0x6f, 0x63, 0xef, 0xd7, 0x36, 0xf6, 0x3e, 0x3d, 0xcb, 0xa5,
-	0xbd, 0xae, 0x52, 0xc2, 0xa1, 0xa0, 0x1c, 0x4c, 0x01, 0x86, 0xca, 0x94, 0x3f, 0xc9, 0x81, 0xd6,
-	0xcf, 0x69, 0x01, 0x59, 0xb5, 0x14, 0xa6, 0xb9, 0xbd, 0xde, 0xcd, 0xd9, 0xaf, 0xa5, 0x30, 0xe9,
-	0xd8, 0x9d, 0xdb, 0xd3, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xe7, 0x73, 0xe7, 0x36, 0x26, 0x03,
-	0x00, 0x00,
+	// 501 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x93, 0x41, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x9b, 0xb6, 0x2b, 0xd4, 0x3b, 0xb0, 0x59, 0x15, 0x0b, 0x15, 0x84, 0x52, 0x2e, 0x9d,
+	0xa6, 0x25, 0x5a, 0x38, 0x20, 0xc4, 0x89, 0x09, 0x55, 0xec, 0x80, 0x04, 0x15, 0x42, 0x88, 0x4b,
+	0xe5, 0x38, 0x6e, 0x6a, 0x35, 0xf1, 0x8b, 0x6c, 0xb7, 0x88, 0x1b, 0xdf, 0x00, 0xbe, 0x08, 0xdf,
+	0x63, 0xc7, 0x1d, 0x39, 0x21, 0xd4, 0x7e, 0x11, 0x14, 0x27, 0x69, 0x23, 0x58, 0x73, 0x7b, 0xef,
+	0xff, 0x9e, 0xff, 0xfa, 0xf9, 0xd9, 0x0f, 0x9d, 0xf2, 0x80, 0x7a, 0x24, 0x4d, 0x63, 0x4e, 0x89,
+	0xe6, 0x20, 0x94, 0xa7, 0x25, 0x11, 0x6a, 0xc6, 0xa4, 0xb7, 0xf2, 0xbd, 0x94, 0xd0, 0x05, 0xd3,
+	0x6e, 0x2a, 0x41, 0x03, 0x7e, 0xc8, 0x03, 0xea, 0x56, 0x5b, 0xdd, 0xb2, 0xd5, 0x5d, 0xf9, 0xfd,
+	0x51, 0xad, 0x91, 0x86, 0x05, 0x13, 0xb9, 0x4f, 0xbf, 0x17, 0x41, 0x04, 0x26, 0xf4, 0xb2, 0xa8,
+	0x50, 0xcf, 0x6a, 0xce, 0x5f, 0x6c, 0xe3, 0xbc, 0x79, 0xf8, 0xdd, 0x42, 0x27, 0xe3, 0xa5, 0x88,
+	0x78, 0x10, 0xb3, 0x0f, 0x99, 0xf5, 0x3b, 0x03, 0xfa, 0x9a, 0x68, 0x82, 0x7b, 0xe8, 0x20, 0x64,
+	0x02, 0x12, 0xdb, 0x1a, 0x58, 0xa3, 0xee, 0x24, 0x4f, 0xf0, 0x7d, 0xd4, 0x21, 0x09, 0x2c, 0x85,
+	0xb6, 0x9b, 0x46, 0x2e, 0xb2, 0x4c, 0x57, 0x4c, 0x84, 0x4c, 0xda, 0xad, 0x5c, 0xcf, 0x33, 0xdc,
+	0x47, 0x77, 0x25, 0xa3, 0x8c, 0xaf, 0x98, 0xb4, 0xdb, 0xa6, 0xb2, 0xcd, 0x31, 0x46, 0xed, 0x84,
+	0x25, 0x60, 0x1f, 0x18, 0xdd, 0xc4, 0xc3, 0x6f, 0x4d, 0xf4, 0x60, 0x0f, 0xd1, 0x47, 0x1f, 0xbf,
+	0x42, 0x1d, 0x33, 0x01, 0x65, 0x5b, 0x83, 0xd6, 0xe8, 0xd0, 0x7f, 0xea, 0xd6, 0xcd, 0xd2, 0x35,
+	0x06, 0x97, 0xed, 0xeb, 0xdf, 0x8f, 0x1b, 0x93, 0xe2, 0x60, 0x05, 0xb4, 0xb9, 0x17, 0xb4, 0xb5,
+	0x07, 0xb4, 0xbd, 0x03, 0xc5, 0x9f, 0x10, 0x9a, 0x81, 0xfc, 0x42, 0x64, 0xc8, 0x45, 0x64, 0xae,
+	0x70, 0xe8, 0xfb, 0xf5, 0x38, 0xe3, 0x6d, 0xff, 0xee, 0x52, 0x05, 0x5d, 0xc5, 0x6b, 0xf8, 0xd3,
+	0x42, 0xbd, 0xdb, 0x5a, 0xf1, 0x29, 0x3a, 0x0a, 0x99, 0xd2, 0x5c, 0x18, 0xef, 0xa9, 0x41, 0xca,
+	0x1f, 0xe7, 0x5e, 0x45, 0x7f, 0x9b, 0xd1, 0xbd, 0x44, 0xed, 0x39, 0xa4, 0xca, 0x6e, 0x9a, 0x31,
+	0x3d, 0xa9, 0xe3, 0xba, 0x70, 0xdf, 0x40, 0x5a, 0x60, 0x98, 0x43, 0xf8, 0x1c, 0xe1, 0x19, 0x89,
+	0xe3, 0x80, 0xd0, 0xc5, 0xb4, 0x9c, 0x81, 0xb2, 0x5b, 0x83, 0xd6, 0xa8, 0x3b, 0x39, 0x2e, 0x2b,
+	0x93, 0xb2, 0x90, 0x7d, 0x22, 0xbc, 0xe3, 0x1d, 0x17, 0x75, 0x7c, 0x86, 0x8e, 0xff, 0x73, 0x29,
+	0x70, 0x8f, 0xfe, 0x35, 0xc1, 0x27, 0xe8, 0x4e, 0x0a, 0x52, 0x4f, 0x79, 0x58, 0x3e, 0x4b, 0x96,
+	0x5e, 0x85, 0xf8, 0x11, 0x42, 0x74, 0x4e, 0x84, 0x60, 0x71, 0x56, 0xcb, 0x1f, 0xa6, 0x5b, 0x28,
+	0x57, 0x61, 0xf6, 0x49, 0x99, 0x94, 0x50, 0xfe, 0xad, 0x3c, 0xb9, 0x7c, 0x7f, 0xbd, 0x76, 0xac,
+	0x9b, 0xb5, 0x63, 0xfd, 0x59, 0x3b, 0xd6, 0x8f, 0x8d, 0xd3, 0xb8, 0xd9, 0x38, 0x8d, 0x5f, 0x1b,
+	0xa7, 0xf1, 0xf9, 0x79, 0xc4, 0xf5, 0x7c, 0x19, 0xb8, 0x14, 0x12, 0x8f, 0x82, 0x4a, 0x40, 0x79,
+	0x3c, 0xa0, 0xe7, 0x11, 0x78, 0xab, 0x17, 0x5e, 0x02, 0xe1, 0x32, 0x66, 0x2a, 0xdb, 0x9e, 0xca,
+	0xd6, 0xe8, 0xaf, 0x29, 0x53, 0x41, 0xc7, 0x2c, 0xcc, 0xb3, 0xbf, 0x03, 0x00, 0x8e, 0x11, 0x71,
+	0xce, 0xe8, 0x03, 0x00, 0x00,
 }
 
 func (m *FungibleTokenPacketData) Marshal() (dAtA []byte, err error) {
@@ -435,6 +526,15 @@ func (m *ForwardingPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FallbackReceivers) > 0 {
+		for iNdEx := len(m.FallbackReceivers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.FallbackReceivers[iNdEx])
+			copy(dAtA[i:], m.FallbackReceivers[iNdEx])
+			i = encodeVarintPacket(dAtA, i, uint64(len(m.FallbackReceivers[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.Hops) > 0 {
 		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -459,6 +559,57 @@ func (m *ForwardingPacketData) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *ForwardingFallback) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardingFallback) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardingFallback) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.Error)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FallbackReceiver) > 0 {
+		i -= len(m.FallbackReceiver)
+		copy(dAtA[i:], m.FallbackReceiver)
+		i = encodeVarintPacket(dAtA, i, uint64(len(m.FallbackReceiver)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPacket(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPacket(v)
 	base := offset
@@ -544,6 +695,37 @@ func (m *ForwardingPacketData) Size() (n int) {
 			n += 1 + l + sovPacket(uint64(l))
 		}
 	}
+	if len(m.FallbackReceivers) > 0 {
+		for _, s := range m.FallbackReceivers {
+			l = len(s)
+			n += 1 + l + sovPacket(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ForwardingFallback) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FallbackReceiver)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovPacket(uint64(l))
+	}
 	return n
 }
 
@@ -1071,6 +1253,216 @@ func (m *ForwardingPacketData) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackReceivers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackReceivers = append(m.FallbackReceivers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPacket(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ForwardingFallback) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPacket
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardingFallback: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardingFallback: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackReceiver", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FallbackReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPacket
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPacket
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPacket
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPacket(dAtA[iNdEx:])
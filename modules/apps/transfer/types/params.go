@@ -1,10 +1,27 @@
 package types
 
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"unicode"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
 const (
 	// DefaultSendEnabled enabled
 	DefaultSendEnabled = true
 	// DefaultReceiveEnabled enabled
 	DefaultReceiveEnabled = true
+	// MaxBasisPoints is the number of basis points making up the whole of a transferred amount.
+	// Protocol fees must be strictly lower than it.
+	MaxBasisPoints = 10_000
 )
 
 // NewParams creates a new parameter configuration for the ibc transfer module
@@ -19,3 +36,242 @@ func NewParams(enableSend, enableReceive bool) Params {
 func DefaultParams() Params {
 	return NewParams(DefaultSendEnabled, DefaultReceiveEnabled)
 }
+
+// NewDenomOverride creates a new DenomOverride instance
+func NewDenomOverride(denom string, sendEnabled, receiveEnabled bool) DenomOverride {
+	return DenomOverride{
+		Denom:          denom,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// NewChannelOverride creates a new ChannelOverride instance
+func NewChannelOverride(portID, channelID string, sendEnabled, receiveEnabled bool) ChannelOverride {
+	return ChannelOverride{
+		PortId:         portID,
+		ChannelId:      channelID,
+		SendEnabled:    sendEnabled,
+		ReceiveEnabled: receiveEnabled,
+	}
+}
+
+// NewProtocolFee creates a new ProtocolFee instance. An empty fee recipient sends the fees to the community pool.
+func NewProtocolFee(basisPoints uint32, feeRecipient string, feeOverrides []FeeOverride, exemptSenders []string) ProtocolFee {
+	return ProtocolFee{
+		BasisPoints:   basisPoints,
+		FeeRecipient:  feeRecipient,
+		FeeOverrides:  feeOverrides,
+		ExemptSenders: exemptSenders,
+	}
+}
+
+// NewFeeOverride creates a new FeeOverride instance. Empty port and channel identifiers
+// apply the override to all channels, an empty denomination applies it to all denominations.
+func NewFeeOverride(portID, channelID, denom string, basisPoints uint32) FeeOverride {
+	return FeeOverride{
+		PortId:      portID,
+		ChannelId:   channelID,
+		Denom:       denom,
+		BasisPoints: basisPoints,
+	}
+}
+
+// Validate performs a basic validation of the denom and channel overrides and of the
+// protocol fee. Each denomination and channel end may be overridden at most once.
+func (p Params) Validate() error {
+	seenDenoms := make(map[string]bool)
+	for _, override := range p.DenomOverrides {
+		if err := sdk.ValidateDenom(override.Denom); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "invalid denom override: %s", err)
+		}
+
+		if seenDenoms[override.Denom] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate denom override for %s", override.Denom)
+		}
+		seenDenoms[override.Denom] = true
+	}
+
+	seenChannels := make(map[string]bool)
+	for _, override := range p.ChannelOverrides {
+		if err := host.PortIdentifierValidator(override.PortId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel override port ID %s", override.PortId)
+		}
+		if err := host.ChannelIdentifierValidator(override.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid channel override channel ID %s", override.ChannelId)
+		}
+
+		key := fmt.Sprintf("%s/%s", override.PortId, override.ChannelId)
+		if seenChannels[key] {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidRequest, "duplicate channel override for port %s and channel %s", override.PortId, override.ChannelId)
+		}
+		seenChannels[key] = true
+	}
+
+	return p.ProtocolFee.Validate()
+}
+
+// Validate performs a basic validation of the protocol fee. The basis points of the fee and of its
+// overrides must be lower than MaxBasisPoints, each override must be scoped to a channel end, a
+// denomination or both and may be defined at most once, and the exempt senders must be valid addresses.
+func (pf ProtocolFee) Validate() error {
+	if pf.BasisPoints >= MaxBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "basis points must be lower than %d, got %d", MaxBasisPoints, pf.BasisPoints)
+	}
+
+	if strings.IndexFunc(pf.FeeRecipient, unicode.IsSpace) != -1 {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "fee recipient must be a module account name, got %q", pf.FeeRecipient)
+	}
+
+	seenOverrides := make(map[string]bool)
+	for _, override := range pf.FeeOverrides {
+		if err := override.Validate(); err != nil {
+			return err
+		}
+
+		key := fmt.Sprintf("%s/%s/%s", override.PortId, override.ChannelId, override.Denom)
+		if seenOverrides[key] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate fee override for port %q, channel %q and denom %q", override.PortId, override.ChannelId, override.Denom)
+		}
+		seenOverrides[key] = true
+	}
+
+	seenSenders := make(map[string]bool)
+	for _, sender := range pf.ExemptSenders {
+		if _, err := sdk.AccAddressFromBech32(sender); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "invalid exempt sender %s: %s", sender, err)
+		}
+
+		if seenSenders[sender] {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "duplicate exempt sender %s", sender)
+		}
+		seenSenders[sender] = true
+	}
+
+	return nil
+}
+
+// Validate performs a basic validation of the fee override.
+func (fo FeeOverride) Validate() error {
+	if fo.BasisPoints >= MaxBasisPoints {
+		return errorsmod.Wrapf(ErrInvalidProtocolFee, "fee override basis points must be lower than %d, got %d", MaxBasisPoints, fo.BasisPoints)
+	}
+
+	if (fo.PortId == "") != (fo.ChannelId == "") {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "fee override port and channel identifiers must be both set or both empty")
+	}
+
+	if fo.ChannelId == "" && fo.Denom == "" {
+		return errorsmod.Wrap(ErrInvalidProtocolFee, "fee override must specify a channel, a denomination or both")
+	}
+
+	if fo.ChannelId != "" {
+		if err := host.PortIdentifierValidator(fo.PortId); err != nil {
+			return errorsmod.Wrapf(err, "invalid fee override port ID %s", fo.PortId)
+		}
+		if err := host.ChannelIdentifierValidator(fo.ChannelId); err != nil {
+			return errorsmod.Wrapf(err, "invalid fee override channel ID %s", fo.ChannelId)
+		}
+	}
+
+	if fo.Denom != "" {
+		if err := sdk.ValidateDenom(fo.Denom); err != nil {
+			return errorsmod.Wrapf(ErrInvalidProtocolFee, "invalid fee override denom: %s", err)
+		}
+	}
+
+	return nil
+}
+
+// IsExempt returns true if the provided sender is exempt from the protocol fee.
+func (pf ProtocolFee) IsExempt(sender string) bool {
+	return slices.Contains(pf.ExemptSenders, sender)
+}
+
+// BasisPointsFor resolves the basis points charged on transfers of the provided denomination over the
+// given channel end. An override matching both the channel end and the denomination takes precedence
+// over an override matching only the channel end, which in turn takes precedence over an override
+// matching only the denomination and finally over the default basis points.
+func (pf ProtocolFee) BasisPointsFor(portID, channelID, denom string) uint32 {
+	var channelMatch, denomMatch *FeeOverride
+	for i, override := range pf.FeeOverrides {
+		channelMatches := override.PortId == portID && override.ChannelId == channelID
+		denomMatches := override.Denom == denom
+
+		switch {
+		case channelMatches && denomMatches:
+			return override.BasisPoints
+		case channelMatches && override.Denom == "":
+			channelMatch = &pf.FeeOverrides[i]
+		case denomMatches && override.ChannelId == "":
+			denomMatch = &pf.FeeOverrides[i]
+		}
+	}
+
+	switch {
+	case channelMatch != nil:
+		return channelMatch.BasisPoints
+	case denomMatch != nil:
+		return denomMatch.BasisPoints
+	default:
+		return pf.BasisPoints
+	}
+}
+
+// ComputeFee returns the fee charged on the provided coin for the given basis points. The fee is
+// truncated, so that amounts too small to be charged a whole unit are transferred free of charge.
+func ComputeFee(coin sdk.Coin, basisPoints uint32) sdk.Coin {
+	amount := coin.Amount.MulRaw(int64(basisPoints)).QuoRaw(MaxBasisPoints)
+	return sdk.NewCoin(coin.Denom, amount)
+}
+
+// IsSendEnabled returns true if the provided denomination may be sent over the given channel end.
+func (p Params) IsSendEnabled(portID, channelID, denom string) bool {
+	return p.isEnabled(portID, channelID, denom, p.SendEnabled,
+		func(o DenomOverride) bool { return o.SendEnabled },
+		func(o ChannelOverride) bool { return o.SendEnabled },
+	)
+}
+
+// IsReceiveEnabled returns true if the provided denomination may be received over the given channel end.
+func (p Params) IsReceiveEnabled(portID, channelID, denom string) bool {
+	return p.isEnabled(portID, channelID, denom, p.ReceiveEnabled,
+		func(o DenomOverride) bool { return o.ReceiveEnabled },
+		func(o ChannelOverride) bool { return o.ReceiveEnabled },
+	)
+}
+
+// isEnabled resolves the enablement of a transfer given the global default and the matching overrides.
+// An override disabling the transfer takes precedence over an override enabling it, which in turn
+// takes precedence over the global default.
+func (p Params) isEnabled(
+	portID, channelID, denom string,
+	defaultEnabled bool,
+	denomEnabled func(DenomOverride) bool,
+	channelEnabled func(ChannelOverride) bool,
+) bool {
+	var matches []bool
+	for _, override := range p.DenomOverrides {
+		if override.Denom == denom {
+			matches = append(matches, denomEnabled(override))
+		}
+	}
+
+	for _, override := range p.ChannelOverrides {
+		if override.PortId == portID && override.ChannelId == channelID {
+			matches = append(matches, channelEnabled(override))
+		}
+	}
+
+	if len(matches) == 0 {
+		return defaultEnabled
+	}
+
+	for _, enabled := range matches {
+		if !enabled {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,243 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+)
+
+func TestValidateParams(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params types.Params
+		expErr error
+	}{
+		{"default params", types.DefaultParams(), nil},
+		{
+			"valid overrides",
+			types.Params{
+				DenomOverrides:   []types.DenomOverride{types.NewDenomOverride("uatom", false, true), types.NewDenomOverride("uosmo", true, false)},
+				ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, validChannel, false, false)},
+			},
+			nil,
+		},
+		{
+			"invalid denom override",
+			types.Params{DenomOverrides: []types.DenomOverride{types.NewDenomOverride("0atom", false, true)}},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"duplicate denom override",
+			types.Params{DenomOverrides: []types.DenomOverride{types.NewDenomOverride("uatom", false, true), types.NewDenomOverride("uatom", true, true)}},
+			ibcerrors.ErrInvalidRequest,
+		},
+		{
+			"invalid channel override port",
+			types.Params{ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(invalidPort, validChannel, false, false)}},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel override channel",
+			types.Params{ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, invalidChannel, false, false)}},
+			host.ErrInvalidID,
+		},
+		{
+			"duplicate channel override",
+			types.Params{ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, validChannel, false, false), types.NewChannelOverride(validPort, validChannel, true, true)}},
+			ibcerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.params.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestParamsTransferEnabled(t *testing.T) {
+	testCases := []struct {
+		name              string
+		params            types.Params
+		expSendEnabled    bool
+		expReceiveEnabled bool
+	}{
+		{
+			"default params",
+			types.DefaultParams(),
+			true,
+			true,
+		},
+		{
+			"global disabled without overrides",
+			types.NewParams(false, false),
+			false,
+			false,
+		},
+		{
+			"denom override disables send",
+			types.Params{
+				SendEnabled:    true,
+				ReceiveEnabled: true,
+				DenomOverrides: []types.DenomOverride{types.NewDenomOverride("uatom", false, true)},
+			},
+			false,
+			true,
+		},
+		{
+			"channel override enables receive despite global disabled",
+			types.Params{
+				ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, validChannel, false, true)},
+			},
+			false,
+			true,
+		},
+		{
+			"disabling override takes precedence over enabling override",
+			types.Params{
+				DenomOverrides:   []types.DenomOverride{types.NewDenomOverride("uatom", true, false)},
+				ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, validChannel, false, true)},
+			},
+			false,
+			false,
+		},
+		{
+			"overrides for other denoms and channels are ignored",
+			types.Params{
+				SendEnabled:      true,
+				ReceiveEnabled:   true,
+				DenomOverrides:   []types.DenomOverride{types.NewDenomOverride("uosmo", false, false)},
+				ChannelOverrides: []types.ChannelOverride{types.NewChannelOverride(validPort, "channel-1", false, false)},
+			},
+			true,
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expSendEnabled, tc.params.IsSendEnabled(validPort, validChannel, "uatom"))
+			require.Equal(t, tc.expReceiveEnabled, tc.params.IsReceiveEnabled(validPort, validChannel, "uatom"))
+		})
+	}
+}
+
+func TestValidateProtocolFee(t *testing.T) {
+	testCases := []struct {
+		name        string
+		protocolFee types.ProtocolFee
+		expErr      error
+	}{
+		{"no protocol fee", types.ProtocolFee{}, nil},
+		{
+			"valid protocol fee",
+			types.NewProtocolFee(
+				25,
+				"fee_collector",
+				[]types.FeeOverride{
+					types.NewFeeOverride(validPort, validChannel, "", 10),
+					types.NewFeeOverride("", "", "uatom", 50),
+					types.NewFeeOverride(validPort, validChannel, "uatom", 0),
+				},
+				[]string{sender},
+			),
+			nil,
+		},
+		{"basis points too high", types.NewProtocolFee(types.MaxBasisPoints, "", nil, nil), types.ErrInvalidProtocolFee},
+		{"fee recipient with whitespace", types.NewProtocolFee(25, "fee collector", nil, nil), types.ErrInvalidProtocolFee},
+		{
+			"override basis points too high",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride(validPort, validChannel, "", types.MaxBasisPoints)}, nil),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"override without channel nor denom",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride("", "", "", 10)}, nil),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"override with port but no channel",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride(validPort, "", "uatom", 10)}, nil),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"override with invalid port",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride(invalidPort, validChannel, "", 10)}, nil),
+			host.ErrInvalidID,
+		},
+		{
+			"override with invalid channel",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride(validPort, invalidChannel, "", 10)}, nil),
+			host.ErrInvalidID,
+		},
+		{
+			"override with invalid denom",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride("", "", "0atom", 10)}, nil),
+			types.ErrInvalidProtocolFee,
+		},
+		{
+			"duplicate override",
+			types.NewProtocolFee(25, "", []types.FeeOverride{types.NewFeeOverride("", "", "uatom", 10), types.NewFeeOverride("", "", "uatom", 20)}, nil),
+			types.ErrInvalidProtocolFee,
+		},
+		{"invalid exempt sender", types.NewProtocolFee(25, "", nil, []string{"invalid"}), ibcerrors.ErrInvalidAddress},
+		{"duplicate exempt sender", types.NewProtocolFee(25, "", nil, []string{sender, sender}), types.ErrInvalidProtocolFee},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			params := types.DefaultParams()
+			params.ProtocolFee = tc.protocolFee
+
+			err := params.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestProtocolFeeBasisPointsFor(t *testing.T) {
+	const otherChannel = "channel-1"
+
+	protocolFee := types.NewProtocolFee(
+		25,
+		"",
+		[]types.FeeOverride{
+			types.NewFeeOverride("", "", "uatom", 50),
+			types.NewFeeOverride(validPort, validChannel, "", 10),
+			types.NewFeeOverride(validPort, validChannel, "uatom", 0),
+		},
+		nil,
+	)
+
+	require.Equal(t, uint32(0), protocolFee.BasisPointsFor(validPort, validChannel, "uatom"), "channel and denom override")
+	require.Equal(t, uint32(10), protocolFee.BasisPointsFor(validPort, validChannel, "uosmo"), "channel override")
+	require.Equal(t, uint32(50), protocolFee.BasisPointsFor(validPort, otherChannel, "uatom"), "denom override")
+	require.Equal(t, uint32(25), protocolFee.BasisPointsFor(validPort, otherChannel, "uosmo"), "default")
+}
+
+func TestComputeFee(t *testing.T) {
+	require.Equal(t, sdk.NewInt64Coin("uatom", 25), types.ComputeFee(sdk.NewInt64Coin("uatom", 10_000), 25))
+	require.Equal(t, sdk.NewInt64Coin("uatom", 1), types.ComputeFee(sdk.NewInt64Coin("uatom", 1_999), 10), "fee is truncated")
+	require.Equal(t, sdk.NewInt64Coin("uatom", 0), types.ComputeFee(sdk.NewInt64Coin("uatom", 10_000), 0))
+}
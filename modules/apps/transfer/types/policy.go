@@ -0,0 +1,68 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+)
+
+// TransferPolicy defines the interface of the checks screening fungible token transfers, such as compliance
+// or anti-spam checks. A transfer is rejected if any of the checks returns an error. A packet rejected on
+// receive is acknowledged with an error acknowledgement carrying the ABCI code of the returned error, or the
+// code of ErrTransferRejected if the returned error is not registered.
+type TransferPolicy interface {
+	// CheckSend is called before an outgoing transfer packet is sent on the provided channel end. The tokens
+	// of packets forwarded to the next hop are checked with the transfer module account as sender.
+	CheckSend(ctx context.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, receiver string, tokens Tokens) error
+	// CheckRecv is called before the tokens of a received packet are credited to the receiver. The receiver
+	// is the transfer module account if the tokens are forwarded to the next hop.
+	CheckRecv(ctx context.Context, packet channeltypes.Packet, data FungibleTokenPacketDataV2, receiver sdk.AccAddress) error
+	// CheckForward is called before the tokens of a received packet are forwarded to the receiver on the next hop.
+	CheckForward(ctx context.Context, packet channeltypes.Packet, nextHop Hop, receiver string, coins sdk.Coins) error
+}
+
+var _ TransferPolicy = (TransferPolicies)(nil)
+
+// TransferPolicies chains multiple transfer policies, which are checked in order. A transfer is rejected
+// with the error of the first policy rejecting it.
+type TransferPolicies []TransferPolicy
+
+// NewTransferPolicies creates a new TransferPolicies instance given a variable number of transfer policies.
+func NewTransferPolicies(policies ...TransferPolicy) TransferPolicies {
+	return policies
+}
+
+// CheckSend implements TransferPolicy.
+func (tp TransferPolicies) CheckSend(ctx context.Context, sourcePort, sourceChannel string, sender sdk.AccAddress, receiver string, tokens Tokens) error {
+	for _, policy := range tp {
+		if err := policy.CheckSend(ctx, sourcePort, sourceChannel, sender, receiver, tokens); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckRecv implements TransferPolicy.
+func (tp TransferPolicies) CheckRecv(ctx context.Context, packet channeltypes.Packet, data FungibleTokenPacketDataV2, receiver sdk.AccAddress) error {
+	for _, policy := range tp {
+		if err := policy.CheckRecv(ctx, packet, data, receiver); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CheckForward implements TransferPolicy.
+func (tp TransferPolicies) CheckForward(ctx context.Context, packet channeltypes.Packet, nextHop Hop, receiver string, coins sdk.Coins) error {
+	for _, policy := range tp {
+		if err := policy.CheckForward(ctx, packet, nextHop, receiver, coins); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
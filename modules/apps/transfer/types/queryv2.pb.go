@@ -10,6 +10,7 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
@@ -30,25 +31,72 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// QueryDenomRequest is the request type for the Query/Denom RPC
-// method
-type QueryDenomRequest struct {
-	// hash (in hex format) or denom (full denom with ibc prefix) of the on chain denomination.
-	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+// ForwardedPacketStatus defines the status of the packet sent to the next hop
+// when forwarding the tokens of a received packet.
+type ForwardedPacketStatus int32
+
+const (
+	// Default zero value enumeration
+	FORWARD_UNSPECIFIED ForwardedPacketStatus = 0
+	// The packet sent to the next hop awaits its acknowledgement or timeout
+	FORWARD_IN_FLIGHT ForwardedPacketStatus = 1
+	// The channel over which the packet was sent to the next hop is closed, the packet
+	// awaits to be timed out on close
+	FORWARD_CHANNEL_CLOSED ForwardedPacketStatus = 2
+	// No commitment exists for the packet sent to the next hop, the forward cannot
+	// be completed by relaying the packet
+	FORWARD_COMMITMENT_NOT_FOUND ForwardedPacketStatus = 3
+)
+
+var ForwardedPacketStatus_name = map[int32]string{
+	0: "FORWARDED_PACKET_STATUS_UNSPECIFIED",
+	1: "FORWARDED_PACKET_STATUS_IN_FLIGHT",
+	2: "FORWARDED_PACKET_STATUS_CHANNEL_CLOSED",
+	3: "FORWARDED_PACKET_STATUS_COMMITMENT_NOT_FOUND",
 }
 
-func (m *QueryDenomRequest) Reset()         { *m = QueryDenomRequest{} }
-func (m *QueryDenomRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomRequest) ProtoMessage()    {}
-func (*QueryDenomRequest) Descriptor() ([]byte, []int) {
+var ForwardedPacketStatus_value = map[string]int32{
+	"FORWARDED_PACKET_STATUS_UNSPECIFIED":          0,
+	"FORWARDED_PACKET_STATUS_IN_FLIGHT":            1,
+	"FORWARDED_PACKET_STATUS_CHANNEL_CLOSED":       2,
+	"FORWARDED_PACKET_STATUS_COMMITMENT_NOT_FOUND": 3,
+}
+
+func (x ForwardedPacketStatus) String() string {
+	return proto.EnumName(ForwardedPacketStatus_name, int32(x))
+}
+
+func (ForwardedPacketStatus) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_03a5118d32b8ebb9, []int{0}
 }
-func (m *QueryDenomRequest) XXX_Unmarshal(b []byte) error {
+
+// ForwardedPacketInfo defines a packet whose tokens are being forwarded along with the
+// packet sent to the next hop and its status.
+type ForwardedPacketInfo struct {
+	// forward_key identifies the packet sent to the next hop
+	ForwardKey types.PacketId `protobuf:"bytes,1,opt,name=forward_key,json=forwardKey,proto3" json:"forward_key"`
+	// packet is the received packet whose acknowledgement is pending on the forward
+	Packet types.Packet `protobuf:"bytes,2,opt,name=packet,proto3" json:"packet"`
+	// forward_commitment is the commitment of the packet sent to the next hop
+	ForwardCommitment []byte `protobuf:"bytes,3,opt,name=forward_commitment,json=forwardCommitment,proto3" json:"forward_commitment,omitempty"`
+	// status is the status of the packet sent to the next hop
+	Status ForwardedPacketStatus `protobuf:"varint,4,opt,name=status,proto3,enum=ibc.applications.transfer.v2.ForwardedPacketStatus" json:"status,omitempty"`
+	// retries is the number of times the tokens have been resent to the next hop after a timeout
+	Retries uint64 `protobuf:"varint,5,opt,name=retries,proto3" json:"retries,omitempty"`
+}
+
+func (m *ForwardedPacketInfo) Reset()         { *m = ForwardedPacketInfo{} }
+func (m *ForwardedPacketInfo) String() string { return proto.CompactTextString(m) }
+func (*ForwardedPacketInfo) ProtoMessage()    {}
+func (*ForwardedPacketInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{0}
+}
+func (m *ForwardedPacketInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *ForwardedPacketInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDenomRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_ForwardedPacketInfo.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -58,44 +106,76 @@ func (m *QueryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, e
 		return b[:n], nil
 	}
 }
-func (m *QueryDenomRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomRequest.Merge(m, src)
+func (m *ForwardedPacketInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ForwardedPacketInfo.Merge(m, src)
 }
-func (m *QueryDenomRequest) XXX_Size() int {
+func (m *ForwardedPacketInfo) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDenomRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomRequest.DiscardUnknown(m)
+func (m *ForwardedPacketInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ForwardedPacketInfo.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDenomRequest proto.InternalMessageInfo
+var xxx_messageInfo_ForwardedPacketInfo proto.InternalMessageInfo
 
-func (m *QueryDenomRequest) GetHash() string {
+func (m *ForwardedPacketInfo) GetForwardKey() types.PacketId {
 	if m != nil {
-		return m.Hash
+		return m.ForwardKey
 	}
-	return ""
+	return types.PacketId{}
 }
 
-// QueryDenomResponse is the response type for the Query/Denom RPC
-// method.
-type QueryDenomResponse struct {
-	// denom returns the requested denomination.
-	Denom *Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+func (m *ForwardedPacketInfo) GetPacket() types.Packet {
+	if m != nil {
+		return m.Packet
+	}
+	return types.Packet{}
 }
 
-func (m *QueryDenomResponse) Reset()         { *m = QueryDenomResponse{} }
-func (m *QueryDenomResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomResponse) ProtoMessage()    {}
-func (*QueryDenomResponse) Descriptor() ([]byte, []int) {
+func (m *ForwardedPacketInfo) GetForwardCommitment() []byte {
+	if m != nil {
+		return m.ForwardCommitment
+	}
+	return nil
+}
+
+func (m *ForwardedPacketInfo) GetStatus() ForwardedPacketStatus {
+	if m != nil {
+		return m.Status
+	}
+	return FORWARD_UNSPECIFIED
+}
+
+func (m *ForwardedPacketInfo) GetRetries() uint64 {
+	if m != nil {
+		return m.Retries
+	}
+	return 0
+}
+
+// QueryForwardedPacketsRequest is the request type for the Query/ForwardedPackets RPC
+// method
+type QueryForwardedPacketsRequest struct {
+	// port_id is the optional port identifier of the channel end over which the tokens are forwarded
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the optional identifier of the channel over which the tokens are forwarded
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForwardedPacketsRequest) Reset()         { *m = QueryForwardedPacketsRequest{} }
+func (m *QueryForwardedPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardedPacketsRequest) ProtoMessage()    {}
+func (*QueryForwardedPacketsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_03a5118d32b8ebb9, []int{1}
 }
-func (m *QueryDenomResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryForwardedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryForwardedPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDenomResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryForwardedPacketsRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -105,44 +185,60 @@ func (m *QueryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryDenomResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomResponse.Merge(m, src)
+func (m *QueryForwardedPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardedPacketsRequest.Merge(m, src)
 }
-func (m *QueryDenomResponse) XXX_Size() int {
+func (m *QueryForwardedPacketsRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDenomResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomResponse.DiscardUnknown(m)
+func (m *QueryForwardedPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardedPacketsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDenomResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryForwardedPacketsRequest proto.InternalMessageInfo
 
-func (m *QueryDenomResponse) GetDenom() *Denom {
+func (m *QueryForwardedPacketsRequest) GetPortId() string {
 	if m != nil {
-		return m.Denom
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryForwardedPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryForwardedPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDenomsRequest is the request type for the Query/Denoms RPC
-// method
-type QueryDenomsRequest struct {
-	// pagination defines an optional pagination for the request.
-	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryForwardedPacketsResponse is the response type for the Query/ForwardedPackets RPC
+// method.
+type QueryForwardedPacketsResponse struct {
+	// forwarded_packets returns the packets whose tokens are being forwarded.
+	ForwardedPackets []ForwardedPacketInfo `protobuf:"bytes,1,rep,name=forwarded_packets,json=forwardedPackets,proto3" json:"forwarded_packets"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomsRequest) Reset()         { *m = QueryDenomsRequest{} }
-func (m *QueryDenomsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomsRequest) ProtoMessage()    {}
-func (*QueryDenomsRequest) Descriptor() ([]byte, []int) {
+func (m *QueryForwardedPacketsResponse) Reset()         { *m = QueryForwardedPacketsResponse{} }
+func (m *QueryForwardedPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardedPacketsResponse) ProtoMessage()    {}
+func (*QueryForwardedPacketsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_03a5118d32b8ebb9, []int{2}
 }
-func (m *QueryDenomsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryForwardedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryForwardedPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDenomsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryForwardedPacketsResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -152,46 +248,55 @@ func (m *QueryDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryDenomsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomsRequest.Merge(m, src)
+func (m *QueryForwardedPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardedPacketsResponse.Merge(m, src)
 }
-func (m *QueryDenomsRequest) XXX_Size() int {
+func (m *QueryForwardedPacketsResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDenomsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomsRequest.DiscardUnknown(m)
+func (m *QueryForwardedPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardedPacketsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDenomsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryForwardedPacketsResponse proto.InternalMessageInfo
 
-func (m *QueryDenomsRequest) GetPagination() *query.PageRequest {
+func (m *QueryForwardedPacketsResponse) GetForwardedPackets() []ForwardedPacketInfo {
+	if m != nil {
+		return m.ForwardedPackets
+	}
+	return nil
+}
+
+func (m *QueryForwardedPacketsResponse) GetPagination() *query.PageResponse {
 	if m != nil {
 		return m.Pagination
 	}
 	return nil
 }
 
-// QueryDenomsResponse is the response type for the Query/Denoms RPC
-// method.
-type QueryDenomsResponse struct {
-	// denoms returns all denominations.
-	Denoms Denoms `protobuf:"bytes,1,rep,name=denoms,proto3,castrepeated=Denoms" json:"denoms"`
-	// pagination defines the pagination in the response.
-	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+// QueryForwardedPacketRequest is the request type for the Query/ForwardedPacket RPC
+// method
+type QueryForwardedPacketRequest struct {
+	// port_id is the port identifier of the channel end over which the tokens are forwarded
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel_id is the identifier of the channel over which the tokens are forwarded
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// sequence is the sequence of the packet sent to the next hop
+	Sequence uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
 }
 
-func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
-func (m *QueryDenomsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryDenomsResponse) ProtoMessage()    {}
-func (*QueryDenomsResponse) Descriptor() ([]byte, []int) {
+func (m *QueryForwardedPacketRequest) Reset()         { *m = QueryForwardedPacketRequest{} }
+func (m *QueryForwardedPacketRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardedPacketRequest) ProtoMessage()    {}
+func (*QueryForwardedPacketRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_03a5118d32b8ebb9, []int{3}
 }
-func (m *QueryDenomsResponse) XXX_Unmarshal(b []byte) error {
+func (m *QueryForwardedPacketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryForwardedPacketRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryDenomsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryForwardedPacketRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -201,419 +306,2305 @@ func (m *QueryDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte,
 		return b[:n], nil
 	}
 }
-func (m *QueryDenomsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryDenomsResponse.Merge(m, src)
+func (m *QueryForwardedPacketRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardedPacketRequest.Merge(m, src)
 }
-func (m *QueryDenomsResponse) XXX_Size() int {
+func (m *QueryForwardedPacketRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryDenomsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryDenomsResponse.DiscardUnknown(m)
+func (m *QueryForwardedPacketRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardedPacketRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryDenomsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryForwardedPacketRequest proto.InternalMessageInfo
 
-func (m *QueryDenomsResponse) GetDenoms() Denoms {
+func (m *QueryForwardedPacketRequest) GetPortId() string {
 	if m != nil {
-		return m.Denoms
+		return m.PortId
 	}
-	return nil
+	return ""
 }
 
-func (m *QueryDenomsResponse) GetPagination() *query.PageResponse {
+func (m *QueryForwardedPacketRequest) GetChannelId() string {
 	if m != nil {
-		return m.Pagination
+		return m.ChannelId
 	}
-	return nil
+	return ""
 }
 
-func init() {
-	proto.RegisterType((*QueryDenomRequest)(nil), "ibc.applications.transfer.v2.QueryDenomRequest")
-	proto.RegisterType((*QueryDenomResponse)(nil), "ibc.applications.transfer.v2.QueryDenomResponse")
-	proto.RegisterType((*QueryDenomsRequest)(nil), "ibc.applications.transfer.v2.QueryDenomsRequest")
-	proto.RegisterType((*QueryDenomsResponse)(nil), "ibc.applications.transfer.v2.QueryDenomsResponse")
+func (m *QueryForwardedPacketRequest) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
 }
 
-func init() {
-	proto.RegisterFile("ibc/applications/transfer/v2/queryv2.proto", fileDescriptor_03a5118d32b8ebb9)
+// QueryForwardedPacketResponse is the response type for the Query/ForwardedPacket RPC
+// method.
+type QueryForwardedPacketResponse struct {
+	// forwarded_packet returns the packet whose tokens are being forwarded.
+	ForwardedPacket ForwardedPacketInfo `protobuf:"bytes,1,opt,name=forwarded_packet,json=forwardedPacket,proto3" json:"forwarded_packet"`
 }
 
-var fileDescriptor_03a5118d32b8ebb9 = []byte{
-	// 464 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0xbf, 0x8a, 0x13, 0x41,
-	0x1c, 0xc7, 0x33, 0xd1, 0x8b, 0x38, 0x82, 0xe0, 0x68, 0x71, 0x84, 0xb0, 0x77, 0xac, 0x72, 0x17,
-	0x02, 0xce, 0xef, 0xb2, 0x16, 0x72, 0x85, 0xcd, 0x21, 0x5a, 0x58, 0xe8, 0xa5, 0xb0, 0x10, 0x9b,
-	0xd9, 0xbd, 0x71, 0x32, 0x98, 0xec, 0x6f, 0x2f, 0x33, 0x59, 0x38, 0xc4, 0xc6, 0x27, 0x10, 0xae,
-	0xf3, 0x11, 0xf4, 0x15, 0x7c, 0x80, 0x2b, 0x03, 0x36, 0x56, 0x2a, 0x89, 0x0f, 0x22, 0x3b, 0x3b,
-	0x8b, 0x1b, 0x85, 0x35, 0x76, 0xc3, 0xee, 0xf7, 0xcf, 0x67, 0x7e, 0xfc, 0x86, 0x0e, 0x74, 0x9c,
-	0x80, 0xc8, 0xb2, 0x89, 0x4e, 0x84, 0xd5, 0x98, 0x1a, 0xb0, 0x33, 0x91, 0x9a, 0x57, 0x72, 0x06,
-	0x79, 0x04, 0xa7, 0x73, 0x39, 0x3b, 0xcb, 0x23, 0x9e, 0xcd, 0xd0, 0x22, 0xeb, 0xe9, 0x38, 0xe1,
-	0x75, 0x2d, 0xaf, 0xb4, 0x3c, 0x8f, 0xba, 0xb7, 0x14, 0x2a, 0x74, 0x42, 0x28, 0x4e, 0xa5, 0xa7,
-	0x3b, 0x48, 0xd0, 0x4c, 0xd1, 0x40, 0x2c, 0x8c, 0x2c, 0xe3, 0x20, 0x1f, 0xc6, 0xd2, 0x8a, 0x21,
-	0x64, 0x42, 0xe9, 0xd4, 0x05, 0x79, 0x6d, 0xbf, 0x91, 0xc5, 0xe2, 0x6b, 0x59, 0x29, 0x7b, 0x0a,
-	0x51, 0x4d, 0x24, 0x88, 0x4c, 0x83, 0x48, 0x53, 0xb4, 0x9e, 0xc7, 0xfd, 0x0d, 0xf7, 0xe9, 0x8d,
-	0xe3, 0xa2, 0xe9, 0xa1, 0x4c, 0x71, 0x3a, 0x92, 0xa7, 0x73, 0x69, 0x2c, 0x63, 0xf4, 0xf2, 0x58,
-	0x98, 0xf1, 0x36, 0xd9, 0x25, 0xfd, 0xab, 0x23, 0x77, 0x0e, 0x9f, 0x52, 0x56, 0x17, 0x9a, 0x0c,
-	0x53, 0x23, 0xd9, 0x21, 0xdd, 0x3a, 0x29, 0x3e, 0x38, 0xe9, 0xb5, 0xe8, 0x36, 0x6f, 0xba, 0x36,
-	0x2f, 0xbd, 0xa5, 0x23, 0x7c, 0x59, 0x0f, 0x34, 0x55, 0xf5, 0x23, 0x4a, 0x7f, 0xdf, 0xd5, 0xa7,
-	0xee, 0xf1, 0x72, 0x30, 0xbc, 0x18, 0x0c, 0x77, 0x83, 0xe1, 0x7e, 0x30, 0xfc, 0x99, 0x50, 0xd2,
-	0x7b, 0x47, 0x35, 0x67, 0xf8, 0x89, 0xd0, 0x9b, 0x6b, 0xf1, 0x1e, 0xf8, 0x09, 0xed, 0xb8, 0x7a,
-	0xb3, 0x4d, 0x76, 0x2f, 0x6d, 0x48, 0x7c, 0x74, 0xfd, 0xe2, 0xdb, 0x4e, 0xeb, 0xe3, 0xf7, 0x9d,
-	0x8e, 0x0f, 0xf3, 0x11, 0xec, 0xf1, 0x1a, 0x6c, 0xdb, 0xc1, 0xee, 0xff, 0x13, 0xb6, 0x24, 0xa9,
-	0xd3, 0x46, 0x9f, 0xdb, 0xf4, 0x8a, 0xa3, 0x7d, 0x1e, 0xb1, 0x73, 0x42, 0x7d, 0x0f, 0x3b, 0x68,
-	0x86, 0xfb, 0x7b, 0x7c, 0xdd, 0xe1, 0x7f, 0x38, 0x4a, 0x8e, 0xf0, 0xce, 0xbb, 0x2f, 0x3f, 0xcf,
-	0xdb, 0x01, 0xeb, 0x81, 0x5f, 0xa9, 0xf5, 0x55, 0xf2, 0x57, 0xfd, 0x40, 0xe8, 0x96, 0x33, 0x32,
-	0xd8, 0xb4, 0xa2, 0x62, 0x3a, 0xd8, 0xdc, 0xe0, 0x91, 0xb8, 0x43, 0xea, 0xb3, 0xbd, 0x26, 0x24,
-	0x78, 0x53, 0xec, 0xe5, 0x83, 0xc1, 0xe0, 0xed, 0xd1, 0xf1, 0xc5, 0x32, 0x20, 0x8b, 0x65, 0x40,
-	0x7e, 0x2c, 0x03, 0xf2, 0x7e, 0x15, 0xb4, 0x16, 0xab, 0xa0, 0xf5, 0x75, 0x15, 0xb4, 0x5e, 0xdc,
-	0x57, 0xda, 0x8e, 0xe7, 0x31, 0x4f, 0x70, 0x0a, 0xfe, 0x75, 0xe9, 0x38, 0xb9, 0xab, 0x10, 0xf2,
-	0x43, 0x98, 0xe2, 0xc9, 0x7c, 0x22, 0xcd, 0x1f, 0x05, 0xf6, 0x2c, 0x93, 0x26, 0xee, 0xb8, 0xe7,
-	0x71, 0xef, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0x86, 0x64, 0x3f, 0xcd, 0xf4, 0x03, 0x00, 0x00,
+func (m *QueryForwardedPacketResponse) Reset()         { *m = QueryForwardedPacketResponse{} }
+func (m *QueryForwardedPacketResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForwardedPacketResponse) ProtoMessage()    {}
+func (*QueryForwardedPacketResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{4}
+}
+func (m *QueryForwardedPacketResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForwardedPacketResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForwardedPacketResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForwardedPacketResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForwardedPacketResponse.Merge(m, src)
+}
+func (m *QueryForwardedPacketResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForwardedPacketResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForwardedPacketResponse.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryForwardedPacketResponse proto.InternalMessageInfo
 
-// QueryV2Client is the client API for QueryV2 service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryV2Client interface {
-	// Denoms queries all denominations
-	Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
-	// Denom queries a denomination
-	Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error)
+func (m *QueryForwardedPacketResponse) GetForwardedPacket() ForwardedPacketInfo {
+	if m != nil {
+		return m.ForwardedPacket
+	}
+	return ForwardedPacketInfo{}
 }
 
-type queryV2Client struct {
-	cc grpc1.ClientConn
+// QueryUnwindRouteRequest is the request type for the Query/UnwindRoute RPC
+// method
+type QueryUnwindRouteRequest struct {
+	// hash (in hex format) or denom (full denom with ibc prefix) of the on chain denomination.
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func NewQueryV2Client(cc grpc1.ClientConn) QueryV2Client {
-	return &queryV2Client{cc}
+func (m *QueryUnwindRouteRequest) Reset()         { *m = QueryUnwindRouteRequest{} }
+func (m *QueryUnwindRouteRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindRouteRequest) ProtoMessage()    {}
+func (*QueryUnwindRouteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{5}
 }
-
-func (c *queryV2Client) Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
-	out := new(QueryDenomsResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/Denoms", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryUnwindRouteRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryUnwindRouteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindRouteRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *QueryUnwindRouteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindRouteRequest.Merge(m, src)
+}
+func (m *QueryUnwindRouteRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindRouteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindRouteRequest.DiscardUnknown(m)
 }
 
-func (c *queryV2Client) Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error) {
-	out := new(QueryDenomResponse)
-	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/Denom", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnwindRouteRequest proto.InternalMessageInfo
+
+func (m *QueryUnwindRouteRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return out, nil
+	return ""
 }
 
-// QueryV2Server is the server API for QueryV2 service.
-type QueryV2Server interface {
-	// Denoms queries all denominations
-	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
-	// Denom queries a denomination
-	Denom(context.Context, *QueryDenomRequest) (*QueryDenomResponse, error)
+// QueryUnwindRouteResponse is the response type for the Query/UnwindRoute RPC
+// method.
+type QueryUnwindRouteResponse struct {
+	// hops are the channel ends over which the tokens are sent in order to reach their native chain,
+	// the first hop being the channel end on this chain. Only the channel of the first hop is validated,
+	// since the channels of the following hops are on the chains along the route.
+	Hops []Hop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	// base_denom is the denomination of the tokens on their native chain.
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// last_hop is the channel end on the chain preceding the native chain, over which the tokens are
+	// sent to their native chain.
+	LastHop Hop `protobuf:"bytes,3,opt,name=last_hop,json=lastHop,proto3" json:"last_hop"`
 }
 
-// UnimplementedQueryV2Server can be embedded to have forward compatible implementations.
-type UnimplementedQueryV2Server struct {
+func (m *QueryUnwindRouteResponse) Reset()         { *m = QueryUnwindRouteResponse{} }
+func (m *QueryUnwindRouteResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryUnwindRouteResponse) ProtoMessage()    {}
+func (*QueryUnwindRouteResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{6}
 }
-
-func (*UnimplementedQueryV2Server) Denoms(ctx context.Context, req *QueryDenomsRequest) (*QueryDenomsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+func (m *QueryUnwindRouteResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryV2Server) Denom(ctx context.Context, req *QueryDenomRequest) (*QueryDenomResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Denom not implemented")
+func (m *QueryUnwindRouteResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryUnwindRouteResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func RegisterQueryV2Server(s grpc1.Server, srv QueryV2Server) {
-	s.RegisterService(&_QueryV2_serviceDesc, srv)
+func (m *QueryUnwindRouteResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryUnwindRouteResponse.Merge(m, src)
+}
+func (m *QueryUnwindRouteResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryUnwindRouteResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryUnwindRouteResponse.DiscardUnknown(m)
 }
 
-func _QueryV2_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
+var xxx_messageInfo_QueryUnwindRouteResponse proto.InternalMessageInfo
+
+func (m *QueryUnwindRouteResponse) GetHops() []Hop {
+	if m != nil {
+		return m.Hops
 	}
-	if interceptor == nil {
-		return srv.(QueryV2Server).Denoms(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v2.QueryV2/Denoms",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryV2Server).Denoms(ctx, req.(*QueryDenomsRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return nil
 }
 
-func _QueryV2_Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryDenomRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryV2Server).Denom(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/ibc.applications.transfer.v2.QueryV2/Denom",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryV2Server).Denom(ctx, req.(*QueryDenomRequest))
+func (m *QueryUnwindRouteResponse) GetBaseDenom() string {
+	if m != nil {
+		return m.BaseDenom
 	}
-	return interceptor(ctx, in, info, handler)
-}
-
-var _QueryV2_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "ibc.applications.transfer.v2.QueryV2",
-	HandlerType: (*QueryV2Server)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Denoms",
-			Handler:    _QueryV2_Denoms_Handler,
-		},
-		{
-			MethodName: "Denom",
-			Handler:    _QueryV2_Denom_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "ibc/applications/transfer/v2/queryv2.proto",
+	return ""
 }
 
-func (m *QueryDenomRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryUnwindRouteResponse) GetLastHop() Hop {
+	if m != nil {
+		return m.LastHop
 	}
-	return dAtA[:n], nil
+	return Hop{}
 }
 
-func (m *QueryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomRequest is the request type for the Query/Denom RPC
+// method
+type QueryDenomRequest struct {
+	// hash (in hex format) or denom (full denom with ibc prefix) of the on chain denomination.
+	Hash string `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
 }
 
-func (m *QueryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Hash) > 0 {
-		i -= len(m.Hash)
-		copy(dAtA[i:], m.Hash)
-		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.Hash)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryDenomRequest) Reset()         { *m = QueryDenomRequest{} }
+func (m *QueryDenomRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomRequest) ProtoMessage()    {}
+func (*QueryDenomRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{7}
+}
+func (m *QueryDenomRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDenomRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomRequest.Merge(m, src)
+}
+func (m *QueryDenomRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomRequest proto.InternalMessageInfo
+
+func (m *QueryDenomRequest) GetHash() string {
+	if m != nil {
+		return m.Hash
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomResponse is the response type for the Query/Denom RPC
+// method.
+type QueryDenomResponse struct {
+	// denom returns the requested denomination.
+	Denom *Denom `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Denom != nil {
-		{
-			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+func (m *QueryDenomResponse) Reset()         { *m = QueryDenomResponse{} }
+func (m *QueryDenomResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomResponse) ProtoMessage()    {}
+func (*QueryDenomResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{8}
+}
+func (m *QueryDenomResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDenomResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomResponse.Merge(m, src)
+}
+func (m *QueryDenomResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomResponse.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomResponse proto.InternalMessageInfo
+
+func (m *QueryDenomResponse) GetDenom() *Denom {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomsRequest is the request type for the Query/Denoms RPC
+// method
+type QueryDenomsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+func (m *QueryDenomsRequest) Reset()         { *m = QueryDenomsRequest{} }
+func (m *QueryDenomsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsRequest) ProtoMessage()    {}
+func (*QueryDenomsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{9}
+}
+func (m *QueryDenomsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryDenomsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsRequest.Merge(m, src)
+}
+func (m *QueryDenomsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryDenomsRequest proto.InternalMessageInfo
+
+func (m *QueryDenomsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
 	}
-	return dAtA[:n], nil
+	return nil
 }
 
-func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+// QueryDenomsResponse is the response type for the Query/Denoms RPC
+// method.
+type QueryDenomsResponse struct {
+	// denoms returns all denominations.
+	Denoms Denoms `protobuf:"bytes,1,rep,name=denoms,proto3,castrepeated=Denoms" json:"denoms"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		{
-			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+func (m *QueryDenomsResponse) Reset()         { *m = QueryDenomsResponse{} }
+func (m *QueryDenomsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomsResponse) ProtoMessage()    {}
+func (*QueryDenomsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_03a5118d32b8ebb9, []int{10}
+}
+func (m *QueryDenomsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Denoms) > 0 {
-		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintQueryv2(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0xa
-		}
+}
+func (m *QueryDenomsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomsResponse.Merge(m, src)
+}
+func (m *QueryDenomsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomsResponse proto.InternalMessageInfo
+
+func (m *QueryDenomsResponse) GetDenoms() Denoms {
+	if m != nil {
+		return m.Denoms
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func encodeVarintQueryv2(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQueryv2(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryDenomsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *QueryDenomRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func init() {
+	proto.RegisterEnum("ibc.applications.transfer.v2.ForwardedPacketStatus", ForwardedPacketStatus_name, ForwardedPacketStatus_value)
+	proto.RegisterType((*ForwardedPacketInfo)(nil), "ibc.applications.transfer.v2.ForwardedPacketInfo")
+	proto.RegisterType((*QueryForwardedPacketsRequest)(nil), "ibc.applications.transfer.v2.QueryForwardedPacketsRequest")
+	proto.RegisterType((*QueryForwardedPacketsResponse)(nil), "ibc.applications.transfer.v2.QueryForwardedPacketsResponse")
+	proto.RegisterType((*QueryForwardedPacketRequest)(nil), "ibc.applications.transfer.v2.QueryForwardedPacketRequest")
+	proto.RegisterType((*QueryForwardedPacketResponse)(nil), "ibc.applications.transfer.v2.QueryForwardedPacketResponse")
+	proto.RegisterType((*QueryUnwindRouteRequest)(nil), "ibc.applications.transfer.v2.QueryUnwindRouteRequest")
+	proto.RegisterType((*QueryUnwindRouteResponse)(nil), "ibc.applications.transfer.v2.QueryUnwindRouteResponse")
+	proto.RegisterType((*QueryDenomRequest)(nil), "ibc.applications.transfer.v2.QueryDenomRequest")
+	proto.RegisterType((*QueryDenomResponse)(nil), "ibc.applications.transfer.v2.QueryDenomResponse")
+	proto.RegisterType((*QueryDenomsRequest)(nil), "ibc.applications.transfer.v2.QueryDenomsRequest")
+	proto.RegisterType((*QueryDenomsResponse)(nil), "ibc.applications.transfer.v2.QueryDenomsResponse")
+}
+
+func init() {
+	proto.RegisterFile("ibc/applications/transfer/v2/queryv2.proto", fileDescriptor_03a5118d32b8ebb9)
+}
+
+var fileDescriptor_03a5118d32b8ebb9 = []byte{
+	// 1129 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xf7, 0x3a, 0x8e, 0xd3, 0x8e, 0x51, 0xeb, 0x4c, 0x5a, 0x62, 0x6d, 0x13, 0xd7, 0x71, 0x51,
+	0x62, 0x02, 0xdd, 0xa9, 0x5d, 0x28, 0x4a, 0x5b, 0x24, 0x92, 0xd8, 0x6e, 0xac, 0x24, 0x76, 0xba,
+	0x71, 0x00, 0x55, 0x48, 0xcb, 0x7a, 0x77, 0x62, 0xaf, 0x12, 0xef, 0x6c, 0x76, 0xd7, 0xae, 0xa2,
+	0x28, 0x97, 0x9e, 0x50, 0x4e, 0x48, 0xbd, 0x21, 0x85, 0x0b, 0xe2, 0x02, 0x7c, 0x01, 0x2a, 0x3e,
+	0x40, 0x4f, 0xa8, 0x12, 0x17, 0x4e, 0x80, 0x12, 0x4e, 0x7c, 0x0a, 0x34, 0xb3, 0xb3, 0xae, 0xed,
+	0xd8, 0xc6, 0x09, 0x3d, 0x79, 0x77, 0xdf, 0xfb, 0xfd, 0xde, 0xef, 0xfd, 0x19, 0xbf, 0x01, 0xf3,
+	0x46, 0x45, 0x43, 0xaa, 0x65, 0xed, 0x1a, 0x9a, 0xea, 0x1a, 0xc4, 0x74, 0x90, 0x6b, 0xab, 0xa6,
+	0xb3, 0x8d, 0x6d, 0xd4, 0xcc, 0xa0, 0xbd, 0x06, 0xb6, 0xf7, 0x9b, 0x19, 0xc9, 0xb2, 0x89, 0x4b,
+	0xe0, 0x94, 0x51, 0xd1, 0xa4, 0x76, 0x5f, 0xc9, 0xf7, 0x95, 0x9a, 0x19, 0xf1, 0x5a, 0x95, 0x54,
+	0x09, 0x73, 0x44, 0xf4, 0xc9, 0xc3, 0x88, 0xf3, 0x1a, 0x71, 0xea, 0xc4, 0x41, 0x15, 0xd5, 0xc1,
+	0x1e, 0x1d, 0x6a, 0xa6, 0x2b, 0xd8, 0x55, 0xd3, 0xc8, 0x52, 0xab, 0x86, 0xc9, 0x88, 0xb8, 0xef,
+	0x7b, 0x03, 0xb4, 0xa4, 0x5b, 0xcf, 0xdc, 0x39, 0x35, 0x50, 0xb8, 0x4b, 0x76, 0xb0, 0x4f, 0x3b,
+	0x43, 0x3d, 0x35, 0x62, 0x63, 0xa4, 0xd5, 0x54, 0xd3, 0xc4, 0xbb, 0x94, 0x8d, 0x3f, 0x72, 0x97,
+	0xa9, 0x2a, 0x21, 0xd5, 0x5d, 0x8c, 0x54, 0xcb, 0x40, 0xaa, 0x69, 0x12, 0x97, 0xe7, 0xc7, 0xac,
+	0xc9, 0x9f, 0x82, 0x60, 0x22, 0x4f, 0xec, 0xa7, 0xaa, 0xad, 0x63, 0x7d, 0x43, 0xd5, 0x76, 0xb0,
+	0x5b, 0x30, 0xb7, 0x09, 0xcc, 0x82, 0xc8, 0xb6, 0xf7, 0x59, 0xd9, 0xc1, 0xfb, 0x31, 0x21, 0x21,
+	0xa4, 0x22, 0x99, 0x69, 0x89, 0x56, 0x89, 0x86, 0x93, 0xfc, 0x18, 0xcd, 0xb4, 0xc4, 0x51, 0xfa,
+	0x52, 0xe8, 0xe5, 0x1f, 0x37, 0x03, 0x32, 0xe0, 0xb8, 0x55, 0xbc, 0x0f, 0x17, 0x40, 0xd8, 0x62,
+	0xd6, 0x58, 0x90, 0x11, 0xdc, 0x18, 0x40, 0xc0, 0xe1, 0x1c, 0x00, 0x6f, 0x03, 0xe8, 0x0b, 0xd0,
+	0x48, 0xbd, 0x6e, 0xb8, 0x75, 0x6c, 0xba, 0xb1, 0x91, 0x84, 0x90, 0x7a, 0x4b, 0x1e, 0xe7, 0x96,
+	0xe5, 0x96, 0x01, 0xae, 0x82, 0xb0, 0xe3, 0xaa, 0x6e, 0xc3, 0x89, 0x85, 0x12, 0x42, 0xea, 0x4a,
+	0xe6, 0xae, 0x34, 0xa8, 0xa1, 0x52, 0x57, 0xca, 0x9b, 0x0c, 0x2a, 0x73, 0x0a, 0x18, 0x03, 0x63,
+	0x36, 0x76, 0x6d, 0x03, 0x3b, 0xb1, 0xd1, 0x84, 0x90, 0x0a, 0xc9, 0xfe, 0x6b, 0xf2, 0x5b, 0x01,
+	0x4c, 0x3d, 0xa6, 0x9d, 0xee, 0x22, 0x70, 0x64, 0xbc, 0xd7, 0xc0, 0x8e, 0x0b, 0x27, 0xc1, 0x98,
+	0x45, 0x6c, 0x57, 0x31, 0x74, 0x56, 0xb3, 0xcb, 0x72, 0x98, 0xbe, 0x16, 0x74, 0x38, 0x0d, 0x00,
+	0x4f, 0x99, 0xda, 0x82, 0xcc, 0x76, 0x99, 0x7f, 0x29, 0xe8, 0x30, 0x0f, 0xc0, 0xeb, 0x99, 0x61,
+	0x69, 0x46, 0x32, 0xb3, 0x92, 0x37, 0x60, 0x12, 0x1d, 0x30, 0x89, 0x0d, 0x98, 0xc4, 0x07, 0x4c,
+	0xda, 0x50, 0xab, 0x98, 0xc7, 0x94, 0xdb, 0x90, 0xc9, 0x5f, 0x05, 0x30, 0xdd, 0x47, 0xa0, 0x63,
+	0x11, 0xd3, 0xc1, 0x50, 0x07, 0x7e, 0xf9, 0xb0, 0xae, 0x78, 0xc5, 0x76, 0x62, 0x42, 0x62, 0x24,
+	0x15, 0xc9, 0xa4, 0xcf, 0x55, 0x34, 0x3a, 0x27, 0xbc, 0x69, 0xd1, 0xed, 0xae, 0x68, 0xf0, 0x51,
+	0x47, 0x3e, 0x5e, 0xf7, 0xe7, 0xfe, 0x33, 0x1f, 0x4f, 0x62, 0x47, 0x42, 0x7b, 0xe0, 0x46, 0xaf,
+	0x7c, 0xfe, 0x6f, 0xbd, 0x45, 0x70, 0xc9, 0xa1, 0x14, 0xa6, 0x86, 0x59, 0xb5, 0x43, 0x72, 0xeb,
+	0x3d, 0xf9, 0xac, 0x4f, 0x93, 0x5b, 0x25, 0xac, 0x80, 0x68, 0x77, 0x09, 0xf9, 0x09, 0xb9, 0x70,
+	0x05, 0xaf, 0x76, 0x55, 0x30, 0x89, 0xc0, 0x24, 0xd3, 0xb0, 0x65, 0x3e, 0x35, 0x4c, 0x5d, 0x26,
+	0x0d, 0xd7, 0xef, 0x37, 0xbc, 0x06, 0x46, 0x75, 0x6c, 0x92, 0x3a, 0xcf, 0xd8, 0x7b, 0x49, 0xbe,
+	0x10, 0x40, 0xec, 0x2c, 0x82, 0x2b, 0x7e, 0x00, 0x42, 0x35, 0x62, 0xf9, 0x7d, 0x9e, 0x19, 0xa4,
+	0x32, 0x2d, 0xad, 0x10, 0x8b, 0xab, 0x62, 0x20, 0x5a, 0x4a, 0xda, 0x31, 0xc5, 0x0b, 0xca, 0x4b,
+	0x49, 0xbf, 0x64, 0xe9, 0x07, 0xb8, 0x04, 0x2e, 0xed, 0xaa, 0x8e, 0xab, 0xd4, 0x88, 0xc5, 0x07,
+	0x77, 0x68, 0xfe, 0x31, 0x0a, 0x5c, 0x21, 0x56, 0x72, 0x0e, 0x8c, 0x33, 0xed, 0x8c, 0xd1, 0xcf,
+	0x13, 0x82, 0x50, 0x4d, 0x75, 0x6a, 0x3c, 0x4d, 0xf6, 0x9c, 0x2c, 0x01, 0xd8, 0xee, 0xc8, 0xd3,
+	0x5b, 0x68, 0xaf, 0x48, 0x24, 0x73, 0x6b, 0x70, 0x17, 0x3c, 0x2c, 0x2f, 0xdb, 0x17, 0xed, 0x84,
+	0xad, 0x63, 0xdc, 0x79, 0x1c, 0x85, 0x0b, 0x1f, 0xc7, 0x1f, 0x05, 0x30, 0xd1, 0x41, 0xcf, 0x05,
+	0xaf, 0x82, 0x30, 0x0b, 0xef, 0x77, 0x64, 0x18, 0xc5, 0x4b, 0x57, 0x68, 0xcd, 0x7e, 0xf8, 0xf3,
+	0x66, 0x98, 0x93, 0x71, 0x8a, 0x37, 0x76, 0xd6, 0xe6, 0x7f, 0x0e, 0x82, 0xeb, 0x3d, 0xff, 0x19,
+	0xe1, 0x27, 0xe0, 0x56, 0xbe, 0x24, 0x7f, 0xb6, 0x28, 0x67, 0x73, 0x59, 0x65, 0x63, 0x71, 0x79,
+	0x35, 0x57, 0x56, 0x36, 0xcb, 0x8b, 0xe5, 0xad, 0x4d, 0x65, 0xab, 0xb8, 0xb9, 0x91, 0x5b, 0x2e,
+	0xe4, 0x0b, 0xb9, 0x6c, 0x34, 0x20, 0x4e, 0x1e, 0x1d, 0x27, 0x26, 0xb8, 0x6b, 0xbb, 0x09, 0x3e,
+	0x04, 0x33, 0xfd, 0x18, 0x0a, 0x45, 0x25, 0xbf, 0x56, 0x78, 0xb4, 0x52, 0x8e, 0x0a, 0xe2, 0xf5,
+	0xa3, 0xe3, 0xc4, 0xb8, 0x8f, 0x6f, 0x19, 0x60, 0x1e, 0xcc, 0xf6, 0x43, 0x2f, 0xaf, 0x2c, 0x16,
+	0x8b, 0xb9, 0x35, 0x65, 0x79, 0xad, 0xb4, 0x99, 0xcb, 0x46, 0x83, 0xa2, 0x78, 0x74, 0x9c, 0x78,
+	0xdb, 0xa7, 0xe8, 0xb4, 0x42, 0x19, 0xbc, 0xdf, 0x97, 0xa7, 0xb4, 0xbe, 0x5e, 0x28, 0xaf, 0xe7,
+	0x8a, 0x65, 0xa5, 0x58, 0x2a, 0x2b, 0xf9, 0xd2, 0x56, 0x31, 0x1b, 0x1d, 0x11, 0x13, 0x47, 0xc7,
+	0x89, 0xa9, 0x16, 0x5b, 0x0f, 0x1f, 0x31, 0xf4, 0xd5, 0x77, 0xf1, 0x40, 0xe6, 0xfb, 0x31, 0x30,
+	0xc6, 0x3a, 0xfd, 0x69, 0x06, 0x3e, 0x17, 0x00, 0xef, 0x11, 0xbc, 0x33, 0xb8, 0xb1, 0x67, 0x47,
+	0x4f, 0x4c, 0x9f, 0x03, 0xe1, 0xf5, 0x30, 0xf9, 0xce, 0xb3, 0xdf, 0xfe, 0x7e, 0x1e, 0x8c, 0xc3,
+	0x29, 0xc4, 0x2f, 0x0e, 0x9d, 0x17, 0x06, 0x3e, 0x26, 0xdf, 0x08, 0x60, 0xd4, 0x3b, 0xb1, 0x68,
+	0xd8, 0x10, 0xbe, 0xa6, 0x3b, 0xc3, 0x03, 0xb8, 0x24, 0x89, 0x49, 0x4a, 0xc1, 0xd9, 0x41, 0x92,
+	0xd0, 0x01, 0x3d, 0xd3, 0x1f, 0xcf, 0xcf, 0x1f, 0xc2, 0x5f, 0x04, 0x10, 0xed, 0x5e, 0x59, 0xf0,
+	0xfe, 0x10, 0x61, 0xfb, 0x2c, 0x62, 0xf1, 0xc1, 0x85, 0xb0, 0x5c, 0x3d, 0x62, 0xea, 0xdf, 0x85,
+	0x73, 0xbd, 0xd5, 0x9f, 0xd9, 0x9f, 0xf0, 0x1f, 0x01, 0x5c, 0xed, 0x62, 0x83, 0x0b, 0xe7, 0x57,
+	0xe0, 0x8b, 0xbf, 0x7f, 0x11, 0x28, 0xd7, 0xfe, 0x25, 0xd3, 0xfe, 0x04, 0x7e, 0xde, 0x5b, 0x3b,
+	0x5f, 0x81, 0x0e, 0x3a, 0x78, 0xbd, 0x1e, 0x0f, 0x11, 0x5d, 0x9a, 0x0e, 0x3a, 0xe0, 0xab, 0xf4,
+	0xf0, 0x6c, 0x86, 0xe8, 0xc0, 0x5f, 0x8f, 0x87, 0xf0, 0x85, 0x00, 0x22, 0x6d, 0x4b, 0x06, 0x7e,
+	0x38, 0x84, 0xda, 0xb3, 0x6b, 0x4c, 0xbc, 0x77, 0x5e, 0x18, 0x4f, 0xf0, 0x21, 0x4b, 0xf0, 0x1e,
+	0xfc, 0x60, 0xf0, 0x68, 0xb1, 0x5f, 0x3a, 0x5b, 0xa8, 0xc1, 0x48, 0x14, 0x9b, 0xb2, 0x2c, 0x3d,
+	0x7e, 0x79, 0x12, 0x17, 0x5e, 0x9d, 0xc4, 0x85, 0xbf, 0x4e, 0xe2, 0xc2, 0xd7, 0xa7, 0xf1, 0xc0,
+	0xab, 0xd3, 0x78, 0xe0, 0xf7, 0xd3, 0x78, 0xe0, 0xc9, 0x47, 0x55, 0xc3, 0xad, 0x35, 0x2a, 0x92,
+	0x46, 0xea, 0x88, 0xdf, 0xec, 0x8d, 0x8a, 0x76, 0xbb, 0x4a, 0x50, 0x73, 0x01, 0xd5, 0x89, 0xde,
+	0xd8, 0xc5, 0x4e, 0x57, 0x38, 0x77, 0xdf, 0xc2, 0x4e, 0x25, 0xcc, 0xae, 0xd2, 0x77, 0xff, 0x1d,
+	0x00, 0xe6, 0x74, 0x52, 0x55, 0x70, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryV2Client is the client API for QueryV2 service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryV2Client interface {
+	// Denoms queries all denominations
+	Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error)
+	// Denom queries a denomination
+	Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error)
+	// ForwardedPackets queries the packets whose tokens are being forwarded to the next hop, optionally
+	// filtered by the channel end over which the tokens are forwarded.
+	ForwardedPackets(ctx context.Context, in *QueryForwardedPacketsRequest, opts ...grpc.CallOption) (*QueryForwardedPacketsResponse, error)
+	// ForwardedPacket queries the packet whose tokens are being forwarded by the packet sent over the
+	// provided channel end with the given sequence.
+	ForwardedPacket(ctx context.Context, in *QueryForwardedPacketRequest, opts ...grpc.CallOption) (*QueryForwardedPacketResponse, error)
+	// UnwindRoute queries the route taken by the tokens of a denomination when they are unwound to
+	// their native chain.
+	UnwindRoute(ctx context.Context, in *QueryUnwindRouteRequest, opts ...grpc.CallOption) (*QueryUnwindRouteResponse, error)
+}
+
+type queryV2Client struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryV2Client(cc grpc1.ClientConn) QueryV2Client {
+	return &queryV2Client{cc}
+}
+
+func (c *queryV2Client) Denoms(ctx context.Context, in *QueryDenomsRequest, opts ...grpc.CallOption) (*QueryDenomsResponse, error) {
+	out := new(QueryDenomsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/Denoms", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Hash)
-	if l > 0 {
-		n += 1 + l + sovQueryv2(uint64(l))
+	return out, nil
+}
+
+func (c *queryV2Client) Denom(ctx context.Context, in *QueryDenomRequest, opts ...grpc.CallOption) (*QueryDenomResponse, error) {
+	out := new(QueryDenomResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/Denom", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryV2Client) ForwardedPackets(ctx context.Context, in *QueryForwardedPacketsRequest, opts ...grpc.CallOption) (*QueryForwardedPacketsResponse, error) {
+	out := new(QueryForwardedPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/ForwardedPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Denom != nil {
-		l = m.Denom.Size()
-		n += 1 + l + sovQueryv2(uint64(l))
+	return out, nil
+}
+
+func (c *queryV2Client) ForwardedPacket(ctx context.Context, in *QueryForwardedPacketRequest, opts ...grpc.CallOption) (*QueryForwardedPacketResponse, error) {
+	out := new(QueryForwardedPacketResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/ForwardedPacket", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return out, nil
 }
 
-func (m *QueryDenomsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (c *queryV2Client) UnwindRoute(ctx context.Context, in *QueryUnwindRouteRequest, opts ...grpc.CallOption) (*QueryUnwindRouteResponse, error) {
+	out := new(QueryUnwindRouteResponse)
+	err := c.cc.Invoke(ctx, "/ibc.applications.transfer.v2.QueryV2/UnwindRoute", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQueryv2(uint64(l))
+	return out, nil
+}
+
+// QueryV2Server is the server API for QueryV2 service.
+type QueryV2Server interface {
+	// Denoms queries all denominations
+	Denoms(context.Context, *QueryDenomsRequest) (*QueryDenomsResponse, error)
+	// Denom queries a denomination
+	Denom(context.Context, *QueryDenomRequest) (*QueryDenomResponse, error)
+	// ForwardedPackets queries the packets whose tokens are being forwarded to the next hop, optionally
+	// filtered by the channel end over which the tokens are forwarded.
+	ForwardedPackets(context.Context, *QueryForwardedPacketsRequest) (*QueryForwardedPacketsResponse, error)
+	// ForwardedPacket queries the packet whose tokens are being forwarded by the packet sent over the
+	// provided channel end with the given sequence.
+	ForwardedPacket(context.Context, *QueryForwardedPacketRequest) (*QueryForwardedPacketResponse, error)
+	// UnwindRoute queries the route taken by the tokens of a denomination when they are unwound to
+	// their native chain.
+	UnwindRoute(context.Context, *QueryUnwindRouteRequest) (*QueryUnwindRouteResponse, error)
+}
+
+// UnimplementedQueryV2Server can be embedded to have forward compatible implementations.
+type UnimplementedQueryV2Server struct {
+}
+
+func (*UnimplementedQueryV2Server) Denoms(ctx context.Context, req *QueryDenomsRequest) (*QueryDenomsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denoms not implemented")
+}
+func (*UnimplementedQueryV2Server) Denom(ctx context.Context, req *QueryDenomRequest) (*QueryDenomResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Denom not implemented")
+}
+func (*UnimplementedQueryV2Server) ForwardedPackets(ctx context.Context, req *QueryForwardedPacketsRequest) (*QueryForwardedPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardedPackets not implemented")
+}
+func (*UnimplementedQueryV2Server) ForwardedPacket(ctx context.Context, req *QueryForwardedPacketRequest) (*QueryForwardedPacketResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForwardedPacket not implemented")
+}
+func (*UnimplementedQueryV2Server) UnwindRoute(ctx context.Context, req *QueryUnwindRouteRequest) (*QueryUnwindRouteResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UnwindRoute not implemented")
+}
+
+func RegisterQueryV2Server(s grpc1.Server, srv QueryV2Server) {
+	s.RegisterService(&_QueryV2_serviceDesc, srv)
+}
+
+func _QueryV2_Denoms_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return n
+	if interceptor == nil {
+		return srv.(QueryV2Server).Denoms(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v2.QueryV2/Denoms",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryV2Server).Denoms(ctx, req.(*QueryDenomsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *QueryDenomsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _QueryV2_Denom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	if len(m.Denoms) > 0 {
-		for _, e := range m.Denoms {
-			l = e.Size()
-			n += 1 + l + sovQueryv2(uint64(l))
-		}
+	if interceptor == nil {
+		return srv.(QueryV2Server).Denom(ctx, in)
 	}
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQueryv2(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v2.QueryV2/Denom",
 	}
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryV2Server).Denom(ctx, req.(*QueryDenomRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func sovQueryv2(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func _QueryV2_ForwardedPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardedPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryV2Server).ForwardedPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v2.QueryV2/ForwardedPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryV2Server).ForwardedPackets(ctx, req.(*QueryForwardedPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func sozQueryv2(x uint64) (n int) {
-	return sovQueryv2(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func _QueryV2_ForwardedPacket_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForwardedPacketRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryV2Server).ForwardedPacket(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v2.QueryV2/ForwardedPacket",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryV2Server).ForwardedPacket(ctx, req.(*QueryForwardedPacketRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _QueryV2_UnwindRoute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryUnwindRouteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryV2Server).UnwindRoute(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.applications.transfer.v2.QueryV2/UnwindRoute",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryV2Server).UnwindRoute(ctx, req.(*QueryUnwindRouteRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _QueryV2_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "ibc.applications.transfer.v2.QueryV2",
+	HandlerType: (*QueryV2Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Denoms",
+			Handler:    _QueryV2_Denoms_Handler,
+		},
+		{
+			MethodName: "Denom",
+			Handler:    _QueryV2_Denom_Handler,
+		},
+		{
+			MethodName: "ForwardedPackets",
+			Handler:    _QueryV2_ForwardedPackets_Handler,
+		},
+		{
+			MethodName: "ForwardedPacket",
+			Handler:    _QueryV2_ForwardedPacket_Handler,
+		},
+		{
+			MethodName: "UnwindRoute",
+			Handler:    _QueryV2_UnwindRoute_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "ibc/applications/transfer/v2/queryv2.proto",
+}
+
+func (m *ForwardedPacketInfo) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ForwardedPacketInfo) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ForwardedPacketInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Retries != 0 {
+		i = encodeVarintQueryv2(dAtA, i, uint64(m.Retries))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Status != 0 {
+		i = encodeVarintQueryv2(dAtA, i, uint64(m.Status))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ForwardCommitment) > 0 {
+		i -= len(m.ForwardCommitment)
+		copy(dAtA[i:], m.ForwardCommitment)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.ForwardCommitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Packet.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryv2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.ForwardKey.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryv2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardedPacketsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardedPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardedPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardedPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ForwardedPackets) > 0 {
+		for iNdEx := len(m.ForwardedPackets) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ForwardedPackets[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryv2(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardedPacketRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardedPacketRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardedPacketRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQueryv2(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForwardedPacketResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForwardedPacketResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForwardedPacketResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.ForwardedPacket.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryv2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindRouteRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindRouteRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindRouteRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryUnwindRouteResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryUnwindRouteResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryUnwindRouteResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.LastHop.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQueryv2(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.BaseDenom) > 0 {
+		i -= len(m.BaseDenom)
+		copy(dAtA[i:], m.BaseDenom)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.BaseDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryv2(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Hash) > 0 {
+		i -= len(m.Hash)
+		copy(dAtA[i:], m.Hash)
+		i = encodeVarintQueryv2(dAtA, i, uint64(len(m.Hash)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Denom != nil {
+		{
+			size, err := m.Denom.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryv2(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Denoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQueryv2(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQueryv2(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQueryv2(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ForwardedPacketInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwardKey.Size()
+	n += 1 + l + sovQueryv2(uint64(l))
+	l = m.Packet.Size()
+	n += 1 + l + sovQueryv2(uint64(l))
+	l = len(m.ForwardCommitment)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	if m.Status != 0 {
+		n += 1 + sovQueryv2(uint64(m.Status))
+	}
+	if m.Retries != 0 {
+		n += 1 + sovQueryv2(uint64(m.Retries))
+	}
+	return n
+}
+
+func (m *QueryForwardedPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardedPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.ForwardedPackets) > 0 {
+		for _, e := range m.ForwardedPackets {
+			l = e.Size()
+			n += 1 + l + sovQueryv2(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForwardedPacketRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovQueryv2(uint64(m.Sequence))
+	}
+	return n
+}
+
+func (m *QueryForwardedPacketResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.ForwardedPacket.Size()
+	n += 1 + l + sovQueryv2(uint64(l))
+	return n
+}
+
+func (m *QueryUnwindRouteRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryUnwindRouteResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovQueryv2(uint64(l))
+		}
+	}
+	l = len(m.BaseDenom)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	l = m.LastHop.Size()
+	n += 1 + l + sovQueryv2(uint64(l))
+	return n
+}
+
+func (m *QueryDenomRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Hash)
+	if l > 0 {
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Denom != nil {
+		l = m.Denom.Size()
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for _, e := range m.Denoms {
+			l = e.Size()
+			n += 1 + l + sovQueryv2(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQueryv2(uint64(l))
+	}
+	return n
+}
+
+func sovQueryv2(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQueryv2(x uint64) (n int) {
+	return sovQueryv2(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ForwardedPacketInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ForwardedPacketInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ForwardedPacketInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardKey", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardKey.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Packet", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Packet.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardCommitment", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardCommitment = append(m.ForwardCommitment[:0], dAtA[iNdEx:postIndex]...)
+			if m.ForwardCommitment == nil {
+				m.ForwardCommitment = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			m.Status = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Status |= ForwardedPacketStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Retries", wireType)
+			}
+			m.Retries = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Retries |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardedPacketsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardedPacketsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardedPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardedPacketsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardedPacketsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardedPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPackets", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ForwardedPackets = append(m.ForwardedPackets, ForwardedPacketInfo{})
+			if err := m.ForwardedPackets[len(m.ForwardedPackets)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardedPacketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardedPacketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardedPacketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForwardedPacketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryForwardedPacketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryForwardedPacketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardedPacket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ForwardedPacket.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnwindRouteRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnwindRouteRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnwindRouteRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryUnwindRouteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryv2
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryUnwindRouteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryUnwindRouteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, Hop{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BaseDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHop", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryv2
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastHop.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryv2(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryv2
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
 func (m *QueryDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
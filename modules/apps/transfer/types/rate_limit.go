@@ -0,0 +1,157 @@
+package types
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+const (
+	// FlowDirectionOutflow identifies tokens leaving the chain through a channel end.
+	FlowDirectionOutflow = "outflow"
+	// FlowDirectionInflow identifies tokens entering the chain through a channel end.
+	FlowDirectionInflow = "inflow"
+
+	// maxPercent is the upper bound of the percentage based quotas.
+	maxPercent = 100
+)
+
+// NewQuota creates a new Quota instance.
+func NewQuota(maxOutflow, maxInflow sdkmath.Int, maxPercentOutflow, maxPercentInflow uint64, window time.Duration) Quota {
+	return Quota{
+		MaxOutflow:        maxOutflow,
+		MaxInflow:         maxInflow,
+		MaxPercentOutflow: maxPercentOutflow,
+		MaxPercentInflow:  maxPercentInflow,
+		Window:            window,
+	}
+}
+
+// Validate performs a basic validation of the quota fields.
+func (q Quota) Validate() error {
+	if q.MaxOutflow.IsNil() || q.MaxOutflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max outflow must not be negative: %s", q.MaxOutflow)
+	}
+	if q.MaxInflow.IsNil() || q.MaxInflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max inflow must not be negative: %s", q.MaxInflow)
+	}
+	if q.MaxPercentOutflow > maxPercent {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent outflow must not exceed %d: %d", maxPercent, q.MaxPercentOutflow)
+	}
+	if q.MaxPercentInflow > maxPercent {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "max percent inflow must not exceed %d: %d", maxPercent, q.MaxPercentInflow)
+	}
+	if q.MaxOutflow.IsZero() && q.MaxInflow.IsZero() && q.MaxPercentOutflow == 0 && q.MaxPercentInflow == 0 {
+		return errorsmod.Wrap(ErrInvalidRateLimit, "at least one threshold must be set")
+	}
+	if q.Window <= 0 {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "window must be positive: %s", q.Window)
+	}
+
+	return nil
+}
+
+// Threshold returns the maximum amount that may flow in the given direction within a window,
+// given the supply snapshotted at the start of the window. The most restrictive of the absolute
+// and percentage based thresholds is returned. A boolean is returned indicating if any threshold
+// applies. A zero supply snapshot results in a zero percentage based threshold, so that no tokens of a
+// denomination without supply, such as a voucher not yet received, can flow until the next window.
+func (q Quota) Threshold(direction string, supply sdkmath.Int) (sdkmath.Int, bool) {
+	maxAmount, percent := q.MaxOutflow, q.MaxPercentOutflow
+	if direction == FlowDirectionInflow {
+		maxAmount, percent = q.MaxInflow, q.MaxPercentInflow
+	}
+
+	threshold, found := sdkmath.ZeroInt(), false
+	if maxAmount.IsPositive() {
+		threshold, found = maxAmount, true
+	}
+
+	if percent > 0 {
+		percentThreshold := supply.Mul(sdkmath.NewIntFromUint64(percent)).Quo(sdkmath.NewInt(maxPercent))
+		if !found || percentThreshold.LT(threshold) {
+			threshold, found = percentThreshold, true
+		}
+	}
+
+	return threshold, found
+}
+
+// NewFlow creates a new Flow instance for a window ending at windowEnd with the
+// given supply snapshot.
+func NewFlow(supply sdkmath.Int, windowEnd time.Time) Flow {
+	return Flow{
+		Outflow:   sdkmath.ZeroInt(),
+		Inflow:    sdkmath.ZeroInt(),
+		Supply:    supply,
+		WindowEnd: windowEnd,
+	}
+}
+
+// Amount returns the amount that has flowed in the given direction within the window.
+func (f Flow) Amount(direction string) sdkmath.Int {
+	if direction == FlowDirectionInflow {
+		return f.Inflow
+	}
+
+	return f.Outflow
+}
+
+// Validate performs a basic validation of the flow fields.
+func (f Flow) Validate() error {
+	if f.Outflow.IsNil() || f.Outflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "outflow must not be negative: %s", f.Outflow)
+	}
+	if f.Inflow.IsNil() || f.Inflow.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "inflow must not be negative: %s", f.Inflow)
+	}
+	if f.Supply.IsNil() || f.Supply.IsNegative() {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "supply must not be negative: %s", f.Supply)
+	}
+
+	return nil
+}
+
+// NewRateLimit creates a new RateLimit instance with an empty flow. The flow window
+// is started on the first transfer subject to the rate limit.
+func NewRateLimit(portID, channelID, denom string, quota Quota) RateLimit {
+	return RateLimit{
+		PortId:    portID,
+		ChannelId: channelID,
+		Denom:     denom,
+		Quota:     quota,
+		Flow:      NewFlow(sdkmath.ZeroInt(), time.Time{}),
+	}
+}
+
+// Validate performs a basic validation of the rate limit fields.
+func (rl RateLimit) Validate() error {
+	if err := ValidateRateLimitIdentifiers(rl.PortId, rl.ChannelId, rl.Denom); err != nil {
+		return err
+	}
+	if err := rl.Quota.Validate(); err != nil {
+		return err
+	}
+
+	return rl.Flow.Validate()
+}
+
+// ValidateRateLimitIdentifiers validates the port, channel and denom a rate limit is keyed by.
+func ValidateRateLimitIdentifiers(portID, channelID, denom string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrapf(err, "invalid rate limit port ID %s", portID)
+	}
+	if err := host.ChannelIdentifierValidator(channelID); err != nil {
+		return errorsmod.Wrapf(err, "invalid rate limit channel ID %s", channelID)
+	}
+	if err := sdk.ValidateDenom(denom); err != nil {
+		return errorsmod.Wrapf(ErrInvalidRateLimit, "invalid denom %s: %s", denom, err)
+	}
+
+	return nil
+}
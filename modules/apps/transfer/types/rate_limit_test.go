@@ -0,0 +1,194 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+func TestRateLimitValidate(t *testing.T) {
+	var rateLimit types.RateLimit
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: absolute quota",
+			func() {},
+			nil,
+		},
+		{
+			"success: percentage quota",
+			func() {
+				rateLimit.Quota = types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 10, 100, time.Hour)
+			},
+			nil,
+		},
+		{
+			"success: ibc denom",
+			func() {
+				rateLimit.Denom = types.NewDenom("uatom", types.NewHop(types.PortID, "channel-1")).IBCDenom()
+			},
+			nil,
+		},
+		{
+			"failure: invalid port ID",
+			func() {
+				rateLimit.PortId = ""
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid channel ID",
+			func() {
+				rateLimit.ChannelId = "channel"
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"failure: invalid denom",
+			func() {
+				rateLimit.Denom = "1atom"
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"failure: negative max outflow",
+			func() {
+				rateLimit.Quota.MaxOutflow = sdkmath.NewInt(-1)
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"failure: max percent inflow exceeds 100",
+			func() {
+				rateLimit.Quota.MaxPercentInflow = 101
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"failure: no threshold set",
+			func() {
+				rateLimit.Quota.MaxOutflow = sdkmath.ZeroInt()
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"failure: zero window",
+			func() {
+				rateLimit.Quota.Window = 0
+			},
+			types.ErrInvalidRateLimit,
+		},
+		{
+			"failure: negative flow",
+			func() {
+				rateLimit.Flow.Inflow = sdkmath.NewInt(-1)
+			},
+			types.ErrInvalidRateLimit,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			rateLimit = types.NewRateLimit(types.PortID, "channel-0", "uatom", types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour))
+
+			tc.malleate()
+
+			err := rateLimit.Validate()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestQuotaThreshold(t *testing.T) {
+	testCases := []struct {
+		name         string
+		quota        types.Quota
+		direction    string
+		supply       sdkmath.Int
+		expThreshold sdkmath.Int
+		expFound     bool
+	}{
+		{
+			"absolute outflow threshold",
+			types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour),
+			types.FlowDirectionOutflow,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(100),
+			true,
+		},
+		{
+			"no inflow threshold",
+			types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 0, 0, time.Hour),
+			types.FlowDirectionInflow,
+			sdkmath.NewInt(1000),
+			sdkmath.ZeroInt(),
+			false,
+		},
+		{
+			"percentage inflow threshold",
+			types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, 5, time.Hour),
+			types.FlowDirectionInflow,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(50),
+			true,
+		},
+		{
+			"percentage threshold is more restrictive",
+			types.NewQuota(sdkmath.NewInt(100), sdkmath.ZeroInt(), 5, 0, time.Hour),
+			types.FlowDirectionOutflow,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(50),
+			true,
+		},
+		{
+			"absolute threshold is more restrictive",
+			types.NewQuota(sdkmath.NewInt(10), sdkmath.ZeroInt(), 5, 0, time.Hour),
+			types.FlowDirectionOutflow,
+			sdkmath.NewInt(1000),
+			sdkmath.NewInt(10),
+			true,
+		},
+		{
+			"percentage threshold is zero for zero supply",
+			types.NewQuota(sdkmath.ZeroInt(), sdkmath.ZeroInt(), 0, 5, time.Hour),
+			types.FlowDirectionInflow,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			true,
+		},
+		{
+			"percentage threshold is zero for zero supply, overriding absolute threshold",
+			types.NewQuota(sdkmath.ZeroInt(), sdkmath.NewInt(100), 0, 5, time.Hour),
+			types.FlowDirectionInflow,
+			sdkmath.ZeroInt(),
+			sdkmath.ZeroInt(),
+			true,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			threshold, found := tc.quota.Threshold(tc.direction, tc.supply)
+			require.Equal(t, tc.expFound, found)
+			require.Equal(t, tc.expThreshold, threshold)
+		})
+	}
+}
@@ -0,0 +1,65 @@
+package types
+
+import (
+	"math"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+// NewRelativeTimeout creates a new RelativeTimeout instance given a height offset and a timestamp offset in nanoseconds.
+func NewRelativeTimeout(heightOffset, timestampOffset uint64) *RelativeTimeout {
+	return &RelativeTimeout{
+		HeightOffset:    heightOffset,
+		TimestampOffset: timestampOffset,
+	}
+}
+
+// Validate performs a basic validation of the RelativeTimeout fields.
+func (rt RelativeTimeout) Validate() error {
+	if rt.HeightOffset == 0 && rt.TimestampOffset == 0 {
+		return errorsmod.Wrap(ErrInvalidPacketTimeout, "relative timeout height offset and timestamp offset cannot both be zero")
+	}
+
+	return nil
+}
+
+// AbsoluteTimeouts returns the absolute timeout height and timestamp obtained by adding the offsets of the relative
+// timeout to the provided latest height and timestamp of the counterparty chain. Offsets set to zero leave the
+// corresponding timeout disabled.
+func (rt RelativeTimeout) AbsoluteTimeouts(latestHeight clienttypes.Height, latestTimestamp uint64) (clienttypes.Height, uint64, error) {
+	timeoutHeight := clienttypes.ZeroHeight()
+	if rt.HeightOffset != 0 {
+		if rt.HeightOffset > math.MaxUint64-latestHeight.RevisionHeight {
+			return clienttypes.ZeroHeight(), 0, errorsmod.Wrapf(ErrInvalidPacketTimeout, "height offset %d overflows latest height %s", rt.HeightOffset, latestHeight)
+		}
+
+		timeoutHeight = clienttypes.NewHeight(latestHeight.RevisionNumber, latestHeight.RevisionHeight+rt.HeightOffset)
+	}
+
+	var timeoutTimestamp uint64
+	if rt.TimestampOffset != 0 {
+		if rt.TimestampOffset > math.MaxUint64-latestTimestamp {
+			return clienttypes.ZeroHeight(), 0, errorsmod.Wrapf(ErrInvalidPacketTimeout, "timestamp offset %d overflows latest timestamp %d", rt.TimestampOffset, latestTimestamp)
+		}
+
+		timeoutTimestamp = latestTimestamp + rt.TimestampOffset
+	}
+
+	return timeoutHeight, timeoutTimestamp, nil
+}
+
+// IsWithin returns true if every non-zero offset of the provided maximum is matched by a non-zero offset of
+// the relative timeout that does not exceed it.
+func (rt RelativeTimeout) IsWithin(maxTimeout RelativeTimeout) bool {
+	if maxTimeout.HeightOffset != 0 && (rt.HeightOffset == 0 || rt.HeightOffset > maxTimeout.HeightOffset) {
+		return false
+	}
+
+	if maxTimeout.TimestampOffset != 0 && (rt.TimestampOffset == 0 || rt.TimestampOffset > maxTimeout.TimestampOffset) {
+		return false
+	}
+
+	return true
+}
@@ -0,0 +1,96 @@
+package types_test
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+func TestRelativeTimeoutAbsoluteTimeouts(t *testing.T) {
+	latestHeight := clienttypes.NewHeight(1, 100)
+	latestTimestamp := uint64(1_000_000)
+
+	testCases := []struct {
+		name            string
+		relativeTimeout *types.RelativeTimeout
+		expHeight       clienttypes.Height
+		expTimestamp    uint64
+		expError        error
+	}{
+		{
+			"success: height and timestamp offsets",
+			types.NewRelativeTimeout(10, 500),
+			clienttypes.NewHeight(1, 110),
+			1_000_500,
+			nil,
+		},
+		{
+			"success: only height offset",
+			types.NewRelativeTimeout(10, 0),
+			clienttypes.NewHeight(1, 110),
+			0,
+			nil,
+		},
+		{
+			"success: only timestamp offset",
+			types.NewRelativeTimeout(0, 500),
+			clienttypes.ZeroHeight(),
+			1_000_500,
+			nil,
+		},
+		{
+			"failure: height offset overflows",
+			types.NewRelativeTimeout(math.MaxUint64, 0),
+			clienttypes.ZeroHeight(),
+			0,
+			types.ErrInvalidPacketTimeout,
+		},
+		{
+			"failure: timestamp offset overflows",
+			types.NewRelativeTimeout(0, math.MaxUint64),
+			clienttypes.ZeroHeight(),
+			0,
+			types.ErrInvalidPacketTimeout,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			timeoutHeight, timeoutTimestamp, err := tc.relativeTimeout.AbsoluteTimeouts(latestHeight, latestTimestamp)
+
+			expPass := tc.expError == nil
+			if expPass {
+				require.NoError(t, err)
+				require.Equal(t, tc.expHeight, timeoutHeight)
+				require.Equal(t, tc.expTimestamp, timeoutTimestamp)
+			} else {
+				require.ErrorIs(t, err, tc.expError)
+			}
+		})
+	}
+}
+
+func TestRelativeTimeoutIsWithin(t *testing.T) {
+	testCases := []struct {
+		name            string
+		relativeTimeout *types.RelativeTimeout
+		maxTimeout      *types.RelativeTimeout
+		expWithin       bool
+	}{
+		{"within height and timestamp offsets", types.NewRelativeTimeout(10, 100), types.NewRelativeTimeout(10, 200), true},
+		{"within timestamp offset, height offset unbounded", types.NewRelativeTimeout(1000, 100), types.NewRelativeTimeout(0, 100), true},
+		{"height offset exceeds maximum", types.NewRelativeTimeout(11, 100), types.NewRelativeTimeout(10, 100), false},
+		{"timestamp offset exceeds maximum", types.NewRelativeTimeout(10, 101), types.NewRelativeTimeout(10, 100), false},
+		{"timestamp offset disabled while maximum is set", types.NewRelativeTimeout(10, 0), types.NewRelativeTimeout(10, 100), false},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expWithin, tc.relativeTimeout.IsWithin(*tc.maxTimeout))
+		})
+	}
+}
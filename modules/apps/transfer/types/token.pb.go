@@ -133,9 +133,66 @@ func (m *Denom) GetTrace() []Hop {
 	return nil
 }
 
+// DenomMigration defines the migration of the vouchers of a denomination to another trace with the same
+// base denomination, e.g. after the channel over which the vouchers were received has been replaced.
+type DenomMigration struct {
+	// the denomination whose vouchers are migrated
+	OldDenom Denom `protobuf:"bytes,1,opt,name=old_denom,json=oldDenom,proto3" json:"old_denom"`
+	// the denomination the vouchers are migrated to
+	NewDenom Denom `protobuf:"bytes,2,opt,name=new_denom,json=newDenom,proto3" json:"new_denom"`
+}
+
+func (m *DenomMigration) Reset()         { *m = DenomMigration{} }
+func (m *DenomMigration) String() string { return proto.CompactTextString(m) }
+func (*DenomMigration) ProtoMessage()    {}
+func (*DenomMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_732b93aa1330663e, []int{2}
+}
+func (m *DenomMigration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomMigration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomMigration.Merge(m, src)
+}
+func (m *DenomMigration) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomMigration proto.InternalMessageInfo
+
+func (m *DenomMigration) GetOldDenom() Denom {
+	if m != nil {
+		return m.OldDenom
+	}
+	return Denom{}
+}
+
+func (m *DenomMigration) GetNewDenom() Denom {
+	if m != nil {
+		return m.NewDenom
+	}
+	return Denom{}
+}
+
 func init() {
 	proto.RegisterType((*Token)(nil), "ibc.applications.transfer.v2.Token")
 	proto.RegisterType((*Denom)(nil), "ibc.applications.transfer.v2.Denom")
+	proto.RegisterType((*DenomMigration)(nil), "ibc.applications.transfer.v2.DenomMigration")
 }
 
 func init() {
@@ -143,25 +200,28 @@ func init() {
 }
 
 var fileDescriptor_732b93aa1330663e = []byte{
-	// 286 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x18, 0x86, 0x1b, 0xb7, 0x0e, 0x96, 0xdd, 0x82, 0xc8, 0x18, 0x12, 0xe7, 0xbc, 0x14, 0xc4, 0x84,
-	0xd5, 0x83, 0x78, 0x10, 0x61, 0x78, 0xf0, 0xea, 0xf0, 0xb4, 0x93, 0x49, 0x16, 0x6b, 0x70, 0xed,
-	0x57, 0x9a, 0x6c, 0xe0, 0xbf, 0xf0, 0x67, 0xed, 0xb8, 0xa3, 0x27, 0x91, 0xf6, 0x8f, 0x48, 0x53,
-	0x27, 0x3b, 0xf5, 0xf6, 0x26, 0x3c, 0xdf, 0xfb, 0xc0, 0x8b, 0x23, 0x23, 0x15, 0x17, 0x79, 0xbe,
-	0x32, 0x4a, 0x38, 0x03, 0x99, 0xe5, 0xae, 0x10, 0x99, 0x7d, 0xd5, 0x05, 0xdf, 0xc4, 0xdc, 0xc1,
-	0xbb, 0xce, 0x58, 0x5e, 0x80, 0x03, 0x72, 0x6a, 0xa4, 0x62, 0x87, 0x24, 0xdb, 0x93, 0x6c, 0x13,
-	0x8f, 0x2e, 0x5b, 0x7a, 0xa6, 0xff, 0xb9, 0xa9, 0x1a, 0x1d, 0x27, 0x90, 0x80, 0x8f, 0xbc, 0x4e,
-	0xcd, 0xef, 0xe4, 0x05, 0x87, 0xcf, 0xb5, 0x8f, 0xdc, 0xe3, 0x70, 0xa9, 0x33, 0x48, 0x87, 0x68,
-	0x8c, 0xa2, 0x41, 0x7c, 0xc1, 0xda, 0xcc, 0xec, 0xa1, 0x46, 0x67, 0xdd, 0xed, 0xf7, 0x59, 0x30,
-	0x6f, 0xee, 0xc8, 0x09, 0xee, 0x89, 0x14, 0xd6, 0x99, 0x1b, 0x1e, 0x8d, 0x51, 0xd4, 0x9f, 0xff,
-	0xbd, 0x26, 0x0b, 0x1c, 0x7a, 0x9a, 0x10, 0xdc, 0x95, 0xc2, 0x6a, 0x2f, 0xe8, 0xcf, 0x7d, 0x26,
-	0x77, 0x38, 0x74, 0x85, 0x50, 0x7a, 0xd8, 0x19, 0x77, 0xa2, 0x41, 0x7c, 0xde, 0x66, 0x9d, 0xb2,
-	0x47, 0xc8, 0xf7, 0x4e, 0x7f, 0x35, 0x7b, 0xda, 0x96, 0x14, 0xed, 0x4a, 0x8a, 0x7e, 0x4a, 0x8a,
-	0x3e, 0x2b, 0x1a, 0xec, 0x2a, 0x1a, 0x7c, 0x55, 0x34, 0x58, 0xdc, 0x24, 0xc6, 0xbd, 0xad, 0x25,
-	0x53, 0x90, 0x72, 0x05, 0x36, 0x05, 0xcb, 0x8d, 0x54, 0x57, 0x09, 0xf0, 0xcd, 0x2d, 0x4f, 0x61,
-	0xb9, 0x5e, 0x69, 0x5b, 0x4f, 0x77, 0x30, 0x99, 0xfb, 0xc8, 0xb5, 0x95, 0x3d, 0xbf, 0xcb, 0xf5,
-	0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd9, 0x51, 0x5a, 0x6f, 0xa4, 0x01, 0x00, 0x00,
+	// 328 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x91, 0x31, 0x4b, 0xc3, 0x40,
+	0x1c, 0xc5, 0x93, 0xb6, 0x29, 0xe6, 0x0a, 0x0e, 0x87, 0x48, 0x29, 0x12, 0x6b, 0x5d, 0x02, 0xe2,
+	0x1d, 0x8d, 0x83, 0x38, 0x88, 0x20, 0x52, 0x5c, 0x1c, 0x2c, 0x4e, 0x5d, 0x34, 0xb9, 0x9c, 0xf1,
+	0x30, 0xb9, 0x7f, 0xc8, 0x5d, 0x5b, 0xfc, 0x16, 0x7e, 0x04, 0x3f, 0x4e, 0xc7, 0x8e, 0x4e, 0x22,
+	0xed, 0x17, 0x91, 0x5c, 0x5a, 0xe9, 0x54, 0xd0, 0xed, 0xfd, 0xc3, 0x7b, 0xef, 0x97, 0xe3, 0x21,
+	0x5f, 0x44, 0x8c, 0x86, 0x79, 0x9e, 0x0a, 0x16, 0x6a, 0x01, 0x52, 0x51, 0x5d, 0x84, 0x52, 0x3d,
+	0xf3, 0x82, 0x4e, 0x02, 0xaa, 0xe1, 0x95, 0x4b, 0x92, 0x17, 0xa0, 0x01, 0x1f, 0x88, 0x88, 0x91,
+	0x4d, 0x27, 0x59, 0x3b, 0xc9, 0x24, 0xe8, 0x9c, 0x6c, 0xe9, 0xe9, 0xff, 0xea, 0xaa, 0xaa, 0xb3,
+	0x97, 0x40, 0x02, 0x46, 0xd2, 0x52, 0x55, 0x5f, 0x7b, 0x4f, 0xc8, 0x79, 0x28, 0x79, 0xf8, 0x0a,
+	0x39, 0x31, 0x97, 0x90, 0xb5, 0xed, 0xae, 0xed, 0xb7, 0x82, 0x63, 0xb2, 0x8d, 0x4c, 0x6e, 0x4a,
+	0xeb, 0x75, 0x63, 0xf6, 0x75, 0x68, 0x0d, 0xab, 0x1c, 0xde, 0x47, 0xcd, 0x30, 0x83, 0xb1, 0xd4,
+	0xed, 0x5a, 0xd7, 0xf6, 0xdd, 0xe1, 0xea, 0xea, 0x8d, 0x90, 0x63, 0xdc, 0x18, 0xa3, 0x46, 0x14,
+	0x2a, 0x6e, 0x00, 0xee, 0xd0, 0x68, 0x7c, 0x89, 0x1c, 0x5d, 0x84, 0x8c, 0xb7, 0xeb, 0xdd, 0xba,
+	0xdf, 0x0a, 0x8e, 0xb6, 0x51, 0xfb, 0xe4, 0x16, 0xf2, 0x35, 0xd3, 0xa4, 0x7a, 0x1f, 0x36, 0xda,
+	0x35, 0xe5, 0x77, 0x22, 0x29, 0x4c, 0x00, 0x0f, 0x90, 0x0b, 0x69, 0xfc, 0xf8, 0xcf, 0xb7, 0xec,
+	0x40, 0x1a, 0x57, 0x7f, 0x3b, 0x40, 0xae, 0xe4, 0xd3, 0x55, 0x4f, 0xed, 0xcf, 0x3d, 0x92, 0x4f,
+	0xab, 0xfb, 0x7e, 0xb6, 0xf0, 0xec, 0xf9, 0xc2, 0xb3, 0xbf, 0x17, 0x9e, 0xfd, 0xbe, 0xf4, 0xac,
+	0xf9, 0xd2, 0xb3, 0x3e, 0x97, 0x9e, 0x35, 0x3a, 0x4f, 0x84, 0x7e, 0x19, 0x47, 0x84, 0x41, 0x46,
+	0x19, 0xa8, 0x0c, 0x14, 0x15, 0x11, 0x3b, 0x4d, 0x80, 0x4e, 0x2e, 0x68, 0x06, 0xf1, 0x38, 0xe5,
+	0xaa, 0x5c, 0x77, 0x63, 0x55, 0xfd, 0x96, 0x73, 0x15, 0x35, 0xcd, 0x74, 0x67, 0x3f, 0x03, 0x00,
+	0x2f, 0x10, 0x77, 0xbd, 0x47, 0x02, 0x00, 0x00,
 }
 
 func (m *Token) Marshal() (dAtA []byte, err error) {
@@ -248,6 +308,49 @@ func (m *Denom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DenomMigration) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomMigration) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomMigration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.NewDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.OldDenom.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintToken(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintToken(dAtA []byte, offset int, v uint64) int {
 	offset -= sovToken(v)
 	base := offset
@@ -293,6 +396,19 @@ func (m *Denom) Size() (n int) {
 	return n
 }
 
+func (m *DenomMigration) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.OldDenom.Size()
+	n += 1 + l + sovToken(uint64(l))
+	l = m.NewDenom.Size()
+	n += 1 + l + sovToken(uint64(l))
+	return n
+}
+
 func sovToken(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -530,6 +646,122 @@ func (m *Denom) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DenomMigration) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToken
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomMigration: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomMigration: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.OldDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDenom", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToken
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToken
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToken
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NewDenom.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToken(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToken
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipToken(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
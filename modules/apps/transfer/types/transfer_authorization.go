@@ -4,6 +4,7 @@ import (
 	"context"
 	"slices"
 	"strings"
+	"time"
 
 	"github.com/cosmos/gogoproto/proto"
 
@@ -47,16 +48,32 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrNotFound, "requested port and channel allocation does not exist")
 	}
 
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	blockTime := ctx.BlockTime()
+
+	if a.Allocations[index].IsExpired(blockTime) {
+		return authz.AcceptResponse{}, errorsmod.Wrapf(ErrInvalidAuthorization, "requested port and channel allocation expired at %s", a.Allocations[index].Expiration)
+	}
+
 	if err := validateForwarding(msgTransfer.Forwarding, a.Allocations[index].AllowedForwarding); err != nil {
 		return authz.AcceptResponse{}, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := validateRelativeTimeout(msgTransfer.RelativeTimeout, a.Allocations[index].MaxRelativeTimeout); err != nil {
+		return authz.AcceptResponse{}, err
+	}
 
 	if !isAllowedAddress(ctx, msgTransfer.Receiver, a.Allocations[index].AllowList) {
 		return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed receiver address for transfer")
 	}
 
+	// the fallback receivers may receive the tokens on the chains of the intermediate hops
+	for _, fallbackReceiver := range msgTransfer.Forwarding.GetFallbackReceivers() {
+		if fallbackReceiver != "" && !isAllowedAddress(ctx, fallbackReceiver, a.Allocations[index].AllowList) {
+			return authz.AcceptResponse{}, errorsmod.Wrap(ibcerrors.ErrInvalidAddress, "not allowed fallback receiver address for transfer")
+		}
+	}
+
 	if err := validateMemo(ctx, msgTransfer.Memo, a.Allocations[index].AllowedPacketData); err != nil {
 		return authz.AcceptResponse{}, err
 	}
@@ -64,6 +81,18 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 	// bool flag to see if we have updated any of the allocations
 	allocationModified := false
 
+	// spend from the periodic spend limit, which is copied as it is updated in place
+	if a.Allocations[index].PeriodicSpendLimit != nil {
+		periodicSpendLimit := *a.Allocations[index].PeriodicSpendLimit
+		if err := periodicSpendLimit.spend(blockTime, msgTransfer.GetCoins()); err != nil {
+			return authz.AcceptResponse{}, err
+		}
+
+		allocationModified = true
+
+		a.Allocations[index].PeriodicSpendLimit = &periodicSpendLimit
+	}
+
 	// update spend limit for each token in the MsgTransfer
 	for _, coin := range msgTransfer.GetCoins() {
 		// If the spend limit is set to the MaxUint256 sentinel value, do not subtract the amount from the spend limit.
@@ -90,6 +119,14 @@ func (a TransferAuthorization) Accept(goCtx context.Context, msg proto.Message)
 		a.Allocations = append(a.Allocations[:index], a.Allocations[index+1:]...)
 	}
 
+	// remove the allocations which have expired, so that the authorization is deleted once all of them expire
+	if allocations := slices.DeleteFunc(a.Allocations, func(allocation Allocation) bool {
+		return allocation.IsExpired(blockTime)
+	}); len(allocations) != len(a.Allocations) {
+		a.Allocations = allocations
+		allocationModified = true
+	}
+
 	if len(a.Allocations) == 0 {
 		return authz.AcceptResponse{Accept: true, Delete: true}, nil
 	}
@@ -142,6 +179,18 @@ func (a TransferAuthorization) ValidateBasic() error {
 			found[allocation.AllowList[i]] = true
 		}
 
+		if allocation.PeriodicSpendLimit != nil {
+			if err := allocation.PeriodicSpendLimit.Validate(); err != nil {
+				return errorsmod.Wrap(err, "invalid periodic spend limit")
+			}
+		}
+
+		if allocation.MaxRelativeTimeout != nil {
+			if err := allocation.MaxRelativeTimeout.Validate(); err != nil {
+				return errorsmod.Wrap(err, "invalid max relative timeout")
+			}
+		}
+
 		for i := 0; i < len(allocation.AllowedForwarding); i++ {
 			for _, hop := range allocation.AllowedForwarding[i].Hops {
 				if err := hop.Validate(); err != nil {
@@ -154,6 +203,59 @@ func (a TransferAuthorization) ValidateBasic() error {
 	return nil
 }
 
+// IsExpired returns true if the allocation has an expiration and the provided block time is at or after it.
+func (a Allocation) IsExpired(blockTime time.Time) bool {
+	return a.Expiration != nil && !blockTime.Before(*a.Expiration)
+}
+
+// NewPeriodicSpendLimit creates a new PeriodicSpendLimit instance allowing periodSpendLimit to be transferred
+// every period. The first period starts with the first transfer.
+func NewPeriodicSpendLimit(period time.Duration, periodSpendLimit sdk.Coins) *PeriodicSpendLimit {
+	return &PeriodicSpendLimit{
+		Period:           period,
+		PeriodSpendLimit: periodSpendLimit,
+	}
+}
+
+// Validate performs a basic validation of the periodic spend limit.
+func (p PeriodicSpendLimit) Validate() error {
+	if p.Period <= 0 {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "period must be positive, got %s", p.Period)
+	}
+
+	if p.PeriodSpendLimit.Empty() {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidCoins, "period spend limit cannot be empty")
+	}
+
+	if err := p.PeriodSpendLimit.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period spend limit: %s", err.Error())
+	}
+
+	if err := p.PeriodCanSpend.Validate(); err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidCoins, "invalid period can spend: %s", err.Error())
+	}
+
+	return nil
+}
+
+// spend subtracts the coins from the amount left to be transferred within the current period, after starting a
+// new period if the current one has ended. An error is returned if the coins exceed the amount left.
+func (p *PeriodicSpendLimit) spend(blockTime time.Time, coins sdk.Coins) error {
+	if !blockTime.Before(p.PeriodReset) {
+		p.PeriodCanSpend = p.PeriodSpendLimit
+		p.PeriodReset = blockTime.Add(p.Period)
+	}
+
+	canSpendLeft, isNegative := p.PeriodCanSpend.SafeSub(coins...)
+	if isNegative {
+		return errorsmod.Wrapf(ibcerrors.ErrInsufficientFunds, "requested amount %s is more than period spend limit left %s, which resets at %s", coins, p.PeriodCanSpend, p.PeriodReset)
+	}
+
+	p.PeriodCanSpend = canSpendLeft
+
+	return nil
+}
+
 // isAllowedAddress returns a boolean indicating if the receiver address is valid for transfer.
 // gasCostPerIteration gas is consumed for each iteration.
 func isAllowedAddress(ctx sdk.Context, receiver string, allowedAddrs []string) bool {
@@ -189,6 +291,24 @@ func validateForwarding(forwarding *Forwarding, allowedForwarding []AllowedForwa
 	return nil
 }
 
+// validateRelativeTimeout returns an error if a maximum relative timeout is set and the relative timeout
+// is either not set or exceeds it.
+func validateRelativeTimeout(relativeTimeout, maxRelativeTimeout *RelativeTimeout) error {
+	if maxRelativeTimeout == nil {
+		return nil
+	}
+
+	if relativeTimeout == nil {
+		return errorsmod.Wrap(ErrInvalidAuthorization, "relative timeout must be set because max relative timeout in allocation is set")
+	}
+
+	if !relativeTimeout.IsWithin(*maxRelativeTimeout) {
+		return errorsmod.Wrapf(ErrInvalidAuthorization, "relative timeout %s exceeds max relative timeout %s", relativeTimeout, maxRelativeTimeout)
+	}
+
+	return nil
+}
+
 // isAllowedForwarding returns whether the provided slice of Hop matches one of the allowed ones.
 func isAllowedForwarding(hops []Hop, allowed []AllowedForwarding) bool {
 	if len(hops) == 0 {
@@ -2,6 +2,7 @@ package types_test
 
 import (
 	"fmt"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 
@@ -447,6 +448,172 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().False(res.Accept)
 			},
 		},
+		{
+			"success: relative timeout within max relative timeout",
+			func() {
+				msgTransfer.TimeoutTimestamp = 0
+				msgTransfer.RelativeTimeout = types.NewRelativeTimeout(0, 100)
+				transferAuthz.Allocations[0].MaxRelativeTimeout = types.NewRelativeTimeout(0, 100)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"failure: relative timeout not set while max relative timeout is set",
+			func() {
+				transferAuthz.Allocations[0].MaxRelativeTimeout = types.NewRelativeTimeout(0, 100)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: relative timeout exceeds max relative timeout",
+			func() {
+				msgTransfer.TimeoutTimestamp = 0
+				msgTransfer.RelativeTimeout = types.NewRelativeTimeout(0, 101)
+				transferAuthz.Allocations[0].MaxRelativeTimeout = types.NewRelativeTimeout(0, 100)
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"success: allocation not yet expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime().Add(time.Hour)
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().True(res.Delete)
+			},
+		},
+		{
+			"success: expired allocations of other channels are removed",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations = append(transferAuthz.Allocations, types.Allocation{
+					SourcePort:    ibctesting.TransferPort,
+					SourceChannel: "channel-1",
+					SpendLimit:    sdk.NewCoins(ibctesting.TestCoin),
+					Expiration:    &expiration,
+				})
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+				suite.Require().Len(updatedAuthz.Allocations, 1)
+				suite.Require().Equal(msgTransfer.SourceChannel, updatedAuthz.Allocations[0].SourceChannel)
+			},
+		},
+		{
+			"failure: allocation expired",
+			func() {
+				expiration := suite.chainA.GetContext().BlockTime()
+				transferAuthz.Allocations[0].Expiration = &expiration
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, types.ErrInvalidAuthorization)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"success: first period starts with the transfer",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(150))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+				suite.Require().False(res.Delete)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().Equal(sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))), periodicSpendLimit.PeriodCanSpend)
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour), periodicSpendLimit.PeriodReset)
+				suite.Require().True(updatedAuthz.Allocations[0].SpendLimit.AmountOf(sdk.DefaultBondDenom).Equal(types.UnboundedSpendLimit()))
+			},
+		},
+		{
+			"success: period spend limit is reset after the period ends",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(ibctesting.TestCoin),
+					PeriodCanSpend:   sdk.NewCoins(),
+					PeriodReset:      suite.chainA.GetContext().BlockTime(),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				periodicSpendLimit := updatedAuthz.Allocations[0].PeriodicSpendLimit
+				suite.Require().True(periodicSpendLimit.PeriodCanSpend.IsZero())
+				suite.Require().Equal(suite.chainA.GetContext().BlockTime().Add(time.Hour), periodicSpendLimit.PeriodReset)
+			},
+		},
+		{
+			"success: total spend limit is updated along with the period spend limit",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(200)))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+
+				updatedAuthz, ok := res.Updated.(*types.TransferAuthorization)
+				suite.Require().True(ok)
+
+				suite.Require().Equal(sdk.NewCoins(ibctesting.TestCoin), updatedAuthz.Allocations[0].SpendLimit)
+				suite.Require().True(updatedAuthz.Allocations[0].PeriodicSpendLimit.PeriodCanSpend.IsZero())
+			},
+		},
+		{
+			"failure: period spend limit left is exceeded",
+			func() {
+				transferAuthz.Allocations[0].SpendLimit = sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, types.UnboundedSpendLimit()))
+				transferAuthz.Allocations[0].PeriodicSpendLimit = &types.PeriodicSpendLimit{
+					Period:           time.Hour,
+					PeriodSpendLimit: sdk.NewCoins(ibctesting.TestCoin),
+					PeriodCanSpend:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, sdkmath.NewInt(50))),
+					PeriodReset:      suite.chainA.GetContext().BlockTime().Add(time.Minute),
+				}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
+		{
+			"failure: denom not in period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(sdk.NewCoin("test-denom", sdkmath.NewInt(100))))
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInsufficientFunds)
+				suite.Require().False(res.Accept)
+			},
+		},
 		{
 			"failure: order of hops is different",
 			func() {
@@ -479,6 +646,30 @@ func (suite *TypesTestSuite) TestTransferAuthorizationAccept() {
 				suite.Require().False(res.Accept)
 			},
 		},
+		{
+			"success: fallback receiver in allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowedForwarding = forwardingWithValidHop
+				msgTransfer.Forwarding = types.NewForwarding(false, validHop)
+				msgTransfer.Forwarding.FallbackReceivers = []string{ibctesting.TestAccAddress}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().NoError(err)
+				suite.Require().True(res.Accept)
+			},
+		},
+		{
+			"failure: fallback receiver not in allow list",
+			func() {
+				transferAuthz.Allocations[0].AllowedForwarding = forwardingWithValidHop
+				msgTransfer.Forwarding = types.NewForwarding(false, validHop)
+				msgTransfer.Forwarding.FallbackReceivers = []string{suite.chainA.SenderAccount.GetAddress().String()}
+			},
+			func(res authz.AcceptResponse, err error) {
+				suite.Require().ErrorIs(err, ibcerrors.ErrInvalidAddress)
+				suite.Require().False(res.Accept)
+			},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -584,6 +775,22 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			nil,
 		},
+		{
+			"success: with max relative timeout",
+			func() {
+				transferAuthz.Allocations[0].MaxRelativeTimeout = types.NewRelativeTimeout(10, 0)
+			},
+			nil,
+		},
+		{
+			"success: with expiration and periodic spend limit",
+			func() {
+				expiration := time.Unix(1_700_000_000, 0)
+				transferAuthz.Allocations[0].Expiration = &expiration
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(24*time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			nil,
+		},
 		{
 			"empty allocations",
 			func() {
@@ -667,6 +874,35 @@ func (suite *TypesTestSuite) TestTransferAuthorizationValidateBasic() {
 			},
 			host.ErrInvalidID,
 		},
+		{
+			"periodic spend limit with zero period",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(0, sdk.NewCoins(ibctesting.TestCoin))
+			},
+			types.ErrInvalidAuthorization,
+		},
+		{
+			"periodic spend limit with empty period spend limit",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins())
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"periodic spend limit with invalid period can spend",
+			func() {
+				transferAuthz.Allocations[0].PeriodicSpendLimit = types.NewPeriodicSpendLimit(time.Hour, sdk.NewCoins(ibctesting.TestCoin))
+				transferAuthz.Allocations[0].PeriodicSpendLimit.PeriodCanSpend = sdk.Coins{sdk.Coin{Denom: "(invalid)", Amount: sdkmath.NewInt(1)}}
+			},
+			ibcerrors.ErrInvalidCoins,
+		},
+		{
+			"max relative timeout with zero offsets",
+			func() {
+				transferAuthz.Allocations[0].MaxRelativeTimeout = types.NewRelativeTimeout(0, 0)
+			},
+			types.ErrInvalidPacketTimeout,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -0,0 +1,280 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
+)
+
+// multihopConsensusState is the consensus state of a chain along the route of a multi-hop channel. The
+// commitment root of the consensus state is used to verify the proofs of the chain, and its timestamp
+// is used to verify the timeouts of packets on the counterparty chain.
+type multihopConsensusState interface {
+	GetRoot() exported.Root
+	GetTimestamp() uint64
+}
+
+// VerifyMultihopMembership verifies a multi-hop proof of the value stored under the provided key on the
+// counterparty chain of a multi-hop channel. The connection end is the first connection of the channel on
+// this chain and the connection hops are the connection identifiers of the channel end.
+func (k *Keeper) VerifyMultihopMembership(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	key []byte,
+	value []byte,
+) error {
+	keyProof, merklePath, root, err := k.verifyMultihopHops(ctx, connection, height, proof, connectionHops, key)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, value); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop membership verification over connection hops %v", connectionHops)
+	}
+
+	return nil
+}
+
+// VerifyMultihopNonMembership verifies a multi-hop proof of the absence of the provided key on the counterparty
+// chain of a multi-hop channel. The connection end is the first connection of the channel on this chain and
+// the connection hops are the connection identifiers of the channel end.
+func (k *Keeper) VerifyMultihopNonMembership(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	key []byte,
+) error {
+	keyProof, merklePath, root, err := k.verifyMultihopHops(ctx, connection, height, proof, connectionHops, key)
+	if err != nil {
+		return err
+	}
+
+	if err := keyProof.VerifyNonMembership(commitmenttypes.GetSDKSpecs(), root, merklePath); err != nil {
+		return errorsmod.Wrapf(err, "failed multi-hop non-membership verification over connection hops %v", connectionHops)
+	}
+
+	return nil
+}
+
+// GetMultihopCounterpartyConnectionHops returns the connection hops of the counterparty channel end of a
+// multi-hop channel, read from the provided multi-hop proof. The connection end is the first connection of
+// the channel on this chain. The connection hops can only be trusted once the proof has been verified.
+func (k *Keeper) GetMultihopCounterpartyConnectionHops(connection types.ConnectionEnd, proof []byte) ([]string, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof)
+	if err != nil {
+		return nil, err
+	}
+
+	return multihopProof.CounterpartyConnectionHops(connection), nil
+}
+
+// GetMultihopCounterpartyConsensus returns the height and the timestamp of the consensus state of the counterparty
+// chain of a multi-hop channel, read from the provided multi-hop proof. The height and the timestamp can only be
+// trusted once the proof has been verified.
+func (k *Keeper) GetMultihopCounterpartyConsensus(proof []byte) (clienttypes.Height, uint64, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	lastHop := multihopProof.Hops[len(multihopProof.Hops)-1]
+	consensusState, err := k.unmarshalMultihopConsensusState(lastHop.ConsensusState)
+	if err != nil {
+		return clienttypes.ZeroHeight(), 0, err
+	}
+
+	return lastHop.ConsensusHeight, consensusState.GetTimestamp(), nil
+}
+
+// verifyMultihopHops verifies the hops of a multi-hop proof: the connection end of each intermediate chain to
+// the next chain, and the client state and the consensus state of the next chain are proven against the
+// consensus state of the intermediate chain, starting with the consensus state stored by the client of the
+// first connection at the provided height. The clients of the intermediate chains must be neither frozen nor
+// expired, and the maximum delay period of the connections along the route is enforced. The proof of the key on the counterparty chain is returned along with the prefixed path of
+// the key and the commitment root it must be verified against.
+func (k *Keeper) verifyMultihopHops(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	connectionHops []string,
+	key []byte,
+) (commitmenttypes.MerkleProof, exported.Path, exported.Root, error) {
+	multihopProof, err := k.unmarshalMultihopProof(proof)
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, nil, nil, err
+	}
+
+	if len(multihopProof.Hops) != len(connectionHops)-1 {
+		return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(
+			types.ErrInvalidMultihopProof,
+			"expected %d hops for connection hops %v, got %d", len(connectionHops)-1, connectionHops, len(multihopProof.Hops),
+		)
+	}
+
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// the delay period of a multi-hop channel is the maximum delay period of the connections along the route,
+	// and is enforced by the client of the first connection on the proofs of the chain following this chain
+	delayConnection := connection
+	for _, hop := range multihopProof.Hops {
+		if hop.Connection.DelayPeriod > delayConnection.DelayPeriod {
+			delayConnection.DelayPeriod = hop.Connection.DelayPeriod
+		}
+	}
+
+	var (
+		prefix exported.Prefix = connection.Counterparty.Prefix
+		root   exported.Root   // nil until the consensus state of the second chain along the route is proven
+	)
+
+	for i, hop := range multihopProof.Hops {
+		connectionKey := host.ConnectionKey(connectionHops[i+1])
+		if err := k.verifyHopMembership(ctx, delayConnection, height, root, prefix, hop.ConnectionProof, connectionKey, k.cdc.MustMarshal(&hop.Connection)); err != nil {
+			return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(err, "failed connection verification of hop %d", i)
+		}
+
+		clientStateKey := host.FullClientStateKey(hop.Connection.ClientId)
+		if err := k.verifyHopMembership(ctx, delayConnection, height, root, prefix, hop.ClientStateProof, clientStateKey, hop.ClientState); err != nil {
+			return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(err, "failed client state verification of hop %d", i)
+		}
+
+		consensusKey := host.FullConsensusStateKey(hop.Connection.ClientId, hop.ConsensusHeight)
+		if err := k.verifyHopMembership(ctx, delayConnection, height, root, prefix, hop.ConsensusProof, consensusKey, hop.ConsensusState); err != nil {
+			return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(err, "failed consensus state verification of hop %d", i)
+		}
+
+		consensusState, err := k.unmarshalMultihopConsensusState(hop.ConsensusState)
+		if err != nil {
+			return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(err, "hop %d", i)
+		}
+
+		if err := k.verifyHopClientStatus(ctx, hop, consensusState); err != nil {
+			return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(err, "hop %d", i)
+		}
+
+		root = consensusState.GetRoot()
+		prefix = hop.Connection.Counterparty.Prefix
+	}
+
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(key))
+	if err != nil {
+		return commitmenttypes.MerkleProof{}, nil, nil, err
+	}
+
+	var keyProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(multihopProof.KeyProof, &keyProof); err != nil {
+		return commitmenttypes.MerkleProof{}, nil, nil, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "cannot unmarshal key proof: %s", err)
+	}
+
+	return keyProof, merklePath, root, nil
+}
+
+// verifyHopMembership verifies the proof of the value stored under the provided key on an intermediate chain of
+// a multi-hop channel. The proofs of the chain following this chain are verified by the client of the first
+// connection, the proofs of the following chains are verified against the provided commitment root.
+func (k *Keeper) verifyHopMembership(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	root exported.Root,
+	prefix exported.Prefix,
+	proof []byte,
+	key []byte,
+	value []byte,
+) error {
+	merklePath, err := commitmenttypes.ApplyPrefix(prefix, commitmenttypes.NewMerklePath(key))
+	if err != nil {
+		return err
+	}
+
+	if root == nil {
+		// get time and block delays
+		timeDelay := connection.DelayPeriod
+		blockDelay := k.getBlockDelay(ctx, connection)
+
+		return k.clientKeeper.VerifyMembership(ctx, connection.ClientId, height, timeDelay, blockDelay, proof, merklePath, value)
+	}
+
+	var merkleProof commitmenttypes.MerkleProof
+	if err := k.cdc.Unmarshal(proof, &merkleProof); err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMultihopProof, "cannot unmarshal proof: %s", err)
+	}
+
+	return merkleProof.VerifyMembership(commitmenttypes.GetSDKSpecs(), root, merklePath, value)
+}
+
+// verifyHopClientStatus returns an error if the client of the connection of an intermediate chain to the next chain
+// is frozen, or expired with respect to the consensus state of the next chain proven for the hop, i.e. if the
+// consensus state is older than the trusting period of the client. Only the status of tendermint clients can be
+// verified on intermediate chains.
+func (k *Keeper) verifyHopClientStatus(ctx context.Context, hop types.HopProof, consensusState multihopConsensusState) error {
+	clientState, err := clienttypes.UnmarshalClientState(k.cdc, hop.ClientState)
+	if err != nil {
+		return errorsmod.Wrapf(types.ErrInvalidMultihopProof, "cannot unmarshal client state: %s", err)
+	}
+
+	tmClientState, ok := clientState.(*ibctm.ClientState)
+	if !ok {
+		return errorsmod.Wrapf(types.ErrInvalidMultihopProof, "status of client of type %T cannot be verified", clientState)
+	}
+
+	if !tmClientState.FrozenHeight.IsZero() {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", hop.Connection.ClientId, exported.Frozen)
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	consensusTime := time.Unix(0, int64(consensusState.GetTimestamp()))
+	if tmClientState.IsExpired(consensusTime, sdkCtx.BlockTime()) {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", hop.Connection.ClientId, exported.Expired)
+	}
+
+	return nil
+}
+
+// unmarshalMultihopProof unmarshals and validates the provided multi-hop proof.
+func (k *Keeper) unmarshalMultihopProof(proof []byte) (types.MultihopProof, error) {
+	var multihopProof types.MultihopProof
+	if err := k.cdc.Unmarshal(proof, &multihopProof); err != nil {
+		return types.MultihopProof{}, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "cannot unmarshal multi-hop proof: %s", err)
+	}
+
+	if err := multihopProof.ValidateBasic(); err != nil {
+		return types.MultihopProof{}, err
+	}
+
+	return multihopProof, nil
+}
+
+// unmarshalMultihopConsensusState unmarshals the consensus state of a chain along the route of a multi-hop channel.
+func (k *Keeper) unmarshalMultihopConsensusState(bz []byte) (multihopConsensusState, error) {
+	consensusState, err := clienttypes.UnmarshalConsensusState(k.cdc, bz)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "cannot unmarshal consensus state: %s", err)
+	}
+
+	multihopConsensusState, ok := consensusState.(multihopConsensusState)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrInvalidMultihopProof, "consensus state of type %T does not provide a commitment root", consensusState)
+	}
+
+	return multihopConsensusState, nil
+}
@@ -0,0 +1,281 @@
+package keeper_test
+
+import (
+	"time"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+var (
+	multihopKey   = host.PacketCommitmentKey(ibctesting.MockPort, "channel-7", 1)
+	multihopValue = []byte("commitment")
+)
+
+// setupMultihopPath opens the connections of a route over three chains and stores a value under the
+// multi-hop key on the last chain of the route.
+func (suite *KeeperTestSuite) setupMultihopPath() *ibctesting.MultihopPath {
+	coordinator := ibctesting.NewCoordinator(suite.T(), 3)
+	chainA := coordinator.GetChain(ibctesting.GetChainID(1))
+	chainB := coordinator.GetChain(ibctesting.GetChainID(2))
+	chainC := coordinator.GetChain(ibctesting.GetChainID(3))
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	path.SetupConnections()
+
+	chainC.App.GetIBCKeeper().ChannelKeeper.SetPacketCommitment(chainC.GetContext(), ibctesting.MockPort, "channel-7", 1, multihopValue)
+	coordinator.CommitBlock(chainC)
+
+	return path
+}
+
+func (suite *KeeperTestSuite) TestVerifyMultihopMembership() {
+	var (
+		path           *ibctesting.MultihopPath
+		proof          []byte
+		proofHeight    clienttypes.Height
+		connectionHops []string
+		value          []byte
+	)
+
+	// malleateProof unmarshals the multi-hop proof, applies the provided function and marshals it back.
+	malleateProof := func(fn func(proof *types.MultihopProof)) {
+		var multihopProof types.MultihopProof
+		suite.Require().NoError(path.EndpointA.Chain.Codec.Unmarshal(proof, &multihopProof))
+		fn(&multihopProof)
+
+		var err error
+		proof, err = path.EndpointA.Chain.Codec.Marshal(&multihopProof)
+		suite.Require().NoError(err)
+	}
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: value is different than proof",
+			func() {
+				value = []byte("different commitment")
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: connection hops do not match the hops of the proof",
+			func() {
+				connectionHops = append(connectionHops, ibctesting.FirstConnectionID)
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"failure: connection hop is different than proof",
+			func() {
+				connectionHops[1] = "connection-100"
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: consensus state of the hop is different than proof",
+			func() {
+				malleateProof(func(proof *types.MultihopProof) {
+					consensusState, err := clienttypes.UnmarshalConsensusState(path.EndpointA.Chain.Codec, proof.Hops[0].ConsensusState)
+					suite.Require().NoError(err)
+
+					tmConsensusState, ok := consensusState.(*ibctm.ConsensusState)
+					suite.Require().True(ok)
+					tmConsensusState.Root = commitmenttypes.NewMerkleRoot([]byte("malleated root"))
+
+					proof.Hops[0].ConsensusState = clienttypes.MustMarshalConsensusState(path.EndpointA.Chain.Codec, tmConsensusState)
+				})
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: invalid multi-hop proof",
+			func() {
+				proof = []byte("invalid proof")
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"failure: hop connection end is not OPEN",
+			func() {
+				malleateProof(func(proof *types.MultihopProof) {
+					proof.Hops[0].Connection.State = types.TRYOPEN
+				})
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"failure: client status is not active",
+			func() {
+				clientState, ok := path.Paths[0].EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.Paths[0].EndpointA.SetClientState(clientState)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: client of the intermediate hop is frozen",
+			func() {
+				clientState, ok := path.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+				path.Paths[1].EndpointA.SetClientState(clientState)
+
+				// the frozen client state is committed on the intermediate chain, whose frozen client cannot be
+				// updated, only the client of the first connection is
+				path.Paths[1].EndpointA.Chain.NextBlock()
+				suite.Require().NoError(path.Paths[0].EndpointA.UpdateClient())
+				proof, proofHeight = path.EndpointA.QueryMultihopProofWithoutClientUpdates(multihopKey)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: client of the intermediate hop is expired",
+			func() {
+				clientState, ok := path.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+				suite.Require().True(ok)
+				clientState.TrustingPeriod = time.Nanosecond
+				path.Paths[1].EndpointA.SetClientState(clientState)
+
+				path.Paths[1].EndpointA.Chain.NextBlock()
+				suite.Require().NoError(path.Paths[0].EndpointA.UpdateClient())
+				proof, proofHeight = path.EndpointA.QueryMultihopProofWithoutClientUpdates(multihopKey)
+			},
+			clienttypes.ErrClientNotActive,
+		},
+		{
+			"failure: client state of the hop is different than proof",
+			func() {
+				malleateProof(func(proof *types.MultihopProof) {
+					clientState, ok := path.Paths[1].EndpointA.GetClientState().(*ibctm.ClientState)
+					suite.Require().True(ok)
+					clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+
+					proof.Hops[0].ClientState = clienttypes.MustMarshalClientState(path.EndpointA.Chain.Codec, clientState)
+				})
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: delay period of the intermediate hop has not passed",
+			func() {
+				connection := path.Paths[1].EndpointA.GetConnection()
+				connection.DelayPeriod = uint64(time.Hour.Nanoseconds())
+				path.Paths[1].EndpointA.SetConnection(connection)
+
+				path.Paths[1].EndpointA.Chain.NextBlock()
+				suite.Require().NoError(path.Paths[0].EndpointA.UpdateClient())
+				proof, proofHeight = path.EndpointA.QueryMultihopProofWithoutClientUpdates(multihopKey)
+			},
+			ibctm.ErrDelayPeriodNotPassed,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = suite.setupMultihopPath()
+			connectionHops = path.EndpointA.ConnectionHops()
+			value = multihopValue
+
+			proof, proofHeight = path.EndpointA.QueryMultihopProof(multihopKey)
+
+			tc.malleate()
+
+			chainA := path.EndpointA.Chain
+			err := chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyMultihopMembership(
+				chainA.GetContext(), path.Paths[0].EndpointA.GetConnection(),
+				proofHeight, proof, connectionHops, multihopKey, value,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestVerifyMultihopNonMembership() {
+	var key []byte
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: key is stored on the counterparty chain",
+			func() {
+				key = multihopKey
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path := suite.setupMultihopPath()
+			key = host.PacketCommitmentKey(ibctesting.MockPort, "channel-7", 2)
+
+			tc.malleate()
+
+			proof, proofHeight := path.EndpointA.QueryMultihopProof(key)
+
+			chainA := path.EndpointA.Chain
+			err := chainA.App.GetIBCKeeper().ConnectionKeeper.VerifyMultihopNonMembership(
+				chainA.GetContext(), path.Paths[0].EndpointA.GetConnection(),
+				proofHeight, proof, path.EndpointA.ConnectionHops(), key,
+			)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestGetMultihopCounterpartyConsensus() {
+	path := suite.setupMultihopPath()
+	proof, _ := path.EndpointA.QueryMultihopProof(multihopKey)
+
+	height, timestamp, err := path.EndpointA.Chain.App.GetIBCKeeper().ConnectionKeeper.GetMultihopCounterpartyConsensus(proof)
+	suite.Require().NoError(err)
+
+	// the consensus state of the counterparty chain is stored by the client of the last connection hop
+	lastHop := path.Paths[len(path.Paths)-1].EndpointA
+	suite.Require().Equal(lastHop.GetClientLatestHeight(), height)
+	suite.Require().Equal(lastHop.GetConsensusState(height).(*ibctm.ConsensusState).GetTimestamp(), timestamp)
+
+	connectionHops, err := path.EndpointA.Chain.App.GetIBCKeeper().ConnectionKeeper.GetMultihopCounterpartyConnectionHops(path.Paths[0].EndpointA.GetConnection(), proof)
+	suite.Require().NoError(err)
+	suite.Require().Equal([]string{path.Paths[1].EndpointB.ConnectionID, path.Paths[0].EndpointB.ConnectionID}, connectionHops)
+}
@@ -200,6 +200,43 @@ func (k *Keeper) VerifyPacketReceiptAbsence(
 	return nil
 }
 
+// VerifyPacketReceipt verifies a proof of an incoming packet receipt at the
+// specified port, specified channel, and specified sequence.
+func (k *Keeper) VerifyPacketReceipt(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.PacketReceiptKey(portID, channelID, sequence))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height, timeDelay, blockDelay,
+		proof, merklePath, receipt,
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed packet receipt verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyNextSequenceRecv verifies a proof of the next sequence number to be
 // received of the specified channel at the specified port.
 func (k *Keeper) VerifyNextSequenceRecv(
@@ -237,6 +274,43 @@ func (k *Keeper) VerifyNextSequenceRecv(
 	return nil
 }
 
+// VerifyNextSequenceAck verifies a proof of the next sequence number to be
+// acknowledged of the specified channel at the specified port.
+func (k *Keeper) VerifyNextSequenceAck(
+	ctx context.Context,
+	connection types.ConnectionEnd,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	clientID := connection.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Active {
+		return errorsmod.Wrapf(clienttypes.ErrClientNotActive, "client (%s) status is %s", clientID, status)
+	}
+
+	// get time and block delays
+	timeDelay := connection.DelayPeriod
+	blockDelay := k.getBlockDelay(ctx, connection)
+
+	merklePath := commitmenttypes.NewMerklePath(host.NextSequenceAckKey(portID, channelID))
+	merklePath, err := commitmenttypes.ApplyPrefix(connection.Counterparty.Prefix, merklePath)
+	if err != nil {
+		return err
+	}
+
+	if err := k.clientKeeper.VerifyMembership(
+		ctx, clientID, height,
+		timeDelay, blockDelay,
+		proof, merklePath, sdk.Uint64ToBigEndian(nextSequenceAck),
+	); err != nil {
+		return errorsmod.Wrapf(err, "failed next sequence acknowledgement verification for client (%s)", clientID)
+	}
+
+	return nil
+}
+
 // VerifyChannelUpgradeError verifies a proof of the provided upgrade error receipt.
 func (k *Keeper) VerifyChannelUpgradeError(
 	ctx context.Context,
@@ -533,6 +533,79 @@ func (suite *KeeperTestSuite) TestVerifyNextSequenceRecv() {
 	}
 }
 
+func (suite *KeeperTestSuite) TestVerifyNextSequenceAck() {
+	var (
+		path       *ibctesting.Path
+		heightDiff uint64
+		offsetSeq  uint64
+	)
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"verification success", func() {}, true},
+		{"client state not found- changed client ID", func() {
+			path.EndpointB.UpdateConnection(func(c *types.ConnectionEnd) { c.ClientId = ibctesting.InvalidID })
+		}, false},
+		{"consensus state not found - increased proof height", func() {
+			heightDiff = 5
+		}, false},
+		{"verification failed - wrong expected next seq ack", func() {
+			offsetSeq = 1
+		}, false},
+		{"client status is not active - client is frozen", func() {
+			clientState, ok := path.EndpointB.GetClientState().(*ibctm.ClientState)
+			suite.Require().True(ok)
+			clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+			path.EndpointB.SetClientState(clientState)
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			path.Setup()
+
+			// send, receive and acknowledge packet
+			sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, 0)
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			suite.Require().NoError(path.EndpointB.UpdateClient())
+
+			nextSeqAckKey := host.NextSequenceAckKey(packet.GetSourcePort(), packet.GetSourceChannel())
+			proof, proofHeight := suite.chainA.QueryProof(nextSeqAckKey)
+
+			// reset variables
+			heightDiff = 0
+			offsetSeq = 0
+			tc.malleate()
+
+			connection := path.EndpointB.GetConnection()
+			err = suite.chainB.App.GetIBCKeeper().ConnectionKeeper.VerifyNextSequenceAck(
+				suite.chainB.GetContext(), connection, malleateHeight(proofHeight, heightDiff), proof,
+				packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1+offsetSeq,
+			)
+
+			if tc.expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+			}
+		})
+	}
+}
+
 func (suite *KeeperTestSuite) TestVerifyUpgradeErrorReceipt() {
 	var (
 		path         *ibctesting.Path
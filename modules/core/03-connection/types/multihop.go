@@ -0,0 +1,104 @@
+package types
+
+import (
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+)
+
+// NewMultihopProof creates a new MultihopProof instance.
+func NewMultihopProof(hops []HopProof, keyProof []byte) MultihopProof {
+	return MultihopProof{
+		Hops:     hops,
+		KeyProof: keyProof,
+	}
+}
+
+// ValidateBasic performs a basic validation of the multi-hop proof.
+func (p MultihopProof) ValidateBasic() error {
+	if len(p.Hops) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "multi-hop proof must contain at least one hop")
+	}
+
+	for i, hop := range p.Hops {
+		if err := hop.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid hop %d", i)
+		}
+	}
+
+	if len(p.KeyProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "key proof cannot be empty")
+	}
+
+	return nil
+}
+
+// CounterpartyConnectionHops returns the connection hops of the counterparty channel end of a multi-hop channel
+// whose first connection end on this chain is provided. The counterparty connection hops are the identifiers of
+// the counterparty connection ends along the route, from the counterparty chain back to this chain.
+func (p MultihopProof) CounterpartyConnectionHops(connection ConnectionEnd) []string {
+	counterpartyHops := []string{connection.Counterparty.ConnectionId}
+	for _, hop := range p.Hops {
+		counterpartyHops = append(counterpartyHops, hop.Connection.Counterparty.ConnectionId)
+	}
+
+	slices.Reverse(counterpartyHops)
+
+	return counterpartyHops
+}
+
+// NewHopProof creates a new HopProof instance.
+func NewHopProof(
+	connection ConnectionEnd, connectionProof []byte,
+	consensusHeight clienttypes.Height, consensusState, consensusProof []byte,
+	clientState, clientStateProof []byte,
+) HopProof {
+	return HopProof{
+		Connection:       connection,
+		ConnectionProof:  connectionProof,
+		ConsensusHeight:  consensusHeight,
+		ConsensusState:   consensusState,
+		ConsensusProof:   consensusProof,
+		ClientState:      clientState,
+		ClientStateProof: clientStateProof,
+	}
+}
+
+// ValidateBasic performs a basic validation of the hop proof. The connection end of the hop must be OPEN.
+func (h HopProof) ValidateBasic() error {
+	if err := h.Connection.ValidateBasic(); err != nil {
+		return errorsmod.Wrapf(ErrInvalidMultihopProof, "invalid connection end: %s", err)
+	}
+
+	if h.Connection.State != OPEN {
+		return errorsmod.Wrapf(ErrInvalidConnectionState, "connection state is not OPEN (got %s)", h.Connection.State)
+	}
+
+	if len(h.ConnectionProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "connection proof cannot be empty")
+	}
+
+	if h.ConsensusHeight.IsZero() {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "consensus height cannot be zero")
+	}
+
+	if len(h.ConsensusState) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "consensus state cannot be empty")
+	}
+
+	if len(h.ConsensusProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "consensus proof cannot be empty")
+	}
+
+	if len(h.ClientState) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "client state cannot be empty")
+	}
+
+	if len(h.ClientStateProof) == 0 {
+		return errorsmod.Wrap(ErrInvalidMultihopProof, "client state proof cannot be empty")
+	}
+
+	return nil
+}
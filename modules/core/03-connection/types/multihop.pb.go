@@ -0,0 +1,908 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: ibc/core/connection/v1/multihop.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	types "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MultihopProof defines a proof of a key stored on the counterparty chain of a
+// multi-hop channel. The proof of the key is chained through the connection ends
+// and the consensus states stored on the intermediate chains of the channel.
+type MultihopProof struct {
+	// proofs of the intermediate chains, ordered from the chain following this
+	// chain to the chain preceding the counterparty chain.
+	Hops []HopProof `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops"`
+	// proof of the key on the counterparty chain, verified against the commitment
+	// root of the consensus state of the last hop.
+	KeyProof []byte `protobuf:"bytes,2,opt,name=key_proof,json=keyProof,proto3" json:"key_proof,omitempty"`
+}
+
+func (m *MultihopProof) Reset()         { *m = MultihopProof{} }
+func (m *MultihopProof) String() string { return proto.CompactTextString(m) }
+func (*MultihopProof) ProtoMessage()    {}
+func (*MultihopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ba5298bc7a3929, []int{0}
+}
+func (m *MultihopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MultihopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MultihopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MultihopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MultihopProof.Merge(m, src)
+}
+func (m *MultihopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *MultihopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_MultihopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MultihopProof proto.InternalMessageInfo
+
+func (m *MultihopProof) GetHops() []HopProof {
+	if m != nil {
+		return m.Hops
+	}
+	return nil
+}
+
+func (m *MultihopProof) GetKeyProof() []byte {
+	if m != nil {
+		return m.KeyProof
+	}
+	return nil
+}
+
+// HopProof defines the proof of an intermediate chain of a multi-hop channel.
+// It proves the connection end of the chain to the next chain along the route,
+// and the client state and the consensus state of the next chain stored by the
+// client of that connection.
+type HopProof struct {
+	// connection end of the intermediate chain to the next chain.
+	Connection ConnectionEnd `protobuf:"bytes,1,opt,name=connection,proto3" json:"connection"`
+	// proof of the connection end.
+	ConnectionProof []byte `protobuf:"bytes,2,opt,name=connection_proof,json=connectionProof,proto3" json:"connection_proof,omitempty"`
+	// height of the consensus state of the next chain.
+	ConsensusHeight types.Height `protobuf:"bytes,3,opt,name=consensus_height,json=consensusHeight,proto3" json:"consensus_height"`
+	// consensus state of the next chain, encoded as stored by the client of the
+	// connection.
+	ConsensusState []byte `protobuf:"bytes,4,opt,name=consensus_state,json=consensusState,proto3" json:"consensus_state,omitempty"`
+	// proof of the consensus state.
+	ConsensusProof []byte `protobuf:"bytes,5,opt,name=consensus_proof,json=consensusProof,proto3" json:"consensus_proof,omitempty"`
+	// client state of the client of the connection, encoded as stored by the
+	// intermediate chain. It is used to verify that the client is neither frozen
+	// nor expired.
+	ClientState []byte `protobuf:"bytes,6,opt,name=client_state,json=clientState,proto3" json:"client_state,omitempty"`
+	// proof of the client state.
+	ClientStateProof []byte `protobuf:"bytes,7,opt,name=client_state_proof,json=clientStateProof,proto3" json:"client_state_proof,omitempty"`
+}
+
+func (m *HopProof) Reset()         { *m = HopProof{} }
+func (m *HopProof) String() string { return proto.CompactTextString(m) }
+func (*HopProof) ProtoMessage()    {}
+func (*HopProof) Descriptor() ([]byte, []int) {
+	return fileDescriptor_45ba5298bc7a3929, []int{1}
+}
+func (m *HopProof) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HopProof) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HopProof.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HopProof) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HopProof.Merge(m, src)
+}
+func (m *HopProof) XXX_Size() int {
+	return m.Size()
+}
+func (m *HopProof) XXX_DiscardUnknown() {
+	xxx_messageInfo_HopProof.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HopProof proto.InternalMessageInfo
+
+func (m *HopProof) GetConnection() ConnectionEnd {
+	if m != nil {
+		return m.Connection
+	}
+	return ConnectionEnd{}
+}
+
+func (m *HopProof) GetConnectionProof() []byte {
+	if m != nil {
+		return m.ConnectionProof
+	}
+	return nil
+}
+
+func (m *HopProof) GetConsensusHeight() types.Height {
+	if m != nil {
+		return m.ConsensusHeight
+	}
+	return types.Height{}
+}
+
+func (m *HopProof) GetConsensusState() []byte {
+	if m != nil {
+		return m.ConsensusState
+	}
+	return nil
+}
+
+func (m *HopProof) GetConsensusProof() []byte {
+	if m != nil {
+		return m.ConsensusProof
+	}
+	return nil
+}
+
+func (m *HopProof) GetClientState() []byte {
+	if m != nil {
+		return m.ClientState
+	}
+	return nil
+}
+
+func (m *HopProof) GetClientStateProof() []byte {
+	if m != nil {
+		return m.ClientStateProof
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*MultihopProof)(nil), "ibc.core.connection.v1.MultihopProof")
+	proto.RegisterType((*HopProof)(nil), "ibc.core.connection.v1.HopProof")
+}
+
+func init() {
+	proto.RegisterFile("ibc/core/connection/v1/multihop.proto", fileDescriptor_45ba5298bc7a3929)
+}
+
+var fileDescriptor_45ba5298bc7a3929 = []byte{
+	// 397 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x41, 0x8b, 0xda, 0x40,
+	0x14, 0xc7, 0x13, 0xb5, 0xd6, 0x8e, 0xb6, 0x4a, 0x28, 0x25, 0x58, 0x88, 0xa9, 0x20, 0x5a, 0xa8,
+	0x99, 0xaa, 0xa7, 0x96, 0x9e, 0x5a, 0x0a, 0x0b, 0xcb, 0xc2, 0xe2, 0xc2, 0x1e, 0xf6, 0x22, 0x9b,
+	0x71, 0x36, 0x19, 0x34, 0x79, 0xc1, 0x99, 0x08, 0x7e, 0x8b, 0xfd, 0x58, 0x1e, 0x3d, 0xee, 0x69,
+	0x59, 0xf4, 0x1b, 0xec, 0x27, 0x58, 0x32, 0x13, 0x4d, 0x5c, 0xd6, 0xdb, 0xcc, 0xcb, 0xef, 0xfd,
+	0x7f, 0x2f, 0xc9, 0x43, 0x1d, 0xe6, 0x12, 0x4c, 0x60, 0x41, 0x31, 0x81, 0x30, 0xa4, 0x44, 0x30,
+	0x08, 0xf1, 0x72, 0x80, 0x83, 0x78, 0x2e, 0x98, 0x0f, 0x91, 0x13, 0x2d, 0x40, 0x80, 0xf1, 0x85,
+	0xb9, 0xc4, 0x49, 0x30, 0x27, 0xc3, 0x9c, 0xe5, 0xa0, 0xf9, 0xd9, 0x03, 0x0f, 0x24, 0x82, 0x93,
+	0x93, 0xa2, 0x9b, 0xad, 0x2c, 0x74, 0xce, 0x68, 0x28, 0x92, 0x40, 0x75, 0x4a, 0x81, 0xee, 0x09,
+	0x6b, 0x2e, 0x5c, 0x82, 0x6d, 0x1f, 0x7d, 0xbc, 0x48, 0x27, 0xb9, 0x5c, 0x00, 0xdc, 0x19, 0xbf,
+	0x51, 0xc9, 0x87, 0x88, 0x9b, 0xba, 0x5d, 0xec, 0x55, 0x87, 0xb6, 0xf3, 0xf6, 0x5c, 0xce, 0x59,
+	0xca, 0xff, 0x2d, 0xad, 0x1f, 0x5b, 0xda, 0x58, 0xf6, 0x18, 0x5f, 0xd1, 0x87, 0x19, 0x5d, 0x4d,
+	0xa2, 0xe4, 0x81, 0x59, 0xb0, 0xf5, 0x5e, 0x6d, 0x5c, 0x99, 0xd1, 0x95, 0x04, 0xdb, 0xcf, 0x05,
+	0x54, 0xd9, 0x77, 0x19, 0xe7, 0x08, 0x65, 0x79, 0xa6, 0x6e, 0xeb, 0xbd, 0xea, 0xb0, 0x73, 0xca,
+	0xf5, 0xef, 0x70, 0xfb, 0x1f, 0x4e, 0x53, 0x61, 0xae, 0xdd, 0xf8, 0x8e, 0x1a, 0xd9, 0xed, 0xc8,
+	0x5e, 0xcf, 0xea, 0x7b, 0x6f, 0x82, 0x72, 0x1a, 0xf2, 0x98, 0x4f, 0x7c, 0xca, 0x3c, 0x5f, 0x98,
+	0x45, 0x69, 0x6f, 0xe6, 0xec, 0xea, 0x4b, 0x26, 0x6f, 0x29, 0x89, 0x54, 0x59, 0x3f, 0x74, 0xaa,
+	0xb2, 0xd1, 0x45, 0x59, 0x69, 0xc2, 0xc5, 0xad, 0xa0, 0x66, 0x49, 0x6a, 0x3f, 0x1d, 0xca, 0x57,
+	0x49, 0xf5, 0x18, 0x54, 0xf3, 0xbd, 0x7b, 0x05, 0xaa, 0xf1, 0xbe, 0xa1, 0x9a, 0x92, 0xa7, 0x71,
+	0x65, 0x49, 0x55, 0x55, 0x4d, 0x65, 0xfd, 0x40, 0x46, 0x1e, 0x49, 0xe3, 0xde, 0x4b, 0xb0, 0x91,
+	0x03, 0xd5, 0xdf, 0xb9, 0x5e, 0x6f, 0x2d, 0x7d, 0xb3, 0xb5, 0xf4, 0xa7, 0xad, 0xa5, 0xdf, 0xef,
+	0x2c, 0x6d, 0xb3, 0xb3, 0xb4, 0x87, 0x9d, 0xa5, 0xdd, 0xfc, 0xf1, 0x98, 0xf0, 0x63, 0xd7, 0x21,
+	0x10, 0x60, 0x02, 0x3c, 0x00, 0x8e, 0x99, 0x4b, 0xfa, 0x1e, 0xe0, 0xe5, 0x2f, 0x1c, 0xc0, 0x34,
+	0x9e, 0x53, 0xae, 0x36, 0xe8, 0xe7, 0xa8, 0x9f, 0x5b, 0x22, 0xb1, 0x8a, 0x28, 0x77, 0xcb, 0x72,
+	0x7b, 0x46, 0x2f, 0x03, 0x00, 0xfe, 0xd4, 0xd6, 0xe7, 0xde, 0x02, 0x00, 0x00,
+}
+
+func (m *MultihopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MultihopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MultihopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.KeyProof) > 0 {
+		i -= len(m.KeyProof)
+		copy(dAtA[i:], m.KeyProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.KeyProof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Hops) > 0 {
+		for iNdEx := len(m.Hops) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Hops[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMultihop(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *HopProof) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HopProof) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HopProof) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ClientStateProof) > 0 {
+		i -= len(m.ClientStateProof)
+		copy(dAtA[i:], m.ClientStateProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ClientStateProof)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.ClientState) > 0 {
+		i -= len(m.ClientState)
+		copy(dAtA[i:], m.ClientState)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ClientState)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.ConsensusProof) > 0 {
+		i -= len(m.ConsensusProof)
+		copy(dAtA[i:], m.ConsensusProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ConsensusProof)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.ConsensusState) > 0 {
+		i -= len(m.ConsensusState)
+		copy(dAtA[i:], m.ConsensusState)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ConsensusState)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size, err := m.ConsensusHeight.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.ConnectionProof) > 0 {
+		i -= len(m.ConnectionProof)
+		copy(dAtA[i:], m.ConnectionProof)
+		i = encodeVarintMultihop(dAtA, i, uint64(len(m.ConnectionProof)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Connection.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMultihop(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMultihop(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMultihop(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MultihopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Hops) > 0 {
+		for _, e := range m.Hops {
+			l = e.Size()
+			n += 1 + l + sovMultihop(uint64(l))
+		}
+	}
+	l = len(m.KeyProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func (m *HopProof) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Connection.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ConnectionProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = m.ConsensusHeight.Size()
+	n += 1 + l + sovMultihop(uint64(l))
+	l = len(m.ConsensusState)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.ConsensusProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.ClientState)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	l = len(m.ClientStateProof)
+	if l > 0 {
+		n += 1 + l + sovMultihop(uint64(l))
+	}
+	return n
+}
+
+func sovMultihop(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMultihop(x uint64) (n int) {
+	return sovMultihop(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MultihopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MultihopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MultihopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Hops", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Hops = append(m.Hops, HopProof{})
+			if err := m.Hops[len(m.Hops)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.KeyProof = append(m.KeyProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.KeyProof == nil {
+				m.KeyProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HopProof) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HopProof: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HopProof: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Connection", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Connection.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConnectionProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConnectionProof = append(m.ConnectionProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConnectionProof == nil {
+				m.ConnectionProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusHeight", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ConsensusHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusState = append(m.ConsensusState[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusState == nil {
+				m.ConsensusState = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ConsensusProof = append(m.ConsensusProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConsensusProof == nil {
+				m.ConsensusProof = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientState", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientState = append(m.ClientState[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientState == nil {
+				m.ClientState = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ClientStateProof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ClientStateProof = append(m.ClientStateProof[:0], dAtA[iNdEx:postIndex]...)
+			if m.ClientStateProof == nil {
+				m.ClientStateProof = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMultihop(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMultihop
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMultihop(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMultihop
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMultihop
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMultihop
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMultihop
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMultihop
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMultihop        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMultihop          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMultihop = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,138 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+func TestMultihopProofValidateBasic(t *testing.T) {
+	var proof types.MultihopProof
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: multiple hops",
+			func() {
+				proof.Hops = append(proof.Hops, proof.Hops[0])
+			},
+			nil,
+		},
+		{
+			"empty hops",
+			func() {
+				proof.Hops = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty key proof",
+			func() {
+				proof.KeyProof = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"connection end is not OPEN",
+			func() {
+				proof.Hops[0].Connection.State = types.TRYOPEN
+			},
+			types.ErrInvalidConnectionState,
+		},
+		{
+			"invalid connection end",
+			func() {
+				proof.Hops[0].Connection.ClientId = "(invalidClientID)"
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty connection proof",
+			func() {
+				proof.Hops[0].ConnectionProof = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"zero consensus height",
+			func() {
+				proof.Hops[0].ConsensusHeight.RevisionHeight = 0
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty consensus state",
+			func() {
+				proof.Hops[0].ConsensusState = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty consensus proof",
+			func() {
+				proof.Hops[0].ConsensusProof = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty client state",
+			func() {
+				proof.Hops[0].ClientState = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+		{
+			"empty client state proof",
+			func() {
+				proof.Hops[0].ClientStateProof = nil
+			},
+			types.ErrInvalidMultihopProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			connection := types.NewConnectionEnd(types.OPEN, clientID, types.Counterparty{clientID2, connectionID2, commitmenttypes.NewMerklePrefix([]byte("prefix"))}, []*types.Version{ibctesting.ConnectionVersion}, 0)
+			hop := types.NewHopProof(connection, []byte("connection proof"), clientHeight, []byte("consensus state"), []byte("consensus proof"), []byte("client state"), []byte("client state proof"))
+			proof = types.NewMultihopProof([]types.HopProof{hop}, []byte("key proof"))
+
+			tc.malleate()
+
+			err := proof.ValidateBasic()
+			if tc.expErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, tc.expErr)
+			}
+		})
+	}
+}
+
+func TestMultihopProofCounterpartyConnectionHops(t *testing.T) {
+	prefix := commitmenttypes.NewMerklePrefix([]byte("prefix"))
+	versions := []*types.Version{ibctesting.ConnectionVersion}
+
+	// route: connection-0 (self) -> connection-1 -> connection-2 (counterparty chain)
+	connection := types.NewConnectionEnd(types.OPEN, "07-tendermint-0", types.Counterparty{"07-tendermint-10", "connection-10", prefix}, versions, 0)
+	hops := []types.HopProof{
+		types.NewHopProof(types.NewConnectionEnd(types.OPEN, "07-tendermint-1", types.Counterparty{"07-tendermint-11", "connection-11", prefix}, versions, 0), nil, clientHeight, nil, nil, nil, nil),
+		types.NewHopProof(types.NewConnectionEnd(types.OPEN, "07-tendermint-2", types.Counterparty{"07-tendermint-12", "connection-12", prefix}, versions, 0), nil, clientHeight, nil, nil, nil, nil),
+	}
+
+	proof := types.NewMultihopProof(hops, nil)
+	require.Equal(t, []string{"connection-12", "connection-11", "connection-10"}, proof.CounterpartyConnectionHops(connection))
+}
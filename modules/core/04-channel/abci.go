@@ -0,0 +1,12 @@
+package channel
+
+import (
+	"context"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/keeper"
+)
+
+// EndBlocker is used to prune the acknowledgements and packet receipts which are no longer needed
+func EndBlocker(ctx context.Context, k *keeper.Keeper) {
+	k.PruneStalePacketState(ctx)
+}
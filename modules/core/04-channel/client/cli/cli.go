@@ -25,6 +25,7 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryChannelClientState(),
 		GetCmdQueryPacketCommitment(),
 		GetCmdQueryPacketCommitments(),
+		GetCmdQueryForceTimeoutPackets(),
 		GetCmdQueryPacketReceipt(),
 		GetCmdQueryPacketAcknowledgement(),
 		GetCmdQueryUnreceivedPackets(),
@@ -34,6 +35,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdQueryUpgradeError(),
 		GetCmdQueryUpgrade(),
 		GetCmdChannelParams(),
+		GetCmdQueryChannelPaused(),
+		GetCmdQueryPausedChannels(),
 	)
 
 	return queryCmd
@@ -52,6 +55,8 @@ func NewTxCmd() *cobra.Command {
 	txCmd.AddCommand(
 		newUpgradeChannelsTxCmd(),
 		newPruneAcknowledgementsTxCmd(),
+		newPauseChannelTxCmd(),
+		newUnpauseChannelTxCmd(),
 	)
 
 	return txCmd
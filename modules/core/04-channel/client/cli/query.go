@@ -204,6 +204,46 @@ func GetCmdQueryPacketCommitments() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryForceTimeoutPackets defines the command to query the packet commitments that can be timed out by the authority
+func GetCmdQueryForceTimeoutPackets() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "force-timeout-packets [port-id] [channel-id]",
+		Short:   "Query all packet commitments associated with a channel that can be timed out by the authority",
+		Long:    "Query all packet commitments associated with a channel whose counterparty client has been expired or frozen for longer than the force timeout threshold",
+		Example: fmt.Sprintf("%s query %s %s force-timeout-packets [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryForceTimeoutPacketsRequest{
+				PortId:     args[0],
+				ChannelId:  args[1],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ForceTimeoutPackets(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "packet commitments that can be timed out by the authority")
+
+	return cmd
+}
+
 // GetCmdQueryPacketCommitment defines the command to query a packet commitment
 func GetCmdQueryPacketCommitment() *cobra.Command {
 	cmd := &cobra.Command{
@@ -560,6 +600,79 @@ func GetCmdQueryUpgrade() *cobra.Command {
 	return cmd
 }
 
+// GetCmdQueryChannelPaused defines the command to query whether a channel is paused
+func GetCmdQueryChannelPaused() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused [port-id] [channel-id]",
+		Short:   "Query whether a channel is paused",
+		Long:    "Query whether a channel is paused",
+		Example: fmt.Sprintf("%s query %s %s paused [port-id] [channel-id]", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryChannelPausedRequest{
+				PortId:    args[0],
+				ChannelId: args[1],
+			}
+
+			res, err := queryClient.ChannelPaused(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// GetCmdQueryPausedChannels defines the command to query all paused channels
+func GetCmdQueryPausedChannels() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "paused-channels",
+		Short:   "Query all paused channels",
+		Long:    "Query all paused channels from a chain",
+		Example: fmt.Sprintf("%s query %s %s paused-channels", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryPausedChannelsRequest{
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.PausedChannels(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "paused channels")
+
+	return cmd
+}
+
 // GetCmdChannelParams returns the command handler for ibc channel parameter querying.
 func GetCmdChannelParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -59,6 +59,56 @@ func newPruneAcknowledgementsTxCmd() *cobra.Command {
 	return cmd
 }
 
+// newPauseChannelTxCmd returns the command to create a new MsgPauseChannel transaction
+func newPauseChannelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "pause-channel [port] [channel]",
+		Short:   "Pause an IBC channel",
+		Long:    "Pause an IBC channel so that packets can no longer be sent, received, acknowledged or timed out on it. The transaction must be signed by the pause guardian.",
+		Example: fmt.Sprintf("%s tx %s %s pause-channel transfer channel-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgPauseChannel(args[0], args[1], signer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// newUnpauseChannelTxCmd returns the command to create a new MsgUnpauseChannel transaction
+func newUnpauseChannelTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "unpause-channel [port] [channel]",
+		Short:   "Unpause a paused IBC channel",
+		Long:    "Unpause a paused IBC channel. The transaction must be signed by the pause guardian.",
+		Example: fmt.Sprintf("%s tx %s %s unpause-channel transfer channel-0", version.AppName, ibcexported.ModuleName, types.SubModuleName),
+		Args:    cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			signer := clientCtx.GetFromAddress().String()
+			msg := types.NewMsgUnpauseChannel(args[0], args[1], signer)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func newUpgradeChannelsTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "upgrade-channels [version]",
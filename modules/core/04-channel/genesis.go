@@ -17,6 +17,7 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gs types.GenesisState) {
 	k.SetParams(ctx, gs.Params)
 	for _, channel := range gs.Channels {
 		ch := types.NewChannel(channel.State, channel.Ordering, channel.Counterparty, channel.ConnectionHops, channel.Version)
+		ch.ReceiptExpiryPeriod = channel.ReceiptExpiryPeriod
 		k.SetChannel(ctx, channel.PortId, channel.ChannelId, ch)
 	}
 	for _, ack := range gs.Acknowledgements {
@@ -37,6 +38,12 @@ func InitGenesis(ctx context.Context, k *keeper.Keeper, gs types.GenesisState) {
 	for _, as := range gs.AckSequences {
 		k.SetNextSequenceAck(ctx, as.PortId, as.ChannelId, as.Sequence)
 	}
+	for _, pc := range gs.PausedChannels {
+		k.SetChannelPaused(ctx, pc.PortId, pc.ChannelId)
+	}
+	for _, re := range gs.ReceiptExpiries {
+		k.SetReceiptExpiry(ctx, re.ExpiryTimestamp, re.PortId, re.ChannelId, re.Sequence)
+	}
 	k.SetNextChannelSequence(ctx, gs.NextChannelSequence)
 }
 
@@ -52,5 +59,7 @@ func ExportGenesis(ctx context.Context, k *keeper.Keeper) types.GenesisState {
 		AckSequences:        k.GetAllPacketAckSeqs(ctx),
 		NextChannelSequence: k.GetNextChannelSequence(ctx),
 		Params:              k.GetParams(ctx),
+		PausedChannels:      k.GetAllPausedChannels(ctx),
+		ReceiptExpiries:     k.GetAllReceiptExpiries(ctx),
 	}
 }
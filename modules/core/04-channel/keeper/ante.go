@@ -16,6 +16,10 @@ func (k *Keeper) RecvPacketReCheckTx(ctx sdk.Context, packet types.Packet) error
 		return errorsmod.Wrap(types.ErrChannelNotFound, packet.GetDestChannel())
 	}
 
+	if err := k.checkChannelNotPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return err
+	}
+
 	if err := k.applyReplayProtection(ctx, packet, channel); err != nil {
 		return err
 	}
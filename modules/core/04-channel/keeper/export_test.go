@@ -34,3 +34,8 @@ func (k *Keeper) SetUpgradeErrorReceipt(ctx sdk.Context, portID, channelID strin
 func (k *Keeper) SetRecvStartSequence(ctx sdk.Context, portID, channelID string, sequence uint64) {
 	k.setRecvStartSequence(ctx, portID, channelID, sequence)
 }
+
+// GetPruningCursor is a wrapper around getPruningCursor to allow the function to be directly called in tests.
+func (k *Keeper) GetPruningCursor(ctx sdk.Context) []byte {
+	return k.getPruningCursor(ctx)
+}
@@ -280,6 +280,55 @@ func (q *queryServer) PacketCommitments(ctx context.Context, req *types.QueryPac
 	}, nil
 }
 
+// ForceTimeoutPackets implements the Query/ForceTimeoutPackets gRPC method
+func (q *queryServer) ForceTimeoutPackets(ctx context.Context, req *types.QueryForceTimeoutPacketsRequest) (*types.QueryForceTimeoutPacketsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	channel, found := q.GetChannel(ctx, req.PortId, req.ChannelId)
+	if !found {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	if err := q.verifyForceTimeoutAllowed(ctx, channel); err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	var commitments []*types.PacketState
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), host.PacketCommitmentPrefixKey(req.PortId, req.ChannelId))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, value []byte) error {
+		keySplit := strings.Split(string(key), "/")
+
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			return err
+		}
+
+		commitment := types.NewPacketState(req.PortId, req.ChannelId, sequence, value)
+		commitments = append(commitments, &commitment)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryForceTimeoutPacketsResponse{
+		Commitments: commitments,
+		Pagination:  pageRes,
+		Height:      selfHeight,
+	}, nil
+}
+
 // PacketReceipt implements the Query/PacketReceipt gRPC method
 func (q *queryServer) PacketReceipt(ctx context.Context, req *types.QueryPacketReceiptRequest) (*types.QueryPacketReceiptResponse, error) {
 	if req == nil {
@@ -435,7 +484,9 @@ func (q *queryServer) UnreceivedPackets(ctx context.Context, req *types.QueryUnr
 
 	var unreceivedSequences []uint64
 	switch channel.Ordering {
-	case types.UNORDERED:
+	case types.UNORDERED, types.UNORDERED_EXPIRING_RECEIPTS:
+		// NOTE: the expired packet receipts of UNORDERED_EXPIRING_RECEIPTS channels may have been pruned,
+		// the packets are then reported as unreceived but can neither be received nor timed out.
 		for i, seq := range req.PacketCommitmentSequences {
 			// filter for invalid sequences to ensure they are not included in the response value.
 			if seq == 0 {
@@ -447,7 +498,7 @@ func (q *queryServer) UnreceivedPackets(ctx context.Context, req *types.QueryUnr
 				unreceivedSequences = append(unreceivedSequences, seq)
 			}
 		}
-	case types.ORDERED:
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		nextSequenceRecv, found := q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -558,7 +609,7 @@ func (q *queryServer) NextSequenceReceive(ctx context.Context, req *types.QueryN
 	// Return the next sequence received for ordered channels. Unordered channels
 	// do not make use of the next sequence receive.
 	var sequence uint64
-	if channel.Ordering != types.UNORDERED {
+	if !channel.Ordering.IsUnordered() {
 		sequence, found = q.GetNextSequenceRecv(ctx, req.PortId, req.ChannelId)
 		if !found {
 			return nil, status.Error(
@@ -652,6 +703,64 @@ func (q *queryServer) Upgrade(ctx context.Context, req *types.QueryUpgradeReques
 	return types.NewQueryUpgradeResponse(upgrade, nil, selfHeight), nil
 }
 
+// ChannelPaused implements the Query/ChannelPaused gRPC method
+func (q *queryServer) ChannelPaused(ctx context.Context, req *types.QueryChannelPausedRequest) (*types.QueryChannelPausedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	if err := validate.GRPCRequest(req.PortId, req.ChannelId); err != nil {
+		return nil, err
+	}
+
+	if !q.HasChannel(ctx, req.PortId, req.ChannelId) {
+		return nil, status.Error(
+			codes.NotFound,
+			errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", req.PortId, req.ChannelId).Error(),
+		)
+	}
+
+	return &types.QueryChannelPausedResponse{
+		Paused: q.IsChannelPaused(ctx, req.PortId, req.ChannelId),
+	}, nil
+}
+
+// PausedChannels implements the Query/PausedChannels gRPC method
+func (q *queryServer) PausedChannels(ctx context.Context, req *types.QueryPausedChannelsRequest) (*types.QueryPausedChannelsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	var channels []*types.IdentifiedChannel
+	store := prefix.NewStore(runtime.KVStoreAdapter(q.storeService.OpenKVStore(ctx)), []byte(host.KeyChannelPausedPrefix))
+
+	pageRes, err := query.Paginate(store, req.Pagination, func(key, _ []byte) error {
+		portID, channelID, err := host.ParseChannelPath(string(key))
+		if err != nil {
+			return err
+		}
+
+		channel, found := q.GetChannel(ctx, portID, channelID)
+		if !found {
+			return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+		}
+
+		identifiedChannel := types.NewIdentifiedChannel(portID, channelID, channel)
+		channels = append(channels, &identifiedChannel)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	selfHeight := clienttypes.GetSelfHeight(ctx)
+	return &types.QueryPausedChannelsResponse{
+		Channels:   channels,
+		Pagination: pageRes,
+		Height:     selfHeight,
+	}, nil
+}
+
 // ChannelParams implements the Query/ChannelParams gRPC method.
 func (q *queryServer) ChannelParams(ctx context.Context, req *types.QueryChannelParamsRequest) (*types.QueryChannelParamsResponse, error) {
 	params := q.GetParams(ctx)
@@ -40,7 +40,7 @@ func (k *Keeper) ChanOpenInit(
 		)
 	}
 
-	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.ConnectionFeature()) {
 		return "", errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
 			"connection version %s does not support channel ordering: %s",
@@ -68,8 +68,10 @@ func (k *Keeper) WriteOpenInitChannel(
 	connectionHops []string,
 	counterparty types.Counterparty,
 	version string,
+	receiptExpiryPeriod uint64,
 ) {
 	channel := types.NewChannel(types.INIT, order, counterparty, connectionHops, version)
+	channel.ReceiptExpiryPeriod = receiptExpiryPeriod
 	k.SetChannel(ctx, portID, channelID, channel)
 
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
@@ -94,12 +96,8 @@ func (k *Keeper) ChanOpenTry(
 	counterpartyVersion string,
 	initProof []byte,
 	proofHeight exported.Height,
+	receiptExpiryPeriod uint64,
 ) (string, error) {
-	// connection hops only supports a single connection
-	if len(connectionHops) != 1 {
-		return "", errorsmod.Wrapf(types.ErrTooManyConnectionHops, "expected 1, got %d", len(connectionHops))
-	}
-
 	// generate a new channel
 	channelID := k.GenerateChannelIdentifier(ctx)
 
@@ -121,7 +119,7 @@ func (k *Keeper) ChanOpenTry(
 		)
 	}
 
-	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.String()) {
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], order.ConnectionFeature()) {
 		return "", errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
 			"connection version %s does not support channel ordering: %s",
@@ -129,7 +127,10 @@ func (k *Keeper) ChanOpenTry(
 		)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.counterpartyConnectionHops(connectionEnd, connectionHops, initProof)
+	if err != nil {
+		return "", err
+	}
 
 	// expectedCounterpaty is the counterparty of the counterparty's channel end
 	// (i.e self)
@@ -138,9 +139,10 @@ func (k *Keeper) ChanOpenTry(
 		types.INIT, order, expectedCounterparty,
 		counterpartyHops, counterpartyVersion,
 	)
+	expectedChannel.ReceiptExpiryPeriod = receiptExpiryPeriod
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, initProof,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, connectionHops, proofHeight, initProof,
 		counterparty.PortId, counterparty.ChannelId, expectedChannel,
 	); err != nil {
 		return "", err
@@ -160,12 +162,14 @@ func (k *Keeper) WriteOpenTryChannel(
 	connectionHops []string,
 	counterparty types.Counterparty,
 	version string,
+	receiptExpiryPeriod uint64,
 ) {
 	k.SetNextSequenceSend(ctx, portID, channelID, 1)
 	k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 	k.SetNextSequenceAck(ctx, portID, channelID, 1)
 
 	channel := types.NewChannel(types.TRYOPEN, order, counterparty, connectionHops, version)
+	channel.ReceiptExpiryPeriod = receiptExpiryPeriod
 
 	k.SetChannel(ctx, portID, channelID, channel)
 
@@ -205,7 +209,10 @@ func (k *Keeper) ChanOpenAck(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.counterpartyConnectionHops(connectionEnd, channel.ConnectionHops, tryProof)
+	if err != nil {
+		return err
+	}
 
 	// counterparty of the counterparty channel end (i.e self)
 	expectedCounterparty := types.NewCounterparty(portID, channelID)
@@ -213,9 +220,10 @@ func (k *Keeper) ChanOpenAck(
 		types.TRYOPEN, channel.Ordering, expectedCounterparty,
 		counterpartyHops, counterpartyVersion,
 	)
+	expectedChannel.ReceiptExpiryPeriod = channel.ReceiptExpiryPeriod
 
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, tryProof,
+	return k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, tryProof,
 		channel.Counterparty.PortId, counterpartyChannelID,
 		expectedChannel)
 }
@@ -276,18 +284,22 @@ func (k *Keeper) ChanOpenConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.counterpartyConnectionHops(connectionEnd, channel.ConnectionHops, ackProof)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.NewChannel(
 		types.OPEN, channel.Ordering, counterparty,
 		counterpartyHops, channel.Version,
 	)
+	expectedChannel.ReceiptExpiryPeriod = channel.ReceiptExpiryPeriod
 
 	// NOTE: If the counterparty has initialized an upgrade in the same block as performing the
 	// ACK handshake step, this channel end will be incapable of opening.
-	return k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, ackProof,
+	return k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, ackProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel)
 }
@@ -387,20 +399,24 @@ func (k *Keeper) ChanCloseConfirm(
 		return errorsmod.Wrapf(connectiontypes.ErrInvalidConnectionState, "connection state is not OPEN (got %s)", connectionEnd.State)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.counterpartyConnectionHops(connectionEnd, channel.ConnectionHops, initProof)
+	if err != nil {
+		return err
+	}
 
 	counterparty := types.NewCounterparty(portID, channelID)
 	expectedChannel := types.Channel{
-		State:           types.CLOSED,
-		Ordering:        channel.Ordering,
-		Counterparty:    counterparty,
-		ConnectionHops:  counterpartyHops,
-		Version:         channel.Version,
-		UpgradeSequence: counterpartyUpgradeSequence,
+		State:               types.CLOSED,
+		Ordering:            channel.Ordering,
+		Counterparty:        counterparty,
+		ConnectionHops:      counterpartyHops,
+		Version:             channel.Version,
+		UpgradeSequence:     counterpartyUpgradeSequence,
+		ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 	}
 
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, initProof,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, initProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
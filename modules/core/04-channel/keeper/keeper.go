@@ -230,6 +230,15 @@ func (k *Keeper) SetPacketReceipt(ctx context.Context, portID, channelID string,
 	}
 }
 
+// SetPacketTimeoutReceipt sets a timeout receipt to the store, recording that the packet timed out
+// on an ORDERED_ALLOW_TIMEOUT channel without being received
+func (k *Keeper) SetPacketTimeoutReceipt(ctx context.Context, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(host.PacketReceiptKey(portID, channelID, sequence), types.TimeoutReceipt); err != nil {
+		panic(err)
+	}
+}
+
 // deletePacketReceipt deletes a packet receipt from the store
 func (k *Keeper) deletePacketReceipt(ctx context.Context, portID, channelID string, sequence uint64) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -238,6 +247,51 @@ func (k *Keeper) deletePacketReceipt(ctx context.Context, portID, channelID stri
 	}
 }
 
+// SetReceiptExpiry indexes the packet receipt of a packet received on an UNORDERED_EXPIRING_RECEIPTS channel
+// by its expiry timestamp, so that it can be pruned once it has expired
+func (k *Keeper) SetReceiptExpiry(ctx context.Context, expiryTimestamp uint64, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(host.ReceiptExpiryKey(expiryTimestamp, portID, channelID, sequence), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
+}
+
+// HasReceiptExpiry returns true if the packet receipt of a packet received on an UNORDERED_EXPIRING_RECEIPTS
+// channel is indexed by the provided expiry timestamp
+func (k *Keeper) HasReceiptExpiry(ctx context.Context, expiryTimestamp uint64, portID, channelID string, sequence uint64) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(host.ReceiptExpiryKey(expiryTimestamp, portID, channelID, sequence))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// GetAllReceiptExpiries returns the expiry timestamps of all the packet receipts indexed for pruning.
+func (k *Keeper) GetAllReceiptExpiries(ctx context.Context) []types.ReceiptExpiry {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte(host.KeyReceiptExpiryPrefix+"/"))
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var receiptExpiries []types.ReceiptExpiry
+	keyPrefixLen := len(host.KeyReceiptExpiryPrefix + "/")
+	prefixLen := len(host.ReceiptExpiryPrefixKey(0))
+	for ; iterator.Valid(); iterator.Next() {
+		expiryKey := iterator.Key()
+		expiryTimestamp := sdk.BigEndianToUint64(expiryKey[keyPrefixLen : keyPrefixLen+8])
+
+		// the receipt expiry key ends with the packet receipt key
+		keySplit := strings.Split(string(expiryKey[prefixLen:]), "/")
+		sequence, err := strconv.ParseUint(keySplit[len(keySplit)-1], 10, 64)
+		if err != nil {
+			panic(err)
+		}
+
+		receiptExpiries = append(receiptExpiries, types.NewReceiptExpiry(keySplit[2], keySplit[4], sequence, expiryTimestamp))
+	}
+	return receiptExpiries
+}
+
 // GetPacketCommitment gets the packet commitment hash from the store
 func (k *Keeper) GetPacketCommitment(ctx context.Context, portID, channelID string, sequence uint64) []byte {
 	store := k.storeService.OpenKVStore(ctx)
@@ -752,6 +806,34 @@ func (k *Keeper) GetRecvStartSequence(ctx context.Context, portID, channelID str
 	return sdk.BigEndianToUint64(bz), true
 }
 
+// setCounterpartyNextSequenceAck sets a channel's proven counterparty next sequence ack to the store.
+func (k *Keeper) setCounterpartyNextSequenceAck(ctx context.Context, portID, channelID string, sequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz := sdk.Uint64ToBigEndian(sequence)
+	if err := store.Set(host.CounterpartyNextSequenceAckKey(portID, channelID), bz); err != nil {
+		panic(err)
+	}
+}
+
+// GetCounterpartyNextSequenceAck gets a channel's proven counterparty next sequence ack from the store.
+// The counterparty next sequence ack of an ORDERED or ORDERED_ALLOW_TIMEOUT channel is set by relayers
+// with a proof of the counterparty state. All the packets received with a lower sequence have been
+// acknowledged or timed out on the counterparty, it is used as an upper bound for pruning stale packet
+// receives.
+func (k *Keeper) GetCounterpartyNextSequenceAck(ctx context.Context, portID, channelID string) (uint64, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(host.CounterpartyNextSequenceAckKey(portID, channelID))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return 0, false
+	}
+
+	return sdk.BigEndianToUint64(bz), true
+}
+
 // SetPruningSequenceStart sets a channel's pruning sequence start to the store.
 func (k *Keeper) SetPruningSequenceStart(ctx context.Context, portID, channelID string, sequence uint64) {
 	store := k.storeService.OpenKVStore(ctx)
@@ -787,7 +869,8 @@ func (k *Keeper) HasPruningSequenceStart(ctx context.Context, portID, channelID
 }
 
 // PruneAcknowledgements prunes packet acknowledgements and receipts that have a sequence number less than pruning sequence end.
-// The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded.
+// The number of packet acks/receipts pruned is bounded by the limit. Pruning can only occur after a channel has been upgraded,
+// or after the counterparty next sequence ack of the channel has been proven.
 //
 // Pruning sequence start keeps track of the packet ack/receipt that can be pruned next. When it reaches pruningSequenceEnd,
 // pruning is complete.
@@ -796,7 +879,7 @@ func (k *Keeper) PruneAcknowledgements(ctx context.Context, portID, channelID st
 	if !found {
 		return 0, 0, errorsmod.Wrapf(types.ErrPruningSequenceStartNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
-	pruningSequenceEnd, found := k.GetRecvStartSequence(ctx, portID, channelID)
+	pruningSequenceEnd, found := k.getPruningSequenceEnd(ctx, portID, channelID)
 	if !found {
 		return 0, 0, errorsmod.Wrapf(types.ErrRecvStartSequenceNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
 	}
@@ -805,7 +888,8 @@ func (k *Keeper) PruneAcknowledgements(ctx context.Context, portID, channelID st
 	end := pruningSequenceStart + limit // note: checked against limit overflowing.
 	for ; start < end; start++ {
 		// stop pruning if pruningSequenceStart has reached pruningSequenceEnd, pruningSequenceEnd is
-		// set to be equal to the _next_ sequence to be sent by the counterparty.
+		// set to be equal to the _next_ sequence to be sent by the counterparty at the upgrade, or to
+		// the proven _next_ sequence to be acknowledged by the counterparty.
 		if start >= pruningSequenceEnd {
 			break
 		}
@@ -507,9 +507,19 @@ func (suite *KeeperTestSuite) TestParams() {
 		expPass bool
 	}{
 		{"success: set default params", types.DefaultParams(), true},
-		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000)), true},
-		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0)), false},
-		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0)), false},
+		{"success: zero timeout height", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 10000), types.DefaultForceTimeoutThreshold), true},
+		{"fail: zero timeout timestamp", types.NewParams(types.NewTimeout(clienttypes.NewHeight(1, 1000), 0), types.DefaultForceTimeoutThreshold), false},
+		{"fail: zero timeout", types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), 0), types.DefaultForceTimeoutThreshold), false},
+		{"success: pause guardian", func() types.Params {
+			params := types.DefaultParams()
+			params.PauseGuardian = ibctesting.TestAccAddress
+			return params
+		}(), true},
+		{"fail: invalid pause guardian", func() types.Params {
+			params := types.DefaultParams()
+			params.PauseGuardian = ibctesting.InvalidID
+			return params
+		}(), false},
 	}
 
 	for _, tc := range testCases {
@@ -23,3 +23,13 @@ func (m Migrator) MigrateParams(ctx sdk.Context) error {
 	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params")
 	return nil
 }
+
+// Migrate7to8 migrates the channel params of the ibc module from consensus version 7 to 8. Force timeouts were added in
+// consensus version 8, the force timeout threshold of the params is set to its default value.
+func (m Migrator) Migrate7to8(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.ForceTimeoutThreshold = channeltypes.DefaultForceTimeoutThreshold
+	m.keeper.SetParams(ctx, params)
+	m.keeper.Logger(ctx).Info("successfully migrated ibc channel params to consensus version 8")
+	return nil
+}
@@ -32,3 +32,25 @@ func (suite *KeeperTestSuite) TestMigrateDefaultParams() {
 		})
 	}
 }
+
+// TestMigrate7to8 tests the migration of the channel params setting the default force timeout threshold
+func (suite *KeeperTestSuite) TestMigrate7to8() {
+	suite.SetupTest() // reset
+
+	ctx := suite.chainA.GetContext()
+	channelKeeper := suite.chainA.GetSimApp().IBCKeeper.ChannelKeeper
+
+	// params of consensus version 7 have no force timeout threshold
+	params := channeltypes.DefaultParams()
+	params.ForceTimeoutThreshold = 0
+	params.UpgradeTimeout = channeltypes.NewTimeout(params.UpgradeTimeout.Height, params.UpgradeTimeout.Timestamp*2)
+	channelKeeper.SetParams(ctx, params)
+
+	migrator := keeper.NewMigrator(channelKeeper)
+	err := migrator.Migrate7to8(ctx)
+	suite.Require().NoError(err)
+
+	expParams := params
+	expParams.ForceTimeoutThreshold = channeltypes.DefaultForceTimeoutThreshold
+	suite.Require().Equal(expParams, channelKeeper.GetParams(ctx))
+}
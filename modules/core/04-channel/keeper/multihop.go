@@ -0,0 +1,199 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+)
+
+// The functions below verify the state of the counterparty channel end of a channel. Proofs of channels
+// with a single connection hop are verified by the client of the connection, proofs of multi-hop channels
+// are multi-hop proofs verified over the connection hops of the channel.
+
+// verifyChannelState verifies a proof of the channel state of the counterparty channel end.
+func (k *Keeper) verifyChannelState(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	channel types.Channel,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyChannelState(ctx, connectionEnd, height, proof, portID, channelID, channel)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.ChannelKey(portID, channelID), k.cdc.MustMarshal(&channel),
+	)
+}
+
+// verifyPacketCommitment verifies a proof of an outgoing packet commitment of the counterparty channel end.
+func (k *Keeper) verifyPacketCommitment(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	commitmentBytes []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketCommitment(ctx, connectionEnd, height, proof, portID, channelID, sequence, commitmentBytes)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.PacketCommitmentKey(portID, channelID, sequence), commitmentBytes,
+	)
+}
+
+// verifyPacketAcknowledgement verifies a proof of an incoming packet acknowledgement of the counterparty channel end.
+func (k *Keeper) verifyPacketAcknowledgement(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	acknowledgement []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketAcknowledgement(ctx, connectionEnd, height, proof, portID, channelID, sequence, acknowledgement)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.PacketAcknowledgementKey(portID, channelID, sequence), types.CommitAcknowledgement(acknowledgement),
+	)
+}
+
+// verifyPacketReceiptAbsence verifies a proof of the absence of an incoming packet receipt of the counterparty channel end.
+func (k *Keeper) verifyPacketReceiptAbsence(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketReceiptAbsence(ctx, connectionEnd, height, proof, portID, channelID, sequence)
+	}
+
+	return k.connectionKeeper.VerifyMultihopNonMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.PacketReceiptKey(portID, channelID, sequence),
+	)
+}
+
+// verifyPacketReceipt verifies a proof of an incoming packet receipt of the counterparty channel end.
+func (k *Keeper) verifyPacketReceipt(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	sequence uint64,
+	receipt []byte,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyPacketReceipt(ctx, connectionEnd, height, proof, portID, channelID, sequence, receipt)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.PacketReceiptKey(portID, channelID, sequence), receipt,
+	)
+}
+
+// verifyNextSequenceRecv verifies a proof of the next sequence number to be received of the counterparty channel end.
+func (k *Keeper) verifyNextSequenceRecv(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceRecv uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyNextSequenceRecv(ctx, connectionEnd, height, proof, portID, channelID, nextSequenceRecv)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.NextSequenceRecvKey(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceRecv),
+	)
+}
+
+// verifyNextSequenceAck verifies a proof of the next sequence number to be acknowledged of the counterparty channel end.
+func (k *Keeper) verifyNextSequenceAck(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	height exported.Height,
+	proof []byte,
+	portID,
+	channelID string,
+	nextSequenceAck uint64,
+) error {
+	if len(connectionHops) == 1 {
+		return k.connectionKeeper.VerifyNextSequenceAck(ctx, connectionEnd, height, proof, portID, channelID, nextSequenceAck)
+	}
+
+	return k.connectionKeeper.VerifyMultihopMembership(
+		ctx, connectionEnd, height, proof, connectionHops,
+		host.NextSequenceAckKey(portID, channelID), sdk.Uint64ToBigEndian(nextSequenceAck),
+	)
+}
+
+// counterpartyConnectionHops returns the connection hops of the counterparty channel end. The connection hops of
+// a multi-hop channel are read from the provided multi-hop proof and are trusted once the proof is verified.
+func (k *Keeper) counterpartyConnectionHops(connectionEnd connectiontypes.ConnectionEnd, connectionHops []string, proof []byte) ([]string, error) {
+	if len(connectionHops) == 1 {
+		return []string{connectionEnd.Counterparty.ConnectionId}, nil
+	}
+
+	return k.connectionKeeper.GetMultihopCounterpartyConnectionHops(connectionEnd, proof)
+}
+
+// counterpartyConsensus returns the height and the timestamp of the counterparty chain the provided proof is
+// verified against. The height and the timestamp of a multi-hop channel are the height and the timestamp of the
+// consensus state of the counterparty chain included in the multi-hop proof.
+func (k *Keeper) counterpartyConsensus(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+) (clienttypes.Height, uint64, error) {
+	if len(connectionHops) == 1 {
+		proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connectionEnd.ClientId, proofHeight)
+		if err != nil {
+			return clienttypes.ZeroHeight(), 0, err
+		}
+
+		return proofHeight.(clienttypes.Height), proofTimestamp, nil
+	}
+
+	return k.connectionKeeper.GetMultihopCounterpartyConsensus(proof)
+}
@@ -0,0 +1,191 @@
+package keeper_test
+
+import (
+	"time"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	"github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+// setupMultihopCoordinator creates a coordinator with 3 test chains, chainA and chainC being the
+// ends of the multi-hop channels and chainB the intermediate chain.
+func (suite *KeeperTestSuite) setupMultihopCoordinator() (*ibctesting.TestChain, *ibctesting.TestChain, *ibctesting.TestChain) {
+	suite.coordinator = ibctesting.NewCoordinator(suite.T(), 3)
+
+	return suite.coordinator.GetChain(ibctesting.GetChainID(1)),
+		suite.coordinator.GetChain(ibctesting.GetChainID(2)),
+		suite.coordinator.GetChain(ibctesting.GetChainID(3))
+}
+
+// TestMultihopChannelHandshake opens a multi-hop channel between chainA and chainC over chainB
+// and checks that each channel end stores the connection hops of its own chain.
+func (suite *KeeperTestSuite) TestMultihopChannelHandshake() {
+	testCases := []struct {
+		name  string
+		order types.Order
+	}{
+		{"unordered channel", types.UNORDERED},
+		{"ordered channel", types.ORDERED},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+			path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			path.Setup()
+
+			channelA := path.EndpointA.GetChannel()
+			suite.Require().Equal(types.OPEN, channelA.State)
+			suite.Require().Equal(tc.order, channelA.Ordering)
+			suite.Require().Equal([]string{path.Paths[0].EndpointA.ConnectionID, path.Paths[1].EndpointA.ConnectionID}, channelA.ConnectionHops)
+			suite.Require().Equal(types.NewCounterparty(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID), channelA.Counterparty)
+
+			channelC := path.EndpointB.GetChannel()
+			suite.Require().Equal(types.OPEN, channelC.State)
+			suite.Require().Equal([]string{path.Paths[1].EndpointB.ConnectionID, path.Paths[0].EndpointB.ConnectionID}, channelC.ConnectionHops)
+			suite.Require().Equal(types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), channelC.Counterparty)
+		})
+	}
+}
+
+// TestMultihopChanOpenTryInvalidConnectionHops tests that the connection hops of a multi-hop channel end must
+// route to the chain of the counterparty channel end.
+func (suite *KeeperTestSuite) TestMultihopChanOpenTryInvalidConnectionHops() {
+	chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	path.SetupConnections()
+
+	suite.Require().NoError(path.EndpointA.ChanOpenInit())
+
+	// a single connection hop routes to chainB, where the counterparty channel end does not exist
+	proof, proofHeight := path.EndpointB.QueryMultihopProof(host.ChannelKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	_, err := chainC.App.GetIBCKeeper().ChannelKeeper.ChanOpenTry(
+		chainC.GetContext(), types.UNORDERED, []string{path.Paths[1].EndpointB.ConnectionID},
+		path.EndpointB.ChannelConfig.PortID, types.NewCounterparty(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID),
+		path.EndpointA.ChannelConfig.Version, proof, proofHeight, 0,
+	)
+	suite.Require().Error(err)
+}
+
+// TestMultihopPacketFlow sends a packet over a multi-hop channel, receives it on the counterparty
+// chain and acknowledges it on the sending chain.
+func (suite *KeeperTestSuite) TestMultihopPacketFlow() {
+	chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	path.Setup()
+
+	// packets sent on multi-hop channels can only time out on timestamp
+	_, err := path.EndpointA.SendPacket(chainC.GetTimeoutHeight(), disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().ErrorIs(err, types.ErrInvalidPacket)
+
+	timeoutTimestamp := chainC.GetTimeoutTimestamp()
+	sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	_, found := chainC.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(chainC.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+
+	ack, found := chainC.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(chainC.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(types.CommitAcknowledgement(mock.MockAcknowledgement.Acknowledgement()), ack)
+
+	commitment := chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	suite.Require().Empty(commitment)
+}
+
+// TestMultihopTimeoutPacket times out a packet sent over a multi-hop channel once the timeout timestamp
+// has passed on the counterparty chain.
+func (suite *KeeperTestSuite) TestMultihopTimeoutPacket() {
+	testCases := []struct {
+		name    string
+		elapsed bool
+		expErr  error
+	}{
+		{"success", true, nil},
+		{"failure: packet timeout not reached", false, types.ErrTimeoutNotReached},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+			path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+			path.Setup()
+
+			// the timeout timestamp of the packet is checked against the timestamp of chainC
+			timeoutTimestamp := uint64(chainC.GetContext().BlockTime().Add(ibctesting.TimeIncrement * 3).UnixNano())
+			if !tc.elapsed {
+				timeoutTimestamp = uint64(chainC.GetContext().BlockTime().Add(time.Hour).UnixNano())
+			}
+
+			sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+
+			suite.coordinator.CommitNBlocks(chainC, 10)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+
+				commitment := chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+				suite.Require().Empty(commitment)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+// TestMultihopForceTimeoutPacket tests that packets sent on multi-hop channels cannot be timed out by the
+// authority, even when the client of the first connection hop is expired.
+func (suite *KeeperTestSuite) TestMultihopForceTimeoutPacket() {
+	chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	path.Setup()
+
+	timeoutTimestamp := chainC.GetTimeoutTimestamp()
+	sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+
+	// expire the client of chainB on chainA and exceed the force timeout threshold
+	suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Duration(types.DefaultForceTimeoutThreshold))
+
+	channelVersion, err := chainA.App.GetIBCKeeper().ChannelKeeper.ForceTimeoutPacket(chainA.GetContext(), packet)
+	suite.Require().ErrorIs(err, types.ErrForceTimeoutNotAllowed)
+	suite.Require().Empty(channelVersion)
+}
+
+// TestMultihopChanUpgradeInit tests that multi-hop channels cannot be upgraded.
+func (suite *KeeperTestSuite) TestMultihopChanUpgradeInit() {
+	chainA, chainB, chainC := suite.setupMultihopCoordinator()
+
+	path := ibctesting.NewMultihopPath(chainA, chainB, chainC)
+	path.Setup()
+
+	channel := path.EndpointA.GetChannel()
+	upgradeFields := types.NewUpgradeFields(channel.Ordering, channel.ConnectionHops, mock.UpgradeVersion)
+
+	_, err := chainA.App.GetIBCKeeper().ChannelKeeper.ChanUpgradeInit(chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, upgradeFields)
+	suite.Require().ErrorIs(err, types.ErrTooManyConnectionHops)
+}
@@ -36,6 +36,10 @@ func (k *Keeper) SendPacket(
 		return 0, errorsmod.Wrapf(types.ErrInvalidChannelState, "channel is not OPEN (got %s)", channel.State)
 	}
 
+	if err := k.checkChannelNotPaused(ctx, sourcePort, sourceChannel); err != nil {
+		return 0, err
+	}
+
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
 
 	sequence, found := k.GetNextSequenceSend(ctx, sourcePort, sourceChannel)
@@ -54,6 +58,11 @@ func (k *Keeper) SendPacket(
 		return 0, errorsmod.Wrap(err, "constructed packet failed basic validation")
 	}
 
+	// the packet receipts of UNORDERED_EXPIRING_RECEIPTS channels expire relative to the timeout timestamp of the packets
+	if channel.Ordering == types.UNORDERED_EXPIRING_RECEIPTS && timeoutTimestamp == 0 {
+		return 0, errorsmod.Wrapf(types.ErrInvalidPacket, "packets sent on %s channels must have a timeout timestamp", channel.Ordering)
+	}
+
 	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return 0, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
@@ -74,6 +83,12 @@ func (k *Keeper) SendPacket(
 		return 0, err
 	}
 
+	// the client of a multi-hop channel tracks the next chain along the route rather than the receiving
+	// chain, the timeout height of the packet cannot be checked and packets can only time out on timestamp
+	if len(channel.ConnectionHops) > 1 && !timeoutHeight.IsZero() {
+		return 0, errorsmod.Wrap(types.ErrInvalidPacket, "packets sent on multi-hop channels cannot have a timeout height")
+	}
+
 	// check if packet is timed out on the receiving chain
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
 	if timeout.Elapsed(latestHeight, latestTimestamp) {
@@ -100,7 +115,10 @@ func (k *Keeper) SendPacket(
 }
 
 // RecvPacket is called by a module in order to receive & process an IBC packet
-// sent on the corresponding channel end on the counterparty chain.
+// sent on the corresponding channel end on the counterparty chain. Timed out packets
+// are rejected, unless the channel is ORDERED_ALLOW_TIMEOUT: a timeout receipt is then
+// written for the packet and ErrTimeoutReceiptWritten is returned. The state changes
+// must be committed in that case, but the packet must not be passed to the application.
 func (k *Keeper) RecvPacket(
 	ctx context.Context,
 	packet types.Packet,
@@ -116,6 +134,10 @@ func (k *Keeper) RecvPacket(
 		return "", errorsmod.Wrapf(types.ErrInvalidChannelState, "expected channel state to be one of [%s, %s, %s], but got %s", types.OPEN, types.FLUSHING, types.FLUSHCOMPLETE, channel.State)
 	}
 
+	if err := k.checkChannelNotPaused(ctx, packet.GetDestPort(), packet.GetDestChannel()); err != nil {
+		return "", err
+	}
+
 	// If counterpartyUpgrade is stored we need to ensure that the
 	// packet sequence is counterparty next sequence send. If the
 	// counterparty is implemented correctly, this may only occur
@@ -160,15 +182,21 @@ func (k *Keeper) RecvPacket(
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
 	selfHeight, selfTimestamp := clienttypes.GetSelfHeight(sdkCtx), uint64(sdkCtx.BlockTime().UnixNano())
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if timeout.Elapsed(selfHeight, selfTimestamp) {
+	timeoutElapsed := timeout.Elapsed(selfHeight, selfTimestamp)
+	if timeoutElapsed && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
 		return "", errorsmod.Wrap(timeout.ErrTimeoutElapsed(selfHeight, selfTimestamp), "packet timeout elapsed")
 	}
 
+	// the packet receipts of UNORDERED_EXPIRING_RECEIPTS channels expire relative to the timeout timestamp of the packets
+	if channel.Ordering == types.UNORDERED_EXPIRING_RECEIPTS && packet.GetTimeoutTimestamp() == 0 {
+		return "", errorsmod.Wrapf(types.ErrInvalidPacket, "packets received on %s channels must have a timeout timestamp", channel.Ordering)
+	}
+
 	commitment := types.CommitPacket(k.cdc, packet)
 
 	// verify that the counterparty did commit to sending this packet
-	if err := k.connectionKeeper.VerifyPacketCommitment(
-		ctx, connectionEnd, proofHeight, proof,
+	if err := k.verifyPacketCommitment(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 		packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence(),
 		commitment,
 	); err != nil {
@@ -179,6 +207,23 @@ func (k *Keeper) RecvPacket(
 		return "", err
 	}
 
+	// ORDERED_ALLOW_TIMEOUT channels skip timed out packets instead of closing: the next receive sequence
+	// has been incremented and the timeout receipt allows the sending end to prove the timeout of the packet.
+	if timeoutElapsed {
+		k.SetPacketTimeoutReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+
+		k.Logger(ctx).Info(
+			"timeout receipt written",
+			"sequence", strconv.FormatUint(packet.GetSequence(), 10),
+			"src_port", packet.GetSourcePort(),
+			"src_channel", packet.GetSourceChannel(),
+			"dst_port", packet.GetDestPort(),
+			"dst_channel", packet.GetDestChannel(),
+		)
+
+		return "", types.ErrTimeoutReceiptWritten
+	}
+
 	// log that a packet has been received & executed
 	k.Logger(ctx).Info(
 		"packet received",
@@ -209,10 +254,11 @@ func (k *Keeper) applyReplayProtection(ctx context.Context, packet types.Packet,
 
 	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
 	switch channel.Ordering {
-	case types.UNORDERED:
+	case types.UNORDERED, types.UNORDERED_EXPIRING_RECEIPTS:
 		// REPLAY PROTECTION: Packet receipts will indicate that a packet has already been received
 		// on unordered channels. Packet receipts must not be pruned, unless it has been marked stale
-		// by the increase of the recvStartSequence.
+		// by the increase of the recvStartSequence, or it has expired on UNORDERED_EXPIRING_RECEIPTS
+		// channels: the packet has then timed out and can no longer be received.
 		_, found := k.GetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 		if found {
 			emitRecvPacketEvent(sdkCtx, packet, channel)
@@ -228,7 +274,13 @@ func (k *Keeper) applyReplayProtection(ctx context.Context, packet types.Packet,
 		// it's just a single store key set to a single byte to indicate that the packet has been received
 		k.SetPacketReceipt(ctx, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-	case types.ORDERED:
+		// the packet receipt is indexed by its expiry timestamp to be pruned once it has expired
+		if channel.Ordering == types.UNORDERED_EXPIRING_RECEIPTS {
+			expiryTimestamp := types.ReceiptExpiryTimestamp(packet.GetTimeoutTimestamp(), channel.GetReceiptExpiryPeriod())
+			k.SetReceiptExpiry(ctx, expiryTimestamp, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+		}
+
+	case types.ORDERED, types.ORDERED_ALLOW_TIMEOUT:
 		// check if the packet is being received in order
 		nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, packet.GetDestPort(), packet.GetDestChannel())
 		if !found {
@@ -365,6 +417,10 @@ func (k *Keeper) AcknowledgePacket(
 		return "", errorsmod.Wrapf(types.ErrInvalidChannelState, "packets cannot be acknowledged on channel with state (%s)", channel.State)
 	}
 
+	if err := k.checkChannelNotPaused(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return "", err
+	}
+
 	// packet must have been sent to the channel's counterparty
 	if packet.GetDestPort() != channel.Counterparty.PortId {
 		return "", errorsmod.Wrapf(
@@ -407,15 +463,15 @@ func (k *Keeper) AcknowledgePacket(
 		return "", errorsmod.Wrapf(types.ErrInvalidPacket, "commitment bytes are not equal: got (%v), expected (%v)", packetCommitment, commitment)
 	}
 
-	if err := k.connectionKeeper.VerifyPacketAcknowledgement(
-		ctx, connectionEnd, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
+	if err := k.verifyPacketAcknowledgement(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet.GetDestPort(), packet.GetDestChannel(),
 		packet.GetSequence(), acknowledgement,
 	); err != nil {
 		return "", err
 	}
 
 	// assert packets acknowledged in order
-	if channel.Ordering == types.ORDERED {
+	if channel.Ordering.IsOrdered() {
 		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
 		if !found {
 			return "", errorsmod.Wrapf(
@@ -431,7 +487,7 @@ func (k *Keeper) AcknowledgePacket(
 			)
 		}
 
-		// All verification complete, in the case of ORDERED channels we must increment nextSequenceAck
+		// All verification complete, in the case of ORDERED and ORDERED_ALLOW_TIMEOUT channels we must increment nextSequenceAck
 		nextSequenceAck++
 
 		// incrementing NextSequenceAck and storing under this chain's channelEnd identifiers
@@ -51,6 +51,17 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 			path.Setup()
 			sourceChannel = path.EndpointA.ChannelID
 		}, true},
+		{"success: UNORDERED_EXPIRING_RECEIPTS channel", func() {
+			path.SetChannelUnorderedExpiringReceipts()
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+			timeoutTimestamp = suite.chainB.GetTimeoutTimestamp()
+		}, true},
+		{"timeout timestamp not set on UNORDERED_EXPIRING_RECEIPTS channel", func() {
+			path.SetChannelUnorderedExpiringReceipts()
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+		}, false},
 		{"success with solomachine: UNORDERED channel", func() {
 			path.Setup()
 			sourceChannel = path.EndpointA.ChannelID
@@ -102,6 +113,13 @@ func (suite *KeeperTestSuite) TestSendPacket() {
 
 			path.EndpointA.UpdateChannel(func(channel *types.Channel) { channel.State = types.TRYOPEN })
 		}, false},
+		{"channel is paused", func() {
+			path.Setup()
+			sourceChannel = path.EndpointA.ChannelID
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+		}, false},
 		{"connection not found", func() {
 			// pass channel check
 			path.Setup()
@@ -281,6 +299,41 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			nil,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			},
+			nil,
+		},
+		{
+			"success: UNORDERED_EXPIRING_RECEIPTS channel",
+			func() {
+				path.SetChannelUnorderedExpiringReceipts()
+				path.Setup()
+
+				timeoutTimestamp := suite.chainB.GetTimeoutTimestamp()
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, timeoutTimestamp)
+			},
+			nil,
+		},
+		{
+			"failure: timeout timestamp not set on UNORDERED_EXPIRING_RECEIPTS channel",
+			func() {
+				path.SetChannelUnorderedExpiringReceipts()
+				path.Setup()
+
+				packet = types.NewPacket(ibctesting.MockPacketData, 1, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+			},
+			types.ErrInvalidPacket,
+		},
 		{
 			"success UNORDERED channel",
 			func() {
@@ -445,6 +498,20 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			types.ErrInvalidChannelState,
 		},
+		{
+			"channel is paused",
+			func() {
+				path.Setup()
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+				err = suite.chainB.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+				suite.Require().NoError(err)
+			},
+			types.ErrChannelPaused,
+		},
 		{
 			"packet source port ≠ channel counterparty port",
 			func() {
@@ -517,6 +584,34 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 			},
 			types.ErrTimeoutElapsed,
 		},
+		{
+			"timeout height passed: ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			},
+			types.ErrTimeoutReceiptWritten,
+		},
+		{
+			"timeout height passed: ORDERED_ALLOW_TIMEOUT channel, out of order packet",
+			func() {
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+				for i := 0; i < 2; i++ {
+					_, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+					suite.Require().NoError(err)
+				}
+				packet = types.NewPacket(ibctesting.MockPacketData, 2, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
 		{
 			"next receive sequence is not found",
 			func() {
@@ -605,13 +700,16 @@ func (suite *KeeperTestSuite) TestRecvPacket() {
 				suite.Require().True(found)
 				receipt, receiptStored := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
 
-				if channelB.Ordering == types.ORDERED {
+				if channelB.Ordering.IsOrdered() {
 					suite.Require().Equal(packet.GetSequence()+1, nextSeqRecv, "sequence not incremented in ordered channel")
 					suite.Require().False(receiptStored, "packet receipt stored on ORDERED channel")
 				} else {
 					suite.Require().Equal(uint64(1), nextSeqRecv, "sequence incremented for UNORDERED channel")
 					suite.Require().True(receiptStored, "packet receipt not stored after RecvPacket in UNORDERED channel")
 					suite.Require().Equal(string([]byte{byte(1)}), receipt, "packet receipt is not empty string")
+
+					hasReceiptExpiry := suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasReceiptExpiry(suite.chainB.GetContext(), types.ReceiptExpiryTimestamp(packet.GetTimeoutTimestamp(), channelB.GetReceiptExpiryPeriod()), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().Equal(channelB.Ordering == types.UNORDERED_EXPIRING_RECEIPTS, hasReceiptExpiry, "packet receipt expiry not indexed on UNORDERED_EXPIRING_RECEIPTS channel")
 				}
 			} else {
 				suite.Require().Error(err)
@@ -1012,6 +1110,25 @@ func (suite *KeeperTestSuite) TestAcknowledgePacket() {
 			},
 			expResult: assertErr(types.ErrInvalidChannelState),
 		},
+		{
+			name: "channel is paused",
+			malleate: func() {
+				path.Setup()
+
+				// create packet commitment
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				// create packet receipt and acknowledgement
+				packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				err = path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+
+				err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			expResult: assertErr(types.ErrChannelPaused),
+		},
 		{
 			name: "channel in flush complete state",
 			malleate: func() {
@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// PauseChannel pauses the provided channel. Packets can no longer be sent, received, acknowledged or timed out
+// on a paused channel until it is unpaused, but the channel is not closed and its state is left untouched.
+func (k *Keeper) PauseChannel(ctx context.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.SetChannelPaused(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel paused", "port-id", portID, "channel-id", channelID)
+
+	emitChannelPausedEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// UnpauseChannel resumes the provided paused channel.
+func (k *Keeper) UnpauseChannel(ctx context.Context, portID, channelID string) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if !k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelNotPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.deleteChannelPaused(ctx, portID, channelID)
+
+	k.Logger(ctx).Info("channel unpaused", "port-id", portID, "channel-id", channelID)
+
+	emitChannelUnpausedEvent(ctx, portID, channelID, channel)
+
+	return nil
+}
+
+// IsChannelPaused returns true if the provided channel is paused.
+func (k *Keeper) IsChannelPaused(ctx context.Context, portID, channelID string) bool {
+	store := k.storeService.OpenKVStore(ctx)
+	has, err := store.Has(host.ChannelPausedKey(portID, channelID))
+	if err != nil {
+		panic(err)
+	}
+	return has
+}
+
+// SetChannelPaused marks the provided channel as paused in the store.
+func (k *Keeper) SetChannelPaused(ctx context.Context, portID, channelID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Set(host.ChannelPausedKey(portID, channelID), []byte{byte(1)}); err != nil {
+		panic(err)
+	}
+}
+
+// GetAllPausedChannels returns all the paused channels.
+func (k *Keeper) GetAllPausedChannels(ctx context.Context) []types.PausedChannel {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(host.KeyChannelPausedPrefix))
+	iterator := store.Iterator(nil, nil)
+	defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+
+	var pausedChannels []types.PausedChannel
+	for ; iterator.Valid(); iterator.Next() {
+		portID, channelID := host.MustParseChannelPath(string(iterator.Key()))
+		pausedChannels = append(pausedChannels, types.NewPausedChannel(portID, channelID))
+	}
+	return pausedChannels
+}
+
+// deleteChannelPaused removes the pause flag of the provided channel from the store.
+func (k *Keeper) deleteChannelPaused(ctx context.Context, portID, channelID string) {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(host.ChannelPausedKey(portID, channelID)); err != nil {
+		panic(err)
+	}
+}
+
+// checkChannelNotPaused returns an error if the provided channel is paused.
+func (k *Keeper) checkChannelNotPaused(ctx context.Context, portID, channelID string) error {
+	if k.IsChannelPaused(ctx, portID, channelID) {
+		return errorsmod.Wrapf(types.ErrChannelPaused, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	return nil
+}
@@ -0,0 +1,159 @@
+package keeper_test
+
+import (
+	channel "github.com/cosmos/ibc-go/v9/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+)
+
+// TestPauseChannel tests pausing a channel on chainA.
+func (suite *KeeperTestSuite) TestPauseChannel() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is already paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			types.ErrChannelPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			tc.malleate()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().True(channelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				// the channel state is left untouched
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestUnpauseChannel tests unpausing a paused channel on chainA and resuming packet flow over it.
+func (suite *KeeperTestSuite) TestUnpauseChannel() {
+	var path *ibctesting.Path
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is not paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.UnpauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			types.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+			err := channelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			_, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().ErrorIs(err, types.ErrChannelPaused)
+
+			tc.malleate()
+
+			err = channelKeeper.UnpauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().False(channelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+
+				sequence, err := path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+				suite.Require().NoError(path.RelayPacket(packet))
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestPausedChannelsGenesis tests that paused channels are exported to and imported from genesis.
+func (suite *KeeperTestSuite) TestPausedChannelsGenesis() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	// a second channel which is not paused
+	ibctesting.NewPath(suite.chainA, suite.chainB).Setup()
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	err := channelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+
+	genesis := channel.ExportGenesis(suite.chainA.GetContext(), channelKeeper)
+	suite.Require().NoError(genesis.Validate())
+	suite.Require().Equal([]types.PausedChannel{types.NewPausedChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)}, genesis.PausedChannels)
+
+	// unpause the channel and check that importing the exported genesis pauses it again
+	err = channelKeeper.UnpauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+	suite.Require().NoError(err)
+	suite.Require().Empty(channelKeeper.GetAllPausedChannels(suite.chainA.GetContext()))
+
+	channel.InitGenesis(suite.chainA.GetContext(), channelKeeper, genesis)
+
+	suite.Require().True(channelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+	suite.Require().Equal(genesis, channel.ExportGenesis(suite.chainA.GetContext(), channelKeeper))
+}
@@ -0,0 +1,287 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	"github.com/cosmos/ibc-go/v9/modules/core/internal/telemetry"
+)
+
+// PruneStalePacketState prunes the acknowledgements and packet receipts which are no longer needed. The acknowledgements
+// and packet receipts of a channel with a sequence lower than its pruning sequence end are no longer needed: the pruning
+// sequence end of an upgraded channel is its recv start sequence, since all the packets sent before the upgrade have been
+// flushed and older packets can no longer be received, and the pruning sequence end of an ORDERED or ORDERED_ALLOW_TIMEOUT
+// channel is the proven next sequence to be acknowledged by the counterparty, since all the packets received with a lower
+// sequence have been acknowledged or timed out on the counterparty. These channels are pruned in turn, starting from the
+// channel stored in the pruning cursor and consuming at most the gas set by the PruningGasPerBlock parameter. The expired
+// packet receipts of UNORDERED_EXPIRING_RECEIPTS channels are then pruned, up to MaxExpiredReceiptsPrunedPerBlock, even if
+// the PruningGasPerBlock parameter is not set. The total number of acknowledgements and packet receipts pruned is returned.
+//
+// NOTE: the gas limit is checked before pruning each sequence, so the gas consumed can slightly exceed it.
+func (k *Keeper) PruneStalePacketState(ctx context.Context) (uint64, uint64) {
+	// pruning is metered by a dedicated gas meter, which bounds the work done in a block without charging for it
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	pruneCtx := sdkCtx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	gasMeter := pruneCtx.GasMeter()
+
+	var totalAcks, totalReceipts uint64
+	if gasLimit := k.GetParams(ctx).PruningGasPerBlock; gasLimit > 0 {
+		cursor := k.getPruningCursor(pruneCtx)
+		for gasMeter.GasConsumed() < gasLimit {
+			portID, channelID, found := k.nextChannelToPrune(pruneCtx, &cursor, gasLimit)
+			if !found {
+				break
+			}
+
+			acks, receipts := k.pruneChannel(pruneCtx, portID, channelID, gasLimit)
+			totalAcks += acks
+			totalReceipts += receipts
+		}
+
+		k.setPruningCursor(pruneCtx, cursor)
+	}
+
+	totalReceipts += k.pruneExpiredReceipts(pruneCtx, types.MaxExpiredReceiptsPrunedPerBlock)
+
+	if totalAcks > 0 || totalReceipts > 0 {
+		k.Logger(ctx).Debug("pruned stale packet state", "acknowledgements", totalAcks, "receipts", totalReceipts, "gas-used", gasMeter.GasConsumed())
+	}
+
+	return totalAcks, totalReceipts
+}
+
+// nextChannelToPrune returns the first channel with acknowledgements and packet receipts left to prune, starting from the
+// channel of the provided cursor. The cursor is moved to the channel returned, to the channel the search stopped at if the
+// gas limit has been reached, or reset if no channel is left to prune until the last channel with a pruning sequence start.
+func (k *Keeper) nextChannelToPrune(ctx sdk.Context, cursor *[]byte, gasLimit uint64) (string, string, bool) {
+	var (
+		portID, channelID string
+		found             bool
+	)
+
+	start := *cursor
+	if start == nil {
+		start = []byte(host.KeyPruningSequenceStart)
+	}
+
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := store.Iterator(start, storetypes.PrefixEndBytes([]byte(host.KeyPruningSequenceStart)))
+	exhausted := true
+	k.IteratePacketSequence(ctx, iterator, func(port, channel string, pruningSequenceStart uint64) bool {
+		*cursor = host.PruningSequenceStartKey(port, channel)
+		if ctx.GasMeter().GasConsumed() >= gasLimit {
+			exhausted = false
+			return true
+		}
+
+		pruningSequenceEnd, ok := k.getPruningSequenceEnd(ctx, port, channel)
+		if !ok || pruningSequenceStart >= pruningSequenceEnd {
+			return false
+		}
+
+		portID, channelID, found = port, channel, true
+		exhausted = false
+		return true
+	})
+
+	if exhausted {
+		// start from the first channel in the next block
+		*cursor = nil
+	}
+
+	return portID, channelID, found
+}
+
+// pruneChannel prunes the acknowledgements and packet receipts of the provided channel from its pruning sequence start
+// until its pruning sequence end or until the gas limit is reached, and returns the number of acknowledgements and packet
+// receipts deleted.
+func (k *Keeper) pruneChannel(ctx sdk.Context, portID, channelID string, gasLimit uint64) (uint64, uint64) {
+	pruningSequenceStart, _ := k.GetPruningSequenceStart(ctx, portID, channelID)
+	pruningSequenceEnd, _ := k.getPruningSequenceEnd(ctx, portID, channelID)
+
+	var acks, receipts uint64
+	sequence := pruningSequenceStart
+	for ; sequence < pruningSequenceEnd && ctx.GasMeter().GasConsumed() < gasLimit; sequence++ {
+		if k.HasPacketAcknowledgement(ctx, portID, channelID, sequence) {
+			k.deletePacketAcknowledgement(ctx, portID, channelID, sequence)
+			acks++
+		}
+
+		// NOTE: packet receipts are only relevant for unordered channels.
+		if _, found := k.GetPacketReceipt(ctx, portID, channelID, sequence); found {
+			k.deletePacketReceipt(ctx, portID, channelID, sequence)
+			receipts++
+		}
+	}
+
+	k.SetPruningSequenceStart(ctx, portID, channelID, sequence)
+
+	telemetry.ReportPrunePacketState(portID, channelID, acks, receipts)
+
+	return acks, receipts
+}
+
+// getPruningSequenceEnd returns the sequence below which the acknowledgements and packet receipts of the provided channel
+// are no longer needed, which is the greatest of its recv start sequence and of its proven counterparty next sequence ack.
+// False is returned if the channel has neither been upgraded nor had its counterparty next sequence ack proven.
+func (k *Keeper) getPruningSequenceEnd(ctx context.Context, portID, channelID string) (uint64, bool) {
+	recvStartSequence, foundRecvStart := k.GetRecvStartSequence(ctx, portID, channelID)
+	counterpartyNextSequenceAck, foundNextSequenceAck := k.GetCounterpartyNextSequenceAck(ctx, portID, channelID)
+
+	return max(recvStartSequence, counterpartyNextSequenceAck), foundRecvStart || foundNextSequenceAck
+}
+
+// UpdateCounterpartyNextSequenceAck verifies the proof of the next sequence to be acknowledged by the counterparty of the
+// provided ORDERED or ORDERED_ALLOW_TIMEOUT channel and stores it. The acknowledgements and packet receipts of the channel
+// with a lower sequence are no longer needed, since the counterparty processes acknowledgements and timeouts in order,
+// and are pruned by PruneStalePacketState or PruneAcknowledgements.
+//
+// NOTE: the counterparty of an UNORDERED channel does not keep track of the sequences acknowledged, the acknowledgements and
+// packet receipts of unordered channels are only pruned after an upgrade or when their receipts expire.
+func (k *Keeper) UpdateCounterpartyNextSequenceAck(
+	ctx context.Context,
+	portID, channelID string,
+	nextSequenceAck uint64,
+	proof []byte,
+	proofHeight exported.Height,
+) error {
+	channel, found := k.GetChannel(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	if channel.Ordering != types.ORDERED && channel.Ordering != types.ORDERED_ALLOW_TIMEOUT {
+		return errorsmod.Wrapf(types.ErrInvalidChannelOrdering, "expected %s or %s, got %s", types.ORDERED, types.ORDERED_ALLOW_TIMEOUT, channel.Ordering)
+	}
+
+	if channel.State == types.INIT || channel.State == types.TRYOPEN {
+		return errorsmod.Wrapf(types.ErrInvalidChannelState, "channel state is not open, flushing or closed (got %s)", channel.State)
+	}
+
+	if stored, found := k.GetCounterpartyNextSequenceAck(ctx, portID, channelID); found && nextSequenceAck <= stored {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidSequence, "next sequence ack must be greater than the stored counterparty next sequence ack (%d <= %d)", nextSequenceAck, stored)
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	if err := k.verifyNextSequenceAck(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
+		channel.Counterparty.PortId, channel.Counterparty.ChannelId, nextSequenceAck,
+	); err != nil {
+		return err
+	}
+
+	// acknowledgements and packet receipts can still be written for the packets which have not been received, the
+	// counterparty next sequence ack stored is capped at the next sequence receive so that they are pruned later on
+	nextSequenceRecv, found := k.GetNextSequenceRecv(ctx, portID, channelID)
+	if !found {
+		return errorsmod.Wrapf(types.ErrSequenceReceiveNotFound, "port ID (%s) channel ID (%s)", portID, channelID)
+	}
+
+	k.setCounterpartyNextSequenceAck(ctx, portID, channelID, min(nextSequenceAck, nextSequenceRecv))
+
+	// the channel is pruned from its first sequence if it has not been upgraded
+	if !k.HasPruningSequenceStart(ctx, portID, channelID) {
+		k.SetPruningSequenceStart(ctx, portID, channelID, 1)
+	}
+
+	k.Logger(ctx).Info("counterparty next sequence ack updated", "port-id", portID, "channel-id", channelID, "next-sequence-ack", nextSequenceAck)
+
+	return nil
+}
+
+// pruneExpiredReceipts prunes the packet receipts of UNORDERED_EXPIRING_RECEIPTS channels which have expired at the
+// block time, in the order of their expiry, until the provided limit of receipt expiries is reached. The number of
+// packet receipts deleted is returned.
+func (k *Keeper) pruneExpiredReceipts(ctx context.Context, limit int) uint64 {
+	// the packet receipts with an expiry timestamp up to the block time have expired
+	blockTime := uint64(sdk.UnwrapSDKContext(ctx).BlockTime().UnixNano()) // TODO: https://github.com/cosmos/ibc-go/issues/5917
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(host.ReceiptExpiryPrefixKey(0), storetypes.PrefixEndBytes(host.ReceiptExpiryPrefixKey(blockTime)))
+	if err != nil {
+		panic(err)
+	}
+
+	// the receipt expiry keys are collected first, since the store must not be written while iterating
+	var expiryKeys [][]byte
+	func() {
+		defer sdk.LogDeferred(k.Logger(ctx), func() error { return iterator.Close() })
+		for ; iterator.Valid() && len(expiryKeys) < limit; iterator.Next() {
+			expiryKeys = append(expiryKeys, slices.Clone(iterator.Key()))
+		}
+	}()
+
+	var receipts uint64
+	prefixLen := len(host.ReceiptExpiryPrefixKey(0))
+	for _, expiryKey := range expiryKeys {
+		// the receipt expiry key ends with the packet receipt key, which may already have been pruned
+		// if the channel has been upgraded
+		receiptKey := expiryKey[prefixLen:]
+		has, err := store.Has(receiptKey)
+		if err != nil {
+			panic(err)
+		}
+
+		if has {
+			if err := store.Delete(receiptKey); err != nil {
+				panic(err)
+			}
+			receipts++
+
+			portID, channelID := host.MustParseChannelPath(string(receiptKey))
+			telemetry.ReportPrunePacketState(portID, channelID, 0, 1)
+		}
+
+		if err := store.Delete(expiryKey); err != nil {
+			panic(err)
+		}
+	}
+
+	return receipts
+}
+
+// getPruningCursor returns the pruning sequence start key of the channel the automatic pruning resumes from, or nil
+// if it starts from the first upgraded channel.
+func (k *Keeper) getPruningCursor(ctx context.Context) []byte {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get([]byte(types.KeyPruningCursor))
+	if err != nil {
+		panic(err)
+	}
+
+	if len(bz) == 0 {
+		return nil
+	}
+
+	return bz
+}
+
+// setPruningCursor sets the pruning sequence start key of the channel the automatic pruning resumes from in the next
+// block. The cursor is deleted if it is nil.
+func (k *Keeper) setPruningCursor(ctx context.Context, cursor []byte) {
+	store := k.storeService.OpenKVStore(ctx)
+	if cursor == nil {
+		if err := store.Delete([]byte(types.KeyPruningCursor)); err != nil {
+			panic(err)
+		}
+		return
+	}
+
+	if err := store.Set([]byte(types.KeyPruningCursor), cursor); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,497 @@
+package keeper_test
+
+import (
+	"time"
+
+	channel "github.com/cosmos/ibc-go/v9/modules/core/04-channel"
+	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
+	ibctesting "github.com/cosmos/ibc-go/v9/testing"
+	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
+)
+
+// pruningGasPerBlock is the pruning gas per block used in tests, high enough to prune all the stale packet state.
+const pruningGasPerBlock = 1_000_000
+
+// TestPruneStalePacketState tests the automatic pruning of the acknowledgements and packet receipts of upgraded channels and of
+// channels whose counterparty next sequence ack has been proven on chainA.
+func (suite *KeeperTestSuite) TestPruneStalePacketState() {
+	var (
+		path     *ibctesting.Path
+		gasLimit uint64
+
+		// setPruningGasPerBlock sets the pruning gas per block parameter of chainA.
+		setPruningGasPerBlock = func(gas uint64) {
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.PruningGasPerBlock = gas
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+		}
+
+		// upgradedPath returns a new path whose channel has been upgraded after 10 packets have been sent from B -> A,
+		// creating 10 packet receipts and 10 packet acks on A.
+		upgradedPath = func() *ibctesting.Path {
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			suite.sendMockPackets(path, 10, true)
+			suite.UpgradeChannel(path, types.UpgradeFields{Version: ibcmock.UpgradeVersion})
+
+			return path
+		}
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		post     func(acks, receipts uint64)
+	}{
+		{
+			"success: all stale packet state pruned",
+			func() {},
+			func(acks, receipts uint64) {
+				suite.Require().Equal(uint64(10), acks)
+				suite.Require().Equal(uint64(10), receipts)
+
+				suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()))
+				suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()))
+
+				start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(11), start)
+
+				// all upgraded channels have been pruned, the next block starts from the first upgraded channel
+				suite.Require().Nil(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningCursor(suite.chainA.GetContext()))
+			},
+		},
+		{
+			"success: automatic pruning disabled",
+			func() {
+				gasLimit = 0
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Zero(acks)
+				suite.Require().Zero(receipts)
+
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), 10)
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()), 10)
+			},
+		},
+		{
+			"success: stale packet state partially pruned within the gas limit, pruning resumes in the next block",
+			func() {
+				gasLimit = 10_000
+			},
+			func(acks, receipts uint64) {
+				suite.Require().NotZero(acks)
+				suite.Require().Less(acks, uint64(10))
+				suite.Require().Equal(acks, receipts)
+
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), int(10-acks))
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()), int(10-receipts))
+
+				start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(acks+1, start)
+
+				cursor := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningCursor(suite.chainA.GetContext())
+				suite.Require().Equal(host.PruningSequenceStartKey(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID), cursor)
+
+				// the remaining stale packet state is pruned with a higher gas limit
+				setPruningGasPerBlock(pruningGasPerBlock)
+				remainingAcks, remainingReceipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneStalePacketState(suite.chainA.GetContext())
+				suite.Require().Equal(10-acks, remainingAcks)
+				suite.Require().Equal(10-receipts, remainingReceipts)
+			},
+		},
+		{
+			"success: stale packet state of multiple upgraded channels pruned",
+			func() {
+				upgradedPath()
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Equal(uint64(20), acks)
+				suite.Require().Equal(uint64(20), receipts)
+
+				suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()))
+				suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()))
+			},
+		},
+		{
+			"success: packet state of packets sent after the upgrade is not pruned",
+			func() {
+				suite.sendMockPackets(path, 5, true)
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Equal(uint64(10), acks)
+				suite.Require().Equal(uint64(10), receipts)
+
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), 5)
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()), 5)
+			},
+		},
+		{
+			"success: stale packet state of an ORDERED channel pruned once the counterparty next sequence ack is proven",
+			func() {
+				suite.SetupTest() // reset
+
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				suite.sendMockPackets(path, 10, true)
+				suite.proveCounterpartyNextSequenceAck(path)
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Equal(uint64(10), acks)
+				suite.Require().Zero(receipts)
+
+				suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()))
+
+				start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(11), start)
+			},
+		},
+		{
+			"success: ORDERED channel whose counterparty next sequence ack is not proven, nothing pruned",
+			func() {
+				suite.SetupTest() // reset
+
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrdered()
+				path.Setup()
+
+				suite.sendMockPackets(path, 10, true)
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Zero(acks)
+				suite.Require().Zero(receipts)
+
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), 10)
+			},
+		},
+		{
+			"success: UNORDERED channel not upgraded, nothing pruned",
+			func() {
+				suite.SetupTest() // reset
+
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				suite.sendMockPackets(path, 10, true)
+			},
+			func(acks, receipts uint64) {
+				suite.Require().Zero(acks)
+				suite.Require().Zero(receipts)
+
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), 10)
+				suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()), 10)
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = upgradedPath()
+			gasLimit = pruningGasPerBlock
+
+			tc.malleate()
+
+			setPruningGasPerBlock(gasLimit)
+			acks, receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneStalePacketState(suite.chainA.GetContext())
+
+			tc.post(acks, receipts)
+		})
+	}
+}
+
+// TestUpdateCounterpartyNextSequenceAck tests updating the proven counterparty next sequence ack of a channel on chainA.
+func (suite *KeeperTestSuite) TestUpdateCounterpartyNextSequenceAck() {
+	var (
+		path            *ibctesting.Path
+		nextSequenceAck uint64
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: ORDERED channel",
+			func() {},
+			nil,
+		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT channel",
+			func() {
+				suite.SetupTest() // reset
+
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.SetChannelOrderedAllowTimeout()
+				path.Setup()
+
+				suite.sendMockPackets(path, 5, true)
+			},
+			nil,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				path.EndpointA.ChannelID = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: UNORDERED channel",
+			func() {
+				suite.SetupTest() // reset
+
+				path = ibctesting.NewPath(suite.chainA, suite.chainB)
+				path.Setup()
+
+				suite.sendMockPackets(path, 5, true)
+			},
+			types.ErrInvalidChannelOrdering,
+		},
+		{
+			"failure: next sequence ack not greater than the stored counterparty next sequence ack",
+			func() {
+				suite.proveCounterpartyNextSequenceAck(path)
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"failure: invalid proof",
+			func() {
+				nextSequenceAck++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			path.Setup()
+
+			// 5 packets are sent from B -> A, received and acknowledged
+			suite.sendMockPackets(path, 5, true)
+
+			nextSequenceAck = 6
+
+			tc.malleate()
+
+			suite.Require().NoError(path.EndpointA.UpdateClient())
+			proof, proofHeight := suite.chainB.QueryProof(host.NextSequenceAckKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.UpdateCounterpartyNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceAck, proof, proofHeight)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				counterpartyNextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetCounterpartyNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(nextSequenceAck, counterpartyNextSequenceAck)
+
+				start, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPruningSequenceStart(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(uint64(1), start)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+// proveCounterpartyNextSequenceAck updates the counterparty next sequence ack of the channel of chainA with a proof of the
+// next sequence ack of the channel of chainB.
+func (suite *KeeperTestSuite) proveCounterpartyNextSequenceAck(path *ibctesting.Path) {
+	suite.Require().NoError(path.EndpointA.UpdateClient())
+
+	nextSequenceAck, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+
+	proof, proofHeight := suite.chainB.QueryProof(host.NextSequenceAckKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID))
+	err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.UpdateCounterpartyNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, nextSequenceAck, proof, proofHeight)
+	suite.Require().NoError(err)
+}
+
+// TestPruneStalePacketStateEndBlock tests that the stale packet state of upgraded channels is pruned at the end of each block.
+func (suite *KeeperTestSuite) TestPruneStalePacketStateEndBlock() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.Setup()
+
+	suite.sendMockPackets(path, 10, true)
+	suite.UpgradeChannel(path, types.UpgradeFields{Version: ibcmock.UpgradeVersion})
+
+	// automatic pruning is disabled by default
+	suite.Require().Len(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()), 10)
+
+	params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+	params.PruningGasPerBlock = pruningGasPerBlock
+	suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+	suite.coordinator.CommitBlock(suite.chainA)
+
+	suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketAcks(suite.chainA.GetContext()))
+	suite.Require().Empty(suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetAllPacketReceipts(suite.chainA.GetContext()))
+}
+
+// TestPruneExpiredReceipts tests the automatic pruning of the expired packet receipts of UNORDERED_EXPIRING_RECEIPTS
+// channels on chainA.
+func (suite *KeeperTestSuite) TestPruneExpiredReceipts() {
+	var (
+		path     *ibctesting.Path
+		gasLimit uint64
+	)
+
+	testCases := []struct {
+		name                string
+		receiptExpiryPeriod uint64
+		malleate            func()
+		expPruned           bool
+	}{
+		{
+			"success: expired packet receipts pruned",
+			0,
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod + ibctesting.DefaultTimeoutTimestampDelta))
+			},
+			true,
+		},
+		{
+			"success: expired packet receipts pruned with the receipt expiry period of the channel",
+			uint64(time.Hour),
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Hour + time.Duration(ibctesting.DefaultTimeoutTimestampDelta))
+			},
+			true,
+		},
+		{
+			"success: expired packet receipts pruned with automatic pruning of stale packet state disabled",
+			0,
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod + ibctesting.DefaultTimeoutTimestampDelta))
+				gasLimit = 0
+			},
+			true,
+		},
+		{
+			"success: packet receipts not expired, nothing pruned",
+			0,
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod))
+			},
+			false,
+		},
+		{
+			"success: packet receipts not expired with the receipt expiry period of the channel, nothing pruned",
+			uint64(2 * types.DefaultReceiptExpiryPeriod),
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod + ibctesting.DefaultTimeoutTimestampDelta))
+			},
+			false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelUnorderedExpiringReceipts()
+			path.EndpointA.ChannelConfig.ReceiptExpiryPeriod = tc.receiptExpiryPeriod
+			path.EndpointB.ChannelConfig.ReceiptExpiryPeriod = tc.receiptExpiryPeriod
+			path.Setup()
+
+			gasLimit = pruningGasPerBlock
+
+			// 5 packets are sent from B -> A, creating 5 packet receipts on A
+			timeoutTimestamp := suite.chainA.GetTimeoutTimestamp()
+			var packets []types.Packet
+			for i := 0; i < 5; i++ {
+				sequence, err := path.EndpointB.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+				err = path.RelayPacket(packet)
+				suite.Require().NoError(err)
+
+				packets = append(packets, packet)
+			}
+
+			tc.malleate()
+
+			params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+			params.PruningGasPerBlock = gasLimit
+			suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+			acks, receipts := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PruneStalePacketState(suite.chainA.GetContext())
+			suite.Require().Zero(acks)
+
+			if tc.expPruned {
+				suite.Require().Equal(uint64(len(packets)), receipts)
+			} else {
+				suite.Require().Zero(receipts)
+			}
+
+			expiryTimestamp := types.ReceiptExpiryTimestamp(timeoutTimestamp, path.EndpointA.GetChannel().GetReceiptExpiryPeriod())
+			for _, packet := range packets {
+				_, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainA.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().Equal(!tc.expPruned, found)
+
+				hasReceiptExpiry := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasReceiptExpiry(suite.chainA.GetContext(), expiryTimestamp, packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+				suite.Require().Equal(!tc.expPruned, hasReceiptExpiry)
+			}
+		})
+	}
+}
+
+// TestReceiptExpiriesGenesis tests that the packet receipts indexed for pruning on chainA are exported and imported
+// with the channel genesis, so that they are still pruned once expired.
+func (suite *KeeperTestSuite) TestReceiptExpiriesGenesis() {
+	suite.SetupTest() // reset
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelUnorderedExpiringReceipts()
+	path.EndpointA.ChannelConfig.ReceiptExpiryPeriod = uint64(time.Hour)
+	path.EndpointB.ChannelConfig.ReceiptExpiryPeriod = uint64(time.Hour)
+	path.Setup()
+
+	timeoutTimestamp := suite.chainA.GetTimeoutTimestamp()
+	sequence, err := path.EndpointB.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+
+	channelKeeper := suite.chainA.App.GetIBCKeeper().ChannelKeeper
+	genesis := channel.ExportGenesis(suite.chainA.GetContext(), channelKeeper)
+	suite.Require().NoError(genesis.Validate())
+
+	expiryTimestamp := timeoutTimestamp + uint64(time.Hour)
+	suite.Require().Equal([]types.ReceiptExpiry{types.NewReceiptExpiry(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence, expiryTimestamp)}, genesis.ReceiptExpiries)
+
+	// importing the exported genesis keeps the receipt expiry period of the channel and the receipt expiry index
+	channel.InitGenesis(suite.chainA.GetContext(), channelKeeper, genesis)
+	suite.Require().Equal(uint64(time.Hour), path.EndpointA.GetChannel().ReceiptExpiryPeriod)
+	suite.Require().True(channelKeeper.HasReceiptExpiry(suite.chainA.GetContext(), expiryTimestamp, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, sequence))
+
+	suite.coordinator.IncrementTimeBy(time.Hour + time.Duration(ibctesting.DefaultTimeoutTimestampDelta))
+
+	_, receipts := channelKeeper.PruneStalePacketState(suite.chainA.GetContext())
+	suite.Require().Equal(uint64(1), receipts)
+	suite.Require().Empty(channelKeeper.GetAllReceiptExpiries(suite.chainA.GetContext()))
+}
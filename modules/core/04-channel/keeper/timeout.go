@@ -4,9 +4,12 @@ import (
 	"bytes"
 	"context"
 	"strconv"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
@@ -34,6 +37,10 @@ func (k *Keeper) TimeoutPacket(
 		)
 	}
 
+	if err := k.checkChannelNotPaused(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return "", err
+	}
+
 	if packet.GetDestPort() != channel.Counterparty.PortId {
 		return "", errorsmod.Wrapf(
 			types.ErrInvalidPacket,
@@ -57,14 +64,14 @@ func (k *Keeper) TimeoutPacket(
 	}
 
 	// check that timeout height or timeout timestamp has passed on the other end
-	proofTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, connectionEnd.ClientId, proofHeight)
+	counterpartyHeight, proofTimestamp, err := k.counterpartyConsensus(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof)
 	if err != nil {
 		return "", err
 	}
 
 	timeout := types.NewTimeout(packet.GetTimeoutHeight().(clienttypes.Height), packet.GetTimeoutTimestamp())
-	if !timeout.Elapsed(proofHeight.(clienttypes.Height), proofTimestamp) {
-		return "", errorsmod.Wrap(timeout.ErrTimeoutNotReached(proofHeight.(clienttypes.Height), proofTimestamp), "packet timeout not reached")
+	if !timeout.Elapsed(counterpartyHeight, proofTimestamp) {
+		return "", errorsmod.Wrap(timeout.ErrTimeoutNotReached(counterpartyHeight, proofTimestamp), "packet timeout not reached")
 	}
 
 	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
@@ -96,15 +103,19 @@ func (k *Keeper) TimeoutPacket(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		err = k.verifyOrderedAllowTimeoutUnreceived(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet, nextSequenceRecv)
+	case types.UNORDERED_EXPIRING_RECEIPTS:
+		err = k.verifyExpiringReceiptAbsence(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet, channel.GetReceiptExpiryPeriod())
 	default:
 		panic(errorsmod.Wrap(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
 	}
@@ -119,6 +130,7 @@ func (k *Keeper) TimeoutPacket(
 
 // TimeoutExecuted deletes the commitment send from this chain after it verifies timeout.
 // If the timed-out packet came from an ORDERED channel then this channel will be closed.
+// ORDERED_ALLOW_TIMEOUT channels remain open and their next sequence ack is incremented.
 // If the channel is in the FLUSHING state and there is a counterparty upgrade, then the
 // upgrade will be aborted if the upgrade has timed out. Otherwise, if there are no more inflight packets,
 // then the channel will be set to the FLUSHCOMPLETE state.
@@ -135,8 +147,14 @@ func (k *Keeper) TimeoutExecuted(
 
 	k.deletePacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
 
+	// packets are timed out in order on ORDERED_ALLOW_TIMEOUT channels, the next sequence ack
+	// is incremented as if the packet had been acknowledged
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		k.SetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()+1)
+	}
+
 	// if an upgrade is in progress, handling packet flushing and update channel state appropriately
-	if channel.State == types.FLUSHING && channel.Ordering == types.UNORDERED {
+	if channel.State == types.FLUSHING && channel.Ordering != types.ORDERED {
 		k.handleFlushState(ctx, packet, channel)
 	}
 
@@ -190,6 +208,10 @@ func (k *Keeper) TimeoutOnClose(
 		return "", errorsmod.Wrapf(types.ErrChannelNotFound, "port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel())
 	}
 
+	if err := k.checkChannelNotPaused(ctx, packet.GetSourcePort(), packet.GetSourceChannel()); err != nil {
+		return "", err
+	}
+
 	if packet.GetDestPort() != channel.Counterparty.PortId {
 		return "", errorsmod.Wrapf(
 			types.ErrInvalidPacket,
@@ -227,28 +249,31 @@ func (k *Keeper) TimeoutOnClose(
 		return "", errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
 	}
 
-	counterpartyHops := []string{connectionEnd.Counterparty.ConnectionId}
+	counterpartyHops, err := k.counterpartyConnectionHops(connectionEnd, channel.ConnectionHops, closedProof)
+	if err != nil {
+		return "", err
+	}
 
 	counterparty := types.NewCounterparty(packet.GetSourcePort(), packet.GetSourceChannel())
 	expectedChannel := types.Channel{
-		State:           types.CLOSED,
-		Ordering:        channel.Ordering,
-		Counterparty:    counterparty,
-		ConnectionHops:  counterpartyHops,
-		Version:         channel.Version,
-		UpgradeSequence: counterpartyUpgradeSequence,
+		State:               types.CLOSED,
+		Ordering:            channel.Ordering,
+		Counterparty:        counterparty,
+		ConnectionHops:      counterpartyHops,
+		Version:             channel.Version,
+		UpgradeSequence:     counterpartyUpgradeSequence,
+		ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 	}
 
 	// check that the opposing channel end has closed
-	if err := k.connectionKeeper.VerifyChannelState(
-		ctx, connectionEnd, proofHeight, closedProof,
+	if err := k.verifyChannelState(
+		ctx, connectionEnd, channel.ConnectionHops, proofHeight, closedProof,
 		channel.Counterparty.PortId, channel.Counterparty.ChannelId,
 		expectedChannel,
 	); err != nil {
 		return "", err
 	}
 
-	var err error
 	switch channel.Ordering {
 	case types.ORDERED:
 		// check that packet has not been received
@@ -257,15 +282,19 @@ func (k *Keeper) TimeoutOnClose(
 		}
 
 		// check that the recv sequence is as claimed
-		err = k.connectionKeeper.VerifyNextSequenceRecv(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyNextSequenceRecv(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
 		)
 	case types.UNORDERED:
-		err = k.connectionKeeper.VerifyPacketReceiptAbsence(
-			ctx, connectionEnd, proofHeight, proof,
+		err = k.verifyPacketReceiptAbsence(
+			ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof,
 			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
 		)
+	case types.ORDERED_ALLOW_TIMEOUT:
+		err = k.verifyOrderedAllowTimeoutUnreceived(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet, nextSequenceRecv)
+	case types.UNORDERED_EXPIRING_RECEIPTS:
+		err = k.verifyExpiringReceiptAbsence(ctx, connectionEnd, channel.ConnectionHops, proofHeight, proof, packet, channel.GetReceiptExpiryPeriod())
 	default:
 		panic(errorsmod.Wrap(types.ErrInvalidChannelOrdering, channel.Ordering.String()))
 	}
@@ -277,3 +306,198 @@ func (k *Keeper) TimeoutOnClose(
 	// NOTE: the remaining code is located in the TimeoutExecuted function
 	return channel.Version, nil
 }
+
+// verifyExpiringReceiptAbsence verifies that a packet sent on an UNORDERED_EXPIRING_RECEIPTS channel has not been
+// received by the counterparty. The absence of the packet receipt only proves that the packet has not been received
+// if the packet receipt had not expired at the proof timestamp, since expired packet receipts may have been pruned.
+// The receipt expiry period of the channel has been agreed upon with the counterparty during the handshake.
+func (k *Keeper) verifyExpiringReceiptAbsence(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	packet types.Packet,
+	receiptExpiryPeriod uint64,
+) error {
+	_, proofTimestamp, err := k.counterpartyConsensus(ctx, connectionEnd, connectionHops, proofHeight, proof)
+	if err != nil {
+		return err
+	}
+
+	if types.IsReceiptExpired(packet.GetTimeoutTimestamp(), proofTimestamp, receiptExpiryPeriod) {
+		return errorsmod.Wrapf(
+			types.ErrReceiptExpired,
+			"proof timestamp (%d) is at least the receipt expiry period (%s) after the packet timeout timestamp (%d)",
+			proofTimestamp, time.Duration(receiptExpiryPeriod), packet.GetTimeoutTimestamp(),
+		)
+	}
+
+	return k.verifyPacketReceiptAbsence(
+		ctx, connectionEnd, connectionHops, proofHeight, proof,
+		packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(),
+	)
+}
+
+// verifyOrderedAllowTimeoutUnreceived verifies that a packet sent on an ORDERED_ALLOW_TIMEOUT channel has not
+// been received by the counterparty. Packets must be timed out in order: the packet sequence must be the next
+// sequence ack. If the counterparty has not moved past the packet, the proof must be a proof of its next
+// sequence receive. Otherwise the counterparty skipped the packet and the proof must be a proof of the timeout
+// receipt written for the packet.
+func (k *Keeper) verifyOrderedAllowTimeoutUnreceived(
+	ctx context.Context,
+	connectionEnd connectiontypes.ConnectionEnd,
+	connectionHops []string,
+	proofHeight exported.Height,
+	proof []byte,
+	packet types.Packet,
+	nextSequenceRecv uint64,
+) error {
+	nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return errorsmod.Wrapf(
+			types.ErrSequenceAckNotFound,
+			"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetSequence() != nextSequenceAck {
+		return errorsmod.Wrapf(
+			types.ErrPacketSequenceOutOfOrder,
+			"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+		)
+	}
+
+	if nextSequenceRecv > packet.GetSequence() {
+		return k.verifyPacketReceipt(
+			ctx, connectionEnd, connectionHops, proofHeight, proof,
+			packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence(), types.TimeoutReceipt,
+		)
+	}
+
+	return k.verifyNextSequenceRecv(
+		ctx, connectionEnd, connectionHops, proofHeight, proof,
+		packet.GetDestPort(), packet.GetDestChannel(), nextSequenceRecv,
+	)
+}
+
+// ForceTimeoutPacket is called by the authority to time out a packet sent on a channel whose counterparty
+// can no longer be proven, because the client of the channel connection has been expired or frozen and its
+// latest consensus state is older than the force timeout threshold. No proof from the counterparty chain is
+// required, the packet is timed out as if its timeout had elapsed on the counterparty chain.
+func (k *Keeper) ForceTimeoutPacket(
+	ctx context.Context,
+	packet types.Packet,
+) (string, error) {
+	channel, found := k.GetChannel(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+	if !found {
+		return "", errorsmod.Wrapf(
+			types.ErrChannelNotFound,
+			"port ID (%s) channel ID (%s)", packet.GetSourcePort(), packet.GetSourceChannel(),
+		)
+	}
+
+	if packet.GetDestPort() != channel.Counterparty.PortId {
+		return "", errorsmod.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination port doesn't match the counterparty's port (%s ≠ %s)", packet.GetDestPort(), channel.Counterparty.PortId,
+		)
+	}
+
+	if packet.GetDestChannel() != channel.Counterparty.ChannelId {
+		return "", errorsmod.Wrapf(
+			types.ErrInvalidPacket,
+			"packet destination channel doesn't match the counterparty's channel (%s ≠ %s)", packet.GetDestChannel(), channel.Counterparty.ChannelId,
+		)
+	}
+
+	if err := k.verifyForceTimeoutAllowed(ctx, channel); err != nil {
+		return "", err
+	}
+
+	commitment := k.GetPacketCommitment(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	if len(commitment) == 0 {
+		// the packet has already been acknowledged or timed out, or it was never sent
+		return "", types.ErrNoOpMsg
+	}
+
+	packetCommitment := types.CommitPacket(k.cdc, packet)
+
+	// verify we sent the packet and haven't cleared it out yet
+	if !bytes.Equal(commitment, packetCommitment) {
+		return "", errorsmod.Wrapf(types.ErrInvalidPacket, "packet commitment bytes are not equal: got (%v), expected (%v)", commitment, packetCommitment)
+	}
+
+	// packets must be timed out in order on ORDERED_ALLOW_TIMEOUT channels
+	if channel.Ordering == types.ORDERED_ALLOW_TIMEOUT {
+		nextSequenceAck, found := k.GetNextSequenceAck(ctx, packet.GetSourcePort(), packet.GetSourceChannel())
+		if !found {
+			return "", errorsmod.Wrapf(
+				types.ErrSequenceAckNotFound,
+				"source port: %s, source channel: %s", packet.GetSourcePort(), packet.GetSourceChannel(),
+			)
+		}
+
+		if packet.GetSequence() != nextSequenceAck {
+			return "", errorsmod.Wrapf(
+				types.ErrPacketSequenceOutOfOrder,
+				"packet sequence ≠ next ack sequence (%d ≠ %d)", packet.GetSequence(), nextSequenceAck,
+			)
+		}
+	}
+
+	emitForceTimeoutPacketEvent(ctx, packet, channel)
+
+	// NOTE: the remaining code is located in the TimeoutExecuted function
+	return channel.Version, nil
+}
+
+// verifyForceTimeoutAllowed returns an error if the packets sent on the provided channel cannot be timed out by the
+// authority. Force timeouts must be enabled in the channel parameters, the channel must have a single connection hop,
+// the client of the connection must be expired or frozen and its latest consensus state must be older than the force
+// timeout threshold.
+func (k *Keeper) verifyForceTimeoutAllowed(ctx context.Context, channel types.Channel) error {
+	threshold := k.GetParams(ctx).ForceTimeoutThreshold
+	if threshold == 0 {
+		return errorsmod.Wrap(types.ErrForceTimeoutNotAllowed, "force timeouts are disabled")
+	}
+
+	// the client of the first connection hop of a multi-hop channel tracks an intermediate chain, whose status says
+	// nothing about the liveness of the counterparty chain which may still have received the packets
+	if len(channel.ConnectionHops) != 1 {
+		return errorsmod.Wrapf(types.ErrForceTimeoutNotAllowed, "force timeouts are not supported for multi-hop channels, got %d connection hops", len(channel.ConnectionHops))
+	}
+
+	connectionEnd, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
+	if !found {
+		return errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
+	}
+
+	clientID := connectionEnd.ClientId
+	if status := k.clientKeeper.GetClientStatus(ctx, clientID); status != exported.Expired && status != exported.Frozen {
+		return errorsmod.Wrapf(types.ErrForceTimeoutNotAllowed, "client (%s) status is %s, expected %s or %s", clientID, status, exported.Expired, exported.Frozen)
+	}
+
+	latestHeight := k.clientKeeper.GetClientLatestHeight(ctx, clientID)
+	latestTimestamp, err := k.clientKeeper.GetClientTimestampAtHeight(ctx, clientID, latestHeight)
+	if err != nil {
+		return err
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx) // TODO: https://github.com/cosmos/ibc-go/issues/7223
+	selfTimestamp := uint64(sdkCtx.BlockTime().UnixNano())
+
+	var elapsed uint64
+	if selfTimestamp > latestTimestamp {
+		elapsed = selfTimestamp - latestTimestamp
+	}
+
+	if elapsed < threshold {
+		return errorsmod.Wrapf(
+			types.ErrForceTimeoutNotAllowed,
+			"latest consensus state of client (%s) is not older than the force timeout threshold (%d < %d)", clientID, elapsed, threshold,
+		)
+	}
+
+	return nil
+}
@@ -3,6 +3,7 @@ package keeper_test
 import (
 	"errors"
 	"fmt"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -15,6 +16,7 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
+	ibctm "github.com/cosmos/ibc-go/v9/modules/light-clients/07-tendermint"
 	ibctesting "github.com/cosmos/ibc-go/v9/testing"
 	"github.com/cosmos/ibc-go/v9/testing/mock"
 )
@@ -102,6 +104,22 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 			path.Setup()
 			packet = types.NewPacket(ibctesting.MockPacketData, 1, ibctesting.InvalidID, ibctesting.InvalidID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
 		}, false},
+		{"channel is paused", func() {
+			expError = types.ErrChannelPaused
+			ordered = false
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+		}, false},
 		{"packet destination port ≠ channel counterparty port", func() {
 			expError = types.ErrInvalidPacket
 			path.Setup()
@@ -236,6 +254,214 @@ func (suite *KeeperTestSuite) TestTimeoutPacket() {
 	}
 }
 
+// TestTimeoutPacketOrderedAllowTimeout tests timing out packets sent on ORDERED_ALLOW_TIMEOUT channels on chainA,
+// proving either the next sequence receive of chainB or the timeout receipt written by chainB.
+func (suite *KeeperTestSuite) TestTimeoutPacketOrderedAllowTimeout() {
+	var (
+		path    *ibctesting.Path
+		packets []types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: next sequence receive proven",
+			func() {},
+			nil,
+		},
+		{
+			"success: timeout receipt proven",
+			func() {
+				err := path.EndpointB.RecvPacket(packets[0])
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: packet sequence ≠ next ack sequence",
+			func() {
+				packets = packets[1:]
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrderedAllowTimeout()
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			packets = nil
+			for i := 0; i < 2; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+				packets = append(packets, types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp))
+			}
+
+			tc.malleate()
+
+			err := path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packets[0])
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				suite.Require().Equal(types.OPEN, path.EndpointA.GetChannel().State, "channel closed after timeout")
+
+				nextSeqAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(packets[0].GetSequence()+1, nextSeqAck)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packets[0].GetSequence())
+				suite.Require().Empty(commitment)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
+// TestRecvPacketOrderedAllowTimeout tests that timed out packets received on ORDERED_ALLOW_TIMEOUT channels
+// are skipped: a timeout receipt is written, the next sequence receive is incremented and no acknowledgement
+// is written.
+func (suite *KeeperTestSuite) TestRecvPacketOrderedAllowTimeout() {
+	suite.SetupTest()
+
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet := types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	suite.Require().NoError(err)
+	suite.Require().NotNil(res)
+
+	// the timed out packet is skipped
+	receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence)
+	suite.Require().True(found)
+	suite.Require().Equal(string(types.TimeoutReceipt), receipt)
+
+	nextSeqRecv, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+	suite.Require().True(found)
+	suite.Require().Equal(sequence+1, nextSeqRecv)
+
+	suite.Require().False(suite.chainB.App.GetIBCKeeper().ChannelKeeper.HasPacketAcknowledgement(suite.chainB.GetContext(), path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, sequence))
+
+	// the timed out packet is timed out on chainA and the following packet can be relayed
+	err = path.EndpointA.TimeoutPacket(packet)
+	suite.Require().NoError(err)
+
+	sequence, err = path.EndpointA.SendPacket(defaultTimeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+	packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, defaultTimeoutHeight, disabledTimeoutTimestamp)
+
+	err = path.RelayPacket(packet)
+	suite.Require().NoError(err)
+}
+
+// TestTimeoutPacketUnorderedExpiringReceipts tests timing out packets sent on UNORDERED_EXPIRING_RECEIPTS channels on
+// chainA, which is only possible with a proof taken before the packet receipt expiry on chainB.
+func (suite *KeeperTestSuite) TestTimeoutPacketUnorderedExpiringReceipts() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expError error
+	}{
+		{
+			"success: packet timed out",
+			func() {},
+			nil,
+		},
+		{
+			"success: timeout proven before the packet receipt expiry",
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod) - 2*time.Hour)
+			},
+			nil,
+		},
+		{
+			"failure: timeout proven after the packet receipt expiry",
+			func() {
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultReceiptExpiryPeriod))
+			},
+			types.ErrReceiptExpired,
+		},
+		{
+			"failure: timeout proven after the packet receipt expiry with the receipt expiry period of the channel",
+			func() {
+				channel := path.EndpointA.GetChannel()
+				channel.ReceiptExpiryPeriod = uint64(time.Hour)
+				path.EndpointA.SetChannel(channel)
+
+				suite.coordinator.IncrementTimeBy(time.Hour)
+			},
+			types.ErrReceiptExpired,
+		},
+		{
+			"failure: packet received",
+			func() {
+				err := path.EndpointB.RecvPacket(packet)
+				suite.Require().NoError(err)
+			},
+			errors.New("failed packet receipt absence verification"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelUnorderedExpiringReceipts()
+			path.Setup()
+
+			timeoutTimestamp := suite.chainB.GetTimeoutTimestamp()
+			sequence, err := path.EndpointA.SendPacket(disabledTimeoutHeight, timeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, disabledTimeoutHeight, timeoutTimestamp)
+
+			tc.malleate()
+
+			// the timeout timestamp of the packet elapses on chainB
+			suite.coordinator.IncrementTimeBy(time.Duration(ibctesting.DefaultTimeoutTimestampDelta))
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.TimeoutPacket(packet)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+
+				commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, packet.GetSequence())
+				suite.Require().Empty(commitment)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+			}
+		})
+	}
+}
+
 // TestTimeoutExecuted verifies that packet commitments are deleted.
 // In addition, the test verifies that the channel state
 // after a timeout is updated accordingly.
@@ -713,3 +939,163 @@ func (suite *KeeperTestSuite) TestTimeoutOnClose() {
 		})
 	}
 }
+
+// TestForceTimeoutPacket tests timing out packets on chainA without a proof from chainB once the client
+// of chainB on chainA has been expired or frozen for longer than the force timeout threshold.
+func (suite *KeeperTestSuite) TestForceTimeoutPacket() {
+	var (
+		path   *ibctesting.Path
+		packet types.Packet
+	)
+
+	// expireClient expires the client of chainB on chainA and exceeds the force timeout threshold
+	expireClient := func() {
+		suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Duration(types.DefaultForceTimeoutThreshold))
+	}
+
+	freezeClient := func() {
+		clientState, ok := path.EndpointA.GetClientState().(*ibctm.ClientState)
+		suite.Require().True(ok)
+
+		clientState.FrozenHeight = clienttypes.NewHeight(0, 1)
+		path.EndpointA.SetClientState(clientState)
+	}
+
+	testCases := []struct {
+		name     string
+		order    types.Order
+		malleate func()
+		expError error
+	}{
+		{
+			"success: UNORDERED channel with expired client",
+			types.UNORDERED,
+			expireClient,
+			nil,
+		},
+		{
+			"success: ORDERED channel with frozen client",
+			types.ORDERED,
+			func() {
+				freezeClient()
+				suite.coordinator.IncrementTimeBy(time.Duration(types.DefaultForceTimeoutThreshold))
+			},
+			nil,
+		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT channel with expired client",
+			types.ORDERED_ALLOW_TIMEOUT,
+			expireClient,
+			nil,
+		},
+		{
+			"failure: channel not found",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				packet.SourceChannel = ibctesting.InvalidID
+			},
+			types.ErrChannelNotFound,
+		},
+		{
+			"failure: packet destination port ≠ channel counterparty port",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				packet.DestinationPort = ibctesting.InvalidID
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: packet destination channel ≠ channel counterparty channel",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				packet.DestinationChannel = ibctesting.InvalidID
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: force timeouts disabled",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				params := types.NewParams(types.DefaultTimeout, 0)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			types.ErrForceTimeoutNotAllowed,
+		},
+		{
+			"failure: client is active",
+			types.UNORDERED,
+			func() {},
+			types.ErrForceTimeoutNotAllowed,
+		},
+		{
+			"failure: client frozen for less than the force timeout threshold",
+			types.UNORDERED,
+			freezeClient,
+			types.ErrForceTimeoutNotAllowed,
+		},
+		{
+			"failure: packet commitment bytes do not match",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				packet.Data = []byte("invalid packet data")
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"failure: packet already timed out",
+			types.UNORDERED,
+			func() {
+				expireClient()
+				_, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ForceTimeoutPacket(suite.chainA.GetContext(), packet)
+				suite.Require().NoError(err)
+
+				err = suite.chainA.App.GetIBCKeeper().ChannelKeeper.TimeoutExecuted(suite.chainA.GetContext(), packet)
+				suite.Require().NoError(err)
+			},
+			types.ErrNoOpMsg,
+		},
+		{
+			"failure: packet sequence ≠ next ack sequence on ORDERED_ALLOW_TIMEOUT channel",
+			types.ORDERED_ALLOW_TIMEOUT,
+			func() {
+				expireClient()
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetNextSequenceAck(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence+1)
+			},
+			types.ErrPacketSequenceOutOfOrder,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.EndpointA.ChannelConfig.Order = tc.order
+			path.EndpointB.ChannelConfig.Order = tc.order
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			sequence, err := path.EndpointA.SendPacket(timeoutHeight, disabledTimeoutTimestamp, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+			packet = types.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, disabledTimeoutTimestamp)
+
+			tc.malleate()
+
+			channelVersion, err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.ForceTimeoutPacket(suite.chainA.GetContext(), packet)
+
+			if tc.expError == nil {
+				suite.Require().NoError(err)
+				suite.Require().Equal(path.EndpointA.GetChannel().Version, channelVersion)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expError)
+				suite.Require().Equal("", channelVersion)
+			}
+		})
+	}
+}
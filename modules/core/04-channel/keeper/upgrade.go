@@ -35,6 +35,10 @@ func (k *Keeper) ChanUpgradeInit(
 		return types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
 	}
 
+	if len(channel.ConnectionHops) > 1 {
+		return types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "upgrades are not supported for multi-hop channels")
+	}
+
 	if err := k.validateSelfUpgradeFields(ctx, upgradeFields, channel); err != nil {
 		return types.Upgrade{}, err
 	}
@@ -94,6 +98,10 @@ func (k *Keeper) ChanUpgradeTry(
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrapf(types.ErrInvalidChannelState, "expected %s, got %s", types.OPEN, channel.State)
 	}
 
+	if len(channel.ConnectionHops) > 1 {
+		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(types.ErrTooManyConnectionHops, "upgrades are not supported for multi-hop channels")
+	}
+
 	connection, found := k.connectionKeeper.GetConnection(ctx, channel.ConnectionHops[0])
 	if !found {
 		return types.Channel{}, types.Upgrade{}, errorsmod.Wrap(connectiontypes.ErrConnectionNotFound, channel.ConnectionHops[0])
@@ -107,12 +115,13 @@ func (k *Keeper) ChanUpgradeTry(
 	// only the counterpartyUpgradeSequence is provided by the relayer
 	counterpartyConnectionHops := []string{connection.Counterparty.ConnectionId}
 	counterpartyChannel := types.Channel{
-		State:           types.OPEN,
-		Ordering:        channel.Ordering,
-		Counterparty:    types.NewCounterparty(portID, channelID),
-		ConnectionHops:  counterpartyConnectionHops,
-		Version:         channel.Version,
-		UpgradeSequence: counterpartyUpgradeSequence, // provided by the relayer
+		State:               types.OPEN,
+		Ordering:            channel.Ordering,
+		Counterparty:        types.NewCounterparty(portID, channelID),
+		ConnectionHops:      counterpartyConnectionHops,
+		Version:             channel.Version,
+		UpgradeSequence:     counterpartyUpgradeSequence, // provided by the relayer
+		ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 	}
 
 	// verify the counterparty channel state containing the upgrade sequence
@@ -281,12 +290,13 @@ func (k *Keeper) ChanUpgradeAck(
 
 	counterpartyHops := []string{connection.Counterparty.ConnectionId}
 	counterpartyChannel := types.Channel{
-		State:           types.FLUSHING,
-		Ordering:        channel.Ordering,
-		ConnectionHops:  counterpartyHops,
-		Counterparty:    types.NewCounterparty(portID, channelID),
-		Version:         channel.Version,
-		UpgradeSequence: channel.UpgradeSequence,
+		State:               types.FLUSHING,
+		Ordering:            channel.Ordering,
+		ConnectionHops:      counterpartyHops,
+		Counterparty:        types.NewCounterparty(portID, channelID),
+		Version:             channel.Version,
+		UpgradeSequence:     channel.UpgradeSequence,
+		ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 	}
 
 	// verify the counterparty channel state containing the upgrade sequence
@@ -418,12 +428,13 @@ func (k *Keeper) ChanUpgradeConfirm(
 
 	counterpartyHops := []string{connection.Counterparty.ConnectionId}
 	counterpartyChannel := types.Channel{
-		State:           counterpartyChannelState,
-		Ordering:        channel.Ordering,
-		ConnectionHops:  counterpartyHops,
-		Counterparty:    types.NewCounterparty(portID, channelID),
-		Version:         channel.Version,
-		UpgradeSequence: channel.UpgradeSequence,
+		State:               counterpartyChannelState,
+		Ordering:            channel.Ordering,
+		ConnectionHops:      counterpartyHops,
+		Counterparty:        types.NewCounterparty(portID, channelID),
+		Version:             channel.Version,
+		UpgradeSequence:     channel.UpgradeSequence,
+		ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 	}
 
 	if err := k.connectionKeeper.VerifyChannelState(
@@ -553,22 +564,24 @@ func (k *Keeper) ChanUpgradeOpen(
 		}
 
 		counterpartyChannel = types.Channel{
-			State:           types.OPEN,
-			Ordering:        upgrade.Fields.Ordering,
-			ConnectionHops:  []string{upgradeConnection.Counterparty.ConnectionId},
-			Counterparty:    types.NewCounterparty(portID, channelID),
-			Version:         upgrade.Fields.Version,
-			UpgradeSequence: counterpartyUpgradeSequence,
+			State:               types.OPEN,
+			Ordering:            upgrade.Fields.Ordering,
+			ConnectionHops:      []string{upgradeConnection.Counterparty.ConnectionId},
+			Counterparty:        types.NewCounterparty(portID, channelID),
+			Version:             upgrade.Fields.Version,
+			UpgradeSequence:     counterpartyUpgradeSequence,
+			ReceiptExpiryPeriod: upgradedReceiptExpiryPeriod(channel, upgrade.Fields.Ordering),
 		}
 
 	case types.FLUSHCOMPLETE:
 		counterpartyChannel = types.Channel{
-			State:           types.FLUSHCOMPLETE,
-			Ordering:        channel.Ordering,
-			ConnectionHops:  []string{connection.Counterparty.ConnectionId},
-			Counterparty:    types.NewCounterparty(portID, channelID),
-			Version:         channel.Version,
-			UpgradeSequence: channel.UpgradeSequence,
+			State:               types.FLUSHCOMPLETE,
+			Ordering:            channel.Ordering,
+			ConnectionHops:      []string{connection.Counterparty.ConnectionId},
+			Counterparty:        types.NewCounterparty(portID, channelID),
+			Version:             channel.Version,
+			UpgradeSequence:     channel.UpgradeSequence,
+			ReceiptExpiryPeriod: channel.ReceiptExpiryPeriod,
 		}
 
 	default:
@@ -609,8 +622,8 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx context.Context, portID, channelID
 	}
 
 	// next seq recv and ack is used for ordered channels to verify the packet has been received/acked in the correct order
-	// this is no longer necessary if the channel is UNORDERED and should be reset to 1
-	if channel.Ordering == types.ORDERED && upgrade.Fields.Ordering == types.UNORDERED {
+	// this is no longer necessary if the channel is UNORDERED or UNORDERED_EXPIRING_RECEIPTS and should be reset to 1
+	if channel.Ordering.IsOrdered() && upgrade.Fields.Ordering.IsUnordered() {
 		k.SetNextSequenceRecv(ctx, portID, channelID, 1)
 		k.SetNextSequenceAck(ctx, portID, channelID, 1)
 	}
@@ -618,7 +631,9 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx context.Context, portID, channelID
 	// next seq recv and ack should updated when moving from UNORDERED to ORDERED using the counterparty NextSequenceSend as set just after blocking new packet sends.
 	// we can be sure that the next packet we are set to receive will be the first packet the counterparty sends after reopening.
 	// we can be sure that our next acknowledgement will be our first packet sent after upgrade, as the counterparty processed all sent packets after flushing completes.
-	if channel.Ordering == types.UNORDERED && upgrade.Fields.Ordering == types.ORDERED {
+	// the same applies when moving from UNORDERED to ORDERED_ALLOW_TIMEOUT, while the sequences are kept when moving between ORDERED and ORDERED_ALLOW_TIMEOUT.
+	// UNORDERED_EXPIRING_RECEIPTS channels are unordered channels and are upgraded as UNORDERED channels.
+	if channel.Ordering.IsUnordered() && upgrade.Fields.Ordering.IsOrdered() {
 		k.SetNextSequenceRecv(ctx, portID, channelID, counterpartyUpgrade.NextSequenceSend)
 		k.SetNextSequenceAck(ctx, portID, channelID, upgrade.NextSequenceSend)
 	}
@@ -637,6 +652,7 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx context.Context, portID, channelID
 
 	// Switch channel fields to upgrade fields and set channel state to OPEN
 	previousState := channel.State
+	channel.ReceiptExpiryPeriod = upgradedReceiptExpiryPeriod(channel, upgrade.Fields.Ordering)
 	channel.Ordering = upgrade.Fields.Ordering
 	channel.Version = upgrade.Fields.Version
 	channel.ConnectionHops = upgrade.Fields.ConnectionHops
@@ -651,6 +667,16 @@ func (k *Keeper) WriteUpgradeOpenChannel(ctx context.Context, portID, channelID
 	return channel
 }
 
+// upgradedReceiptExpiryPeriod returns the receipt expiry period of the provided channel once upgraded to the provided
+// ordering. The receipt expiry period is kept if the channel remains UNORDERED_EXPIRING_RECEIPTS and reset otherwise,
+// so that channels upgraded to UNORDERED_EXPIRING_RECEIPTS use the default receipt expiry period.
+func upgradedReceiptExpiryPeriod(channel types.Channel, ordering types.Order) uint64 {
+	if ordering != types.UNORDERED_EXPIRING_RECEIPTS {
+		return 0
+	}
+	return channel.ReceiptExpiryPeriod
+}
+
 // ChanUpgradeCancel is called by the msg server to prove that an error receipt was written on the counterparty
 // which constitutes a valid situation where the upgrade should be cancelled. An error is returned if sufficient evidence
 // for cancelling the upgrade has not been provided.
@@ -954,7 +980,7 @@ func (k *Keeper) validateSelfUpgradeFields(ctx context.Context, proposedUpgrade
 		)
 	}
 
-	if !connectiontypes.VerifySupportedFeature(getVersions[0], proposedUpgrade.Ordering.String()) {
+	if !connectiontypes.VerifySupportedFeature(getVersions[0], proposedUpgrade.Ordering.ConnectionFeature()) {
 		return errorsmod.Wrapf(
 			connectiontypes.ErrInvalidVersion,
 			"connection version %s does not support channel ordering: %s",
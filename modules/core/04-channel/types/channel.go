@@ -29,21 +29,60 @@ func (ch Channel) ValidateBasic() error {
 	if ch.State == UNINITIALIZED {
 		return ErrInvalidChannelState
 	}
-	if !slices.Contains([]Order{ORDERED, UNORDERED}, ch.Ordering) {
+	if !slices.Contains([]Order{ORDERED, UNORDERED, ORDERED_ALLOW_TIMEOUT, UNORDERED_EXPIRING_RECEIPTS}, ch.Ordering) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, ch.Ordering.String())
 	}
-	if len(ch.ConnectionHops) != 1 {
-		return errorsmod.Wrap(
-			ErrTooManyConnectionHops,
-			"current IBC version only supports one connection hop",
-		)
+	if len(ch.ConnectionHops) == 0 {
+		return errorsmod.Wrap(ErrInvalidChannel, "connection hops cannot be empty")
 	}
-	if err := host.ConnectionIdentifierValidator(ch.ConnectionHops[0]); err != nil {
-		return errorsmod.Wrap(err, "invalid connection hop ID")
+	// channels with more than one connection hop are multi-hop channels
+	for _, connectionID := range ch.ConnectionHops {
+		if err := host.ConnectionIdentifierValidator(connectionID); err != nil {
+			return errorsmod.Wrap(err, "invalid connection hop ID")
+		}
+	}
+	if ch.ReceiptExpiryPeriod != 0 && ch.Ordering != UNORDERED_EXPIRING_RECEIPTS {
+		return errorsmod.Wrapf(ErrInvalidChannel, "receipt expiry period cannot be set on %s channels", ch.Ordering)
 	}
 	return ch.Counterparty.ValidateBasic()
 }
 
+// GetReceiptExpiryPeriod returns the receipt expiry period of an UNORDERED_EXPIRING_RECEIPTS channel, which is
+// the default receipt expiry period if the channel does not set one.
+func (ch Channel) GetReceiptExpiryPeriod() uint64 {
+	if ch.ReceiptExpiryPeriod == 0 {
+		return DefaultReceiptExpiryPeriod
+	}
+	return ch.ReceiptExpiryPeriod
+}
+
+// IsOrdered returns true if packets are received in the order in which they were sent on channels
+// with the ordering, i.e. for ORDERED and ORDERED_ALLOW_TIMEOUT channels.
+func (o Order) IsOrdered() bool {
+	return o == ORDERED || o == ORDERED_ALLOW_TIMEOUT
+}
+
+// IsUnordered returns true if packets can be received in any order on channels with the ordering,
+// i.e. for UNORDERED and UNORDERED_EXPIRING_RECEIPTS channels.
+func (o Order) IsUnordered() bool {
+	return o == UNORDERED || o == UNORDERED_EXPIRING_RECEIPTS
+}
+
+// ConnectionFeature returns the connection version feature that the connection of a channel with the
+// ordering must support. ORDERED_ALLOW_TIMEOUT channels are ordered channels and rely on the ORDER_ORDERED
+// feature of the connection, UNORDERED_EXPIRING_RECEIPTS channels are unordered channels and rely on the
+// ORDER_UNORDERED feature of the connection.
+func (o Order) ConnectionFeature() string {
+	switch o {
+	case ORDERED_ALLOW_TIMEOUT:
+		return ORDERED.String()
+	case UNORDERED_EXPIRING_RECEIPTS:
+		return UNORDERED.String()
+	default:
+		return o.String()
+	}
+}
+
 // NewCounterparty returns a new Counterparty instance
 func NewCounterparty(portID, channelID string) Counterparty {
 	return Counterparty{
@@ -68,14 +107,15 @@ func (c Counterparty) ValidateBasic() error {
 // NewIdentifiedChannel creates a new IdentifiedChannel instance
 func NewIdentifiedChannel(portID, channelID string, ch Channel) IdentifiedChannel {
 	return IdentifiedChannel{
-		State:           ch.State,
-		Ordering:        ch.Ordering,
-		Counterparty:    ch.Counterparty,
-		ConnectionHops:  ch.ConnectionHops,
-		Version:         ch.Version,
-		UpgradeSequence: ch.UpgradeSequence,
-		PortId:          portID,
-		ChannelId:       channelID,
+		State:               ch.State,
+		Ordering:            ch.Ordering,
+		Counterparty:        ch.Counterparty,
+		ConnectionHops:      ch.ConnectionHops,
+		Version:             ch.Version,
+		UpgradeSequence:     ch.UpgradeSequence,
+		ReceiptExpiryPeriod: ch.ReceiptExpiryPeriod,
+		PortId:              portID,
+		ChannelId:           channelID,
 	}
 }
 
@@ -88,5 +128,6 @@ func (ic IdentifiedChannel) ValidateBasic() error {
 		return errorsmod.Wrap(err, "invalid port ID")
 	}
 	channel := NewChannel(ic.State, ic.Ordering, ic.Counterparty, ic.ConnectionHops, ic.Version)
+	channel.ReceiptExpiryPeriod = ic.ReceiptExpiryPeriod
 	return channel.ValidateBasic()
 }
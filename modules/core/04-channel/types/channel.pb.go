@@ -86,18 +86,30 @@ const (
 	UNORDERED Order = 1
 	// packets are delivered exactly in the order which they were sent
 	ORDERED Order = 2
+	// packets are delivered in the order which they were sent, but packets may
+	// time out without closing the channel: the receiving end skips timed out
+	// packets and writes a timeout receipt which proves the timeout
+	ORDERED_ALLOW_TIMEOUT Order = 3
+	// packets can be delivered in any order and must have a timeout timestamp:
+	// the packet receipts of the receiving end expire once the receipt expiry
+	// period has elapsed after the packet timeout timestamp, and are then pruned
+	UNORDERED_EXPIRING_RECEIPTS Order = 4
 )
 
 var Order_name = map[int32]string{
 	0: "ORDER_NONE_UNSPECIFIED",
 	1: "ORDER_UNORDERED",
 	2: "ORDER_ORDERED",
+	3: "ORDER_ORDERED_ALLOW_TIMEOUT",
+	4: "ORDER_UNORDERED_EXPIRING_RECEIPTS",
 }
 
 var Order_value = map[string]int32{
-	"ORDER_NONE_UNSPECIFIED": 0,
-	"ORDER_UNORDERED":        1,
-	"ORDER_ORDERED":          2,
+	"ORDER_NONE_UNSPECIFIED":            0,
+	"ORDER_UNORDERED":                   1,
+	"ORDER_ORDERED":                     2,
+	"ORDER_ORDERED_ALLOW_TIMEOUT":       3,
+	"ORDER_UNORDERED_EXPIRING_RECEIPTS": 4,
 }
 
 func (x Order) String() string {
@@ -126,6 +138,10 @@ type Channel struct {
 	// upgrade sequence indicates the latest upgrade attempt performed by this channel
 	// the value of 0 indicates the channel has never been upgraded
 	UpgradeSequence uint64 `protobuf:"varint,6,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// period in nanoseconds after the timeout timestamp of a packet after which its packet receipt expires,
+	// which is agreed upon during the handshake. It can only be set on UNORDERED_EXPIRING_RECEIPTS channels
+	// and the value of 0 indicates the default receipt expiry period
+	ReceiptExpiryPeriod uint64 `protobuf:"varint,7,opt,name=receipt_expiry_period,json=receiptExpiryPeriod,proto3" json:"receipt_expiry_period,omitempty"`
 }
 
 func (m *Channel) Reset()         { *m = Channel{} }
@@ -182,6 +198,10 @@ type IdentifiedChannel struct {
 	// upgrade sequence indicates the latest upgrade attempt performed by this channel
 	// the value of 0 indicates the channel has never been upgraded
 	UpgradeSequence uint64 `protobuf:"varint,8,opt,name=upgrade_sequence,json=upgradeSequence,proto3" json:"upgrade_sequence,omitempty"`
+	// period in nanoseconds after the timeout timestamp of a packet after which its packet receipt expires,
+	// which is agreed upon during the handshake. It can only be set on UNORDERED_EXPIRING_RECEIPTS channels
+	// and the value of 0 indicates the default receipt expiry period
+	ReceiptExpiryPeriod uint64 `protobuf:"varint,9,opt,name=receipt_expiry_period,json=receiptExpiryPeriod,proto3" json:"receipt_expiry_period,omitempty"`
 }
 
 func (m *IdentifiedChannel) Reset()         { *m = IdentifiedChannel{} }
@@ -561,6 +581,15 @@ func (m *Timeout) GetTimestamp() uint64 {
 type Params struct {
 	// the relative timeout after which channel upgrades will time out.
 	UpgradeTimeout Timeout `protobuf:"bytes,1,opt,name=upgrade_timeout,json=upgradeTimeout,proto3" json:"upgrade_timeout"`
+	// the duration in nanoseconds since the latest consensus state of an expired or frozen client after which
+	// the packets sent on the channels of the client can be timed out by the authority. Zero disables force timeouts.
+	ForceTimeoutThreshold uint64 `protobuf:"varint,2,opt,name=force_timeout_threshold,json=forceTimeoutThreshold,proto3" json:"force_timeout_threshold,omitempty"`
+	// the address allowed to pause and unpause channels in addition to the authority. Empty if there is no guardian.
+	PauseGuardian string `protobuf:"bytes,3,opt,name=pause_guardian,json=pauseGuardian,proto3" json:"pause_guardian,omitempty"`
+	// the maximum amount of gas consumed each block by the automatic pruning of acknowledgements and packet receipts
+	// which are no longer needed. Zero disables automatic pruning, except for the expired packet receipts of
+	// UNORDERED_EXPIRING_RECEIPTS channels, which are always pruned.
+	PruningGasPerBlock uint64 `protobuf:"varint,4,opt,name=pruning_gas_per_block,json=pruningGasPerBlock,proto3" json:"pruning_gas_per_block,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -603,6 +632,27 @@ func (m *Params) GetUpgradeTimeout() Timeout {
 	return Timeout{}
 }
 
+func (m *Params) GetForceTimeoutThreshold() uint64 {
+	if m != nil {
+		return m.ForceTimeoutThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetPauseGuardian() string {
+	if m != nil {
+		return m.PauseGuardian
+	}
+	return ""
+}
+
+func (m *Params) GetPruningGasPerBlock() uint64 {
+	if m != nil {
+		return m.PruningGasPerBlock
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("ibc.core.channel.v1.State", State_name, State_value)
 	proto.RegisterEnum("ibc.core.channel.v1.Order", Order_name, Order_value)
@@ -620,66 +670,77 @@ func init() {
 func init() { proto.RegisterFile("ibc/core/channel/v1/channel.proto", fileDescriptor_c3a07336710636a0) }
 
 var fileDescriptor_c3a07336710636a0 = []byte{
-	// 937 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x4f, 0x6f, 0xe2, 0xc6,
-	0x1b, 0xc6, 0xc4, 0xfc, 0x7b, 0x93, 0x80, 0x33, 0xf9, 0xfd, 0x52, 0xcb, 0x4a, 0xc1, 0x8b, 0x5a,
-	0x95, 0x4d, 0xb5, 0xb0, 0xd9, 0x56, 0x55, 0xb7, 0xb7, 0x04, 0xbc, 0x8b, 0xb5, 0x14, 0x90, 0x81,
-	0x43, 0xf7, 0x82, 0x8c, 0x3d, 0x05, 0x6b, 0xc1, 0x43, 0xed, 0x81, 0xd5, 0xaa, 0xe7, 0x4a, 0x2b,
-	0x4e, 0xfd, 0x02, 0x48, 0x95, 0xfa, 0x15, 0xfa, 0x21, 0xf6, 0xb8, 0xc7, 0x3d, 0x55, 0x55, 0xf2,
-	0x1d, 0x7a, 0xae, 0x3c, 0x33, 0x0e, 0x10, 0x45, 0x51, 0x55, 0xa9, 0xb7, 0x9e, 0x98, 0xf7, 0x79,
-	0x9f, 0xf7, 0x7d, 0xde, 0x3f, 0xc3, 0xc8, 0xf0, 0xc0, 0x1b, 0x39, 0x35, 0x87, 0x04, 0xb8, 0xe6,
-	0x4c, 0x6c, 0xdf, 0xc7, 0xd3, 0xda, 0xf2, 0x3c, 0x3e, 0x56, 0xe7, 0x01, 0xa1, 0x04, 0x1d, 0x7b,
-	0x23, 0xa7, 0x1a, 0x51, 0xaa, 0x31, 0xbe, 0x3c, 0xd7, 0xfe, 0x37, 0x26, 0x63, 0xc2, 0xfc, 0xb5,
-	0xe8, 0xc4, 0xa9, 0x5a, 0x69, 0x93, 0x6d, 0xea, 0x61, 0x9f, 0xb2, 0x64, 0xec, 0xc4, 0x09, 0xe5,
-	0xdf, 0x92, 0x90, 0xa9, 0xf3, 0x2c, 0xe8, 0x31, 0xa4, 0x42, 0x6a, 0x53, 0xac, 0x4a, 0xba, 0x54,
-	0xc9, 0x3f, 0xd1, 0xaa, 0x77, 0xe8, 0x54, 0x7b, 0x11, 0xc3, 0xe2, 0x44, 0xf4, 0x15, 0x64, 0x49,
-	0xe0, 0xe2, 0xc0, 0xf3, 0xc7, 0x6a, 0xf2, 0x9e, 0xa0, 0x4e, 0x44, 0xb2, 0x6e, 0xb8, 0xe8, 0x05,
-	0x1c, 0x38, 0x64, 0xe1, 0x53, 0x1c, 0xcc, 0xed, 0x80, 0xbe, 0x51, 0xf7, 0x74, 0xa9, 0xb2, 0xff,
-	0xe4, 0xc1, 0x9d, 0xb1, 0xf5, 0x2d, 0xe2, 0xa5, 0xfc, 0xee, 0xf7, 0x52, 0xc2, 0xda, 0x09, 0x46,
-	0x9f, 0x41, 0xc1, 0x21, 0xbe, 0x8f, 0x1d, 0xea, 0x11, 0x7f, 0x38, 0x21, 0xf3, 0x50, 0x95, 0xf5,
-	0xbd, 0x4a, 0xce, 0xca, 0x6f, 0xe0, 0x26, 0x99, 0x87, 0x48, 0x85, 0xcc, 0x12, 0x07, 0xa1, 0x47,
-	0x7c, 0x35, 0xa5, 0x4b, 0x95, 0x9c, 0x15, 0x9b, 0xe8, 0x21, 0x28, 0x8b, 0xf9, 0x38, 0xb0, 0x5d,
-	0x3c, 0x0c, 0xf1, 0x0f, 0x0b, 0xec, 0x3b, 0x58, 0x4d, 0xeb, 0x52, 0x45, 0xb6, 0x0a, 0x02, 0xef,
-	0x09, 0xf8, 0x1b, 0xf9, 0xed, 0x2f, 0xa5, 0x44, 0xf9, 0xcf, 0x24, 0x1c, 0x99, 0x2e, 0xf6, 0xa9,
-	0xf7, 0xbd, 0x87, 0xdd, 0xff, 0x06, 0xf8, 0x11, 0x64, 0xe6, 0x24, 0xa0, 0x43, 0xcf, 0x65, 0x73,
-	0xcb, 0x59, 0xe9, 0xc8, 0x34, 0x5d, 0xf4, 0x31, 0x80, 0x28, 0x25, 0xf2, 0x65, 0x98, 0x2f, 0x27,
-	0x10, 0xd3, 0xbd, 0x73, 0xf0, 0xd9, 0xfb, 0x06, 0xdf, 0x82, 0x83, 0xed, 0x7e, 0xb6, 0x85, 0xa5,
-	0x7b, 0x84, 0x93, 0xb7, 0x84, 0x45, 0xb6, 0x0f, 0x49, 0x48, 0x77, 0x6d, 0xe7, 0x15, 0xa6, 0x48,
-	0x83, 0xec, 0x4d, 0x05, 0x12, 0xab, 0xe0, 0xc6, 0x46, 0x25, 0xd8, 0x0f, 0xc9, 0x22, 0x70, 0xf0,
-	0x30, 0x4a, 0x2e, 0x92, 0x01, 0x87, 0xba, 0x24, 0xa0, 0xe8, 0x53, 0xc8, 0x0b, 0x82, 0x50, 0x60,
-	0x0b, 0xc9, 0x59, 0x87, 0x1c, 0x8d, 0xef, 0xc7, 0x43, 0x50, 0x5c, 0x1c, 0x52, 0xcf, 0xb7, 0xd9,
-	0xa4, 0x59, 0x32, 0x99, 0x11, 0x0b, 0x5b, 0x38, 0xcb, 0x58, 0x83, 0xe3, 0x6d, 0x6a, 0x9c, 0x96,
-	0x8f, 0x1d, 0x6d, 0xb9, 0xe2, 0xdc, 0x08, 0x64, 0xd7, 0xa6, 0x36, 0x1b, 0xff, 0x81, 0xc5, 0xce,
-	0xe8, 0x39, 0xe4, 0xa9, 0x37, 0xc3, 0x64, 0x41, 0x87, 0x13, 0xec, 0x8d, 0x27, 0x94, 0x2d, 0x60,
-	0x7f, 0xe7, 0x8e, 0xf1, 0xc7, 0x60, 0x79, 0x5e, 0x6d, 0x32, 0x86, 0xb8, 0x20, 0x87, 0x22, 0x8e,
-	0x83, 0xe8, 0x73, 0x38, 0x8a, 0x13, 0x45, 0xbf, 0x21, 0xb5, 0x67, 0x73, 0xb1, 0x27, 0x45, 0x38,
-	0xfa, 0x31, 0x2e, 0x46, 0xfb, 0x23, 0xec, 0xf3, 0xc9, 0xb2, 0xfb, 0xfe, 0x4f, 0xf7, 0xb4, 0xb3,
-	0x96, 0xbd, 0x5b, 0x6b, 0x89, 0x5b, 0x96, 0x37, 0x2d, 0x0b, 0x71, 0x17, 0xb2, 0x5c, 0xdc, 0x74,
-	0xff, 0x0d, 0x65, 0xa1, 0xd2, 0x81, 0xc2, 0x85, 0xf3, 0xca, 0x27, 0xaf, 0xa7, 0xd8, 0x1d, 0xe3,
-	0x19, 0xf6, 0x29, 0x52, 0x21, 0x1d, 0xe0, 0x70, 0x31, 0xa5, 0xea, 0xff, 0xa3, 0xa2, 0x9a, 0x09,
-	0x4b, 0xd8, 0xe8, 0x04, 0x52, 0x38, 0x08, 0x48, 0xa0, 0x9e, 0x44, 0x42, 0xcd, 0x84, 0xc5, 0xcd,
-	0x4b, 0x80, 0x6c, 0x80, 0xc3, 0x39, 0xf1, 0x43, 0x5c, 0xb6, 0x21, 0xd3, 0xe7, 0xd3, 0x44, 0x5f,
-	0x43, 0x5a, 0xac, 0x4c, 0xfa, 0x9b, 0x2b, 0x13, 0x7c, 0x74, 0x0a, 0xb9, 0xcd, 0x8e, 0x92, 0xac,
-	0xf0, 0x0d, 0x50, 0x1e, 0x44, 0x17, 0x3e, 0xb0, 0x67, 0x21, 0x7a, 0x01, 0xf1, 0x5f, 0x6c, 0x28,
-	0x56, 0x28, 0xa4, 0x4e, 0xef, 0x7c, 0x45, 0x44, 0x61, 0x42, 0x2c, 0x2f, 0x42, 0x05, 0x7a, 0xf6,
-	0x53, 0x12, 0x52, 0x3d, 0xf1, 0xa2, 0x95, 0x7a, 0xfd, 0x8b, 0xbe, 0x31, 0x1c, 0xb4, 0xcd, 0xb6,
-	0xd9, 0x37, 0x2f, 0x5a, 0xe6, 0x4b, 0xa3, 0x31, 0x1c, 0xb4, 0x7b, 0x5d, 0xa3, 0x6e, 0x3e, 0x33,
-	0x8d, 0x86, 0x92, 0xd0, 0x8e, 0x56, 0x6b, 0xfd, 0x70, 0x87, 0x80, 0x54, 0x00, 0x1e, 0x17, 0x81,
-	0x8a, 0xa4, 0x65, 0x57, 0x6b, 0x5d, 0x8e, 0xce, 0xa8, 0x08, 0x87, 0xdc, 0xd3, 0xb7, 0xbe, 0xeb,
-	0x74, 0x8d, 0xb6, 0x92, 0xd4, 0xf6, 0x57, 0x6b, 0x3d, 0x23, 0xcc, 0x4d, 0x24, 0x73, 0xee, 0xf1,
-	0x48, 0xe6, 0x39, 0x85, 0x03, 0xee, 0xa9, 0xb7, 0x3a, 0x3d, 0xa3, 0xa1, 0xc8, 0x1a, 0xac, 0xd6,
-	0x7a, 0x9a, 0x5b, 0x48, 0x87, 0x3c, 0xf7, 0x3e, 0x6b, 0x0d, 0x7a, 0x4d, 0xb3, 0xfd, 0x5c, 0x49,
-	0x69, 0x07, 0xab, 0xb5, 0x9e, 0x8d, 0x6d, 0x74, 0x06, 0xc7, 0x5b, 0x8c, 0x7a, 0xe7, 0xdb, 0x6e,
-	0xcb, 0xe8, 0x1b, 0x4a, 0x9a, 0xd7, 0xbf, 0x03, 0x6a, 0xf2, 0xdb, 0x5f, 0x8b, 0x89, 0xb3, 0xd7,
-	0x90, 0x62, 0x4f, 0x35, 0xfa, 0x04, 0x4e, 0x3a, 0x56, 0xc3, 0xb0, 0x86, 0xed, 0x4e, 0xdb, 0xb8,
-	0xd5, 0x3d, 0x2b, 0x30, 0xc2, 0x51, 0x19, 0x0a, 0x9c, 0x35, 0x68, 0xb3, 0x5f, 0xa3, 0xa1, 0x48,
-	0xda, 0xe1, 0x6a, 0xad, 0xe7, 0x6e, 0x80, 0xa8, 0x7d, 0xce, 0x89, 0x19, 0xa2, 0x7d, 0x61, 0x72,
-	0xe1, 0xcb, 0xde, 0xbb, 0xab, 0xa2, 0xf4, 0xfe, 0xaa, 0x28, 0xfd, 0x71, 0x55, 0x94, 0x7e, 0xbe,
-	0x2e, 0x26, 0xde, 0x5f, 0x17, 0x13, 0x1f, 0xae, 0x8b, 0x89, 0x97, 0x4f, 0xc7, 0x1e, 0x9d, 0x2c,
-	0x46, 0x55, 0x87, 0xcc, 0x6a, 0x0e, 0x09, 0x67, 0x24, 0xac, 0x79, 0x23, 0xe7, 0xd1, 0x98, 0xd4,
-	0x96, 0x4f, 0x6b, 0x33, 0xe2, 0x2e, 0xa6, 0x38, 0xe4, 0x9f, 0x08, 0x8f, 0xbf, 0x7c, 0x14, 0x7f,
-	0x73, 0xd0, 0x37, 0x73, 0x1c, 0x8e, 0xd2, 0xec, 0x1b, 0xe1, 0x8b, 0xbf, 0x02, 0x00, 0x00, 0xff,
-	0xff, 0x39, 0xda, 0x3d, 0x84, 0x94, 0x08, 0x00, 0x00,
+	// 1106 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0xcb, 0x6e, 0xdb, 0x46,
+	0x14, 0x15, 0x25, 0xea, 0x75, 0x6d, 0xcb, 0xcc, 0xb8, 0x4e, 0x58, 0x26, 0x95, 0x18, 0xa3, 0x45,
+	0x9d, 0x14, 0x91, 0xe2, 0xb4, 0x08, 0x9a, 0xec, 0x6c, 0x99, 0xb1, 0x89, 0x28, 0x92, 0x40, 0xc9,
+	0x68, 0x9b, 0x0d, 0x41, 0x91, 0x13, 0x89, 0x88, 0xc4, 0x61, 0x87, 0x94, 0xdb, 0xa0, 0xeb, 0x02,
+	0x81, 0x56, 0xfd, 0x01, 0x01, 0x05, 0xba, 0xeb, 0x97, 0x64, 0x99, 0x65, 0x56, 0x45, 0x91, 0x2c,
+	0xda, 0x7f, 0xe8, 0xa6, 0xe0, 0xcc, 0xd0, 0x92, 0x0c, 0xc3, 0x7d, 0x00, 0xdd, 0x75, 0xa5, 0xb9,
+	0xe7, 0x9c, 0xfb, 0x98, 0x39, 0xe4, 0x88, 0x70, 0xd3, 0x1f, 0xb8, 0x0d, 0x97, 0x50, 0xdc, 0x70,
+	0x47, 0x4e, 0x10, 0xe0, 0x71, 0xe3, 0x74, 0x2f, 0x5d, 0xd6, 0x43, 0x4a, 0x62, 0x82, 0xb6, 0xfc,
+	0x81, 0x5b, 0x4f, 0x24, 0xf5, 0x14, 0x3f, 0xdd, 0xd3, 0xde, 0x1b, 0x92, 0x21, 0x61, 0x7c, 0x23,
+	0x59, 0x71, 0xa9, 0x56, 0x5b, 0x54, 0x1b, 0xfb, 0x38, 0x88, 0x59, 0x31, 0xb6, 0xe2, 0x82, 0x9d,
+	0xdf, 0xb3, 0x50, 0x6c, 0xf2, 0x2a, 0xe8, 0x2e, 0xe4, 0xa3, 0xd8, 0x89, 0xb1, 0x2a, 0xe9, 0xd2,
+	0x6e, 0xe5, 0x9e, 0x56, 0xbf, 0xa0, 0x4f, 0xbd, 0x97, 0x28, 0x2c, 0x2e, 0x44, 0xf7, 0xa1, 0x44,
+	0xa8, 0x87, 0xa9, 0x1f, 0x0c, 0xd5, 0xec, 0x25, 0x49, 0x9d, 0x44, 0x64, 0x9d, 0x69, 0xd1, 0x63,
+	0x58, 0x77, 0xc9, 0x34, 0x88, 0x31, 0x0d, 0x1d, 0x1a, 0xbf, 0x50, 0x73, 0xba, 0xb4, 0xbb, 0x76,
+	0xef, 0xe6, 0x85, 0xb9, 0xcd, 0x25, 0xe1, 0x81, 0xfc, 0xea, 0x97, 0x5a, 0xc6, 0x5a, 0x49, 0x46,
+	0x1f, 0xc3, 0xa6, 0x4b, 0x82, 0x00, 0xbb, 0xb1, 0x4f, 0x02, 0x7b, 0x44, 0xc2, 0x48, 0x95, 0xf5,
+	0xdc, 0x6e, 0xd9, 0xaa, 0x2c, 0xe0, 0x63, 0x12, 0x46, 0x48, 0x85, 0xe2, 0x29, 0xa6, 0x91, 0x4f,
+	0x02, 0x35, 0xaf, 0x4b, 0xbb, 0x65, 0x2b, 0x0d, 0xd1, 0x2d, 0x50, 0xa6, 0xe1, 0x90, 0x3a, 0x1e,
+	0xb6, 0x23, 0xfc, 0xf5, 0x14, 0x07, 0x2e, 0x56, 0x0b, 0xba, 0xb4, 0x2b, 0x5b, 0x9b, 0x02, 0xef,
+	0x09, 0x18, 0xdd, 0x83, 0x6d, 0x8a, 0x5d, 0xec, 0x87, 0xb1, 0x8d, 0xbf, 0x0d, 0x7d, 0xfa, 0xc2,
+	0x0e, 0x31, 0xf5, 0x89, 0xa7, 0x16, 0x99, 0x7e, 0x4b, 0x90, 0x06, 0xe3, 0xba, 0x8c, 0x7a, 0x28,
+	0xbf, 0xfc, 0xb1, 0x96, 0xd9, 0xf9, 0x39, 0x07, 0x57, 0x4c, 0x0f, 0x07, 0xb1, 0xff, 0xcc, 0xc7,
+	0xde, 0xff, 0x87, 0x7e, 0x0d, 0x8a, 0x21, 0xa1, 0xb1, 0xed, 0x7b, 0xec, 0xac, 0xcb, 0x56, 0x21,
+	0x09, 0x4d, 0x0f, 0x7d, 0x00, 0x20, 0x46, 0xb1, 0x7d, 0x7e, 0xae, 0x65, 0xab, 0x2c, 0x10, 0xd3,
+	0xbb, 0xd0, 0xac, 0xd2, 0x3f, 0x34, 0xab, 0xfc, 0x57, 0x66, 0xb5, 0x60, 0x7d, 0xf9, 0x0c, 0x96,
+	0x87, 0x95, 0x2e, 0x19, 0x36, 0x7b, 0x6e, 0x58, 0x51, 0xed, 0x4d, 0x16, 0x0a, 0x5d, 0xc7, 0x7d,
+	0x8e, 0x63, 0xa4, 0x41, 0xe9, 0x6c, 0x6a, 0x89, 0x4d, 0x71, 0x16, 0xa3, 0x1a, 0xac, 0x45, 0x64,
+	0x4a, 0x5d, 0x6c, 0x27, 0xc5, 0x45, 0x31, 0xe0, 0x50, 0x97, 0xd0, 0x18, 0x7d, 0x04, 0x15, 0x21,
+	0x10, 0x1d, 0x98, 0x89, 0x65, 0x6b, 0x83, 0xa3, 0xe9, 0x33, 0x75, 0x0b, 0x14, 0x0f, 0x47, 0xb1,
+	0x1f, 0x38, 0xcc, 0x1d, 0x56, 0x4c, 0x66, 0xc2, 0xcd, 0x25, 0x9c, 0x55, 0x6c, 0xc0, 0xd6, 0xb2,
+	0x34, 0x2d, 0xcb, 0xad, 0x42, 0x4b, 0x54, 0x5a, 0x1b, 0x81, 0xec, 0x39, 0xb1, 0xc3, 0x2c, 0x5b,
+	0xb7, 0xd8, 0x1a, 0x1d, 0x41, 0x25, 0xf6, 0x27, 0x98, 0x4c, 0x63, 0x7b, 0x84, 0xfd, 0xe1, 0x28,
+	0x66, 0xa6, 0xad, 0xad, 0x3c, 0x97, 0xfc, 0xd2, 0x39, 0xdd, 0xab, 0x1f, 0x33, 0x85, 0x78, 0xa8,
+	0x36, 0x44, 0x1e, 0x07, 0xd1, 0x27, 0x70, 0x25, 0x2d, 0x94, 0xfc, 0x46, 0xb1, 0x33, 0x09, 0x85,
+	0xb7, 0x8a, 0x20, 0xfa, 0x29, 0x2e, 0x8e, 0xf6, 0x3b, 0x58, 0xe3, 0x27, 0xcb, 0xde, 0x91, 0x7f,
+	0xeb, 0xd3, 0x8a, 0x2d, 0xb9, 0x73, 0xb6, 0xa4, 0x5b, 0x96, 0x17, 0x5b, 0x16, 0xcd, 0x3d, 0x28,
+	0xf1, 0xe6, 0xa6, 0xf7, 0x5f, 0x74, 0x16, 0x5d, 0x3a, 0xb0, 0xb9, 0xef, 0x3e, 0x0f, 0xc8, 0x37,
+	0x63, 0xec, 0x0d, 0xf1, 0x04, 0x07, 0x31, 0x52, 0xa1, 0x40, 0x71, 0x34, 0x1d, 0xc7, 0xea, 0x76,
+	0x32, 0xd4, 0x71, 0xc6, 0x12, 0x31, 0xba, 0x0a, 0x79, 0x4c, 0x29, 0xa1, 0xea, 0xd5, 0xa4, 0xd1,
+	0x71, 0xc6, 0xe2, 0xe1, 0x01, 0x40, 0x89, 0xe2, 0x28, 0x24, 0x41, 0x84, 0x77, 0x1c, 0x28, 0xf6,
+	0xf9, 0x69, 0xa2, 0xcf, 0xa1, 0x20, 0x2c, 0x93, 0xfe, 0xa6, 0x65, 0x42, 0x8f, 0x6e, 0x40, 0x79,
+	0xe1, 0x51, 0x96, 0x0d, 0xbe, 0x00, 0x76, 0x7e, 0x93, 0x92, 0x27, 0x9e, 0x3a, 0x93, 0x08, 0x3d,
+	0x86, 0xf4, 0xbd, 0xb4, 0x85, 0x87, 0xa2, 0xd7, 0x8d, 0x0b, 0xaf, 0x1e, 0x31, 0x99, 0xe8, 0x56,
+	0x11, 0xa9, 0xe9, 0xbc, 0xf7, 0xe1, 0xda, 0x33, 0x42, 0xdd, 0xb3, 0x52, 0x76, 0x3c, 0xa2, 0x38,
+	0x1a, 0x91, 0xb1, 0x27, 0x66, 0xd8, 0x66, 0xb4, 0x90, 0xf7, 0x53, 0x32, 0x79, 0x73, 0x42, 0x67,
+	0x1a, 0x61, 0x7b, 0x38, 0x75, 0xa8, 0xe7, 0x3b, 0x41, 0xfa, 0xe6, 0x30, 0xf4, 0x48, 0x80, 0x68,
+	0x0f, 0xb6, 0x43, 0x3a, 0x0d, 0xfc, 0x60, 0x68, 0x0f, 0x9d, 0x28, 0xb9, 0x2d, 0xec, 0xc1, 0x98,
+	0xb8, 0xcf, 0x99, 0xf7, 0xb2, 0x85, 0x04, 0x79, 0xe4, 0x44, 0x5d, 0x4c, 0x0f, 0x12, 0xe6, 0xf6,
+	0xf7, 0x59, 0xc8, 0xf7, 0xc4, 0xc5, 0x5c, 0xeb, 0xf5, 0xf7, 0xfb, 0x86, 0x7d, 0xd2, 0x36, 0xdb,
+	0x66, 0xdf, 0xdc, 0x6f, 0x99, 0x4f, 0x8d, 0x43, 0xfb, 0xa4, 0xdd, 0xeb, 0x1a, 0x4d, 0xf3, 0x91,
+	0x69, 0x1c, 0x2a, 0x19, 0xed, 0xca, 0x6c, 0xae, 0x6f, 0xac, 0x08, 0x90, 0x0a, 0xc0, 0xf3, 0x12,
+	0x50, 0x91, 0xb4, 0xd2, 0x6c, 0xae, 0xcb, 0xc9, 0x1a, 0x55, 0x61, 0x83, 0x33, 0x7d, 0xeb, 0xab,
+	0x4e, 0xd7, 0x68, 0x2b, 0x59, 0x6d, 0x6d, 0x36, 0xd7, 0x8b, 0x22, 0x5c, 0x64, 0x32, 0x32, 0xc7,
+	0x33, 0x19, 0x73, 0x03, 0xd6, 0x39, 0xd3, 0x6c, 0x75, 0x7a, 0xc6, 0xa1, 0x22, 0x6b, 0x30, 0x9b,
+	0xeb, 0x05, 0x1e, 0x21, 0x1d, 0x2a, 0x9c, 0x7d, 0xd4, 0x3a, 0xe9, 0x1d, 0x9b, 0xed, 0x23, 0x25,
+	0xaf, 0xad, 0xcf, 0xe6, 0x7a, 0x29, 0x8d, 0xd1, 0x6d, 0xd8, 0x5a, 0x52, 0x34, 0x3b, 0x4f, 0xba,
+	0x2d, 0xa3, 0x6f, 0x28, 0x05, 0x3e, 0xff, 0x0a, 0xa8, 0xc9, 0x2f, 0x7f, 0xaa, 0x66, 0x6e, 0xff,
+	0x21, 0x41, 0x9e, 0xfd, 0xe5, 0xa0, 0x0f, 0xe1, 0x6a, 0xc7, 0x3a, 0x34, 0x2c, 0xbb, 0xdd, 0x69,
+	0x1b, 0xe7, 0xb6, 0xcf, 0x26, 0x4c, 0x70, 0xb4, 0x03, 0x9b, 0x5c, 0x75, 0xd2, 0x66, 0xbf, 0xc6,
+	0xa1, 0x22, 0x69, 0x1b, 0xb3, 0xb9, 0x5e, 0x3e, 0x03, 0x92, 0xfd, 0x73, 0x4d, 0xaa, 0x10, 0xfb,
+	0x4f, 0xf9, 0x87, 0x70, 0x7d, 0x85, 0xb7, 0xf7, 0x5b, 0xad, 0xce, 0x17, 0x76, 0xdf, 0x7c, 0x62,
+	0x74, 0x4e, 0xfa, 0x4a, 0x4e, 0x7b, 0x7f, 0x36, 0xd7, 0xb7, 0x2f, 0x24, 0xd1, 0x23, 0xb8, 0x79,
+	0xae, 0xbf, 0x6d, 0x7c, 0xd9, 0x35, 0x2d, 0xb3, 0x7d, 0x64, 0x5b, 0x46, 0xd3, 0x30, 0xbb, 0xfd,
+	0x9e, 0x22, 0x6b, 0xb5, 0xd9, 0x5c, 0xbf, 0x7e, 0x89, 0x84, 0xef, 0xfe, 0xa0, 0xf7, 0xea, 0x6d,
+	0x55, 0x7a, 0xfd, 0xb6, 0x2a, 0xfd, 0xfa, 0xb6, 0x2a, 0xfd, 0xf0, 0xae, 0x9a, 0x79, 0xfd, 0xae,
+	0x9a, 0x79, 0xf3, 0xae, 0x9a, 0x79, 0xfa, 0x60, 0xe8, 0xc7, 0xa3, 0xe9, 0xa0, 0xee, 0x92, 0x49,
+	0xc3, 0x25, 0xd1, 0x84, 0x44, 0x0d, 0x7f, 0xe0, 0xde, 0x19, 0x92, 0xc6, 0xe9, 0x83, 0xc6, 0x84,
+	0x78, 0xd3, 0x31, 0x8e, 0xf8, 0x27, 0xda, 0xdd, 0xcf, 0xee, 0xa4, 0xdf, 0x7c, 0xf1, 0x8b, 0x10,
+	0x47, 0x83, 0x02, 0xfb, 0x46, 0xfb, 0xf4, 0xcf, 0x01, 0x00, 0x21, 0x00, 0xf4, 0xd3, 0x14, 0x0a,
+	0x00, 0x00,
 }
 
 func (m *Channel) Marshal() (dAtA []byte, err error) {
@@ -702,6 +763,11 @@ func (m *Channel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptExpiryPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.ReceiptExpiryPeriod))
+		i--
+		dAtA[i] = 0x38
+	}
 	if m.UpgradeSequence != 0 {
 		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
 		i--
@@ -766,6 +832,11 @@ func (m *IdentifiedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ReceiptExpiryPeriod != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.ReceiptExpiryPeriod))
+		i--
+		dAtA[i] = 0x48
+	}
 	if m.UpgradeSequence != 0 {
 		i = encodeVarintChannel(dAtA, i, uint64(m.UpgradeSequence))
 		i--
@@ -1154,6 +1225,23 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PruningGasPerBlock != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.PruningGasPerBlock))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.PauseGuardian) > 0 {
+		i -= len(m.PauseGuardian)
+		copy(dAtA[i:], m.PauseGuardian)
+		i = encodeVarintChannel(dAtA, i, uint64(len(m.PauseGuardian)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ForceTimeoutThreshold != 0 {
+		i = encodeVarintChannel(dAtA, i, uint64(m.ForceTimeoutThreshold))
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.UpgradeTimeout.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -1205,6 +1293,9 @@ func (m *Channel) Size() (n int) {
 	if m.UpgradeSequence != 0 {
 		n += 1 + sovChannel(uint64(m.UpgradeSequence))
 	}
+	if m.ReceiptExpiryPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.ReceiptExpiryPeriod))
+	}
 	return n
 }
 
@@ -1243,6 +1334,9 @@ func (m *IdentifiedChannel) Size() (n int) {
 	if m.UpgradeSequence != 0 {
 		n += 1 + sovChannel(uint64(m.UpgradeSequence))
 	}
+	if m.ReceiptExpiryPeriod != 0 {
+		n += 1 + sovChannel(uint64(m.ReceiptExpiryPeriod))
+	}
 	return n
 }
 
@@ -1400,6 +1494,16 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.UpgradeTimeout.Size()
 	n += 1 + l + sovChannel(uint64(l))
+	if m.ForceTimeoutThreshold != 0 {
+		n += 1 + sovChannel(uint64(m.ForceTimeoutThreshold))
+	}
+	l = len(m.PauseGuardian)
+	if l > 0 {
+		n += 1 + l + sovChannel(uint64(l))
+	}
+	if m.PruningGasPerBlock != 0 {
+		n += 1 + sovChannel(uint64(m.PruningGasPerBlock))
+	}
 	return n
 }
 
@@ -1592,6 +1696,25 @@ func (m *Channel) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptExpiryPeriod", wireType)
+			}
+			m.ReceiptExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptExpiryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -1860,6 +1983,25 @@ func (m *IdentifiedChannel) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptExpiryPeriod", wireType)
+			}
+			m.ReceiptExpiryPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ReceiptExpiryPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
@@ -2857,6 +2999,76 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForceTimeoutThreshold", wireType)
+			}
+			m.ForceTimeoutThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ForceTimeoutThreshold |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseGuardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthChannel
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthChannel
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauseGuardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PruningGasPerBlock", wireType)
+			}
+			m.PruningGasPerBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowChannel
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PruningGasPerBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipChannel(dAtA[iNdEx:])
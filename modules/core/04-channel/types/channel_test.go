@@ -17,11 +17,17 @@ func TestChannelValidateBasic(t *testing.T) {
 		expPass bool
 	}{
 		{"valid channel", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, connHops, version), true},
+		{"valid ORDERED_ALLOW_TIMEOUT channel", types.NewChannel(types.TRYOPEN, types.ORDERED_ALLOW_TIMEOUT, counterparty, connHops, version), true},
+		{"valid UNORDERED_EXPIRING_RECEIPTS channel", types.NewChannel(types.TRYOPEN, types.UNORDERED_EXPIRING_RECEIPTS, counterparty, connHops, version), true},
 		{"invalid state", types.NewChannel(types.UNINITIALIZED, types.ORDERED, counterparty, connHops, version), false},
 		{"invalid order", types.NewChannel(types.TRYOPEN, types.NONE, counterparty, connHops, version), false},
-		{"more than 1 connection hop", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "connection2"}, version), false},
+		{"multi-hop channel", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "connection2"}, version), true},
+		{"empty connection hops", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{}, version), false},
+		{"invalid multi-hop connection hop identifier", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"connection1", "(invalid)"}, version), false},
 		{"invalid connection hop identifier", types.NewChannel(types.TRYOPEN, types.ORDERED, counterparty, []string{"(invalid)"}, version), false},
 		{"invalid counterparty", types.NewChannel(types.TRYOPEN, types.ORDERED, types.NewCounterparty("(invalidport)", "channelidone"), connHops, version), false},
+		{"valid UNORDERED_EXPIRING_RECEIPTS channel with a receipt expiry period", types.Channel{State: types.TRYOPEN, Ordering: types.UNORDERED_EXPIRING_RECEIPTS, Counterparty: counterparty, ConnectionHops: connHops, Version: version, ReceiptExpiryPeriod: 1}, true},
+		{"receipt expiry period on UNORDERED channel", types.Channel{State: types.TRYOPEN, Ordering: types.UNORDERED, Counterparty: counterparty, ConnectionHops: connHops, Version: version, ReceiptExpiryPeriod: 1}, false},
 	}
 
 	for i, tc := range testCases {
@@ -37,6 +37,12 @@ func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
 		&MsgChannelUpgradeTimeout{},
 		&MsgChannelUpgradeCancel{},
 		&MsgPruneAcknowledgements{},
+		&MsgUpdateCounterpartyNextSequenceAck{},
+		&MsgForceTimeoutPackets{},
+		&MsgPauseChannel{},
+		&MsgUnpauseChannel{},
+		&MsgRecvPackets{},
+		&MsgAcknowledgements{},
 		&MsgUpdateParams{},
 	)
 
@@ -58,4 +58,20 @@ var (
 	ErrTimeoutElapsed                  = errorsmod.Register(SubModuleName, 40, "timeout elapsed")
 	ErrPruningSequenceStartNotFound    = errorsmod.Register(SubModuleName, 41, "pruning sequence start not found")
 	ErrRecvStartSequenceNotFound       = errorsmod.Register(SubModuleName, 42, "recv start sequence not found")
+
+	// ErrTimeoutReceiptWritten is returned when a timed out packet is received on an ORDERED_ALLOW_TIMEOUT channel.
+	// Core IBC commits the timeout receipt and does not call the application callbacks.
+	ErrTimeoutReceiptWritten = errorsmod.Register(SubModuleName, 43, "packet timeout elapsed, timeout receipt written")
+
+	// ErrForceTimeoutNotAllowed is returned when the packets of a channel cannot be timed out by the authority,
+	// because the counterparty client is still active or has not been expired or frozen for long enough.
+	ErrForceTimeoutNotAllowed = errorsmod.Register(SubModuleName, 44, "force timeout not allowed")
+
+	// ErrChannelPaused is returned when a packet is sent, received, acknowledged or timed out on a paused channel.
+	ErrChannelPaused    = errorsmod.Register(SubModuleName, 45, "channel is paused")
+	ErrChannelNotPaused = errorsmod.Register(SubModuleName, 46, "channel is not paused")
+
+	// ErrReceiptExpired is returned when a packet sent on an UNORDERED_EXPIRING_RECEIPTS channel is timed out with a proof
+	// of the counterparty state taken after the expiry of the packet receipt, which may then have been pruned.
+	ErrReceiptExpired = errorsmod.Register(SubModuleName, 47, "packet receipt expired")
 )
@@ -31,6 +31,8 @@ const (
 	EventTypeAcknowledgePacket = "acknowledge_packet"
 	EventTypeTimeoutPacket     = "timeout_packet"
 
+	EventTypeForceTimeoutPacket = "force_timeout_packet"
+
 	AttributeKeyDataHex          = "packet_data_hex"
 	AttributeKeyAckHex           = "packet_ack_hex"
 	AttributeKeyTimeoutHeight    = "packet_timeout_height"
@@ -62,6 +64,8 @@ var (
 	EventTypeChannelUpgradeCancel  = "channel_upgrade_cancelled"
 	EventTypeChannelUpgradeError   = "channel_upgrade_error"
 	EventTypeChannelFlushComplete  = "channel_flush_complete"
+	EventTypeChannelPaused         = "channel_paused"
+	EventTypeChannelUnpaused       = "channel_unpaused"
 
 	AttributeValueCategory = fmt.Sprintf("%s_%s", ibcexported.ModuleName, SubModuleName)
 )
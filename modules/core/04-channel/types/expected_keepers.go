@@ -58,6 +58,16 @@ type ConnectionKeeper interface {
 		channelID string,
 		sequence uint64,
 	) error
+	VerifyPacketReceipt(
+		ctx context.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		sequence uint64,
+		receipt []byte,
+	) error
 	VerifyNextSequenceRecv(
 		ctx context.Context,
 		connection connectiontypes.ConnectionEnd,
@@ -67,6 +77,15 @@ type ConnectionKeeper interface {
 		channelID string,
 		nextSequenceRecv uint64,
 	) error
+	VerifyNextSequenceAck(
+		ctx context.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		portID,
+		channelID string,
+		nextSequenceAck uint64,
+	) error
 	VerifyChannelUpgrade(
 		ctx context.Context,
 		connection connectiontypes.ConnectionEnd,
@@ -85,4 +104,23 @@ type ConnectionKeeper interface {
 		channelID string,
 		errorReceipt ErrorReceipt,
 	) error
+	VerifyMultihopMembership(
+		ctx context.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		key []byte,
+		value []byte,
+	) error
+	VerifyMultihopNonMembership(
+		ctx context.Context,
+		connection connectiontypes.ConnectionEnd,
+		height exported.Height,
+		proof []byte,
+		connectionHops []string,
+		key []byte,
+	) error
+	GetMultihopCounterpartyConnectionHops(connection connectiontypes.ConnectionEnd, proof []byte) ([]string, error)
+	GetMultihopCounterpartyConsensus(proof []byte) (clienttypes.Height, uint64, error)
 }
@@ -41,11 +41,50 @@ func (ps PacketSequence) Validate() error {
 	return validateGenFields(ps.PortId, ps.ChannelId, ps.Sequence)
 }
 
+// NewPausedChannel creates a new PausedChannel instance.
+func NewPausedChannel(portID, channelID string) PausedChannel {
+	return PausedChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (pc PausedChannel) Validate() error {
+	if err := host.PortIdentifierValidator(pc.PortId); err != nil {
+		return fmt.Errorf("invalid port Id: %w", err)
+	}
+	if err := host.ChannelIdentifierValidator(pc.ChannelId); err != nil {
+		return fmt.Errorf("invalid channel Id: %w", err)
+	}
+	return nil
+}
+
+// NewReceiptExpiry creates a new ReceiptExpiry instance.
+func NewReceiptExpiry(portID, channelID string, seq, expiryTimestamp uint64) ReceiptExpiry {
+	return ReceiptExpiry{
+		PortId:          portID,
+		ChannelId:       channelID,
+		Sequence:        seq,
+		ExpiryTimestamp: expiryTimestamp,
+	}
+}
+
+// Validate performs basic validation of fields returning an error upon any
+// failure.
+func (re ReceiptExpiry) Validate() error {
+	if re.ExpiryTimestamp == 0 {
+		return errors.New("expiry timestamp cannot be 0")
+	}
+	return validateGenFields(re.PortId, re.ChannelId, re.Sequence)
+}
+
 // NewGenesisState creates a GenesisState instance.
 func NewGenesisState(
 	channels []IdentifiedChannel, acks, receipts, commitments []PacketState,
 	sendSeqs, recvSeqs, ackSeqs []PacketSequence, nextChannelSequence uint64,
-	params Params,
+	params Params, pausedChannels []PausedChannel, receiptExpiries []ReceiptExpiry,
 ) GenesisState {
 	return GenesisState{
 		Channels:            channels,
@@ -57,6 +96,8 @@ func NewGenesisState(
 		AckSequences:        ackSeqs,
 		NextChannelSequence: nextChannelSequence,
 		Params:              params,
+		PausedChannels:      pausedChannels,
+		ReceiptExpiries:     receiptExpiries,
 	}
 }
 
@@ -72,6 +113,8 @@ func DefaultGenesisState() GenesisState {
 		AckSequences:        []PacketSequence{},
 		NextChannelSequence: 0,
 		Params:              DefaultParams(),
+		PausedChannels:      []PausedChannel{},
+		ReceiptExpiries:     []ReceiptExpiry{},
 	}
 }
 
@@ -143,6 +186,46 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	// a paused channel must be one of the channels of the genesis state and can only be paused once
+	channels := make(map[string]bool, len(gs.Channels))
+	for _, channel := range gs.Channels {
+		channels[string(host.ChannelKey(channel.PortId, channel.ChannelId))] = true
+	}
+
+	pausedChannels := make(map[string]bool, len(gs.PausedChannels))
+	for i, pc := range gs.PausedChannels {
+		if err := pc.Validate(); err != nil {
+			return fmt.Errorf("invalid paused channel %v index %d: %w", pc, i, err)
+		}
+
+		path := string(host.ChannelKey(pc.PortId, pc.ChannelId))
+		if !channels[path] {
+			return fmt.Errorf("invalid paused channel %v index %d: channel not found", pc, i)
+		}
+		if pausedChannels[path] {
+			return fmt.Errorf("invalid paused channel %v index %d: duplicate paused channel", pc, i)
+		}
+		pausedChannels[path] = true
+	}
+
+	// a packet receipt can only be indexed for pruning once, on one of the channels of the genesis state
+	receiptExpiries := make(map[string]bool, len(gs.ReceiptExpiries))
+	for i, re := range gs.ReceiptExpiries {
+		if err := re.Validate(); err != nil {
+			return fmt.Errorf("invalid receipt expiry %v index %d: %w", re, i, err)
+		}
+
+		if !channels[string(host.ChannelKey(re.PortId, re.ChannelId))] {
+			return fmt.Errorf("invalid receipt expiry %v index %d: channel not found", re, i)
+		}
+
+		receiptKey := string(host.PacketReceiptKey(re.PortId, re.ChannelId, re.Sequence))
+		if receiptExpiries[receiptKey] {
+			return fmt.Errorf("invalid receipt expiry %v index %d: duplicate receipt expiry", re, i)
+		}
+		receiptExpiries[receiptKey] = true
+	}
+
 	return nil
 }
 
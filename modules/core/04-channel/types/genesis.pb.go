@@ -35,6 +35,10 @@ type GenesisState struct {
 	// the sequence for the next generated channel identifier
 	NextChannelSequence uint64 `protobuf:"varint,8,opt,name=next_channel_sequence,json=nextChannelSequence,proto3" json:"next_channel_sequence,omitempty"`
 	Params              Params `protobuf:"bytes,9,opt,name=params,proto3" json:"params"`
+	// the channels which are paused
+	PausedChannels []PausedChannel `protobuf:"bytes,10,rep,name=paused_channels,json=pausedChannels,proto3" json:"paused_channels"`
+	// the packet receipts of UNORDERED_EXPIRING_RECEIPTS channels indexed by their expiry timestamp
+	ReceiptExpiries []ReceiptExpiry `protobuf:"bytes,11,rep,name=receipt_expiries,json=receiptExpiries,proto3" json:"receipt_expiries"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -133,6 +137,20 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetPausedChannels() []PausedChannel {
+	if m != nil {
+		return m.PausedChannels
+	}
+	return nil
+}
+
+func (m *GenesisState) GetReceiptExpiries() []ReceiptExpiry {
+	if m != nil {
+		return m.ReceiptExpiries
+	}
+	return nil
+}
+
 // PacketSequence defines the genesis type necessary to retrieve and store
 // next send and receive sequences.
 type PacketSequence struct {
@@ -195,45 +213,178 @@ func (m *PacketSequence) GetSequence() uint64 {
 	return 0
 }
 
+// PausedChannel defines the genesis type necessary to retrieve and store
+// the pause flag of a channel.
+type PausedChannel struct {
+	PortId    string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+}
+
+func (m *PausedChannel) Reset()         { *m = PausedChannel{} }
+func (m *PausedChannel) String() string { return proto.CompactTextString(m) }
+func (*PausedChannel) ProtoMessage()    {}
+func (*PausedChannel) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{2}
+}
+func (m *PausedChannel) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PausedChannel) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PausedChannel.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PausedChannel) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PausedChannel.Merge(m, src)
+}
+func (m *PausedChannel) XXX_Size() int {
+	return m.Size()
+}
+func (m *PausedChannel) XXX_DiscardUnknown() {
+	xxx_messageInfo_PausedChannel.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PausedChannel proto.InternalMessageInfo
+
+func (m *PausedChannel) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *PausedChannel) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+// ReceiptExpiry defines the genesis type necessary to retrieve and store
+// the expiry timestamp of the packet receipt of a packet received on an
+// UNORDERED_EXPIRING_RECEIPTS channel.
+type ReceiptExpiry struct {
+	PortId          string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	ChannelId       string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Sequence        uint64 `protobuf:"varint,3,opt,name=sequence,proto3" json:"sequence,omitempty"`
+	ExpiryTimestamp uint64 `protobuf:"varint,4,opt,name=expiry_timestamp,json=expiryTimestamp,proto3" json:"expiry_timestamp,omitempty"`
+}
+
+func (m *ReceiptExpiry) Reset()         { *m = ReceiptExpiry{} }
+func (m *ReceiptExpiry) String() string { return proto.CompactTextString(m) }
+func (*ReceiptExpiry) ProtoMessage()    {}
+func (*ReceiptExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_cb06ec201f452595, []int{3}
+}
+func (m *ReceiptExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ReceiptExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ReceiptExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ReceiptExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReceiptExpiry.Merge(m, src)
+}
+func (m *ReceiptExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *ReceiptExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReceiptExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReceiptExpiry proto.InternalMessageInfo
+
+func (m *ReceiptExpiry) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ReceiptExpiry) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ReceiptExpiry) GetSequence() uint64 {
+	if m != nil {
+		return m.Sequence
+	}
+	return 0
+}
+
+func (m *ReceiptExpiry) GetExpiryTimestamp() uint64 {
+	if m != nil {
+		return m.ExpiryTimestamp
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "ibc.core.channel.v1.GenesisState")
 	proto.RegisterType((*PacketSequence)(nil), "ibc.core.channel.v1.PacketSequence")
+	proto.RegisterType((*PausedChannel)(nil), "ibc.core.channel.v1.PausedChannel")
+	proto.RegisterType((*ReceiptExpiry)(nil), "ibc.core.channel.v1.ReceiptExpiry")
 }
 
 func init() { proto.RegisterFile("ibc/core/channel/v1/genesis.proto", fileDescriptor_cb06ec201f452595) }
 
 var fileDescriptor_cb06ec201f452595 = []byte{
-	// 470 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x93, 0x41, 0x6f, 0xd3, 0x30,
-	0x18, 0x86, 0x9b, 0xb5, 0xeb, 0x5a, 0x77, 0x9b, 0xc0, 0x03, 0x11, 0x8a, 0xc8, 0xca, 0x90, 0x50,
-	0x2f, 0x8b, 0x59, 0xe1, 0xd2, 0x6b, 0x39, 0x40, 0x2f, 0x68, 0xca, 0x6e, 0x48, 0xa8, 0x4a, 0xec,
-	0x8f, 0xcc, 0x6a, 0x63, 0x87, 0xd8, 0x2d, 0xf0, 0x2f, 0xf8, 0x2d, 0xfc, 0x8a, 0x1d, 0x77, 0xe4,
-	0x34, 0xa1, 0xf6, 0x5f, 0x70, 0x42, 0x71, 0x9c, 0xac, 0x68, 0x05, 0xa9, 0xb7, 0xf8, 0xfb, 0xde,
-	0xf7, 0x79, 0xdf, 0x43, 0x3e, 0xf4, 0x8c, 0x47, 0x94, 0x50, 0x99, 0x01, 0xa1, 0x97, 0xa1, 0x10,
-	0x30, 0x23, 0x8b, 0x33, 0x12, 0x83, 0x00, 0xc5, 0x95, 0x9f, 0x66, 0x52, 0x4b, 0x7c, 0xc4, 0x23,
-	0xea, 0xe7, 0x12, 0xdf, 0x4a, 0xfc, 0xc5, 0x59, 0xf7, 0x41, 0x2c, 0x63, 0x69, 0xf6, 0x24, 0xff,
-	0x2a, 0xa4, 0xdd, 0x8d, 0xb4, 0xd2, 0x65, 0x24, 0x27, 0x3f, 0x76, 0xd1, 0xfe, 0xdb, 0x82, 0x7f,
-	0xa1, 0x43, 0x0d, 0xf8, 0x23, 0x6a, 0x59, 0x85, 0x72, 0x9d, 0x5e, 0xbd, 0xdf, 0x19, 0xbc, 0xf0,
-	0x37, 0x24, 0xfa, 0x63, 0x06, 0x42, 0xf3, 0x4f, 0x1c, 0xd8, 0x9b, 0x62, 0x38, 0x7a, 0x7c, 0x75,
-	0x73, 0x5c, 0xfb, 0x7d, 0x73, 0x7c, 0xff, 0xce, 0x2a, 0xa8, 0x90, 0x38, 0x40, 0xf7, 0x42, 0x3a,
-	0x15, 0xf2, 0xcb, 0x0c, 0x58, 0x0c, 0x09, 0x08, 0xad, 0xdc, 0x1d, 0x13, 0xd3, 0xdb, 0x18, 0x73,
-	0x1e, 0xd2, 0x29, 0x68, 0x53, 0x6d, 0xd4, 0xc8, 0x03, 0x82, 0x3b, 0x7e, 0xfc, 0x0e, 0x75, 0xa8,
-	0x4c, 0x12, 0xae, 0x0b, 0x5c, 0x7d, 0x2b, 0xdc, 0xba, 0x15, 0x8f, 0x50, 0x2b, 0x03, 0x0a, 0x3c,
-	0xd5, 0xca, 0x6d, 0x6c, 0x85, 0xa9, 0x7c, 0xf8, 0x1c, 0x1d, 0x2a, 0x10, 0x6c, 0xa2, 0xe0, 0xf3,
-	0x1c, 0x04, 0x05, 0xe5, 0xee, 0x1a, 0xd2, 0xf3, 0xff, 0x91, 0xac, 0xd6, 0xc2, 0x0e, 0x72, 0x40,
-	0x39, 0x33, 0xc4, 0x0c, 0xe8, 0x62, 0x8d, 0xd8, 0xdc, 0x9a, 0x98, 0x03, 0x6e, 0x89, 0xef, 0xd1,
-	0x41, 0x48, 0xa7, 0x6b, 0xc0, 0xbd, 0x6d, 0x81, 0xfb, 0x21, 0x9d, 0xde, 0xf2, 0x06, 0xe8, 0xa1,
-	0x80, 0xaf, 0x7a, 0x62, 0x5d, 0x15, 0xd8, 0x6d, 0xf5, 0x9c, 0x7e, 0x23, 0x38, 0xca, 0x97, 0xf6,
-	0x5f, 0x28, 0x4d, 0x78, 0x88, 0x9a, 0x69, 0x98, 0x85, 0x89, 0x72, 0xdb, 0x3d, 0xa7, 0xdf, 0x19,
-	0x3c, 0xf9, 0x47, 0x78, 0x2e, 0xb1, 0xa1, 0xd6, 0x70, 0xc2, 0xd0, 0xe1, 0xdf, 0xa5, 0xf0, 0x23,
-	0xb4, 0x97, 0xca, 0x4c, 0x4f, 0x38, 0x73, 0x9d, 0x9e, 0xd3, 0x6f, 0x07, 0xcd, 0xfc, 0x39, 0x66,
-	0xf8, 0x29, 0x42, 0x65, 0x29, 0xce, 0xdc, 0x1d, 0xb3, 0x6b, 0xdb, 0xc9, 0x98, 0xe1, 0x2e, 0x6a,
-	0x55, 0x5d, 0xeb, 0xa6, 0x6b, 0xf5, 0x1e, 0x5d, 0x5c, 0x2d, 0x3d, 0xe7, 0x7a, 0xe9, 0x39, 0xbf,
-	0x96, 0x9e, 0xf3, 0x7d, 0xe5, 0xd5, 0xae, 0x57, 0x5e, 0xed, 0xe7, 0xca, 0xab, 0x7d, 0x18, 0xc6,
-	0x5c, 0x5f, 0xce, 0x23, 0x9f, 0xca, 0x84, 0x50, 0xa9, 0x12, 0xa9, 0x08, 0x8f, 0xe8, 0x69, 0x2c,
-	0xc9, 0x62, 0x48, 0x12, 0xc9, 0xe6, 0x33, 0x50, 0xc5, 0xdd, 0xbd, 0x7c, 0x7d, 0x5a, 0x9e, 0x9e,
-	0xfe, 0x96, 0x82, 0x8a, 0x9a, 0xe6, 0xec, 0x5e, 0xfd, 0x09, 0x00, 0x00, 0xff, 0xff, 0x06, 0xa2,
-	0xe9, 0xa6, 0xe9, 0x03, 0x00, 0x00,
+	// 567 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0x4f, 0x6f, 0xd3, 0x30,
+	0x18, 0xc6, 0x9b, 0xb5, 0x74, 0xad, 0xbb, 0xfe, 0xc1, 0x03, 0x11, 0x8a, 0xc8, 0x4a, 0x91, 0x50,
+	0x39, 0x2c, 0x61, 0x85, 0x4b, 0xaf, 0x45, 0x68, 0xf4, 0x82, 0x46, 0xca, 0x09, 0x09, 0x45, 0xa9,
+	0xf3, 0x92, 0x59, 0x6d, 0xe2, 0x10, 0xbb, 0x65, 0xfd, 0x12, 0x88, 0x8f, 0xb5, 0xe3, 0x8e, 0x88,
+	0xc3, 0x84, 0xda, 0x6f, 0xc1, 0x09, 0xc5, 0xf9, 0xb3, 0x56, 0xeb, 0x26, 0x15, 0x69, 0xb7, 0xf8,
+	0x7d, 0x9f, 0xe7, 0xf7, 0xd8, 0x6f, 0x2c, 0xa3, 0x67, 0x74, 0x44, 0x0c, 0xc2, 0x42, 0x30, 0xc8,
+	0xa9, 0xed, 0xfb, 0x30, 0x31, 0x66, 0x47, 0x86, 0x0b, 0x3e, 0x70, 0xca, 0xf5, 0x20, 0x64, 0x82,
+	0xe1, 0x7d, 0x3a, 0x22, 0x7a, 0x24, 0xd1, 0x13, 0x89, 0x3e, 0x3b, 0x6a, 0x3e, 0x70, 0x99, 0xcb,
+	0x64, 0xdf, 0x88, 0xbe, 0x62, 0x69, 0x73, 0x23, 0x2d, 0x75, 0x49, 0x49, 0xfb, 0x77, 0x11, 0xed,
+	0x1d, 0xc7, 0xfc, 0xa1, 0xb0, 0x05, 0xe0, 0x2f, 0xa8, 0x94, 0x28, 0xb8, 0xaa, 0xb4, 0xf2, 0x9d,
+	0x4a, 0xf7, 0x85, 0xbe, 0x21, 0x51, 0x1f, 0x38, 0xe0, 0x0b, 0xfa, 0x95, 0x82, 0xf3, 0x36, 0x2e,
+	0xf6, 0x1f, 0x9f, 0x5f, 0x1e, 0xe4, 0xfe, 0x5e, 0x1e, 0xdc, 0xbf, 0xd6, 0x32, 0x33, 0x24, 0x36,
+	0x51, 0xc3, 0x26, 0x63, 0x9f, 0x7d, 0x9f, 0x80, 0xe3, 0x82, 0x07, 0xbe, 0xe0, 0xea, 0x8e, 0x8c,
+	0x69, 0x6d, 0x8c, 0x39, 0xb1, 0xc9, 0x18, 0x84, 0xdc, 0x5a, 0xbf, 0x10, 0x05, 0x98, 0xd7, 0xfc,
+	0xf8, 0x3d, 0xaa, 0x10, 0xe6, 0x79, 0x54, 0xc4, 0xb8, 0xfc, 0x56, 0xb8, 0x55, 0x2b, 0xee, 0xa3,
+	0x52, 0x08, 0x04, 0x68, 0x20, 0xb8, 0x5a, 0xd8, 0x0a, 0x93, 0xf9, 0xf0, 0x09, 0xaa, 0x71, 0xf0,
+	0x1d, 0x8b, 0xc3, 0xb7, 0x29, 0xf8, 0x04, 0xb8, 0x7a, 0x4f, 0x92, 0x9e, 0xdf, 0x46, 0x4a, 0xb4,
+	0x09, 0xac, 0x1a, 0x01, 0xd2, 0x9a, 0x24, 0x86, 0x40, 0x66, 0x2b, 0xc4, 0xe2, 0xd6, 0xc4, 0x08,
+	0x70, 0x45, 0xfc, 0x80, 0xaa, 0x36, 0x19, 0xaf, 0x00, 0x77, 0xb7, 0x05, 0xee, 0xd9, 0x64, 0x7c,
+	0xc5, 0xeb, 0xa2, 0x87, 0x3e, 0x9c, 0x09, 0x2b, 0x71, 0x65, 0x60, 0xb5, 0xd4, 0x52, 0x3a, 0x05,
+	0x73, 0x3f, 0x6a, 0x26, 0x77, 0x21, 0x35, 0xe1, 0x1e, 0x2a, 0x06, 0x76, 0x68, 0x7b, 0x5c, 0x2d,
+	0xb7, 0x94, 0x4e, 0xa5, 0xfb, 0xe4, 0x86, 0xf0, 0x48, 0x92, 0x84, 0x26, 0x06, 0xfc, 0x11, 0xd5,
+	0x03, 0x7b, 0xca, 0xc1, 0xb1, 0xb2, 0xab, 0x8a, 0xe4, 0x01, 0xda, 0x37, 0x30, 0x22, 0x6d, 0x7a,
+	0x4d, 0x63, 0x54, 0x2d, 0x58, 0x2d, 0x72, 0x3c, 0x44, 0x8d, 0xe4, 0x0f, 0x5a, 0x70, 0x16, 0xd0,
+	0x90, 0x02, 0x57, 0x2b, 0xb7, 0x30, 0xcd, 0x58, 0xfc, 0x2e, 0xd2, 0xce, 0x13, 0x66, 0x3d, 0x5c,
+	0x29, 0x52, 0xe0, 0x6d, 0x07, 0xd5, 0xd6, 0x87, 0x87, 0x1f, 0xa1, 0xdd, 0x80, 0x85, 0xc2, 0xa2,
+	0x8e, 0xaa, 0xb4, 0x94, 0x4e, 0xd9, 0x2c, 0x46, 0xcb, 0x81, 0x83, 0x9f, 0x22, 0x94, 0x0e, 0x8f,
+	0x3a, 0xea, 0x8e, 0xec, 0x95, 0x93, 0xca, 0xc0, 0xc1, 0x4d, 0x54, 0xca, 0x66, 0x9a, 0x97, 0x33,
+	0xcd, 0xd6, 0xed, 0x63, 0x54, 0x5d, 0x3b, 0xe1, 0xff, 0x86, 0xb4, 0x7f, 0x28, 0xa8, 0xba, 0x76,
+	0xae, 0xbb, 0xd8, 0x2e, 0x7e, 0x89, 0x1a, 0x72, 0xc2, 0x73, 0x4b, 0x50, 0x0f, 0xb8, 0xb0, 0xbd,
+	0x40, 0x2d, 0x48, 0x4d, 0x3d, 0xae, 0x7f, 0x4a, 0xcb, 0xfd, 0xe1, 0xf9, 0x42, 0x53, 0x2e, 0x16,
+	0x9a, 0xf2, 0x67, 0xa1, 0x29, 0x3f, 0x97, 0x5a, 0xee, 0x62, 0xa9, 0xe5, 0x7e, 0x2d, 0xb5, 0xdc,
+	0xe7, 0x9e, 0x4b, 0xc5, 0xe9, 0x74, 0xa4, 0x13, 0xe6, 0x19, 0x84, 0x71, 0x8f, 0x71, 0x83, 0x8e,
+	0xc8, 0xa1, 0xcb, 0x8c, 0x59, 0xcf, 0xf0, 0x98, 0x33, 0x9d, 0x00, 0x8f, 0x5f, 0xbe, 0x57, 0x6f,
+	0x0e, 0xd3, 0xc7, 0x4f, 0xcc, 0x03, 0xe0, 0xa3, 0xa2, 0x7c, 0xf8, 0x5e, 0xff, 0x1b, 0x00, 0x07,
+	0x58, 0x10, 0x20, 0x6b, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -256,6 +407,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.ReceiptExpiries) > 0 {
+		for iNdEx := len(m.ReceiptExpiries) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ReceiptExpiries[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
+	if len(m.PausedChannels) > 0 {
+		for iNdEx := len(m.PausedChannels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PausedChannels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -414,6 +593,90 @@ func (m *PacketSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PausedChannel) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PausedChannel) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PausedChannel) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ReceiptExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ReceiptExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ReceiptExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiryTimestamp != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ExpiryTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Sequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintGenesis(dAtA []byte, offset int, v uint64) int {
 	offset -= sovGenesis(v)
 	base := offset
@@ -478,6 +741,18 @@ func (m *GenesisState) Size() (n int) {
 	}
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.PausedChannels) > 0 {
+		for _, e := range m.PausedChannels {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.ReceiptExpiries) > 0 {
+		for _, e := range m.ReceiptExpiries {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -501,6 +776,46 @@ func (m *PacketSequence) Size() (n int) {
 	return n
 }
 
+func (m *PausedChannel) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	return n
+}
+
+func (m *ReceiptExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.Sequence != 0 {
+		n += 1 + sovGenesis(uint64(m.Sequence))
+	}
+	if m.ExpiryTimestamp != 0 {
+		n += 1 + sovGenesis(uint64(m.ExpiryTimestamp))
+	}
+	return n
+}
+
 func sovGenesis(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -826,17 +1141,85 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipGenesis(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedChannels", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
 				return ErrInvalidLengthGenesis
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedChannels = append(m.PausedChannels, PausedChannel{})
+			if err := m.PausedChannels[len(m.PausedChannels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiptExpiries", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceiptExpiries = append(m.ReceiptExpiries, ReceiptExpiry{})
+			if err := m.ReceiptExpiries[len(m.ReceiptExpiries)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
 			iNdEx += skippy
 		}
@@ -980,6 +1363,272 @@ func (m *PacketSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PausedChannel) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PausedChannel: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PausedChannel: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ReceiptExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ReceiptExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ReceiptExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sequence", wireType)
+			}
+			m.Sequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Sequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryTimestamp", wireType)
+			}
+			m.ExpiryTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipGenesis(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -68,6 +68,12 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				2,
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
+				[]types.PausedChannel{
+					types.NewPausedChannel(testPort1, testChannel1),
+				},
+				[]types.ReceiptExpiry{
+					types.NewReceiptExpiry(testPort2, testChannel2, 1, 1),
+				},
 			),
 			expPass: true,
 		},
@@ -138,6 +144,94 @@ func TestValidateGenesis(t *testing.T) {
 			},
 			expPass: false,
 		},
+		{
+			name: "invalid paused channel",
+			genState: types.GenesisState{
+				PausedChannels: []types.PausedChannel{
+					types.NewPausedChannel(testPort1, "(testChannel1)"),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "paused channel not found",
+			genState: types.GenesisState{
+				Channels: []types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				NextChannelSequence: 1,
+				PausedChannels: []types.PausedChannel{
+					types.NewPausedChannel(testPort2, testChannel2),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate paused channel",
+			genState: types.GenesisState{
+				Channels: []types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				NextChannelSequence: 1,
+				PausedChannels: []types.PausedChannel{
+					types.NewPausedChannel(testPort1, testChannel1),
+					types.NewPausedChannel(testPort1, testChannel1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "invalid receipt expiry",
+			genState: types.GenesisState{
+				ReceiptExpiries: []types.ReceiptExpiry{
+					types.NewReceiptExpiry(testPort1, testChannel1, 1, 0),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "receipt expiry channel not found",
+			genState: types.GenesisState{
+				Channels: []types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				NextChannelSequence: 1,
+				ReceiptExpiries: []types.ReceiptExpiry{
+					types.NewReceiptExpiry(testPort2, testChannel2, 1, 1),
+				},
+			},
+			expPass: false,
+		},
+		{
+			name: "duplicate receipt expiry",
+			genState: types.GenesisState{
+				Channels: []types.IdentifiedChannel{
+					types.NewIdentifiedChannel(
+						testPort1, testChannel1, types.NewChannel(
+							types.INIT, testChannelOrder, counterparty2, []string{testConnectionIDA}, testChannelVersion,
+						),
+					),
+				},
+				NextChannelSequence: 1,
+				ReceiptExpiries: []types.ReceiptExpiry{
+					types.NewReceiptExpiry(testPort1, testChannel1, 1, 1),
+					types.NewReceiptExpiry(testPort1, testChannel1, 1, 2),
+				},
+			},
+			expPass: false,
+		},
 		{
 			name: "invalid channel identifier",
 			genState: types.NewGenesisState(
@@ -173,6 +267,8 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
@@ -211,6 +307,8 @@ func TestValidateGenesis(t *testing.T) {
 				},
 				0,
 				types.Params{UpgradeTimeout: types.DefaultTimeout},
+				nil,
+				nil,
 			),
 			expPass: false,
 		},
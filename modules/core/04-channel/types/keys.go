@@ -31,6 +31,10 @@ const (
 
 	// ParamsKey defines the key to store the params in the keeper.
 	ParamsKey = "channelParams"
+
+	// KeyPruningCursor is the key used to store the pruning sequence start key of the channel
+	// the automatic pruning of acknowledgements and packet receipts resumes from in the next block.
+	KeyPruningCursor = "pruningCursor"
 )
 
 // FormatChannelIdentifier returns the channel identifier with the sequence appended.
@@ -32,6 +32,12 @@ var (
 	_ sdk.Msg = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.Msg = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.Msg = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.Msg = (*MsgUpdateCounterpartyNextSequenceAck)(nil)
+	_ sdk.Msg = (*MsgForceTimeoutPackets)(nil)
+	_ sdk.Msg = (*MsgPauseChannel)(nil)
+	_ sdk.Msg = (*MsgUnpauseChannel)(nil)
+	_ sdk.Msg = (*MsgRecvPackets)(nil)
+	_ sdk.Msg = (*MsgAcknowledgements)(nil)
 
 	_ sdk.HasValidateBasic = (*MsgChannelOpenInit)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelOpenTry)(nil)
@@ -50,6 +56,12 @@ var (
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeTimeout)(nil)
 	_ sdk.HasValidateBasic = (*MsgChannelUpgradeCancel)(nil)
 	_ sdk.HasValidateBasic = (*MsgPruneAcknowledgements)(nil)
+	_ sdk.HasValidateBasic = (*MsgUpdateCounterpartyNextSequenceAck)(nil)
+	_ sdk.HasValidateBasic = (*MsgForceTimeoutPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgPauseChannel)(nil)
+	_ sdk.HasValidateBasic = (*MsgUnpauseChannel)(nil)
+	_ sdk.HasValidateBasic = (*MsgRecvPackets)(nil)
+	_ sdk.HasValidateBasic = (*MsgAcknowledgements)(nil)
 )
 
 // NewMsgChannelOpenInit creates a new MsgChannelOpenInit. It sets the counterparty channel
@@ -393,6 +405,156 @@ func (msg MsgAcknowledgement) ValidateBasic() error {
 	return msg.Packet.ValidateBasic()
 }
 
+// MaxPacketsPerBatch is the maximum number of packets relayed by a MsgRecvPackets or a MsgAcknowledgements,
+// which bounds the number of membership proofs verified by a single message.
+const MaxPacketsPerBatch = 100
+
+// NewMsgRecvPackets constructs a new MsgRecvPackets
+func NewMsgRecvPackets(
+	packets []Packet, commitmentsProof []byte, proofHeight clienttypes.Height,
+	signer string,
+) *MsgRecvPackets {
+	return &MsgRecvPackets{
+		Packets:          packets,
+		ProofCommitments: commitmentsProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgRecvPackets) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+	if len(msg.Packets) > MaxPacketsPerBatch {
+		return errorsmod.Wrapf(ErrInvalidPacket, "packets cannot exceed %d, got %d", MaxPacketsPerBatch, len(msg.Packets))
+	}
+	if len(msg.ProofCommitments) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitments proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet %d", i)
+		}
+	}
+	return nil
+}
+
+// RecvPacketMsgs splits the batch into a MsgRecvPacket per packet, each carrying the membership proof
+// of its packet commitment taken from the compressed proof.
+func (msg MsgRecvPackets) RecvPacketMsgs() ([]*MsgRecvPacket, error) {
+	proofs, err := splitCompressedProof(msg.ProofCommitments, len(msg.Packets))
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*MsgRecvPacket, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		msgs[i] = NewMsgRecvPacket(packet, proofs[i], msg.ProofHeight, msg.Signer)
+	}
+
+	return msgs, nil
+}
+
+// NewMsgAcknowledgements constructs a new MsgAcknowledgements
+func NewMsgAcknowledgements(
+	packets []Packet,
+	acks [][]byte, ackedProof []byte,
+	proofHeight clienttypes.Height,
+	signer string,
+) *MsgAcknowledgements {
+	return &MsgAcknowledgements{
+		Packets:          packets,
+		Acknowledgements: acks,
+		ProofAcked:       ackedProof,
+		ProofHeight:      proofHeight,
+		Signer:           signer,
+	}
+}
+
+// ValidateBasic implements sdk.Msg
+func (msg MsgAcknowledgements) ValidateBasic() error {
+	if len(msg.Packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets cannot be empty")
+	}
+	if len(msg.Packets) > MaxPacketsPerBatch {
+		return errorsmod.Wrapf(ErrInvalidPacket, "packets cannot exceed %d, got %d", MaxPacketsPerBatch, len(msg.Packets))
+	}
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return errorsmod.Wrapf(ErrInvalidAcknowledgement, "expected %d acknowledgements, got %d", len(msg.Packets), len(msg.Acknowledgements))
+	}
+	if len(msg.ProofAcked) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty acknowledgements proof")
+	}
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+	for i, packet := range msg.Packets {
+		if len(msg.Acknowledgements[i]) == 0 {
+			return errorsmod.Wrapf(ErrInvalidAcknowledgement, "ack bytes of packet %d cannot be empty", i)
+		}
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet %d", i)
+		}
+	}
+	return nil
+}
+
+// AcknowledgementMsgs splits the batch into a MsgAcknowledgement per packet, each carrying the membership
+// proof of its acknowledgement taken from the compressed proof.
+func (msg MsgAcknowledgements) AcknowledgementMsgs() ([]*MsgAcknowledgement, error) {
+	if len(msg.Acknowledgements) != len(msg.Packets) {
+		return nil, errorsmod.Wrapf(ErrInvalidAcknowledgement, "expected %d acknowledgements, got %d", len(msg.Packets), len(msg.Acknowledgements))
+	}
+
+	proofs, err := splitCompressedProof(msg.ProofAcked, len(msg.Packets))
+	if err != nil {
+		return nil, err
+	}
+
+	msgs := make([]*MsgAcknowledgement, len(msg.Packets))
+	for i, packet := range msg.Packets {
+		msgs[i] = NewMsgAcknowledgement(packet, msg.Acknowledgements[i], proofs[i], msg.ProofHeight, msg.Signer)
+	}
+
+	return msgs, nil
+}
+
+// splitCompressedProof splits the provided compressed proof into the encoded membership proofs of the keys it proves,
+// which must be the provided number of keys.
+func splitCompressedProof(proof []byte, keys int) ([][]byte, error) {
+	var compressedProof commitmenttypes.MerkleProof
+	if err := compressedProof.Unmarshal(proof); err != nil {
+		return nil, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot unmarshal compressed proof: %v", err)
+	}
+
+	merkleProofs, err := compressedProof.SplitCompressedProof()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(merkleProofs) != keys {
+		return nil, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "compressed proof proves %d keys, expected %d", len(merkleProofs), keys)
+	}
+
+	proofs := make([][]byte, len(merkleProofs))
+	for i := range merkleProofs {
+		bz, err := merkleProofs[i].Marshal()
+		if err != nil {
+			return nil, errorsmod.Wrapf(commitmenttypes.ErrInvalidProof, "cannot marshal proof %d: %v", i, err)
+		}
+		proofs[i] = bz
+	}
+
+	return proofs, nil
+}
+
 var _ sdk.Msg = &MsgChannelUpgradeInit{}
 
 // NewMsgChannelUpgradeInit constructs a new MsgChannelUpgradeInit
@@ -774,3 +936,142 @@ func (msg *MsgPruneAcknowledgements) ValidateBasic() error {
 
 	return nil
 }
+
+// NewMsgUpdateCounterpartyNextSequenceAck creates a new instance of MsgUpdateCounterpartyNextSequenceAck.
+func NewMsgUpdateCounterpartyNextSequenceAck(
+	portID, channelID string, nextSequenceAck uint64, nextSequenceAckProof []byte,
+	proofHeight clienttypes.Height, signer string,
+) *MsgUpdateCounterpartyNextSequenceAck {
+	return &MsgUpdateCounterpartyNextSequenceAck{
+		PortId:               portID,
+		ChannelId:            channelID,
+		NextSequenceAck:      nextSequenceAck,
+		ProofNextSequenceAck: nextSequenceAckProof,
+		ProofHeight:          proofHeight,
+		Signer:               signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUpdateCounterpartyNextSequenceAck.
+func (msg *MsgUpdateCounterpartyNextSequenceAck) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	if msg.NextSequenceAck == 0 {
+		return errorsmod.Wrap(ibcerrors.ErrInvalidSequence, "next sequence acknowledgement cannot be 0")
+	}
+
+	if len(msg.ProofNextSequenceAck) == 0 {
+		return errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty next sequence acknowledgement proof")
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
+
+// NewMsgForceTimeoutPackets creates a new instance of MsgForceTimeoutPackets.
+func NewMsgForceTimeoutPackets(portID, channelID string, packets []Packet, signer string) *MsgForceTimeoutPackets {
+	return &MsgForceTimeoutPackets{
+		PortId:    portID,
+		ChannelId: channelID,
+		Packets:   packets,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgForceTimeoutPackets.
+func (msg *MsgForceTimeoutPackets) ValidateBasic() error {
+	if err := host.PortIdentifierValidator(msg.PortId); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(msg.ChannelId) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	if len(msg.Packets) == 0 {
+		return errorsmod.Wrap(ErrInvalidPacket, "packets to time out cannot be empty")
+	}
+
+	sequences := make(map[uint64]struct{}, len(msg.Packets))
+	for _, packet := range msg.Packets {
+		if err := packet.ValidateBasic(); err != nil {
+			return errorsmod.Wrapf(err, "invalid packet with sequence %d", packet.Sequence)
+		}
+
+		if packet.SourcePort != msg.PortId || packet.SourceChannel != msg.ChannelId {
+			return errorsmod.Wrapf(
+				ErrInvalidPacket,
+				"packet source port ID (%s) and channel ID (%s) do not match port ID (%s) and channel ID (%s)",
+				packet.SourcePort, packet.SourceChannel, msg.PortId, msg.ChannelId,
+			)
+		}
+
+		if _, found := sequences[packet.Sequence]; found {
+			return errorsmod.Wrapf(ErrInvalidPacket, "duplicate packet sequence %d", packet.Sequence)
+		}
+		sequences[packet.Sequence] = struct{}{}
+	}
+
+	return nil
+}
+
+// NewMsgPauseChannel creates a new instance of MsgPauseChannel.
+func NewMsgPauseChannel(portID, channelID, signer string) *MsgPauseChannel {
+	return &MsgPauseChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgPauseChannel.
+func (msg *MsgPauseChannel) ValidateBasic() error {
+	return validatePauseChannelMsg(msg.PortId, msg.ChannelId, msg.Signer)
+}
+
+// NewMsgUnpauseChannel creates a new instance of MsgUnpauseChannel.
+func NewMsgUnpauseChannel(portID, channelID, signer string) *MsgUnpauseChannel {
+	return &MsgUnpauseChannel{
+		PortId:    portID,
+		ChannelId: channelID,
+		Signer:    signer,
+	}
+}
+
+// ValidateBasic performs basic checks on a MsgUnpauseChannel.
+func (msg *MsgUnpauseChannel) ValidateBasic() error {
+	return validatePauseChannelMsg(msg.PortId, msg.ChannelId, msg.Signer)
+}
+
+// validatePauseChannelMsg performs the basic checks shared by MsgPauseChannel and MsgUnpauseChannel.
+func validatePauseChannelMsg(portID, channelID, signer string) error {
+	if err := host.PortIdentifierValidator(portID); err != nil {
+		return errorsmod.Wrap(err, "invalid port ID")
+	}
+
+	if !IsValidChannelID(channelID) {
+		return ErrInvalidChannelIdentifier
+	}
+
+	_, err := sdk.AccAddressFromBech32(signer)
+	if err != nil {
+		return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", err)
+	}
+
+	return nil
+}
@@ -74,7 +74,7 @@ var (
 	emptyAddr string
 
 	connHops             = []string{"testconnection"}
-	invalidConnHops      = []string{"testconnection", "testconnection"}
+	emptyConnHops        = []string{}
 	invalidShortConnHops = []string{invalidShortConnection}
 	invalidLongConnHops  = []string{invalidLongConnection}
 )
@@ -176,17 +176,14 @@ func (suite *TypesTestSuite) TestMsgChannelOpenInitValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenInit(portid, version, types.Order(3),
+			types.NewMsgChannelOpenInit(portid, version, types.Order(99),
 				connHops, cpportid, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(3).String()),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(99).String()),
 		},
 		{
-			"connection hops more than 1 ",
-			types.NewMsgChannelOpenInit(portid, version, types.ORDERED, invalidConnHops, cpportid, addr),
-			errorsmod.Wrap(
-				types.ErrTooManyConnectionHops,
-				"current IBC version only supports one connection hop",
-			),
+			"empty connection hops",
+			types.NewMsgChannelOpenInit(portid, version, types.ORDERED, emptyConnHops, cpportid, addr),
+			errorsmod.Wrap(types.ErrInvalidChannel, "connection hops cannot be empty"),
 		},
 		{
 			"too short connection id",
@@ -330,16 +327,13 @@ func (suite *TypesTestSuite) TestMsgChannelOpenTryValidateBasic() {
 		},
 		{
 			"invalid channel order",
-			types.NewMsgChannelOpenTry(portid, version, types.Order(4), connHops, cpportid, cpchanid, version, suite.proof, height, addr),
-			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(4).String()),
+			types.NewMsgChannelOpenTry(portid, version, types.Order(99), connHops, cpportid, cpchanid, version, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidChannelOrdering, types.Order(99).String()),
 		},
 		{
-			"connection hops more than 1 ",
-			types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, invalidConnHops, cpportid, cpchanid, version, suite.proof, height, addr),
-			errorsmod.Wrap(
-				types.ErrTooManyConnectionHops,
-				"current IBC version only supports one connection hop",
-			),
+			"empty connection hops",
+			types.NewMsgChannelOpenTry(portid, version, types.UNORDERED, emptyConnHops, cpportid, cpchanid, version, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidChannel, "connection hops cannot be empty"),
 		},
 		{
 			"too short connection id",
@@ -1092,6 +1086,131 @@ func (suite *TypesTestSuite) TestMsgAcknowledgementGetSigners() {
 	suite.Require().Equal(expSigner.Bytes(), signers[0])
 }
 
+func (suite *TypesTestSuite) TestMsgRecvPacketsValidateBasic() {
+	packets := []types.Packet{packet, types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgRecvPackets
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgRecvPackets(packets, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"empty packets",
+			types.NewMsgRecvPackets(nil, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty"),
+		},
+		{
+			"too many packets",
+			types.NewMsgRecvPackets(make([]types.Packet, types.MaxPacketsPerBatch+1), suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidPacket, "packets cannot exceed %d, got %d", types.MaxPacketsPerBatch, types.MaxPacketsPerBatch+1),
+		},
+		{
+			"missing signer address",
+			types.NewMsgRecvPackets(packets, suite.proof, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"proof contain empty proof",
+			types.NewMsgRecvPackets(packets, emptyProof, height, addr),
+			errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty commitments proof"),
+		},
+		{
+			"invalid packet",
+			types.NewMsgRecvPackets([]types.Packet{packet, invalidPacket}, suite.proof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgAcknowledgementsValidateBasic() {
+	packets := []types.Packet{packet, types.NewPacket(validPacketData, 2, portid, chanid, cpportid, cpchanid, timeoutHeight, timeoutTimestamp)}
+	acks := [][]byte{packet.GetData(), packet.GetData()}
+
+	testCases := []struct {
+		name   string
+		msg    *types.MsgAcknowledgements
+		expErr error
+	}{
+		{
+			"success",
+			types.NewMsgAcknowledgements(packets, acks, suite.proof, height, addr),
+			nil,
+		},
+		{
+			"empty packets",
+			types.NewMsgAcknowledgements(nil, nil, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidPacket, "packets cannot be empty"),
+		},
+		{
+			"too many packets",
+			types.NewMsgAcknowledgements(make([]types.Packet, types.MaxPacketsPerBatch+1), make([][]byte, types.MaxPacketsPerBatch+1), suite.proof, height, addr),
+			errorsmod.Wrapf(types.ErrInvalidPacket, "packets cannot exceed %d, got %d", types.MaxPacketsPerBatch, types.MaxPacketsPerBatch+1),
+		},
+		{
+			"acknowledgements do not match packets",
+			types.NewMsgAcknowledgements(packets, acks[:1], suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidAcknowledgement, "expected 2 acknowledgements, got 1"),
+		},
+		{
+			"empty ack",
+			types.NewMsgAcknowledgements(packets, [][]byte{packet.GetData(), nil}, suite.proof, height, addr),
+			errorsmod.Wrap(types.ErrInvalidAcknowledgement, "ack bytes of packet 1 cannot be empty"),
+		},
+		{
+			"missing signer address",
+			types.NewMsgAcknowledgements(packets, acks, suite.proof, height, emptyAddr),
+			errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "string could not be parsed as address: %v", errors.New("empty address string is not allowed")),
+		},
+		{
+			"cannot submit an empty proof",
+			types.NewMsgAcknowledgements(packets, acks, emptyProof, height, addr),
+			errorsmod.Wrap(commitmenttypes.ErrInvalidProof, "cannot submit an empty acknowledgements proof"),
+		},
+		{
+			"invalid packet",
+			types.NewMsgAcknowledgements([]types.Packet{packet, invalidPacket}, acks, suite.proof, height, addr),
+			errorsmod.Wrap(errorsmod.Wrap(types.ErrInvalidPacket, "packet sequence cannot be 0"), "invalid packet 1"),
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			err := tc.msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().Error(err)
+				suite.Require().Equal(err.Error(), tc.expErr.Error())
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgChannelUpgradeInitValidateBasic() {
 	var msg *types.MsgChannelUpgradeInit
 
@@ -1861,6 +1980,264 @@ func (suite *TypesTestSuite) TestMsgPruneAcknowledgementsValidateBasic() {
 	}
 }
 
+func (suite *TypesTestSuite) TestMsgUpdateCounterpartyNextSequenceAckValidateBasic() {
+	var msg *types.MsgUpdateCounterpartyNextSequenceAck
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"zero next sequence acknowledgement",
+			func() {
+				msg.NextSequenceAck = 0
+			},
+			ibcerrors.ErrInvalidSequence,
+		},
+		{
+			"empty proof",
+			func() {
+				msg.ProofNextSequenceAck = emptyProof
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgUpdateCounterpartyNextSequenceAck(ibctesting.MockPort, ibctesting.FirstChannelID, 1, suite.proof, height, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgForceTimeoutPacketsValidateBasic() {
+	var msg *types.MsgForceTimeoutPackets
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+		{
+			"empty packets",
+			func() {
+				msg.Packets = nil
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"invalid packet",
+			func() {
+				msg.Packets = []types.Packet{invalidPacket}
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"packet source channel does not match",
+			func() {
+				msg.ChannelId = "channel-1"
+			},
+			types.ErrInvalidPacket,
+		},
+		{
+			"duplicate packet sequence",
+			func() {
+				msg.Packets = append(msg.Packets, packet)
+			},
+			types.ErrInvalidPacket,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgForceTimeoutPackets(portid, chanid, []types.Packet{packet}, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgPauseChannelValidateBasic() {
+	var msg *types.MsgPauseChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgPauseChannel(portid, chanid, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *TypesTestSuite) TestMsgUnpauseChannelValidateBasic() {
+	var msg *types.MsgUnpauseChannel
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"invalid port identifier",
+			func() {
+				msg.PortId = invalidPort
+			},
+			host.ErrInvalidID,
+		},
+		{
+			"invalid channel identifier",
+			func() {
+				msg.ChannelId = invalidChannel
+			},
+			types.ErrInvalidChannelIdentifier,
+		},
+		{
+			"empty signer address",
+			func() {
+				msg.Signer = emptyAddr
+			},
+			ibcerrors.ErrInvalidAddress,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			msg = types.NewMsgUnpauseChannel(portid, chanid, addr)
+
+			tc.malleate()
+			err := msg.ValidateBasic()
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+			} else {
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
 func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	var msg *types.MsgUpdateParams
 
@@ -1885,14 +2262,14 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 			"invalid params: non zero height",
 			func() {
 				newHeight := clienttypes.NewHeight(1, 1000)
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(newHeight, uint64(100000)), types.DefaultForceTimeoutThreshold))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
 		{
 			"invalid params: zero timestamp",
 			func() {
-				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0))))
+				msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(0)), types.DefaultForceTimeoutThreshold))
 			},
 			types.ErrInvalidUpgradeTimeout,
 		},
@@ -1901,7 +2278,7 @@ func (suite *TypesTestSuite) TestMsgUpdateParamsValidateBasic() {
 	for _, tc := range testCases {
 		tc := tc
 		suite.Run(tc.name, func() {
-			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000))))
+			msg = types.NewMsgUpdateChannelParams(authtypes.NewModuleAddress(govtypes.ModuleName).String(), types.NewParams(types.NewTimeout(clienttypes.ZeroHeight(), uint64(100000)), types.DefaultForceTimeoutThreshold))
 
 			tc.malleate()
 			err := msg.ValidateBasic()
@@ -2,6 +2,8 @@ package types
 
 import (
 	"crypto/sha256"
+	"math"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 
@@ -13,6 +15,35 @@ import (
 	"github.com/cosmos/ibc-go/v9/modules/core/exported"
 )
 
+// TimeoutReceipt is the packet receipt written by the receiving end of an ORDERED_ALLOW_TIMEOUT channel
+// when it skips a timed out packet. The sending end proves the timeout of the packet with it.
+var TimeoutReceipt = []byte{byte(2)}
+
+// DefaultReceiptExpiryPeriod is the period in nanoseconds after the timeout timestamp of a packet received on an
+// UNORDERED_EXPIRING_RECEIPTS channel after which its packet receipt expires, if the channel does not set a receipt
+// expiry period. It is a protocol constant, since both ends of a channel must agree on the receipt expiry period.
+const DefaultReceiptExpiryPeriod = uint64(7 * 24 * time.Hour)
+
+// MaxExpiredReceiptsPrunedPerBlock is the maximum number of expired packet receipts of UNORDERED_EXPIRING_RECEIPTS
+// channels pruned in a block. Expired packet receipts are pruned in every block, independently of the PruningGasPerBlock
+// parameter, so that the packet receipts stored by UNORDERED_EXPIRING_RECEIPTS channels remain bounded.
+const MaxExpiredReceiptsPrunedPerBlock = 100
+
+// IsReceiptExpired returns true if the packet receipt of a packet with the provided timeout timestamp received on an
+// UNORDERED_EXPIRING_RECEIPTS channel with the provided receipt expiry period has expired at the provided timestamp.
+func IsReceiptExpired(timeoutTimestamp, timestamp, receiptExpiryPeriod uint64) bool {
+	return timestamp >= ReceiptExpiryTimestamp(timeoutTimestamp, receiptExpiryPeriod)
+}
+
+// ReceiptExpiryTimestamp returns the timestamp at which the packet receipt of a packet with the provided timeout
+// timestamp received on an UNORDERED_EXPIRING_RECEIPTS channel with the provided receipt expiry period expires.
+func ReceiptExpiryTimestamp(timeoutTimestamp, receiptExpiryPeriod uint64) uint64 {
+	if timeoutTimestamp > math.MaxUint64-receiptExpiryPeriod {
+		return math.MaxUint64
+	}
+	return timeoutTimestamp + receiptExpiryPeriod
+}
+
 // CommitPacket returns the packet commitment bytes. The commitment consists of:
 // sha256_hash(timeout_timestamp + timeout_height.RevisionNumber + timeout_height.RevisionHeight + sha256_hash(data))
 // from a given packet. This results in a fixed length preimage.
@@ -1,6 +1,7 @@
 package types_test
 
 import (
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -80,3 +81,32 @@ func TestPacketValidateBasic(t *testing.T) {
 		}
 	}
 }
+
+func TestIsReceiptExpired(t *testing.T) {
+	const timeoutTimestamp = uint64(100)
+
+	testCases := []struct {
+		name       string
+		timestamp  uint64
+		expExpired bool
+	}{
+		{"timestamp before the timeout timestamp", timeoutTimestamp - 1, false},
+		{"timestamp equal to the timeout timestamp", timeoutTimestamp, false},
+		{"receipt expiry period not elapsed", timeoutTimestamp + types.DefaultReceiptExpiryPeriod - 1, false},
+		{"receipt expiry period elapsed", timeoutTimestamp + types.DefaultReceiptExpiryPeriod, true},
+		{"receipt expiry period elapsed at the max timestamp", math.MaxUint64, true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expExpired, types.IsReceiptExpired(timeoutTimestamp, tc.timestamp, types.DefaultReceiptExpiryPeriod))
+		})
+	}
+}
+
+func TestReceiptExpiryTimestamp(t *testing.T) {
+	require.Equal(t, uint64(100)+types.DefaultReceiptExpiryPeriod, types.ReceiptExpiryTimestamp(100, types.DefaultReceiptExpiryPeriod))
+	require.Equal(t, uint64(math.MaxUint64), types.ReceiptExpiryTimestamp(math.MaxUint64-1, types.DefaultReceiptExpiryPeriod))
+}
@@ -5,7 +5,10 @@ import (
 
 	errorsmod "cosmossdk.io/errors"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	ibcerrors "github.com/cosmos/ibc-go/v9/modules/core/errors"
 )
 
 // DefaultTimeout defines a default parameter for the channel upgrade protocol.
@@ -13,16 +16,23 @@ import (
 // This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
 var DefaultTimeout = NewTimeout(clienttypes.ZeroHeight(), uint64(10*time.Minute.Nanoseconds()))
 
+// DefaultForceTimeoutThreshold defines a default parameter for the force timeout of packets.
+// The packets sent on a channel can be timed out by the authority once the counterparty client has been expired or frozen
+// and its latest consensus state is older than this threshold. A threshold of zero disables force timeouts.
+// This parameter can be overridden by a valid authority using the UpdateChannelParams rpc.
+var DefaultForceTimeoutThreshold = uint64((30 * 24 * time.Hour).Nanoseconds())
+
 // NewParams creates a new parameter configuration for the channel submodule
-func NewParams(upgradeTimeout Timeout) Params {
+func NewParams(upgradeTimeout Timeout, forceTimeoutThreshold uint64) Params {
 	return Params{
-		UpgradeTimeout: upgradeTimeout,
+		UpgradeTimeout:        upgradeTimeout,
+		ForceTimeoutThreshold: forceTimeoutThreshold,
 	}
 }
 
 // DefaultParams is the default parameter configuration for the channel submodule
 func DefaultParams() Params {
-	return NewParams(DefaultTimeout)
+	return NewParams(DefaultTimeout, DefaultForceTimeoutThreshold)
 }
 
 // Validate the params.
@@ -33,5 +43,10 @@ func (p Params) Validate() error {
 	if p.UpgradeTimeout.Timestamp == 0 {
 		return errorsmod.Wrapf(ErrInvalidUpgradeTimeout, "upgrade timeout timestamp invalid: %v", p.UpgradeTimeout.Timestamp)
 	}
+	if p.PauseGuardian != "" {
+		if _, err := sdk.AccAddressFromBech32(p.PauseGuardian); err != nil {
+			return errorsmod.Wrapf(ibcerrors.ErrInvalidAddress, "pause guardian could not be parsed as address: %v", err)
+		}
+	}
 	return nil
 }
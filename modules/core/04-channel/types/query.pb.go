@@ -913,6 +913,136 @@ func (m *QueryPacketCommitmentsResponse) GetHeight() types.Height {
 	return types.Height{}
 }
 
+// QueryForceTimeoutPacketsRequest is the request type for the
+// Query/ForceTimeoutPackets RPC method
+type QueryForceTimeoutPacketsRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryForceTimeoutPacketsRequest) Reset()         { *m = QueryForceTimeoutPacketsRequest{} }
+func (m *QueryForceTimeoutPacketsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryForceTimeoutPacketsRequest) ProtoMessage()    {}
+func (*QueryForceTimeoutPacketsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{14}
+}
+func (m *QueryForceTimeoutPacketsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForceTimeoutPacketsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForceTimeoutPacketsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForceTimeoutPacketsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForceTimeoutPacketsRequest.Merge(m, src)
+}
+func (m *QueryForceTimeoutPacketsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForceTimeoutPacketsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForceTimeoutPacketsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForceTimeoutPacketsRequest proto.InternalMessageInfo
+
+func (m *QueryForceTimeoutPacketsRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *QueryForceTimeoutPacketsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryForceTimeoutPacketsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryForceTimeoutPacketsResponse is the response type for the
+// Query/ForceTimeoutPackets RPC method
+type QueryForceTimeoutPacketsResponse struct {
+	// packet commitments that can be timed out by the authority
+	Commitments []*PacketState `protobuf:"bytes,1,rep,name=commitments,proto3" json:"commitments,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryForceTimeoutPacketsResponse) Reset()         { *m = QueryForceTimeoutPacketsResponse{} }
+func (m *QueryForceTimeoutPacketsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryForceTimeoutPacketsResponse) ProtoMessage()    {}
+func (*QueryForceTimeoutPacketsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{15}
+}
+func (m *QueryForceTimeoutPacketsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryForceTimeoutPacketsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryForceTimeoutPacketsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryForceTimeoutPacketsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryForceTimeoutPacketsResponse.Merge(m, src)
+}
+func (m *QueryForceTimeoutPacketsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryForceTimeoutPacketsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryForceTimeoutPacketsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryForceTimeoutPacketsResponse proto.InternalMessageInfo
+
+func (m *QueryForceTimeoutPacketsResponse) GetCommitments() []*PacketState {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
+func (m *QueryForceTimeoutPacketsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryForceTimeoutPacketsResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
 // QueryPacketReceiptRequest is the request type for the
 // Query/PacketReceipt RPC method
 type QueryPacketReceiptRequest struct {
@@ -928,7 +1058,7 @@ func (m *QueryPacketReceiptRequest) Reset()         { *m = QueryPacketReceiptReq
 func (m *QueryPacketReceiptRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptRequest) ProtoMessage()    {}
 func (*QueryPacketReceiptRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{14}
+	return fileDescriptor_1034a1e9abc4cca1, []int{16}
 }
 func (m *QueryPacketReceiptRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -994,7 +1124,7 @@ func (m *QueryPacketReceiptResponse) Reset()         { *m = QueryPacketReceiptRe
 func (m *QueryPacketReceiptResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketReceiptResponse) ProtoMessage()    {}
 func (*QueryPacketReceiptResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{15}
+	return fileDescriptor_1034a1e9abc4cca1, []int{17}
 }
 func (m *QueryPacketReceiptResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1059,7 +1189,7 @@ func (m *QueryPacketAcknowledgementRequest) Reset()         { *m = QueryPacketAc
 func (m *QueryPacketAcknowledgementRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{16}
+	return fileDescriptor_1034a1e9abc4cca1, []int{18}
 }
 func (m *QueryPacketAcknowledgementRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1125,7 +1255,7 @@ func (m *QueryPacketAcknowledgementResponse) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{17}
+	return fileDescriptor_1034a1e9abc4cca1, []int{19}
 }
 func (m *QueryPacketAcknowledgementResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1192,7 +1322,7 @@ func (m *QueryPacketAcknowledgementsRequest) Reset()         { *m = QueryPacketA
 func (m *QueryPacketAcknowledgementsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsRequest) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{18}
+	return fileDescriptor_1034a1e9abc4cca1, []int{20}
 }
 func (m *QueryPacketAcknowledgementsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1263,7 +1393,7 @@ func (m *QueryPacketAcknowledgementsResponse) Reset()         { *m = QueryPacket
 func (m *QueryPacketAcknowledgementsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryPacketAcknowledgementsResponse) ProtoMessage()    {}
 func (*QueryPacketAcknowledgementsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{19}
+	return fileDescriptor_1034a1e9abc4cca1, []int{21}
 }
 func (m *QueryPacketAcknowledgementsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1328,7 +1458,7 @@ func (m *QueryUnreceivedPacketsRequest) Reset()         { *m = QueryUnreceivedPa
 func (m *QueryUnreceivedPacketsRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsRequest) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{20}
+	return fileDescriptor_1034a1e9abc4cca1, []int{22}
 }
 func (m *QueryUnreceivedPacketsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1391,7 +1521,7 @@ func (m *QueryUnreceivedPacketsResponse) Reset()         { *m = QueryUnreceivedP
 func (m *QueryUnreceivedPacketsResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedPacketsResponse) ProtoMessage()    {}
 func (*QueryUnreceivedPacketsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{21}
+	return fileDescriptor_1034a1e9abc4cca1, []int{23}
 }
 func (m *QueryUnreceivedPacketsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1449,7 +1579,7 @@ func (m *QueryUnreceivedAcksRequest) Reset()         { *m = QueryUnreceivedAcksR
 func (m *QueryUnreceivedAcksRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksRequest) ProtoMessage()    {}
 func (*QueryUnreceivedAcksRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{22}
+	return fileDescriptor_1034a1e9abc4cca1, []int{24}
 }
 func (m *QueryUnreceivedAcksRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1642,7 @@ func (m *QueryUnreceivedAcksResponse) Reset()         { *m = QueryUnreceivedAcks
 func (m *QueryUnreceivedAcksResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUnreceivedAcksResponse) ProtoMessage()    {}
 func (*QueryUnreceivedAcksResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{23}
+	return fileDescriptor_1034a1e9abc4cca1, []int{25}
 }
 func (m *QueryUnreceivedAcksResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1568,7 +1698,7 @@ func (m *QueryNextSequenceReceiveRequest) Reset()         { *m = QueryNextSequen
 func (m *QueryNextSequenceReceiveRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceReceiveRequest) ProtoMessage()    {}
 func (*QueryNextSequenceReceiveRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{24}
+	return fileDescriptor_1034a1e9abc4cca1, []int{26}
 }
 func (m *QueryNextSequenceReceiveRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1626,7 +1756,7 @@ func (m *QueryNextSequenceReceiveResponse) Reset()         { *m = QueryNextSeque
 func (m *QueryNextSequenceReceiveResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceReceiveResponse) ProtoMessage()    {}
 func (*QueryNextSequenceReceiveResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{25}
+	return fileDescriptor_1034a1e9abc4cca1, []int{27}
 }
 func (m *QueryNextSequenceReceiveResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1689,7 +1819,7 @@ func (m *QueryNextSequenceSendRequest) Reset()         { *m = QueryNextSequenceS
 func (m *QueryNextSequenceSendRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceSendRequest) ProtoMessage()    {}
 func (*QueryNextSequenceSendRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{26}
+	return fileDescriptor_1034a1e9abc4cca1, []int{28}
 }
 func (m *QueryNextSequenceSendRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1747,7 +1877,7 @@ func (m *QueryNextSequenceSendResponse) Reset()         { *m = QueryNextSequence
 func (m *QueryNextSequenceSendResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryNextSequenceSendResponse) ProtoMessage()    {}
 func (*QueryNextSequenceSendResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{27}
+	return fileDescriptor_1034a1e9abc4cca1, []int{29}
 }
 func (m *QueryNextSequenceSendResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1807,7 +1937,7 @@ func (m *QueryUpgradeErrorRequest) Reset()         { *m = QueryUpgradeErrorReque
 func (m *QueryUpgradeErrorRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeErrorRequest) ProtoMessage()    {}
 func (*QueryUpgradeErrorRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{28}
+	return fileDescriptor_1034a1e9abc4cca1, []int{30}
 }
 func (m *QueryUpgradeErrorRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1863,7 +1993,7 @@ func (m *QueryUpgradeErrorResponse) Reset()         { *m = QueryUpgradeErrorResp
 func (m *QueryUpgradeErrorResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeErrorResponse) ProtoMessage()    {}
 func (*QueryUpgradeErrorResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{29}
+	return fileDescriptor_1034a1e9abc4cca1, []int{31}
 }
 func (m *QueryUpgradeErrorResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1923,7 +2053,7 @@ func (m *QueryUpgradeRequest) Reset()         { *m = QueryUpgradeRequest{} }
 func (m *QueryUpgradeRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeRequest) ProtoMessage()    {}
 func (*QueryUpgradeRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{30}
+	return fileDescriptor_1034a1e9abc4cca1, []int{32}
 }
 func (m *QueryUpgradeRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1979,7 +2109,7 @@ func (m *QueryUpgradeResponse) Reset()         { *m = QueryUpgradeResponse{} }
 func (m *QueryUpgradeResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryUpgradeResponse) ProtoMessage()    {}
 func (*QueryUpgradeResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{31}
+	return fileDescriptor_1034a1e9abc4cca1, []int{33}
 }
 func (m *QueryUpgradeResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2029,22 +2159,26 @@ func (m *QueryUpgradeResponse) GetProofHeight() types.Height {
 	return types.Height{}
 }
 
-// QueryChannelParamsRequest is the request type for the Query/ChannelParams RPC method.
-type QueryChannelParamsRequest struct {
+// QueryChannelPausedRequest is the request type for the Query/ChannelPaused RPC method
+type QueryChannelPausedRequest struct {
+	// port unique identifier
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// channel unique identifier
+	ChannelId string `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
 }
 
-func (m *QueryChannelParamsRequest) Reset()         { *m = QueryChannelParamsRequest{} }
-func (m *QueryChannelParamsRequest) String() string { return proto.CompactTextString(m) }
-func (*QueryChannelParamsRequest) ProtoMessage()    {}
-func (*QueryChannelParamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{32}
+func (m *QueryChannelPausedRequest) Reset()         { *m = QueryChannelPausedRequest{} }
+func (m *QueryChannelPausedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPausedRequest) ProtoMessage()    {}
+func (*QueryChannelPausedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{34}
 }
-func (m *QueryChannelParamsRequest) XXX_Unmarshal(b []byte) error {
+func (m *QueryChannelPausedRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryChannelParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChannelPausedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryChannelParamsRequest.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChannelPausedRequest.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2054,36 +2188,50 @@ func (m *QueryChannelParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([
 		return b[:n], nil
 	}
 }
-func (m *QueryChannelParamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryChannelParamsRequest.Merge(m, src)
+func (m *QueryChannelPausedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPausedRequest.Merge(m, src)
 }
-func (m *QueryChannelParamsRequest) XXX_Size() int {
+func (m *QueryChannelPausedRequest) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryChannelParamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryChannelParamsRequest.DiscardUnknown(m)
+func (m *QueryChannelPausedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPausedRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryChannelParamsRequest proto.InternalMessageInfo
+var xxx_messageInfo_QueryChannelPausedRequest proto.InternalMessageInfo
 
-// QueryChannelParamsResponse is the response type for the Query/ChannelParams RPC method.
-type QueryChannelParamsResponse struct {
-	// params defines the parameters of the module.
-	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+func (m *QueryChannelPausedRequest) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
 }
 
-func (m *QueryChannelParamsResponse) Reset()         { *m = QueryChannelParamsResponse{} }
-func (m *QueryChannelParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*QueryChannelParamsResponse) ProtoMessage()    {}
-func (*QueryChannelParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_1034a1e9abc4cca1, []int{33}
+func (m *QueryChannelPausedRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
 }
-func (m *QueryChannelParamsResponse) XXX_Unmarshal(b []byte) error {
+
+// QueryChannelPausedResponse is the response type for the Query/ChannelPaused RPC method
+type QueryChannelPausedResponse struct {
+	// whether the channel is paused
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+}
+
+func (m *QueryChannelPausedResponse) Reset()         { *m = QueryChannelPausedResponse{} }
+func (m *QueryChannelPausedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelPausedResponse) ProtoMessage()    {}
+func (*QueryChannelPausedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{35}
+}
+func (m *QueryChannelPausedResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *QueryChannelParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *QueryChannelPausedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_QueryChannelParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_QueryChannelPausedResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -2093,194 +2241,403 @@ func (m *QueryChannelParamsResponse) XXX_Marshal(b []byte, deterministic bool) (
 		return b[:n], nil
 	}
 }
-func (m *QueryChannelParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_QueryChannelParamsResponse.Merge(m, src)
+func (m *QueryChannelPausedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelPausedResponse.Merge(m, src)
 }
-func (m *QueryChannelParamsResponse) XXX_Size() int {
+func (m *QueryChannelPausedResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *QueryChannelParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_QueryChannelParamsResponse.DiscardUnknown(m)
+func (m *QueryChannelPausedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelPausedResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_QueryChannelParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_QueryChannelPausedResponse proto.InternalMessageInfo
 
-func (m *QueryChannelParamsResponse) GetParams() *Params {
+func (m *QueryChannelPausedResponse) GetPaused() bool {
 	if m != nil {
-		return m.Params
+		return m.Paused
 	}
-	return nil
+	return false
 }
 
-func init() {
-	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
-	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
-	proto.RegisterType((*QueryChannelsRequest)(nil), "ibc.core.channel.v1.QueryChannelsRequest")
-	proto.RegisterType((*QueryChannelsResponse)(nil), "ibc.core.channel.v1.QueryChannelsResponse")
-	proto.RegisterType((*QueryConnectionChannelsRequest)(nil), "ibc.core.channel.v1.QueryConnectionChannelsRequest")
-	proto.RegisterType((*QueryConnectionChannelsResponse)(nil), "ibc.core.channel.v1.QueryConnectionChannelsResponse")
-	proto.RegisterType((*QueryChannelClientStateRequest)(nil), "ibc.core.channel.v1.QueryChannelClientStateRequest")
-	proto.RegisterType((*QueryChannelClientStateResponse)(nil), "ibc.core.channel.v1.QueryChannelClientStateResponse")
-	proto.RegisterType((*QueryChannelConsensusStateRequest)(nil), "ibc.core.channel.v1.QueryChannelConsensusStateRequest")
-	proto.RegisterType((*QueryChannelConsensusStateResponse)(nil), "ibc.core.channel.v1.QueryChannelConsensusStateResponse")
-	proto.RegisterType((*QueryPacketCommitmentRequest)(nil), "ibc.core.channel.v1.QueryPacketCommitmentRequest")
-	proto.RegisterType((*QueryPacketCommitmentResponse)(nil), "ibc.core.channel.v1.QueryPacketCommitmentResponse")
-	proto.RegisterType((*QueryPacketCommitmentsRequest)(nil), "ibc.core.channel.v1.QueryPacketCommitmentsRequest")
-	proto.RegisterType((*QueryPacketCommitmentsResponse)(nil), "ibc.core.channel.v1.QueryPacketCommitmentsResponse")
-	proto.RegisterType((*QueryPacketReceiptRequest)(nil), "ibc.core.channel.v1.QueryPacketReceiptRequest")
-	proto.RegisterType((*QueryPacketReceiptResponse)(nil), "ibc.core.channel.v1.QueryPacketReceiptResponse")
-	proto.RegisterType((*QueryPacketAcknowledgementRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementRequest")
-	proto.RegisterType((*QueryPacketAcknowledgementResponse)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementResponse")
-	proto.RegisterType((*QueryPacketAcknowledgementsRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementsRequest")
-	proto.RegisterType((*QueryPacketAcknowledgementsResponse)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementsResponse")
-	proto.RegisterType((*QueryUnreceivedPacketsRequest)(nil), "ibc.core.channel.v1.QueryUnreceivedPacketsRequest")
-	proto.RegisterType((*QueryUnreceivedPacketsResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedPacketsResponse")
-	proto.RegisterType((*QueryUnreceivedAcksRequest)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksRequest")
-	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksResponse")
-	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveRequest")
-	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveResponse")
-	proto.RegisterType((*QueryNextSequenceSendRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceSendRequest")
-	proto.RegisterType((*QueryNextSequenceSendResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceSendResponse")
-	proto.RegisterType((*QueryUpgradeErrorRequest)(nil), "ibc.core.channel.v1.QueryUpgradeErrorRequest")
-	proto.RegisterType((*QueryUpgradeErrorResponse)(nil), "ibc.core.channel.v1.QueryUpgradeErrorResponse")
-	proto.RegisterType((*QueryUpgradeRequest)(nil), "ibc.core.channel.v1.QueryUpgradeRequest")
-	proto.RegisterType((*QueryUpgradeResponse)(nil), "ibc.core.channel.v1.QueryUpgradeResponse")
-	proto.RegisterType((*QueryChannelParamsRequest)(nil), "ibc.core.channel.v1.QueryChannelParamsRequest")
-	proto.RegisterType((*QueryChannelParamsResponse)(nil), "ibc.core.channel.v1.QueryChannelParamsResponse")
+// QueryPausedChannelsRequest is the request type for the Query/PausedChannels RPC method
+type QueryPausedChannelsRequest struct {
+	// pagination request
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
-
-var fileDescriptor_1034a1e9abc4cca1 = []byte{
-	// 1756 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xcf, 0x6f, 0x14, 0x47,
-	0x16, 0x76, 0xd9, 0xc6, 0x3f, 0x0a, 0x03, 0xa6, 0x6c, 0x2f, 0xa6, 0x6d, 0x8f, 0xcd, 0xa0, 0x5d,
-	0x0c, 0x5a, 0xba, 0xb1, 0xcd, 0xb2, 0xb0, 0x62, 0x91, 0xb0, 0x77, 0x17, 0x8c, 0x16, 0x30, 0xed,
-	0x90, 0x00, 0x52, 0x32, 0xe9, 0xe9, 0x29, 0xc6, 0x2d, 0x7b, 0xba, 0x9b, 0xe9, 0x9e, 0x01, 0xe4,
-	0x38, 0x8a, 0x72, 0x20, 0x1c, 0xa3, 0xa0, 0x28, 0x52, 0x2e, 0x91, 0x72, 0x0a, 0x91, 0xa2, 0x28,
-	0x7f, 0x41, 0x2e, 0x39, 0x70, 0x0b, 0x12, 0x39, 0x44, 0x42, 0x22, 0x11, 0x26, 0x22, 0xd7, 0x5c,
-	0x72, 0x8e, 0xba, 0xea, 0x55, 0x4f, 0xf7, 0x4c, 0x77, 0xdb, 0xe3, 0xf6, 0x48, 0x28, 0xb7, 0xe9,
-	0xea, 0x7a, 0xaf, 0xbe, 0xef, 0x7b, 0x55, 0xaf, 0xeb, 0x3d, 0x1b, 0x8f, 0x1b, 0x79, 0x5d, 0xd1,
-	0xad, 0x32, 0x55, 0xf4, 0x25, 0xcd, 0x34, 0xe9, 0x8a, 0x52, 0x9d, 0x52, 0x6e, 0x55, 0x68, 0xf9,
-	0xae, 0x6c, 0x97, 0x2d, 0xd7, 0x22, 0x03, 0x46, 0x5e, 0x97, 0xbd, 0x09, 0x32, 0x4c, 0x90, 0xab,
-	0x53, 0x52, 0xc0, 0x6a, 0xc5, 0xa0, 0xa6, 0xeb, 0x19, 0xf1, 0x5f, 0xdc, 0x4a, 0x3a, 0xa2, 0x5b,
-	0x4e, 0xc9, 0x72, 0x94, 0xbc, 0xe6, 0x50, 0xee, 0x4e, 0xa9, 0x4e, 0xe5, 0xa9, 0xab, 0x4d, 0x29,
-	0xb6, 0x56, 0x34, 0x4c, 0xcd, 0x35, 0x2c, 0x13, 0xe6, 0x1e, 0x88, 0x82, 0x20, 0x16, 0xe3, 0x53,
-	0x46, 0x8b, 0x96, 0x55, 0x5c, 0xa1, 0x8a, 0x66, 0x1b, 0x8a, 0x66, 0x9a, 0x96, 0xcb, 0xec, 0x1d,
-	0x78, 0xbb, 0x1f, 0xde, 0xb2, 0xa7, 0x7c, 0xe5, 0xa6, 0xa2, 0x99, 0x80, 0x5e, 0x1a, 0x2c, 0x5a,
-	0x45, 0x8b, 0xfd, 0x54, 0xbc, 0x5f, 0x49, 0x2b, 0x56, 0xec, 0x62, 0x59, 0x2b, 0x50, 0x3e, 0x25,
-	0x7b, 0x11, 0x0f, 0x5c, 0xf1, 0x60, 0xcf, 0xf1, 0x09, 0x2a, 0xbd, 0x55, 0xa1, 0x8e, 0x4b, 0xf6,
-	0xe1, 0x6e, 0xdb, 0x2a, 0xbb, 0x39, 0xa3, 0x30, 0x8c, 0x26, 0xd0, 0x64, 0xaf, 0xda, 0xe5, 0x3d,
-	0xce, 0x17, 0xc8, 0x18, 0xc6, 0xe0, 0xcb, 0x7b, 0xd7, 0xce, 0xde, 0xf5, 0xc2, 0xc8, 0x7c, 0x21,
-	0xfb, 0x10, 0xe1, 0xc1, 0xb0, 0x3f, 0xc7, 0xb6, 0x4c, 0x87, 0x92, 0x13, 0xb8, 0x1b, 0x66, 0x31,
-	0x87, 0x3b, 0xa7, 0x47, 0xe5, 0x08, 0xc1, 0x65, 0x61, 0x26, 0x26, 0x93, 0x41, 0xbc, 0xc3, 0x2e,
-	0x5b, 0xd6, 0x4d, 0xb6, 0x54, 0x9f, 0xca, 0x1f, 0xc8, 0x1c, 0xee, 0x63, 0x3f, 0x72, 0x4b, 0xd4,
-	0x28, 0x2e, 0xb9, 0xc3, 0x1d, 0xcc, 0xa5, 0x14, 0x70, 0xc9, 0x83, 0x54, 0x9d, 0x92, 0xcf, 0xb3,
-	0x19, 0xb3, 0x9d, 0x8f, 0x9e, 0x8d, 0xb7, 0xa9, 0x3b, 0x99, 0x15, 0x1f, 0xca, 0xbe, 0x15, 0x86,
-	0xea, 0x08, 0xee, 0xff, 0xc3, 0xb8, 0x16, 0x3b, 0x40, 0xfb, 0x37, 0x99, 0x07, 0x5a, 0xf6, 0x02,
-	0x2d, 0xf3, 0x7d, 0x03, 0x81, 0x96, 0x17, 0xb4, 0x22, 0x05, 0x5b, 0x35, 0x60, 0x99, 0x7d, 0x86,
-	0xf0, 0x50, 0xdd, 0x02, 0x20, 0xc6, 0x2c, 0xee, 0x01, 0x7e, 0xce, 0x30, 0x9a, 0xe8, 0x60, 0xfe,
-	0xa3, 0xd4, 0x98, 0x2f, 0x50, 0xd3, 0x35, 0x6e, 0x1a, 0xb4, 0x20, 0x74, 0xf1, 0xed, 0xc8, 0xb9,
-	0x10, 0xca, 0x76, 0x86, 0xf2, 0xd0, 0x86, 0x28, 0x39, 0x80, 0x20, 0x4c, 0x72, 0x12, 0x77, 0x35,
-	0xa9, 0x22, 0xcc, 0xcf, 0xde, 0x47, 0x38, 0xc3, 0x09, 0x5a, 0xa6, 0x49, 0x75, 0xcf, 0x5b, 0xbd,
-	0x96, 0x19, 0x8c, 0x75, 0xff, 0x25, 0x6c, 0xa5, 0xc0, 0x48, 0x9d, 0xd6, 0xed, 0x5b, 0xd6, 0xfa,
-	0x57, 0x84, 0xc7, 0x63, 0xa1, 0xfc, 0xb9, 0x54, 0xbf, 0x26, 0x44, 0xe7, 0x98, 0xe6, 0xd8, 0xec,
-	0x45, 0x57, 0x73, 0x69, 0xda, 0xc3, 0xfb, 0x93, 0x2f, 0x62, 0x84, 0x6b, 0x10, 0x51, 0xc3, 0xfb,
-	0x0c, 0x5f, 0x9f, 0x1c, 0x87, 0x9a, 0x73, 0xbc, 0x29, 0x70, 0x52, 0x0e, 0x47, 0x11, 0x09, 0x48,
-	0x1a, 0xf0, 0x39, 0x64, 0x44, 0x0d, 0xb7, 0xf2, 0xc8, 0x7f, 0x85, 0xf0, 0x81, 0x10, 0x43, 0x8f,
-	0x93, 0xe9, 0x54, 0x9c, 0xed, 0xd0, 0x8f, 0x1c, 0xc2, 0x7b, 0xca, 0xb4, 0x6a, 0x38, 0x86, 0x65,
-	0xe6, 0xcc, 0x4a, 0x29, 0x4f, 0xcb, 0x0c, 0x65, 0xa7, 0xba, 0x5b, 0x0c, 0x5f, 0x62, 0xa3, 0xa1,
-	0x89, 0x40, 0xa7, 0x33, 0x3c, 0x11, 0xf0, 0x3e, 0x45, 0x38, 0x9b, 0x84, 0x17, 0x82, 0xf2, 0x6f,
-	0xbc, 0x47, 0x17, 0x6f, 0x42, 0xc1, 0x18, 0x94, 0xf9, 0x27, 0x43, 0x16, 0x9f, 0x0c, 0xf9, 0xac,
-	0x79, 0x57, 0xdd, 0xad, 0x87, 0xdc, 0x90, 0x11, 0xdc, 0x0b, 0x81, 0xf4, 0x59, 0xf5, 0xf0, 0x81,
-	0xf9, 0x42, 0x2d, 0x1a, 0x1d, 0x49, 0xd1, 0xe8, 0xdc, 0x4a, 0x34, 0xca, 0x78, 0x94, 0x91, 0x5b,
-	0xd0, 0xf4, 0x65, 0xea, 0xce, 0x59, 0xa5, 0x92, 0xe1, 0x96, 0xa8, 0xe9, 0xa6, 0x8d, 0x83, 0x84,
-	0x7b, 0x1c, 0xcf, 0x85, 0xa9, 0x53, 0x08, 0x80, 0xff, 0x9c, 0xfd, 0x14, 0xe1, 0xb1, 0x98, 0x45,
-	0x41, 0x4c, 0x96, 0xb2, 0xc4, 0x28, 0x5b, 0xb8, 0x4f, 0x0d, 0x8c, 0xb4, 0x72, 0x7b, 0x7e, 0x16,
-	0x07, 0xce, 0x49, 0x2b, 0x49, 0x38, 0xcf, 0x76, 0x6c, 0x39, 0xcf, 0xbe, 0x14, 0x29, 0x3f, 0x02,
-	0xa1, 0x9f, 0x66, 0x77, 0xd6, 0xd4, 0x12, 0x99, 0x76, 0x22, 0x32, 0xd3, 0x72, 0x27, 0x7c, 0x2f,
-	0x07, 0x8d, 0x5e, 0x85, 0x34, 0x6b, 0xe1, 0xfd, 0x01, 0xa2, 0x2a, 0xd5, 0xa9, 0x61, 0xb7, 0x74,
-	0x67, 0x3e, 0x40, 0x58, 0x8a, 0x5a, 0x11, 0x64, 0x95, 0x70, 0x4f, 0xd9, 0x1b, 0xaa, 0x52, 0xee,
-	0xb7, 0x47, 0xf5, 0x9f, 0x5b, 0x79, 0x46, 0x6f, 0x43, 0xc2, 0xe4, 0xa0, 0xce, 0xea, 0xcb, 0xa6,
-	0x75, 0x7b, 0x85, 0x16, 0x8a, 0xb4, 0xd5, 0x07, 0xf5, 0xa1, 0x48, 0x7d, 0x31, 0x2b, 0x83, 0x2c,
-	0x93, 0x78, 0x8f, 0x16, 0x7e, 0x05, 0x47, 0xb6, 0x7e, 0xb8, 0x95, 0xe7, 0xf6, 0x45, 0x22, 0xd6,
-	0x57, 0xe5, 0xf0, 0x92, 0x33, 0x78, 0xc4, 0x66, 0x00, 0x73, 0xb5, 0xb3, 0x96, 0x13, 0x82, 0x3b,
-	0xc3, 0x9d, 0x13, 0x1d, 0x93, 0x9d, 0xea, 0x7e, 0xbb, 0xee, 0x64, 0x2f, 0x8a, 0x09, 0xd9, 0xdf,
-	0x11, 0x3e, 0x98, 0x48, 0x13, 0x62, 0xf2, 0x7f, 0xdc, 0x5f, 0x27, 0xfe, 0xe6, 0xd3, 0x40, 0x83,
-	0xe5, 0xab, 0x90, 0x0b, 0x3e, 0x11, 0x79, 0xf9, 0xaa, 0x29, 0xce, 0x1c, 0xc7, 0x9c, 0x3a, 0xb4,
-	0x1b, 0x84, 0xa4, 0x63, 0xa3, 0x90, 0xdc, 0x81, 0x74, 0x1c, 0x01, 0x0c, 0x82, 0x31, 0x8a, 0x7b,
-	0x6b, 0xfe, 0x10, 0xf3, 0x57, 0x1b, 0x08, 0x68, 0xd2, 0xde, 0xa4, 0x26, 0xf7, 0x44, 0xba, 0xaa,
-	0x2d, 0x7d, 0x56, 0x5f, 0x4e, 0x2d, 0xc8, 0x31, 0x3c, 0x08, 0x82, 0x68, 0xfa, 0x72, 0x83, 0x12,
-	0xc4, 0x16, 0x3b, 0xaf, 0x26, 0x41, 0x05, 0x8f, 0x44, 0xe2, 0x68, 0x31, 0xff, 0xeb, 0x70, 0x57,
-	0xbe, 0x44, 0xef, 0xf8, 0xf1, 0x50, 0x39, 0x80, 0xb4, 0xf7, 0xf0, 0x6f, 0x10, 0x9e, 0x88, 0xf7,
-	0x0d, 0xbc, 0xa6, 0xf1, 0x90, 0x49, 0xef, 0xd4, 0x36, 0x4b, 0x0e, 0xd8, 0xb3, 0xa5, 0x3a, 0xd5,
-	0x01, 0xb3, 0xd1, 0xb6, 0x95, 0x29, 0xf0, 0x75, 0xb8, 0xcb, 0x05, 0x21, 0x2f, 0x52, 0xb3, 0x90,
-	0x56, 0x8b, 0x2f, 0xc4, 0xd1, 0x6b, 0x74, 0x0c, 0x42, 0xfc, 0x1d, 0x93, 0xb0, 0x10, 0x0e, 0x35,
-	0x0b, 0xa0, 0x42, 0xbf, 0x59, 0x67, 0xd5, 0x4a, 0x09, 0x54, 0x3c, 0xcc, 0x37, 0x22, 0x6f, 0xb0,
-	0xfc, 0xb7, 0x5c, 0xb6, 0xca, 0x69, 0xe9, 0x7f, 0x87, 0xe0, 0x1a, 0x12, 0x76, 0xea, 0x27, 0xda,
-	0x5d, 0xd4, 0x1b, 0xe0, 0xb1, 0xb7, 0x5d, 0xb8, 0xf5, 0x1f, 0x88, 0xcc, 0xb2, 0x60, 0xca, 0x26,
-	0x02, 0xfc, 0x3e, 0x1a, 0x18, 0x6b, 0xa5, 0x34, 0xa2, 0xcb, 0x04, 0x2c, 0xd2, 0xaa, 0xf2, 0xb5,
-	0xe8, 0x32, 0xf9, 0xfe, 0x40, 0x90, 0xd3, 0xb8, 0x1b, 0xda, 0x5b, 0x89, 0x5d, 0x26, 0x30, 0x03,
-	0xa4, 0xc2, 0xa4, 0x95, 0x02, 0x8c, 0x40, 0x18, 0xa1, 0x8e, 0x5b, 0xd0, 0xca, 0x5a, 0x49, 0xe4,
-	0xca, 0xec, 0x15, 0xc8, 0xa4, 0x75, 0x2f, 0x81, 0xd3, 0x0c, 0xee, 0xb2, 0xd9, 0x08, 0x50, 0x1a,
-	0x89, 0xf9, 0x86, 0x32, 0x23, 0x98, 0x3a, 0xfd, 0xcb, 0x08, 0xde, 0xc1, 0x7c, 0x92, 0xcf, 0x11,
-	0xee, 0x06, 0xc7, 0x64, 0x32, 0xd2, 0x34, 0xa2, 0xff, 0x27, 0x1d, 0xde, 0xc4, 0x4c, 0x8e, 0x2f,
-	0x3b, 0xfb, 0xfe, 0x93, 0x17, 0x0f, 0xda, 0x4f, 0x93, 0x7f, 0x29, 0x09, 0xfd, 0x4d, 0x47, 0x59,
-	0xad, 0x05, 0x74, 0x4d, 0xf1, 0xc2, 0xec, 0x28, 0xab, 0x10, 0xfc, 0x35, 0x72, 0x1f, 0xe1, 0x1e,
-	0xd1, 0xaf, 0x21, 0x1b, 0xaf, 0x2d, 0x94, 0x93, 0x8e, 0x6c, 0x66, 0x2a, 0xe0, 0xfc, 0x2b, 0xc3,
-	0x39, 0x4e, 0xc6, 0x12, 0x71, 0x92, 0x6f, 0x11, 0x26, 0x8d, 0x4d, 0x24, 0x32, 0x93, 0xb0, 0x52,
-	0x5c, 0xf7, 0x4b, 0x3a, 0xde, 0x9c, 0x11, 0x00, 0x3d, 0xc3, 0x80, 0x9e, 0x24, 0x27, 0xa2, 0x81,
-	0xfa, 0x86, 0x9e, 0xa6, 0xfe, 0xc3, 0x5a, 0x8d, 0xc1, 0x63, 0x8f, 0x41, 0x43, 0x07, 0x27, 0x91,
-	0x41, 0x5c, 0x2b, 0x29, 0x91, 0x41, 0x6c, 0x93, 0x28, 0x7b, 0x99, 0x31, 0x98, 0x27, 0xe7, 0xb6,
-	0xbe, 0x25, 0x94, 0x60, 0x6b, 0x89, 0x7c, 0xd4, 0x8e, 0x87, 0x22, 0x5b, 0x20, 0xe4, 0xc4, 0xc6,
-	0x00, 0xa3, 0x7a, 0x3c, 0xd2, 0x3f, 0x9b, 0xb6, 0x03, 0x6e, 0x1f, 0x20, 0x46, 0xee, 0x3d, 0x44,
-	0xde, 0x4d, 0xc3, 0x2e, 0xdc, 0xae, 0x51, 0x44, 0xdf, 0x47, 0x59, 0xad, 0xeb, 0x20, 0xad, 0x29,
-	0x3c, 0xed, 0x04, 0x5e, 0xf0, 0x81, 0x35, 0xf2, 0x14, 0xe1, 0xfe, 0xfa, 0x32, 0x9c, 0x4c, 0xc5,
-	0xf3, 0x8a, 0x69, 0xb3, 0x48, 0xd3, 0xcd, 0x98, 0x80, 0x0a, 0x6f, 0x33, 0x11, 0x6e, 0x90, 0x6b,
-	0x29, 0x34, 0x68, 0xb8, 0xf8, 0x3a, 0xca, 0xaa, 0xf8, 0x88, 0xaf, 0x91, 0x27, 0x08, 0xef, 0x6d,
-	0x68, 0x32, 0x90, 0x26, 0xb0, 0xfa, 0xa7, 0x70, 0xa6, 0x29, 0x1b, 0x20, 0x78, 0x95, 0x11, 0xbc,
-	0x4c, 0x2e, 0x6e, 0x2b, 0x41, 0xf2, 0x3d, 0xc2, 0xbb, 0x42, 0xf5, 0x3d, 0x91, 0x37, 0x42, 0x17,
-	0x6e, 0x3d, 0x48, 0xca, 0xa6, 0xe7, 0x03, 0x93, 0x37, 0x19, 0x93, 0x37, 0xc8, 0xd5, 0xf4, 0x4c,
-	0xe0, 0x9a, 0x11, 0x8a, 0xd3, 0x3a, 0xc2, 0x43, 0x91, 0xf5, 0x60, 0xd2, 0xd1, 0x4c, 0xea, 0x26,
-	0x24, 0x1d, 0xcd, 0xc4, 0x5e, 0x40, 0xf6, 0x3a, 0x63, 0xba, 0x48, 0xae, 0xa4, 0x67, 0xaa, 0xe9,
-	0xcb, 0x21, 0x96, 0x2f, 0x11, 0xfe, 0x4b, 0x74, 0xd5, 0x4b, 0x9a, 0x85, 0xeb, 0xef, 0xcb, 0x93,
-	0xcd, 0x1b, 0x02, 0xd1, 0x1b, 0x8c, 0xe8, 0x6b, 0x44, 0xdd, 0x16, 0xa2, 0x61, 0x3a, 0xf7, 0xda,
-	0xf1, 0xde, 0x86, 0x6a, 0x32, 0xe9, 0xdc, 0xc5, 0xd5, 0xc4, 0x49, 0xe7, 0x2e, 0xb6, 0x5c, 0xdd,
-	0xa6, 0xf4, 0x1a, 0x95, 0x5a, 0x12, 0xea, 0xec, 0x35, 0xa5, 0xe2, 0x03, 0xca, 0xd9, 0x40, 0xf9,
-	0x37, 0x84, 0x77, 0x87, 0x6b, 0x4a, 0xa2, 0x6c, 0x86, 0x51, 0xa0, 0x0a, 0x96, 0x8e, 0x6d, 0xde,
-	0x00, 0xf8, 0xbf, 0xc3, 0xe8, 0x57, 0x89, 0xdb, 0x1a, 0xf6, 0xa1, 0xa2, 0x3a, 0x44, 0xdb, 0xdb,
-	0xf1, 0xe4, 0x07, 0x84, 0x07, 0x22, 0x8a, 0x4e, 0x92, 0x70, 0x0d, 0x88, 0xaf, 0x7f, 0xa5, 0x7f,
-	0x34, 0x69, 0x05, 0x12, 0x2c, 0x30, 0x09, 0x2e, 0x90, 0xf3, 0x29, 0x24, 0x08, 0x55, 0x84, 0xde,
-	0x8d, 0xa8, 0xbf, 0xbe, 0x7e, 0x4c, 0xfa, 0x52, 0xc6, 0x14, 0xb1, 0x49, 0x5f, 0xca, 0xb8, 0xf2,
-	0x74, 0x5b, 0x3e, 0x24, 0x8d, 0xf5, 0xad, 0x77, 0x4d, 0xed, 0x0b, 0xd6, 0x84, 0xe4, 0x68, 0xc2,
-	0x56, 0x6b, 0x2c, 0x48, 0x25, 0x79, 0xb3, 0xd3, 0xb7, 0x31, 0x28, 0x50, 0x67, 0xe5, 0x58, 0xd5,
-	0x49, 0xbe, 0x44, 0xb8, 0x1b, 0x96, 0x4a, 0x2a, 0x4c, 0xc2, 0x25, 0x63, 0x52, 0x61, 0x52, 0x57,
-	0x0c, 0x66, 0x2f, 0x30, 0xc8, 0xff, 0x21, 0xb3, 0xe9, 0x21, 0x93, 0x8f, 0x11, 0xde, 0x15, 0x2a,
-	0xcf, 0x92, 0xbe, 0xdb, 0x51, 0x45, 0x5e, 0xd2, 0x77, 0x3b, 0xb2, 0xee, 0xcb, 0x1e, 0x64, 0xf0,
-	0xc7, 0xc8, 0x48, 0x24, 0x7c, 0x5e, 0xe7, 0xcd, 0x2e, 0x3e, 0x7a, 0x9e, 0x41, 0x8f, 0x9f, 0x67,
-	0xd0, 0xcf, 0xcf, 0x33, 0xe8, 0xc3, 0xf5, 0x4c, 0xdb, 0xe3, 0xf5, 0x4c, 0xdb, 0x8f, 0xeb, 0x99,
-	0xb6, 0x1b, 0xa7, 0x8a, 0x86, 0xbb, 0x54, 0xc9, 0xcb, 0xba, 0x55, 0x52, 0xe0, 0x9f, 0x54, 0x8c,
-	0xbc, 0x7e, 0xb4, 0x68, 0x29, 0xd5, 0x53, 0x4a, 0xc9, 0x2a, 0x54, 0x56, 0xa8, 0xc3, 0xbd, 0x1e,
-	0x3b, 0x7e, 0x54, 0x38, 0x76, 0xef, 0xda, 0xd4, 0xc9, 0x77, 0xb1, 0xbf, 0x16, 0xce, 0xfc, 0x11,
-	0x00, 0x00, 0xff, 0xff, 0x49, 0x5b, 0x44, 0x98, 0x34, 0x23, 0x00, 0x00,
+func (m *QueryPausedChannelsRequest) Reset()         { *m = QueryPausedChannelsRequest{} }
+func (m *QueryPausedChannelsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelsRequest) ProtoMessage()    {}
+func (*QueryPausedChannelsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{36}
+}
+func (m *QueryPausedChannelsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelsRequest.Merge(m, src)
+}
+func (m *QueryPausedChannelsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryPausedChannelsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryPausedChannelsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Channel queries an IBC Channel.
-	Channel(ctx context.Context, in *QueryChannelRequest, opts ...grpc.CallOption) (*QueryChannelResponse, error)
-	// Channels queries all the IBC channels of a chain.
-	Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error)
+// QueryPausedChannelsResponse is the response type for the Query/PausedChannels RPC method
+type QueryPausedChannelsResponse struct {
+	// list of paused channels of the chain.
+	Channels []*IdentifiedChannel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
+	// pagination response
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// query block height
+	Height types.Height `protobuf:"bytes,3,opt,name=height,proto3" json:"height"`
+}
+
+func (m *QueryPausedChannelsResponse) Reset()         { *m = QueryPausedChannelsResponse{} }
+func (m *QueryPausedChannelsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPausedChannelsResponse) ProtoMessage()    {}
+func (*QueryPausedChannelsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{37}
+}
+func (m *QueryPausedChannelsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPausedChannelsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPausedChannelsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPausedChannelsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPausedChannelsResponse.Merge(m, src)
+}
+func (m *QueryPausedChannelsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPausedChannelsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPausedChannelsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPausedChannelsResponse proto.InternalMessageInfo
+
+func (m *QueryPausedChannelsResponse) GetChannels() []*IdentifiedChannel {
+	if m != nil {
+		return m.Channels
+	}
+	return nil
+}
+
+func (m *QueryPausedChannelsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func (m *QueryPausedChannelsResponse) GetHeight() types.Height {
+	if m != nil {
+		return m.Height
+	}
+	return types.Height{}
+}
+
+// QueryChannelParamsRequest is the request type for the Query/ChannelParams RPC method.
+type QueryChannelParamsRequest struct {
+}
+
+func (m *QueryChannelParamsRequest) Reset()         { *m = QueryChannelParamsRequest{} }
+func (m *QueryChannelParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelParamsRequest) ProtoMessage()    {}
+func (*QueryChannelParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{38}
+}
+func (m *QueryChannelParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelParamsRequest.Merge(m, src)
+}
+func (m *QueryChannelParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelParamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelParamsRequest proto.InternalMessageInfo
+
+// QueryChannelParamsResponse is the response type for the Query/ChannelParams RPC method.
+type QueryChannelParamsResponse struct {
+	// params defines the parameters of the module.
+	Params *Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params,omitempty"`
+}
+
+func (m *QueryChannelParamsResponse) Reset()         { *m = QueryChannelParamsResponse{} }
+func (m *QueryChannelParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryChannelParamsResponse) ProtoMessage()    {}
+func (*QueryChannelParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1034a1e9abc4cca1, []int{39}
+}
+func (m *QueryChannelParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryChannelParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryChannelParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryChannelParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryChannelParamsResponse.Merge(m, src)
+}
+func (m *QueryChannelParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryChannelParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryChannelParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryChannelParamsResponse proto.InternalMessageInfo
+
+func (m *QueryChannelParamsResponse) GetParams() *Params {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryChannelRequest)(nil), "ibc.core.channel.v1.QueryChannelRequest")
+	proto.RegisterType((*QueryChannelResponse)(nil), "ibc.core.channel.v1.QueryChannelResponse")
+	proto.RegisterType((*QueryChannelsRequest)(nil), "ibc.core.channel.v1.QueryChannelsRequest")
+	proto.RegisterType((*QueryChannelsResponse)(nil), "ibc.core.channel.v1.QueryChannelsResponse")
+	proto.RegisterType((*QueryConnectionChannelsRequest)(nil), "ibc.core.channel.v1.QueryConnectionChannelsRequest")
+	proto.RegisterType((*QueryConnectionChannelsResponse)(nil), "ibc.core.channel.v1.QueryConnectionChannelsResponse")
+	proto.RegisterType((*QueryChannelClientStateRequest)(nil), "ibc.core.channel.v1.QueryChannelClientStateRequest")
+	proto.RegisterType((*QueryChannelClientStateResponse)(nil), "ibc.core.channel.v1.QueryChannelClientStateResponse")
+	proto.RegisterType((*QueryChannelConsensusStateRequest)(nil), "ibc.core.channel.v1.QueryChannelConsensusStateRequest")
+	proto.RegisterType((*QueryChannelConsensusStateResponse)(nil), "ibc.core.channel.v1.QueryChannelConsensusStateResponse")
+	proto.RegisterType((*QueryPacketCommitmentRequest)(nil), "ibc.core.channel.v1.QueryPacketCommitmentRequest")
+	proto.RegisterType((*QueryPacketCommitmentResponse)(nil), "ibc.core.channel.v1.QueryPacketCommitmentResponse")
+	proto.RegisterType((*QueryPacketCommitmentsRequest)(nil), "ibc.core.channel.v1.QueryPacketCommitmentsRequest")
+	proto.RegisterType((*QueryPacketCommitmentsResponse)(nil), "ibc.core.channel.v1.QueryPacketCommitmentsResponse")
+	proto.RegisterType((*QueryForceTimeoutPacketsRequest)(nil), "ibc.core.channel.v1.QueryForceTimeoutPacketsRequest")
+	proto.RegisterType((*QueryForceTimeoutPacketsResponse)(nil), "ibc.core.channel.v1.QueryForceTimeoutPacketsResponse")
+	proto.RegisterType((*QueryPacketReceiptRequest)(nil), "ibc.core.channel.v1.QueryPacketReceiptRequest")
+	proto.RegisterType((*QueryPacketReceiptResponse)(nil), "ibc.core.channel.v1.QueryPacketReceiptResponse")
+	proto.RegisterType((*QueryPacketAcknowledgementRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementRequest")
+	proto.RegisterType((*QueryPacketAcknowledgementResponse)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementResponse")
+	proto.RegisterType((*QueryPacketAcknowledgementsRequest)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementsRequest")
+	proto.RegisterType((*QueryPacketAcknowledgementsResponse)(nil), "ibc.core.channel.v1.QueryPacketAcknowledgementsResponse")
+	proto.RegisterType((*QueryUnreceivedPacketsRequest)(nil), "ibc.core.channel.v1.QueryUnreceivedPacketsRequest")
+	proto.RegisterType((*QueryUnreceivedPacketsResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedPacketsResponse")
+	proto.RegisterType((*QueryUnreceivedAcksRequest)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksRequest")
+	proto.RegisterType((*QueryUnreceivedAcksResponse)(nil), "ibc.core.channel.v1.QueryUnreceivedAcksResponse")
+	proto.RegisterType((*QueryNextSequenceReceiveRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveRequest")
+	proto.RegisterType((*QueryNextSequenceReceiveResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceReceiveResponse")
+	proto.RegisterType((*QueryNextSequenceSendRequest)(nil), "ibc.core.channel.v1.QueryNextSequenceSendRequest")
+	proto.RegisterType((*QueryNextSequenceSendResponse)(nil), "ibc.core.channel.v1.QueryNextSequenceSendResponse")
+	proto.RegisterType((*QueryUpgradeErrorRequest)(nil), "ibc.core.channel.v1.QueryUpgradeErrorRequest")
+	proto.RegisterType((*QueryUpgradeErrorResponse)(nil), "ibc.core.channel.v1.QueryUpgradeErrorResponse")
+	proto.RegisterType((*QueryUpgradeRequest)(nil), "ibc.core.channel.v1.QueryUpgradeRequest")
+	proto.RegisterType((*QueryUpgradeResponse)(nil), "ibc.core.channel.v1.QueryUpgradeResponse")
+	proto.RegisterType((*QueryChannelPausedRequest)(nil), "ibc.core.channel.v1.QueryChannelPausedRequest")
+	proto.RegisterType((*QueryChannelPausedResponse)(nil), "ibc.core.channel.v1.QueryChannelPausedResponse")
+	proto.RegisterType((*QueryPausedChannelsRequest)(nil), "ibc.core.channel.v1.QueryPausedChannelsRequest")
+	proto.RegisterType((*QueryPausedChannelsResponse)(nil), "ibc.core.channel.v1.QueryPausedChannelsResponse")
+	proto.RegisterType((*QueryChannelParamsRequest)(nil), "ibc.core.channel.v1.QueryChannelParamsRequest")
+	proto.RegisterType((*QueryChannelParamsResponse)(nil), "ibc.core.channel.v1.QueryChannelParamsResponse")
+}
+
+func init() { proto.RegisterFile("ibc/core/channel/v1/query.proto", fileDescriptor_1034a1e9abc4cca1) }
+
+var fileDescriptor_1034a1e9abc4cca1 = []byte{
+	// 1911 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4d, 0x6c, 0xdc, 0x5a,
+	0x15, 0xce, 0x4d, 0xf2, 0xf2, 0x73, 0x9a, 0xa6, 0x79, 0x37, 0xc9, 0x7b, 0x89, 0x93, 0x4c, 0x92,
+	0x79, 0xf0, 0x5e, 0xfa, 0xf4, 0x6a, 0xe7, 0xef, 0xf5, 0x07, 0x95, 0x4a, 0x49, 0xa0, 0x6d, 0x2a,
+	0xda, 0xa6, 0x4e, 0x0b, 0x6d, 0x25, 0x18, 0x3c, 0x9e, 0x9b, 0x89, 0x95, 0x8c, 0x3d, 0x1d, 0x7b,
+	0xa6, 0xad, 0x42, 0x10, 0x62, 0x51, 0xba, 0x44, 0x54, 0x08, 0x89, 0x0d, 0x2a, 0x0b, 0x44, 0x91,
+	0x10, 0x62, 0xcd, 0x82, 0x0d, 0x8b, 0xee, 0xa8, 0x54, 0x16, 0x48, 0x45, 0x05, 0x35, 0x95, 0x0a,
+	0x4b, 0x36, 0xac, 0x91, 0xaf, 0x8f, 0x3d, 0xf6, 0x8c, 0xed, 0xcc, 0xc4, 0x19, 0x29, 0xea, 0x2e,
+	0xbe, 0x3e, 0xe7, 0xdc, 0xef, 0xfb, 0xce, 0xf5, 0xb1, 0xe7, 0x6b, 0x61, 0x52, 0xcb, 0xaa, 0x92,
+	0x6a, 0x94, 0x98, 0xa4, 0x6e, 0x2a, 0xba, 0xce, 0xb6, 0xa5, 0xca, 0x9c, 0x74, 0xaf, 0xcc, 0x4a,
+	0x0f, 0xc5, 0x62, 0xc9, 0xb0, 0x0c, 0x3a, 0xa8, 0x65, 0x55, 0xd1, 0x0e, 0x10, 0x31, 0x40, 0xac,
+	0xcc, 0x09, 0xbe, 0xac, 0x6d, 0x8d, 0xe9, 0x96, 0x9d, 0xe4, 0xfc, 0xe5, 0x64, 0x09, 0x9f, 0xab,
+	0x86, 0x59, 0x30, 0x4c, 0x29, 0xab, 0x98, 0xcc, 0x29, 0x27, 0x55, 0xe6, 0xb2, 0xcc, 0x52, 0xe6,
+	0xa4, 0xa2, 0x92, 0xd7, 0x74, 0xc5, 0xd2, 0x0c, 0x1d, 0x63, 0xa7, 0xc3, 0x20, 0xb8, 0x9b, 0x39,
+	0x21, 0xe3, 0x79, 0xc3, 0xc8, 0x6f, 0x33, 0x49, 0x29, 0x6a, 0x92, 0xa2, 0xeb, 0x86, 0xc5, 0xf3,
+	0x4d, 0xbc, 0x3b, 0x8a, 0x77, 0xf9, 0x55, 0xb6, 0xbc, 0x21, 0x29, 0x3a, 0xa2, 0x17, 0x86, 0xf2,
+	0x46, 0xde, 0xe0, 0x7f, 0x4a, 0xf6, 0x5f, 0x71, 0x3b, 0x96, 0x8b, 0xf9, 0x92, 0x92, 0x63, 0x4e,
+	0x48, 0xfa, 0x2a, 0x0c, 0xde, 0xb0, 0x61, 0xaf, 0x38, 0x01, 0x32, 0xbb, 0x57, 0x66, 0xa6, 0x45,
+	0x3f, 0x86, 0xee, 0xa2, 0x51, 0xb2, 0x32, 0x5a, 0x6e, 0x84, 0x4c, 0x91, 0x99, 0x5e, 0xb9, 0xcb,
+	0xbe, 0x5c, 0xcd, 0xd1, 0x09, 0x00, 0xac, 0x65, 0xdf, 0x6b, 0xe7, 0xf7, 0x7a, 0x71, 0x65, 0x35,
+	0x97, 0x7e, 0x46, 0x60, 0x28, 0x58, 0xcf, 0x2c, 0x1a, 0xba, 0xc9, 0xe8, 0x69, 0xe8, 0xc6, 0x28,
+	0x5e, 0xf0, 0xd8, 0xfc, 0xb8, 0x18, 0x22, 0xb8, 0xe8, 0xa6, 0xb9, 0xc1, 0x74, 0x08, 0x3e, 0x28,
+	0x96, 0x0c, 0x63, 0x83, 0x6f, 0xd5, 0x27, 0x3b, 0x17, 0x74, 0x05, 0xfa, 0xf8, 0x1f, 0x99, 0x4d,
+	0xa6, 0xe5, 0x37, 0xad, 0x91, 0x0e, 0x5e, 0x52, 0xf0, 0x95, 0x74, 0x9a, 0x54, 0x99, 0x13, 0x2f,
+	0xf3, 0x88, 0xe5, 0xce, 0xe7, 0xaf, 0x27, 0xdb, 0xe4, 0x63, 0x3c, 0xcb, 0x59, 0x4a, 0x7f, 0x2f,
+	0x08, 0xd5, 0x74, 0xb9, 0x5f, 0x04, 0xa8, 0xf6, 0x0e, 0xd1, 0x7e, 0x2a, 0x3a, 0x8d, 0x16, 0xed,
+	0x46, 0x8b, 0xce, 0xb9, 0xc1, 0x46, 0x8b, 0x6b, 0x4a, 0x9e, 0x61, 0xae, 0xec, 0xcb, 0x4c, 0xbf,
+	0x26, 0x30, 0x5c, 0xb3, 0x01, 0x8a, 0xb1, 0x0c, 0x3d, 0xc8, 0xcf, 0x1c, 0x21, 0x53, 0x1d, 0xbc,
+	0x7e, 0x98, 0x1a, 0xab, 0x39, 0xa6, 0x5b, 0xda, 0x86, 0xc6, 0x72, 0xae, 0x2e, 0x5e, 0x1e, 0xbd,
+	0x14, 0x40, 0xd9, 0xce, 0x51, 0x7e, 0xb6, 0x2f, 0x4a, 0x07, 0x80, 0x1f, 0x26, 0x3d, 0x0b, 0x5d,
+	0x4d, 0xaa, 0x88, 0xf1, 0xe9, 0xc7, 0x04, 0x52, 0x0e, 0x41, 0x43, 0xd7, 0x99, 0x6a, 0x57, 0xab,
+	0xd5, 0x32, 0x05, 0xa0, 0x7a, 0x37, 0xf1, 0x28, 0xf9, 0x56, 0xe8, 0xc5, 0x10, 0x16, 0x07, 0xd1,
+	0xfa, 0xdf, 0x04, 0x26, 0x23, 0xa1, 0xbc, 0x5f, 0xaa, 0xdf, 0x76, 0x45, 0x77, 0x30, 0xad, 0xf0,
+	0xe8, 0x75, 0x4b, 0xb1, 0x58, 0xd2, 0x87, 0xf7, 0x9f, 0x9e, 0x88, 0x21, 0xa5, 0x51, 0x44, 0x05,
+	0x3e, 0xd6, 0x3c, 0x7d, 0x32, 0x0e, 0xd4, 0x8c, 0x69, 0x87, 0xe0, 0x93, 0x72, 0x32, 0x8c, 0x88,
+	0x4f, 0x52, 0x5f, 0xcd, 0x61, 0x2d, 0x6c, 0xb9, 0x95, 0x8f, 0xfc, 0xef, 0x09, 0x4c, 0x07, 0x18,
+	0xda, 0x9c, 0x74, 0xb3, 0x6c, 0x1e, 0x86, 0x7e, 0xf4, 0x33, 0x38, 0x51, 0x62, 0x15, 0xcd, 0xd4,
+	0x0c, 0x3d, 0xa3, 0x97, 0x0b, 0x59, 0x56, 0xe2, 0x28, 0x3b, 0xe5, 0x7e, 0x77, 0xf9, 0x1a, 0x5f,
+	0x0d, 0x04, 0x22, 0x9d, 0xce, 0x60, 0x20, 0xe2, 0x7d, 0x45, 0x20, 0x1d, 0x87, 0x17, 0x9b, 0xf2,
+	0x75, 0x38, 0xa1, 0xba, 0x77, 0x02, 0xcd, 0x18, 0x12, 0x9d, 0x57, 0x86, 0xe8, 0xbe, 0x32, 0xc4,
+	0x25, 0xfd, 0xa1, 0xdc, 0xaf, 0x06, 0xca, 0xd0, 0x31, 0xe8, 0xc5, 0x46, 0x7a, 0xac, 0x7a, 0x9c,
+	0x85, 0xd5, 0x5c, 0xb5, 0x1b, 0x1d, 0x71, 0xdd, 0xe8, 0x3c, 0x48, 0x37, 0x4a, 0x30, 0xce, 0xc9,
+	0xad, 0x29, 0xea, 0x16, 0xb3, 0x56, 0x8c, 0x42, 0x41, 0xb3, 0x0a, 0x4c, 0xb7, 0x92, 0xf6, 0x41,
+	0x80, 0x1e, 0xd3, 0x2e, 0xa1, 0xab, 0x0c, 0x1b, 0xe0, 0x5d, 0xa7, 0x7f, 0x49, 0x60, 0x22, 0x62,
+	0x53, 0x14, 0x93, 0x8f, 0x2c, 0x77, 0x95, 0x6f, 0xdc, 0x27, 0xfb, 0x56, 0x5a, 0x79, 0x3c, 0x7f,
+	0x15, 0x05, 0xce, 0x4c, 0x2a, 0x49, 0x70, 0xce, 0x76, 0x1c, 0x78, 0xce, 0xbe, 0x73, 0x47, 0x7e,
+	0x08, 0x42, 0x6f, 0xcc, 0x1e, 0xab, 0xaa, 0xe5, 0x4e, 0xda, 0xa9, 0xd0, 0x49, 0xeb, 0x14, 0x71,
+	0xce, 0xb2, 0x3f, 0xe9, 0x28, 0x8c, 0xd9, 0xa7, 0xee, 0x30, 0xbc, 0x68, 0x94, 0x54, 0x76, 0x53,
+	0x2b, 0x30, 0xa3, 0x6c, 0x39, 0x80, 0x8f, 0x4c, 0x37, 0xfe, 0x43, 0x60, 0x2a, 0x1a, 0xe3, 0xfb,
+	0xd5, 0x0f, 0x03, 0x46, 0x7d, 0x07, 0x4f, 0x66, 0x2a, 0xd3, 0x8a, 0x2d, 0x9d, 0x14, 0x4f, 0x08,
+	0x08, 0x61, 0x3b, 0xa2, 0xac, 0x02, 0xf4, 0x94, 0xec, 0xa5, 0x0a, 0x73, 0xea, 0xf6, 0xc8, 0xde,
+	0x75, 0x2b, 0x67, 0xe6, 0x7d, 0x98, 0xf6, 0x81, 0x5a, 0x52, 0xb7, 0x74, 0xe3, 0xfe, 0x36, 0xcb,
+	0xe5, 0x59, 0xab, 0x07, 0xe7, 0x33, 0xf7, 0x55, 0x14, 0xb1, 0x33, 0xca, 0x32, 0x03, 0x27, 0x94,
+	0xe0, 0x2d, 0x1c, 0xa1, 0xb5, 0xcb, 0xad, 0x9c, 0xa3, 0x6f, 0x63, 0xb1, 0x1e, 0x95, 0xc7, 0x97,
+	0x5e, 0x80, 0xb1, 0x22, 0x07, 0x98, 0xa9, 0x3e, 0x6b, 0x19, 0x57, 0x70, 0x73, 0xa4, 0x73, 0xaa,
+	0x63, 0xa6, 0x53, 0x1e, 0x2d, 0xd6, 0x4c, 0xda, 0x75, 0x37, 0x20, 0xfd, 0x3f, 0x02, 0x9f, 0xc4,
+	0xd2, 0xc4, 0x9e, 0x7c, 0x0b, 0x06, 0x6a, 0xc4, 0x6f, 0x7c, 0x0c, 0xd4, 0x65, 0x1e, 0x85, 0x59,
+	0xf0, 0x0b, 0xf7, 0x3d, 0x79, 0x4b, 0x77, 0x9f, 0xb9, 0x43, 0x9a, 0xcc, 0xfb, 0xb4, 0xa4, 0x63,
+	0xbf, 0x96, 0x3c, 0x80, 0x54, 0x14, 0x30, 0x6c, 0xc6, 0x38, 0xf4, 0x56, 0xeb, 0x11, 0x5e, 0xaf,
+	0xba, 0xe0, 0xd3, 0xa4, 0xbd, 0x49, 0x4d, 0x1e, 0xb9, 0xe3, 0xaa, 0xba, 0xf5, 0x92, 0xba, 0x95,
+	0x58, 0x90, 0x59, 0x18, 0x42, 0x41, 0x14, 0x75, 0xab, 0x4e, 0x09, 0x5a, 0x74, 0x4f, 0x5e, 0x55,
+	0x82, 0x32, 0x8c, 0x85, 0xe2, 0x68, 0x31, 0xff, 0x3b, 0xf8, 0xba, 0xbe, 0xc6, 0x1e, 0x78, 0xfd,
+	0x90, 0x1d, 0x00, 0x49, 0x7f, 0x17, 0xfd, 0xd1, 0x7d, 0xcd, 0x86, 0xd6, 0x46, 0x5e, 0xf3, 0x30,
+	0xac, 0xb3, 0x07, 0xd5, 0xc3, 0x92, 0x41, 0xf6, 0x7c, 0xab, 0x4e, 0x79, 0x50, 0xaf, 0xcf, 0x6d,
+	0xe5, 0x08, 0xfc, 0x36, 0x8c, 0xd7, 0x41, 0x5e, 0x67, 0x7a, 0x2e, 0xa9, 0x16, 0xbf, 0x75, 0x1f,
+	0xbd, 0xfa, 0xc2, 0x28, 0xc4, 0x17, 0x40, 0x83, 0x42, 0x98, 0x4c, 0xcf, 0xa1, 0x0a, 0x03, 0x7a,
+	0x4d, 0x56, 0x2b, 0x25, 0x90, 0x61, 0xc4, 0x39, 0x88, 0x8e, 0xe1, 0xf5, 0xcd, 0x52, 0xc9, 0x28,
+	0x25, 0xa5, 0xff, 0x17, 0x02, 0xa3, 0x21, 0x45, 0xbd, 0x41, 0x7b, 0x9c, 0xd9, 0x0b, 0x4e, 0xef,
+	0x8b, 0x16, 0xfe, 0x0a, 0x9b, 0x0e, 0x9d, 0xb2, 0x98, 0xca, 0x03, 0x11, 0x7e, 0x1f, 0xf3, 0xad,
+	0xb5, 0x52, 0x1a, 0xd7, 0xf5, 0x43, 0x16, 0x49, 0x55, 0xf9, 0x83, 0xeb, 0xfa, 0x79, 0xf5, 0x50,
+	0x90, 0xf3, 0xd0, 0x8d, 0x76, 0x63, 0xac, 0xeb, 0x87, 0x69, 0x88, 0xd4, 0x4d, 0x69, 0xa5, 0x00,
+	0xeb, 0x30, 0xea, 0xff, 0x5d, 0xbd, 0xa6, 0x94, 0x4d, 0x96, 0xf8, 0xd9, 0x58, 0x04, 0x21, 0xac,
+	0x28, 0x6a, 0xf1, 0x11, 0x74, 0x15, 0xf9, 0x0a, 0x2f, 0xda, 0x23, 0xe3, 0x55, 0x3a, 0xe7, 0x7d,
+	0x66, 0xda, 0x97, 0xad, 0x32, 0x23, 0xdf, 0x12, 0x18, 0x0b, 0xdd, 0xe6, 0xfd, 0x32, 0xc7, 0xc6,
+	0x6a, 0xfb, 0x5a, 0x52, 0x0a, 0xae, 0x96, 0xe9, 0x1b, 0x20, 0x84, 0xdd, 0x44, 0x05, 0x16, 0xec,
+	0xfe, 0xd8, 0x2b, 0xa8, 0xf2, 0x58, 0xc4, 0xb7, 0x11, 0x4f, 0xc2, 0xd0, 0xf9, 0xdf, 0x4c, 0xc1,
+	0x07, 0xbc, 0x26, 0xfd, 0x35, 0x81, 0x6e, 0x2c, 0x4c, 0x67, 0x42, 0x53, 0x43, 0x7c, 0x76, 0xe1,
+	0x64, 0x03, 0x91, 0x0e, 0xbe, 0xf4, 0xf2, 0x8f, 0x5f, 0xbe, 0x7d, 0xd2, 0x7e, 0x9e, 0x7e, 0x4d,
+	0x8a, 0xf9, 0x77, 0x04, 0x53, 0xda, 0xa9, 0x9e, 0xd0, 0x5d, 0xc9, 0x3e, 0xb7, 0xa6, 0xb4, 0x83,
+	0xa7, 0x79, 0x97, 0x3e, 0x26, 0xd0, 0xe3, 0xb6, 0x9e, 0xee, 0xbf, 0xb7, 0xab, 0x9c, 0xf0, 0x79,
+	0x23, 0xa1, 0x88, 0xf3, 0xab, 0x1c, 0xe7, 0x24, 0x9d, 0x88, 0xc5, 0x49, 0xff, 0x4c, 0x80, 0xd6,
+	0x9b, 0xb5, 0x74, 0x21, 0x66, 0xa7, 0x28, 0x97, 0x59, 0x58, 0x6c, 0x2e, 0x09, 0x81, 0x5e, 0xe0,
+	0x40, 0xcf, 0xd2, 0xd3, 0xe1, 0x40, 0xbd, 0x44, 0x5b, 0x53, 0xef, 0x62, 0xb7, 0xca, 0xe0, 0x85,
+	0xcd, 0xa0, 0xce, 0x29, 0x8d, 0x65, 0x10, 0x65, 0xd9, 0x0a, 0x8b, 0xcd, 0x25, 0x21, 0x83, 0xeb,
+	0x9c, 0xc1, 0x2a, 0xbd, 0x74, 0xf0, 0x23, 0x21, 0xf9, 0x2d, 0x5c, 0xfa, 0xb3, 0x76, 0x18, 0x0e,
+	0xb5, 0x1a, 0xe9, 0xe9, 0xfd, 0x01, 0x86, 0x79, 0xa9, 0xc2, 0x99, 0xa6, 0xf3, 0x90, 0xdb, 0x4f,
+	0x08, 0x27, 0xf7, 0x23, 0x42, 0x7f, 0x98, 0x84, 0x5d, 0xd0, 0x16, 0x95, 0x5c, 0x7f, 0x55, 0xda,
+	0xa9, 0x71, 0x6a, 0x77, 0x25, 0x67, 0x82, 0xf8, 0x6e, 0x38, 0x0b, 0xbb, 0xf4, 0x15, 0x81, 0x81,
+	0x5a, 0xbb, 0x8b, 0xce, 0x45, 0xf3, 0x8a, 0xb0, 0x33, 0x85, 0xf9, 0x66, 0x52, 0x50, 0x85, 0xef,
+	0x73, 0x11, 0xee, 0xd2, 0xdb, 0x09, 0x34, 0xa8, 0xfb, 0x41, 0x63, 0x4a, 0x3b, 0xee, 0xc7, 0xd9,
+	0x2e, 0x7d, 0x49, 0xe0, 0xc3, 0xda, 0xed, 0x4d, 0xda, 0x04, 0x56, 0xef, 0x29, 0x5c, 0x68, 0x2a,
+	0x07, 0x09, 0xde, 0xe2, 0x04, 0xaf, 0xd3, 0xab, 0x87, 0x4a, 0x90, 0xfe, 0x83, 0xc0, 0x60, 0x88,
+	0x29, 0x46, 0x63, 0x9e, 0xb3, 0x68, 0x9f, 0x4f, 0xf8, 0xb2, 0xc9, 0x2c, 0xe4, 0x76, 0x9b, 0x73,
+	0x93, 0xe9, 0x5a, 0x02, 0x6e, 0x1b, 0x76, 0xfd, 0x8c, 0xe5, 0x6c, 0x90, 0x29, 0x22, 0x8d, 0xbf,
+	0x12, 0x38, 0x1e, 0xb0, 0xa5, 0xa8, 0xb8, 0x9f, 0xf8, 0x41, 0xc7, 0x4c, 0x90, 0x1a, 0x8e, 0x47,
+	0x32, 0xdf, 0xe5, 0x64, 0xbe, 0x43, 0x6f, 0x25, 0x6f, 0x14, 0x7e, 0x1d, 0x07, 0x8e, 0xe1, 0x1e,
+	0x81, 0xe1, 0x50, 0x1b, 0x23, 0x6e, 0xf2, 0xc4, 0x99, 0x60, 0xc2, 0x99, 0xa6, 0xf3, 0x90, 0xe9,
+	0x1d, 0xce, 0x74, 0x9d, 0xde, 0x48, 0xce, 0x54, 0x51, 0xb7, 0x02, 0x2c, 0xdf, 0x11, 0xf8, 0x28,
+	0x74, 0x73, 0x93, 0x36, 0x0b, 0xd7, 0x3b, 0x9c, 0x67, 0x9b, 0x4f, 0x44, 0xa2, 0x77, 0x39, 0xd1,
+	0x9b, 0x54, 0x3e, 0x14, 0xa2, 0x41, 0x3a, 0x8f, 0xda, 0xe1, 0xc3, 0x3a, 0x13, 0x24, 0x6e, 0xac,
+	0x44, 0x59, 0x39, 0xc2, 0x42, 0x53, 0x39, 0x87, 0xfa, 0xf6, 0x08, 0x9b, 0x9c, 0x31, 0xf6, 0xd0,
+	0xae, 0x54, 0xf6, 0x00, 0x79, 0x8f, 0xea, 0x7f, 0x09, 0xf4, 0x07, 0xad, 0x10, 0x2a, 0x35, 0xc2,
+	0xc8, 0x67, 0xde, 0x08, 0xb3, 0x8d, 0x27, 0x20, 0xff, 0x1f, 0x70, 0xfa, 0x15, 0x6a, 0xb5, 0x86,
+	0x7d, 0xc0, 0x0b, 0x0a, 0xd0, 0xb6, 0x4f, 0x3c, 0xfd, 0x1b, 0x81, 0xc1, 0x10, 0xaf, 0x24, 0x6e,
+	0xfa, 0x46, 0xdb, 0x36, 0xc2, 0x97, 0x4d, 0x66, 0xa1, 0x04, 0x6b, 0x5c, 0x82, 0x2b, 0xf4, 0x72,
+	0x02, 0x09, 0x02, 0x46, 0x86, 0xfd, 0xc1, 0x37, 0x50, 0x6b, 0x7b, 0xc4, 0x7d, 0x08, 0x44, 0x78,
+	0x2f, 0xc2, 0x7c, 0x33, 0x29, 0x87, 0xf8, 0x9e, 0xac, 0xb7, 0x65, 0xec, 0xaf, 0xf0, 0x3e, 0xbf,
+	0x95, 0x41, 0x4f, 0xc5, 0x1c, 0xb5, 0x7a, 0x1f, 0x45, 0x10, 0x1b, 0x0d, 0x3f, 0xc4, 0xa6, 0xa0,
+	0x3d, 0x90, 0xe1, 0x66, 0x09, 0xfd, 0x1d, 0x81, 0x6e, 0xdc, 0x2a, 0xee, 0x77, 0x57, 0xd0, 0xe9,
+	0x10, 0x4e, 0x36, 0x10, 0x89, 0x90, 0xaf, 0x70, 0xc8, 0xdf, 0xa0, 0xcb, 0xc9, 0x21, 0xd3, 0x3f,
+	0x11, 0x38, 0x1e, 0x70, 0x07, 0xe2, 0xde, 0xdb, 0x61, 0xde, 0x84, 0x20, 0x35, 0x1c, 0x8f, 0xf0,
+	0x57, 0x39, 0xfc, 0x15, 0xba, 0x94, 0x68, 0x12, 0x70, 0xac, 0x4f, 0x09, 0xf4, 0x07, 0xed, 0x03,
+	0x1a, 0xfb, 0x19, 0x11, 0xe2, 0x67, 0x08, 0xb3, 0x8d, 0x27, 0x20, 0x81, 0x2f, 0x38, 0x81, 0x4f,
+	0xe9, 0x57, 0x42, 0x09, 0x38, 0xd0, 0x32, 0xde, 0x8f, 0xb2, 0x9f, 0xfb, 0x15, 0xb6, 0x7f, 0xa2,
+	0x37, 0xa4, 0xb0, 0xcf, 0x25, 0x10, 0xa4, 0x86, 0xe3, 0x11, 0xe0, 0x27, 0x1c, 0xe0, 0x04, 0x1d,
+	0x8b, 0x00, 0x68, 0x07, 0x2f, 0xaf, 0x3f, 0x7f, 0x93, 0x22, 0x2f, 0xde, 0xa4, 0xc8, 0xbf, 0xde,
+	0xa4, 0xc8, 0x4f, 0xf7, 0x52, 0x6d, 0x2f, 0xf6, 0x52, 0x6d, 0x7f, 0xdf, 0x4b, 0xb5, 0xdd, 0x3d,
+	0x97, 0xd7, 0xac, 0xcd, 0x72, 0x56, 0x54, 0x8d, 0x82, 0x84, 0xff, 0x9b, 0x50, 0xcb, 0xaa, 0xa7,
+	0xf2, 0x86, 0x54, 0x39, 0x27, 0x15, 0x8c, 0x5c, 0x79, 0x9b, 0x99, 0x4e, 0xd5, 0xd9, 0xc5, 0x53,
+	0x6e, 0x61, 0xeb, 0x61, 0x91, 0x99, 0xd9, 0x2e, 0xfe, 0xdf, 0x3a, 0x16, 0xfe, 0x3f, 0x00, 0xc9,
+	0xa5, 0xb9, 0xce, 0xdd, 0x28, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Channel queries an IBC Channel.
+	Channel(ctx context.Context, in *QueryChannelRequest, opts ...grpc.CallOption) (*QueryChannelResponse, error)
+	// Channels queries all the IBC channels of a chain.
+	Channels(ctx context.Context, in *QueryChannelsRequest, opts ...grpc.CallOption) (*QueryChannelsResponse, error)
 	// ConnectionChannels queries all the channels associated with a connection
 	// end.
 	ConnectionChannels(ctx context.Context, in *QueryConnectionChannelsRequest, opts ...grpc.CallOption) (*QueryConnectionChannelsResponse, error)
@@ -2295,6 +2652,9 @@ type QueryClient interface {
 	// PacketCommitments returns all the packet commitments hashes associated
 	// with a channel.
 	PacketCommitments(ctx context.Context, in *QueryPacketCommitmentsRequest, opts ...grpc.CallOption) (*QueryPacketCommitmentsResponse, error)
+	// ForceTimeoutPackets returns the packet commitments associated with a channel
+	// that can be timed out by the authority using MsgForceTimeoutPackets.
+	ForceTimeoutPackets(ctx context.Context, in *QueryForceTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryForceTimeoutPacketsResponse, error)
 	// PacketReceipt queries if a given packet sequence has been received on the
 	// queried chain
 	PacketReceipt(ctx context.Context, in *QueryPacketReceiptRequest, opts ...grpc.CallOption) (*QueryPacketReceiptResponse, error)
@@ -2317,6 +2677,10 @@ type QueryClient interface {
 	UpgradeError(ctx context.Context, in *QueryUpgradeErrorRequest, opts ...grpc.CallOption) (*QueryUpgradeErrorResponse, error)
 	// Upgrade returns the upgrade for a given port and channel id.
 	Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts ...grpc.CallOption) (*QueryUpgradeResponse, error)
+	// ChannelPaused returns whether a channel is paused.
+	ChannelPaused(ctx context.Context, in *QueryChannelPausedRequest, opts ...grpc.CallOption) (*QueryChannelPausedResponse, error)
+	// PausedChannels returns all the paused channels of the chain.
+	PausedChannels(ctx context.Context, in *QueryPausedChannelsRequest, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error)
 	// ChannelParams queries all parameters of the ibc channel submodule.
 	ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error)
 }
@@ -2392,6 +2756,15 @@ func (c *queryClient) PacketCommitments(ctx context.Context, in *QueryPacketComm
 	return out, nil
 }
 
+func (c *queryClient) ForceTimeoutPackets(ctx context.Context, in *QueryForceTimeoutPacketsRequest, opts ...grpc.CallOption) (*QueryForceTimeoutPacketsResponse, error) {
+	out := new(QueryForceTimeoutPacketsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ForceTimeoutPackets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) PacketReceipt(ctx context.Context, in *QueryPacketReceiptRequest, opts ...grpc.CallOption) (*QueryPacketReceiptResponse, error) {
 	out := new(QueryPacketReceiptResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PacketReceipt", in, out, opts...)
@@ -2473,6 +2846,24 @@ func (c *queryClient) Upgrade(ctx context.Context, in *QueryUpgradeRequest, opts
 	return out, nil
 }
 
+func (c *queryClient) ChannelPaused(ctx context.Context, in *QueryChannelPausedRequest, opts ...grpc.CallOption) (*QueryChannelPausedResponse, error) {
+	out := new(QueryChannelPausedResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ChannelPaused", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PausedChannels(ctx context.Context, in *QueryPausedChannelsRequest, opts ...grpc.CallOption) (*QueryPausedChannelsResponse, error) {
+	out := new(QueryPausedChannelsResponse)
+	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/PausedChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) ChannelParams(ctx context.Context, in *QueryChannelParamsRequest, opts ...grpc.CallOption) (*QueryChannelParamsResponse, error) {
 	out := new(QueryChannelParamsResponse)
 	err := c.cc.Invoke(ctx, "/ibc.core.channel.v1.Query/ChannelParams", in, out, opts...)
@@ -2502,6 +2893,9 @@ type QueryServer interface {
 	// PacketCommitments returns all the packet commitments hashes associated
 	// with a channel.
 	PacketCommitments(context.Context, *QueryPacketCommitmentsRequest) (*QueryPacketCommitmentsResponse, error)
+	// ForceTimeoutPackets returns the packet commitments associated with a channel
+	// that can be timed out by the authority using MsgForceTimeoutPackets.
+	ForceTimeoutPackets(context.Context, *QueryForceTimeoutPacketsRequest) (*QueryForceTimeoutPacketsResponse, error)
 	// PacketReceipt queries if a given packet sequence has been received on the
 	// queried chain
 	PacketReceipt(context.Context, *QueryPacketReceiptRequest) (*QueryPacketReceiptResponse, error)
@@ -2524,6 +2918,10 @@ type QueryServer interface {
 	UpgradeError(context.Context, *QueryUpgradeErrorRequest) (*QueryUpgradeErrorResponse, error)
 	// Upgrade returns the upgrade for a given port and channel id.
 	Upgrade(context.Context, *QueryUpgradeRequest) (*QueryUpgradeResponse, error)
+	// ChannelPaused returns whether a channel is paused.
+	ChannelPaused(context.Context, *QueryChannelPausedRequest) (*QueryChannelPausedResponse, error)
+	// PausedChannels returns all the paused channels of the chain.
+	PausedChannels(context.Context, *QueryPausedChannelsRequest) (*QueryPausedChannelsResponse, error)
 	// ChannelParams queries all parameters of the ibc channel submodule.
 	ChannelParams(context.Context, *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error)
 }
@@ -2553,6 +2951,9 @@ func (*UnimplementedQueryServer) PacketCommitment(ctx context.Context, req *Quer
 func (*UnimplementedQueryServer) PacketCommitments(ctx context.Context, req *QueryPacketCommitmentsRequest) (*QueryPacketCommitmentsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketCommitments not implemented")
 }
+func (*UnimplementedQueryServer) ForceTimeoutPackets(ctx context.Context, req *QueryForceTimeoutPacketsRequest) (*QueryForceTimeoutPacketsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ForceTimeoutPackets not implemented")
+}
 func (*UnimplementedQueryServer) PacketReceipt(ctx context.Context, req *QueryPacketReceiptRequest) (*QueryPacketReceiptResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PacketReceipt not implemented")
 }
@@ -2580,6 +2981,12 @@ func (*UnimplementedQueryServer) UpgradeError(ctx context.Context, req *QueryUpg
 func (*UnimplementedQueryServer) Upgrade(ctx context.Context, req *QueryUpgradeRequest) (*QueryUpgradeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Upgrade not implemented")
 }
+func (*UnimplementedQueryServer) ChannelPaused(ctx context.Context, req *QueryChannelPausedRequest) (*QueryChannelPausedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChannelPaused not implemented")
+}
+func (*UnimplementedQueryServer) PausedChannels(ctx context.Context, req *QueryPausedChannelsRequest) (*QueryPausedChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PausedChannels not implemented")
+}
 func (*UnimplementedQueryServer) ChannelParams(ctx context.Context, req *QueryChannelParamsRequest) (*QueryChannelParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ChannelParams not implemented")
 }
@@ -2714,6 +3121,24 @@ func _Query_PacketCommitments_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ForceTimeoutPackets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryForceTimeoutPacketsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ForceTimeoutPackets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/ForceTimeoutPackets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ForceTimeoutPackets(ctx, req.(*QueryForceTimeoutPacketsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_PacketReceipt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryPacketReceiptRequest)
 	if err := dec(in); err != nil {
@@ -2876,6 +3301,42 @@ func _Query_Upgrade_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ChannelPaused_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryChannelPausedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ChannelPaused(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/ChannelPaused",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ChannelPaused(ctx, req.(*QueryChannelPausedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PausedChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPausedChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PausedChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/ibc.core.channel.v1.Query/PausedChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PausedChannels(ctx, req.(*QueryPausedChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_ChannelParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryChannelParamsRequest)
 	if err := dec(in); err != nil {
@@ -2926,6 +3387,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PacketCommitments",
 			Handler:    _Query_PacketCommitments_Handler,
 		},
+		{
+			MethodName: "ForceTimeoutPackets",
+			Handler:    _Query_ForceTimeoutPackets_Handler,
+		},
 		{
 			MethodName: "PacketReceipt",
 			Handler:    _Query_PacketReceipt_Handler,
@@ -2962,6 +3427,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Upgrade",
 			Handler:    _Query_Upgrade_Handler,
 		},
+		{
+			MethodName: "ChannelPaused",
+			Handler:    _Query_ChannelPaused_Handler,
+		},
+		{
+			MethodName: "PausedChannels",
+			Handler:    _Query_PausedChannels_Handler,
+		},
 		{
 			MethodName: "ChannelParams",
 			Handler:    _Query_ChannelParams_Handler,
@@ -3647,7 +4120,7 @@ func (m *QueryPacketCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryPacketReceiptRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryForceTimeoutPacketsRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3657,20 +4130,128 @@ func (m *QueryPacketReceiptRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryPacketReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryForceTimeoutPacketsRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryPacketReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryForceTimeoutPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Sequence != 0 {
-		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
-		i--
-		dAtA[i] = 0x18
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryForceTimeoutPacketsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryForceTimeoutPacketsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryForceTimeoutPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPacketReceiptRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPacketReceiptRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPacketReceiptRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sequence != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Sequence))
+		i--
+		dAtA[i] = 0x18
 	}
 	if len(m.ChannelId) > 0 {
 		i -= len(m.ChannelId)
@@ -3849,20 +4430,20 @@ func (m *QueryPacketAcknowledgementsRequest) MarshalToSizedBuffer(dAtA []byte) (
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA20 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j19 int
+		dAtA23 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j22 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA20[j19] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA23[j22] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j19++
+				j22++
 			}
-			dAtA20[j19] = uint8(num)
-			j19++
+			dAtA23[j22] = uint8(num)
+			j22++
 		}
-		i -= j19
-		copy(dAtA[i:], dAtA20[:j19])
-		i = encodeVarintQuery(dAtA, i, uint64(j19))
+		i -= j22
+		copy(dAtA[i:], dAtA23[:j22])
+		i = encodeVarintQuery(dAtA, i, uint64(j22))
 		i--
 		dAtA[i] = 0x22
 	}
@@ -3975,20 +4556,20 @@ func (m *QueryUnreceivedPacketsRequest) MarshalToSizedBuffer(dAtA []byte) (int,
 	var l int
 	_ = l
 	if len(m.PacketCommitmentSequences) > 0 {
-		dAtA25 := make([]byte, len(m.PacketCommitmentSequences)*10)
-		var j24 int
+		dAtA28 := make([]byte, len(m.PacketCommitmentSequences)*10)
+		var j27 int
 		for _, num := range m.PacketCommitmentSequences {
 			for num >= 1<<7 {
-				dAtA25[j24] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j24++
+				j27++
 			}
-			dAtA25[j24] = uint8(num)
-			j24++
+			dAtA28[j27] = uint8(num)
+			j27++
 		}
-		i -= j24
-		copy(dAtA[i:], dAtA25[:j24])
-		i = encodeVarintQuery(dAtA, i, uint64(j24))
+		i -= j27
+		copy(dAtA[i:], dAtA28[:j27])
+		i = encodeVarintQuery(dAtA, i, uint64(j27))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4040,20 +4621,20 @@ func (m *QueryUnreceivedPacketsResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA28 := make([]byte, len(m.Sequences)*10)
-		var j27 int
+		dAtA31 := make([]byte, len(m.Sequences)*10)
+		var j30 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA28[j27] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA31[j30] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j27++
+				j30++
 			}
-			dAtA28[j27] = uint8(num)
-			j27++
+			dAtA31[j30] = uint8(num)
+			j30++
 		}
-		i -= j27
-		copy(dAtA[i:], dAtA28[:j27])
-		i = encodeVarintQuery(dAtA, i, uint64(j27))
+		i -= j30
+		copy(dAtA[i:], dAtA31[:j30])
+		i = encodeVarintQuery(dAtA, i, uint64(j30))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4081,20 +4662,20 @@ func (m *QueryUnreceivedAcksRequest) MarshalToSizedBuffer(dAtA []byte) (int, err
 	var l int
 	_ = l
 	if len(m.PacketAckSequences) > 0 {
-		dAtA30 := make([]byte, len(m.PacketAckSequences)*10)
-		var j29 int
+		dAtA33 := make([]byte, len(m.PacketAckSequences)*10)
+		var j32 int
 		for _, num := range m.PacketAckSequences {
 			for num >= 1<<7 {
-				dAtA30[j29] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j29++
+				j32++
 			}
-			dAtA30[j29] = uint8(num)
-			j29++
+			dAtA33[j32] = uint8(num)
+			j32++
 		}
-		i -= j29
-		copy(dAtA[i:], dAtA30[:j29])
-		i = encodeVarintQuery(dAtA, i, uint64(j29))
+		i -= j32
+		copy(dAtA[i:], dAtA33[:j32])
+		i = encodeVarintQuery(dAtA, i, uint64(j32))
 		i--
 		dAtA[i] = 0x1a
 	}
@@ -4146,20 +4727,20 @@ func (m *QueryUnreceivedAcksResponse) MarshalToSizedBuffer(dAtA []byte) (int, er
 	i--
 	dAtA[i] = 0x12
 	if len(m.Sequences) > 0 {
-		dAtA33 := make([]byte, len(m.Sequences)*10)
-		var j32 int
+		dAtA36 := make([]byte, len(m.Sequences)*10)
+		var j35 int
 		for _, num := range m.Sequences {
 			for num >= 1<<7 {
-				dAtA33[j32] = uint8(uint64(num)&0x7f | 0x80)
+				dAtA36[j35] = uint8(uint64(num)&0x7f | 0x80)
 				num >>= 7
-				j32++
+				j35++
 			}
-			dAtA33[j32] = uint8(num)
-			j32++
+			dAtA36[j35] = uint8(num)
+			j35++
 		}
-		i -= j32
-		copy(dAtA[i:], dAtA33[:j32])
-		i = encodeVarintQuery(dAtA, i, uint64(j32))
+		i -= j35
+		copy(dAtA[i:], dAtA36[:j35])
+		i = encodeVarintQuery(dAtA, i, uint64(j35))
 		i--
 		dAtA[i] = 0xa
 	}
@@ -4504,7 +5085,7 @@ func (m *QueryUpgradeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
+func (m *QueryChannelPausedRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4514,20 +5095,34 @@ func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryChannelParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryChannelPausedRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChannelParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryChannelPausedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *QueryChannelPausedResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4537,19 +5132,52 @@ func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryChannelParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QueryChannelPausedResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QueryChannelPausedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Params != nil {
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPausedChannelsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPausedChannelsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPausedChannelsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
 		{
-			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -4562,66 +5190,183 @@ func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryChannelRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.PortId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.ChannelId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+func (m *QueryPausedChannelsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryChannelResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Channel != nil {
-		l = m.Channel.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = len(m.Proof)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	l = m.ProofHeight.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryPausedChannelsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryChannelsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryPausedChannelsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.Pagination != nil {
-		l = m.Pagination.Size()
-		n += 1 + l + sovQuery(uint64(l))
-	}
-	return n
-}
-
+	{
+		size, err := m.Height.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Channels) > 0 {
+		for iNdEx := len(m.Channels) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Channels[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryChannelParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryChannelParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryChannelParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Params != nil {
+		{
+			size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryChannelRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Channel != nil {
+		l = m.Channel.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Proof)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.ProofHeight.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryChannelsResponse) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4844,6 +5589,48 @@ func (m *QueryPacketCommitmentsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryForceTimeoutPacketsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryForceTimeoutPacketsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryPacketReceiptRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -5192,6 +5979,69 @@ func (m *QueryUpgradeResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryChannelPausedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryChannelPausedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	return n
+}
+
+func (m *QueryPausedChannelsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPausedChannelsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Channels) > 0 {
+		for _, e := range m.Channels {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Height.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
 func (m *QueryChannelParamsRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -6885,7 +7735,310 @@ func (m *QueryPacketCommitmentResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketCommitmentsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryPacketCommitmentsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPacketCommitmentsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPacketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, &PacketState{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryForceTimeoutPacketsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6908,10 +8061,10 @@ func (m *QueryPacketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketCommitmentsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForceTimeoutPacketsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForceTimeoutPacketsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7035,7 +8188,7 @@ func (m *QueryPacketCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryPacketCommitmentsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryForceTimeoutPacketsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7058,10 +8211,10 @@ func (m *QueryPacketCommitmentsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryPacketCommitmentsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryForceTimeoutPacketsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryPacketCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryForceTimeoutPacketsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8765,9 +9918,259 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 			} else {
 				return fmt.Errorf("proto: wrong wireType = %d for field Sequences", wireType)
 			}
-		case 2:
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PortId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+			}
+			m.NextSequenceReceive = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
+			if m.Proof == nil {
+				m.Proof = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -8794,7 +10197,7 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -8819,7 +10222,7 @@ func (m *QueryUnreceivedAcksResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8842,10 +10245,10 @@ func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSequenceSendRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSequenceSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -8933,7 +10336,7 @@ func (m *QueryNextSequenceReceiveRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8956,17 +10359,17 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryNextSequenceSendResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceReceiveResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryNextSequenceSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceReceive", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
 			}
-			m.NextSequenceReceive = 0
+			m.NextSequenceSend = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -8976,7 +10379,7 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceReceive |= uint64(b&0x7F) << shift
+				m.NextSequenceSend |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -9069,7 +10472,7 @@ func (m *QueryNextSequenceReceiveResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9092,10 +10495,10 @@ func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceSendRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeErrorRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceSendRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9183,7 +10586,7 @@ func (m *QueryNextSequenceSendRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9206,17 +10609,17 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryNextSequenceSendResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeErrorResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryNextSequenceSendResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NextSequenceSend", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
 			}
-			m.NextSequenceSend = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9226,11 +10629,25 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NextSequenceSend |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
@@ -9319,7 +10736,7 @@ func (m *QueryNextSequenceSendResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9342,10 +10759,10 @@ func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeErrorRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeErrorRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9433,7 +10850,7 @@ func (m *QueryUpgradeErrorRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9456,15 +10873,15 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeErrorResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryUpgradeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeErrorResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ErrorReceipt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9491,7 +10908,7 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ErrorReceipt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -9583,7 +11000,7 @@ func (m *QueryUpgradeErrorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelPausedRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9606,10 +11023,10 @@ func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelPausedRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelPausedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -9697,7 +11114,7 @@ func (m *QueryUpgradeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryChannelPausedResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -9720,15 +11137,85 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryUpgradeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryChannelPausedResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryUpgradeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryChannelPausedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Upgrade", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9755,15 +11242,102 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Upgrade.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPausedChannelsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPausedChannelsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Channels", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Channels = append(m.Channels, &IdentifiedChannel{})
+			if err := m.Channels[len(m.Channels)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Proof", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -9773,29 +11347,31 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Proof = append(m.Proof[:0], dAtA[iNdEx:postIndex]...)
-			if m.Proof == nil {
-				m.Proof = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ProofHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -9822,7 +11398,7 @@ func (m *QueryUpgradeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.ProofHeight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Height.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -44,12 +44,13 @@ func (u Upgrade) ValidateBasic() error {
 
 // ValidateBasic performs a basic validation of the proposed upgrade fields
 func (uf UpgradeFields) ValidateBasic() error {
-	if !slices.Contains(connectiontypes.SupportedOrderings, uf.Ordering.String()) {
+	if !slices.Contains(connectiontypes.SupportedOrderings, uf.Ordering.ConnectionFeature()) {
 		return errorsmod.Wrap(ErrInvalidChannelOrdering, uf.Ordering.String())
 	}
 
+	// multi-hop channels cannot be upgraded, and channels cannot be upgraded to multi-hop channels
 	if len(uf.ConnectionHops) != 1 {
-		return errorsmod.Wrap(ErrTooManyConnectionHops, "current IBC version only supports one connection hop")
+		return errorsmod.Wrapf(ErrTooManyConnectionHops, "upgrades are only supported for channels with a single connection hop, got %d", len(uf.ConnectionHops))
 	}
 
 	if strings.TrimSpace(uf.Version) == "" {
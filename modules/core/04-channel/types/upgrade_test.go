@@ -25,6 +25,20 @@ func (suite *TypesTestSuite) TestUpgradeValidateBasic() {
 			func() {},
 			true,
 		},
+		{
+			"success: ORDERED_ALLOW_TIMEOUT ordering",
+			func() {
+				upgrade.Fields.Ordering = types.ORDERED_ALLOW_TIMEOUT
+			},
+			true,
+		},
+		{
+			"success: UNORDERED_EXPIRING_RECEIPTS ordering",
+			func() {
+				upgrade.Fields.Ordering = types.UNORDERED_EXPIRING_RECEIPTS
+			},
+			true,
+		},
 		{
 			"invalid ordering",
 			func() {
@@ -198,6 +198,78 @@ func verifyChainedMembershipProof(root []byte, specs []*ics23.ProofSpec, proofs
 	return nil
 }
 
+// NewCompressedMerkleProof combines the provided membership proofs, taken at the same height of the same chain, into a
+// compressed proof. The existence proofs of the lowest subtree are combined into an ICS-23 compressed batch proof, in the
+// order of the provided proofs, sharing their common inner nodes. The proofs of the higher subtrees must be identical,
+// i.e. all the keys must belong to the same store, and are shared by all the keys.
+//
+// NOTE: the compression only reduces the size of the encoded proofs. The membership proofs are split back with
+// SplitCompressedProof and each one is verified independently against the commitment root.
+func NewCompressedMerkleProof(proofs []MerkleProof) (MerkleProof, error) {
+	if len(proofs) == 0 {
+		return MerkleProof{}, errorsmod.Wrap(ErrInvalidProof, "compressed proof must combine at least one proof")
+	}
+
+	subtrees := len(proofs[0].Proofs)
+	lowestProofs := make([]*ics23.CommitmentProof, len(proofs))
+	for i, proof := range proofs {
+		if subtrees == 0 || len(proof.Proofs) != subtrees {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidProof, "proof %d has %d subtree proofs, expected %d", i, len(proof.Proofs), subtrees)
+		}
+
+		if proof.Proofs[0].GetExist() == nil {
+			return MerkleProof{}, errorsmod.Wrapf(ErrInvalidProof, "lowest subtree proof of proof %d must be an existence proof", i)
+		}
+
+		for j := 1; j < subtrees; j++ {
+			if !proto.Equal(proof.Proofs[j], proofs[0].Proofs[j]) {
+				return MerkleProof{}, errorsmod.Wrapf(ErrInvalidProof, "proof %d of subtree %d differs from the first proof", i, j)
+			}
+		}
+
+		lowestProofs[i] = proof.Proofs[0]
+	}
+
+	compressedProof, err := ics23.CombineProofs(lowestProofs)
+	if err != nil {
+		return MerkleProof{}, errorsmod.Wrapf(ErrInvalidProof, "could not combine proofs: %v", err)
+	}
+
+	return MerkleProof{
+		Proofs: append([]*ics23.CommitmentProof{compressedProof}, proofs[0].Proofs[1:]...),
+	}, nil
+}
+
+// SplitCompressedProof returns the membership proofs of the keys proven by a compressed proof, in order. Each membership
+// proof consists of the existence proof of a key in the lowest subtree, taken from the ICS-23 batch or compressed batch
+// proof of the lowest subtree, followed by the proofs of the higher subtrees shared by all the keys. Nothing is verified
+// while splitting: the membership proofs must then be verified as any membership proof.
+func (proof MerkleProof) SplitCompressedProof() ([]MerkleProof, error) {
+	if len(proof.Proofs) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "compressed proof cannot be empty")
+	}
+
+	batch := ics23.Decompress(proof.Proofs[0]).GetBatch()
+	if batch == nil || len(batch.Entries) == 0 {
+		return nil, errorsmod.Wrap(ErrInvalidProof, "lowest subtree proof must be a non-empty batch or compressed batch proof")
+	}
+
+	proofs := make([]MerkleProof, len(batch.Entries))
+	for i, entry := range batch.Entries {
+		exist := entry.GetExist()
+		if exist == nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProof, "compressed proof entry %d must be an existence proof", i)
+		}
+
+		existProof := &ics23.CommitmentProof{Proof: &ics23.CommitmentProof_Exist{Exist: exist}}
+		proofs[i] = MerkleProof{
+			Proofs: append([]*ics23.CommitmentProof{existProof}, proof.Proofs[1:]...),
+		}
+	}
+
+	return proofs, nil
+}
+
 // blankMerkleProof and blankProofOps will be used to compare against their zero values,
 // and are declared as globals to avoid having to unnecessarily re-allocate on every comparison.
 var (
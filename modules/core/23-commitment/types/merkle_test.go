@@ -141,6 +141,88 @@ func (suite *MerkleTestSuite) TestVerifyNonMembership() {
 	}
 }
 
+func (suite *MerkleTestSuite) TestCompressedProof() {
+	keys := []string{"KEY1", "KEY2", "KEY3"}
+	for _, key := range keys {
+		suite.iavlStore.Set([]byte(key), []byte("VALUE"+key))
+	}
+	cid := suite.store.Commit()
+
+	queryProof := func(key string) types.MerkleProof {
+		res, err := suite.store.Query(&storetypes.RequestQuery{
+			Path:  fmt.Sprintf("/%s/key", suite.storeKey.Name()), // required path to get key/value+proof
+			Data:  []byte(key),
+			Prove: true,
+		})
+		suite.Require().NoError(err)
+
+		proof, err := types.ConvertProofs(res.ProofOps)
+		suite.Require().NoError(err)
+
+		return proof
+	}
+
+	var proofs []types.MerkleProof
+
+	cases := []struct {
+		name     string
+		malleate func()
+		expPass  bool
+	}{
+		{"success", func() {}, true},
+		{"success: single proof", func() { proofs = proofs[:1] }, true},
+		{"no proofs", func() { proofs = nil }, false},
+		{"proof with wrong number of subtree proofs", func() {
+			proofs[1] = types.MerkleProof{Proofs: proofs[1].Proofs[1:]}
+		}, false},
+		{"non-existence proof", func() {
+			proofs[1] = queryProof("NOTAKEY")
+		}, false},
+		{"proofs of different stores", func() {
+			otherProof := queryProof("KEY2")
+			otherProof.Proofs[1] = proofs[0].Proofs[0]
+			proofs[1] = otherProof
+		}, false},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			proofs = nil
+			for _, key := range keys {
+				proofs = append(proofs, queryProof(key))
+			}
+
+			tc.malleate()
+
+			compressedProof, err := types.NewCompressedMerkleProof(proofs)
+			if !tc.expPass {
+				suite.Require().Error(err)
+				return
+			}
+
+			suite.Require().NoError(err)
+
+			splitProofs, err := compressedProof.SplitCompressedProof()
+			suite.Require().NoError(err)
+			suite.Require().Len(splitProofs, len(proofs))
+
+			root := types.NewMerkleRoot(cid.Hash)
+			for i, proof := range splitProofs {
+				path := types.NewMerklePath([]byte(suite.storeKey.Name()), []byte(keys[i]))
+				suite.Require().NoError(proof.VerifyMembership(types.GetSDKSpecs(), &root, path, []byte("VALUE"+keys[i])))
+			}
+		})
+	}
+
+	// only compressed proofs can be split
+	_, err := queryProof("KEY1").SplitCompressedProof()
+	suite.Require().Error(err)
+
+	_, err = types.MerkleProof{}.SplitCompressedProof()
+	suite.Require().Error(err)
+}
+
 func TestApplyPrefix(t *testing.T) {
 	prefix := types.NewMerklePrefix([]byte("storePrefixKey"))
 
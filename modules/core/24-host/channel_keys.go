@@ -9,6 +9,7 @@ const (
 	KeyUpgradePrefix        = "upgrades"
 	KeyUpgradeErrorPrefix   = "upgradeError"
 	KeyCounterpartyUpgrade  = "counterpartyUpgrade"
+	KeyChannelPausedPrefix  = "channelPaused"
 )
 
 // ICS04
@@ -34,6 +35,11 @@ func ChannelCounterpartyUpgradeKey(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s/%s", KeyChannelUpgradePrefix, KeyCounterpartyUpgrade, channelPath(portID, channelID)))
 }
 
+// ChannelPausedKey returns the store key for the pause flag of a particular channel
+func ChannelPausedKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyChannelPausedPrefix, channelPath(portID, channelID)))
+}
+
 func channelPath(portID, channelID string) string {
 	return fmt.Sprintf("%s/%s/%s/%s", KeyPortPrefix, portID, KeyChannelPrefix, channelID)
 }
@@ -1,6 +1,9 @@
 package host
 
-import "fmt"
+import (
+	"encoding/binary"
+	"fmt"
+)
 
 const (
 	KeySequencePrefix         = "sequences"
@@ -12,6 +15,8 @@ const (
 	KeyPacketReceiptPrefix    = "receipts"
 	KeyPruningSequenceStart   = "pruningSequenceStart"
 	KeyRecvStartSequence      = "recvStartSequence"
+	KeyReceiptExpiryPrefix    = "receiptExpiry"
+	KeyCounterpartyNextSeqAck = "counterpartyNextSequenceAck"
 )
 
 // ICS04
@@ -73,6 +78,28 @@ func RecvStartSequenceKey(portID, channelID string) []byte {
 	return []byte(fmt.Sprintf("%s/%s", KeyRecvStartSequence, channelPath(portID, channelID)))
 }
 
+// CounterpartyNextSequenceAckKey returns the store key for the proven next sequence to be acknowledged
+// by the counterparty of a particular channel
+func CounterpartyNextSequenceAckKey(portID, channelID string) []byte {
+	return []byte(fmt.Sprintf("%s/%s", KeyCounterpartyNextSeqAck, channelPath(portID, channelID)))
+}
+
+// ReceiptExpiryKey returns the store key under which the packet receipt of a packet received on an
+// UNORDERED_EXPIRING_RECEIPTS channel is indexed by its expiry timestamp. The key ends with the packet
+// receipt key.
+func ReceiptExpiryKey(expiryTimestamp uint64, portID, channelID string, sequence uint64) []byte {
+	return append(ReceiptExpiryPrefixKey(expiryTimestamp), PacketReceiptKey(portID, channelID, sequence)...)
+}
+
+// ReceiptExpiryPrefixKey returns the prefix of the store keys under which the packet receipts expiring
+// at the provided timestamp are indexed. The expiry timestamp is big endian encoded so that the packet
+// receipts are iterated in the order of their expiry.
+func ReceiptExpiryPrefixKey(expiryTimestamp uint64) []byte {
+	key := []byte(KeyReceiptExpiryPrefix + "/")
+	key = binary.BigEndian.AppendUint64(key, expiryTimestamp)
+	return append(key, '/')
+}
+
 func sequencePath(sequence uint64) string {
 	return fmt.Sprintf("%s/%d", KeySequencePrefix, sequence)
 }
@@ -23,7 +23,10 @@ func NewRedundantRelayDecorator(k *keeper.Keeper) RedundantRelayDecorator {
 // and all packet messages are redundant. If the transaction is just a single UpdateClient message, or the multimsg transaction
 // contains some other message type, then the antedecorator returns no error and continues processing to ensure these transactions
 // are included. This will ensure that relayers do not waste fees on multiMsg transactions when another relayer has already submitted
-// all packets, by rejecting the tx at the mempool layer.
+// all packets, by rejecting the tx at the mempool layer. Packet messages for paused channels are rejected at the mempool layer
+// as well, since the channel keeper returns an error for any packet received, acknowledged or timed out on a paused channel.
+// The packets of batch packet messages (RecvPackets, Acknowledgements) are counted individually, a batch message is therefore
+// redundant only if all its packets are redundant.
 func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
 	// do not run redundancy check on DeliverTx or simulate
 	if (ctx.IsCheckTx() || ctx.IsReCheckTx()) && !simulate {
@@ -33,18 +36,7 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 		for _, m := range tx.GetMsgs() {
 			switch msg := m.(type) {
 			case *channeltypes.MsgRecvPacket:
-				var (
-					response *channeltypes.MsgRecvPacketResponse
-					err      error
-				)
-				// when we are in ReCheckTx mode, ctx.IsCheckTx() will also return true
-				// therefore we must start the if statement on ctx.IsReCheckTx() to correctly
-				// determine which mode we are in
-				if ctx.IsReCheckTx() {
-					response, err = rrd.recvPacketReCheckTx(ctx, msg)
-				} else {
-					response, err = rrd.recvPacketCheckTx(ctx, msg)
-				}
+				response, err := rrd.recvPacket(ctx, msg)
 				if err != nil {
 					return ctx, err
 				}
@@ -54,6 +46,25 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgRecvPackets:
+				// each packet of the batch is counted as a packet message
+				recvMsgs, err := msg.RecvPacketMsgs()
+				if err != nil {
+					return ctx, errorsmod.Wrap(err, "invalid compressed proof")
+				}
+
+				for _, recvMsg := range recvMsgs {
+					response, err := rrd.recvPacket(ctx, recvMsg)
+					if err != nil {
+						return ctx, err
+					}
+
+					if response.Result == channeltypes.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+
 			case *channeltypes.MsgAcknowledgement:
 				response, err := rrd.k.Acknowledgement(ctx, msg)
 				if err != nil {
@@ -64,6 +75,19 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 				}
 				packetMsgs++
 
+			case *channeltypes.MsgAcknowledgements:
+				// each acknowledgement of the batch is counted as a packet message
+				response, err := rrd.k.Acknowledgements(ctx, msg)
+				if err != nil {
+					return ctx, err
+				}
+				for _, result := range response.Results {
+					if result == channeltypes.NOOP {
+						redundancies++
+					}
+					packetMsgs++
+				}
+
 			case *channeltypes.MsgTimeout:
 				response, err := rrd.k.Timeout(ctx, msg)
 				if err != nil {
@@ -105,6 +129,19 @@ func (rrd RedundantRelayDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simula
 	return next(ctx, tx, simulate)
 }
 
+// recvPacket runs the subset of ibc recv packet logic of the current mode, CheckTx or ReCheckTx, to be used specifically
+// within the RedundantRelayDecorator AnteHandler.
+func (rrd RedundantRelayDecorator) recvPacket(ctx sdk.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
+	// when we are in ReCheckTx mode, ctx.IsCheckTx() will also return true
+	// therefore we must start the if statement on ctx.IsReCheckTx() to correctly
+	// determine which mode we are in
+	if ctx.IsReCheckTx() {
+		return rrd.recvPacketReCheckTx(ctx, msg)
+	}
+
+	return rrd.recvPacketCheckTx(ctx, msg)
+}
+
 // recvPacketCheckTx runs a subset of ibc recv packet logic to be used specifically within the RedundantRelayDecorator AnteHandler.
 // It only performs core IBC receiving logic and skips any application logic.
 func (rrd RedundantRelayDecorator) recvPacketCheckTx(ctx sdk.Context, msg *channeltypes.MsgRecvPacket) (*channeltypes.MsgRecvPacketResponse, error) {
@@ -114,7 +151,8 @@ func (rrd RedundantRelayDecorator) recvPacketCheckTx(ctx sdk.Context, msg *chann
 	_, err := rrd.k.ChannelKeeper.RecvPacket(cacheCtx, msg.Packet, msg.ProofCommitment, msg.ProofHeight)
 
 	switch err {
-	case nil:
+	case nil, channeltypes.ErrTimeoutReceiptWritten:
+		// writing the timeout receipt of a packet timed out on an ORDERED_ALLOW_TIMEOUT channel is not redundant
 		writeFn()
 	case channeltypes.ErrNoOpMsg:
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
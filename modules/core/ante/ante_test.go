@@ -97,6 +97,75 @@ func (suite *AnteTestSuite) createAcknowledgementMessage(isRedundant bool) sdk.M
 	return channeltypes.NewMsgAcknowledgement(packet, ibctesting.MockAcknowledgement, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
 }
 
+// createRecvPacketsMessage creates a RecvPackets message for a batch of packets sent from chain A to chain B.
+// A packet of the batch is received before the batch is created if it is redundant.
+func (suite *AnteTestSuite) createRecvPacketsMessage(isRedundant ...bool) *channeltypes.MsgRecvPackets {
+	var (
+		packets    []channeltypes.Packet
+		packetKeys [][]byte
+	)
+	for _, redundant := range isRedundant {
+		sequence, err := suite.path.EndpointA.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			clienttypes.NewHeight(2, 0), 0)
+
+		if redundant {
+			err = suite.path.EndpointB.RecvPacket(packet)
+			suite.Require().NoError(err)
+		}
+
+		packets = append(packets, packet)
+		packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	}
+
+	err := suite.path.EndpointB.UpdateClient()
+	suite.Require().NoError(err)
+
+	proof, proofHeight := suite.chainA.QueryCompressedProof(packetKeys...)
+
+	return channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
+// createAcknowledgementsMessage creates an Acknowledgements message for a batch of packets sent from chain B to chain A.
+// A packet of the batch is acknowledged before the batch is created if it is redundant.
+func (suite *AnteTestSuite) createAcknowledgementsMessage(isRedundant ...bool) sdk.Msg {
+	var packets []channeltypes.Packet
+	for range isRedundant {
+		sequence, err := suite.path.EndpointB.SendPacket(clienttypes.NewHeight(2, 0), 0, ibctesting.MockPacketData)
+		suite.Require().NoError(err)
+
+		packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence,
+			suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID,
+			suite.path.EndpointA.ChannelConfig.PortID, suite.path.EndpointA.ChannelID,
+			clienttypes.NewHeight(2, 0), 0))
+	}
+
+	err := suite.path.EndpointA.RecvPackets(packets...)
+	suite.Require().NoError(err)
+
+	var (
+		acks    [][]byte
+		ackKeys [][]byte
+	)
+	for i, packet := range packets {
+		if isRedundant[i] {
+			err = suite.path.EndpointB.AcknowledgePacket(packet, ibctesting.MockAcknowledgement)
+			suite.Require().NoError(err)
+		}
+
+		acks = append(acks, ibctesting.MockAcknowledgement)
+		ackKeys = append(ackKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+	}
+
+	proof, proofHeight := suite.chainA.QueryCompressedProof(ackKeys...)
+
+	return channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, suite.path.EndpointA.Chain.SenderAccount.GetAddress().String())
+}
+
 // createTimeoutMessage creates an Timeout message for a packet sent from chain B to chain A.
 func (suite *AnteTestSuite) createTimeoutMessage(isRedundant bool) sdk.Msg {
 	height := suite.chainA.LatestCommittedHeader.GetHeight()
@@ -306,6 +375,34 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			nil,
 		},
+		{
+			"success on one new RecvPackets message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(false, false, false)}
+			},
+			nil,
+		},
+		{
+			"success on one RecvPackets message with one new packet and two redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false, true)}
+			},
+			nil,
+		},
+		{
+			"success on one new Acknowledgements message",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(false, false, false)}
+			},
+			nil,
+		},
+		{
+			"success on one Acknowledgements message with one new acknowledgement and two redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, false, true)}
+			},
+			nil,
+		},
 		{
 			"success on one new UpdateClient message",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -368,6 +465,29 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			channeltypes.ErrRedundantTx,
 		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true, true)}
+			},
+			channeltypes.ErrRedundantTx,
+		},
+		{
+			"no success on one Acknowledgements message with only redundant acknowledgements",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createAcknowledgementsMessage(true, true, true)}
+			},
+			channeltypes.ErrRedundantTx,
+		},
+		{
+			"no success on one RecvPackets message with an invalid compressed proof",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createRecvPacketsMessage(false, false)
+				msg.ProofCommitments = []byte("invalid-proof")
+				return []sdk.Msg{msg}
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
 		{
 			"no success on three redundant messages of each type",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -503,6 +623,30 @@ func (suite *AnteTestSuite) TestAnteDecoratorCheckTx() {
 			},
 			channeltypes.ErrRedundantTx,
 		},
+		{
+			"no success on one new RecvPacket message for a paused channel",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createRecvPacketMessage(false)
+
+				err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			channeltypes.ErrChannelPaused,
+		},
+		{
+			"no success on one new Acknowledgement message for a paused channel",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createAcknowledgementMessage(false)
+
+				err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			channeltypes.ErrChannelPaused,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -565,6 +709,13 @@ func (suite *AnteTestSuite) TestAnteDecoratorReCheckTx() {
 			},
 			nil,
 		},
+		{
+			"success on one RecvPackets message with one redundant and one new packet",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, false)}
+			},
+			nil,
+		},
 		{
 			"success on invalid proof (proof checks occur in checkTx)",
 			func(suite *AnteTestSuite) []sdk.Msg {
@@ -595,6 +746,25 @@ func (suite *AnteTestSuite) TestAnteDecoratorReCheckTx() {
 			},
 			channeltypes.ErrRedundantTx,
 		},
+		{
+			"no success on one RecvPackets message with only redundant packets",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				return []sdk.Msg{suite.createRecvPacketsMessage(true, true)}
+			},
+			channeltypes.ErrRedundantTx,
+		},
+		{
+			"no success on one new RecvPacket message for a paused channel",
+			func(suite *AnteTestSuite) []sdk.Msg {
+				msg := suite.createRecvPacketMessage(false)
+
+				err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainB.GetContext(), suite.path.EndpointB.ChannelConfig.PortID, suite.path.EndpointB.ChannelID)
+				suite.Require().NoError(err)
+
+				return []sdk.Msg{msg}
+			},
+			channeltypes.ErrChannelPaused,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -143,6 +143,12 @@ func (suite *IBCTestSuite) TestValidateGenesis() {
 					},
 					0,
 					channeltypes.Params{UpgradeTimeout: channeltypes.DefaultTimeout},
+					[]channeltypes.PausedChannel{
+						channeltypes.NewPausedChannel(port1, channel1),
+					},
+					[]channeltypes.ReceiptExpiry{
+						channeltypes.NewReceiptExpiry(port1, channel1, 1, 1),
+					},
 				),
 			},
 			expPass: true,
@@ -302,6 +308,12 @@ func (suite *IBCTestSuite) TestInitGenesis() {
 					},
 					0,
 					channeltypes.Params{UpgradeTimeout: channeltypes.DefaultTimeout},
+					[]channeltypes.PausedChannel{
+						channeltypes.NewPausedChannel(port1, channel1),
+					},
+					[]channeltypes.ReceiptExpiry{
+						channeltypes.NewReceiptExpiry(port1, channel1, 1, 1),
+					},
 				),
 			},
 		},
@@ -42,3 +42,13 @@ func addPacketLabels(packet types.Packet) []metrics.Label {
 		telemetry.NewLabel(ibcmetrics.LabelDestinationChannel, packet.DestinationChannel),
 	}
 }
+
+func ReportPrunePacketState(portID, channelID string, acknowledgements, receipts uint64) {
+	labels := []metrics.Label{
+		telemetry.NewLabel(ibcmetrics.LabelPortID, portID),
+		telemetry.NewLabel(ibcmetrics.LabelChannelID, channelID),
+	}
+
+	telemetry.IncrCounterWithLabels([]string{"ibc", "prune", "acknowledgements"}, float32(acknowledgements), labels)
+	telemetry.IncrCounterWithLabels([]string{"ibc", "prune", "receipts"}, float32(receipts), labels)
+}
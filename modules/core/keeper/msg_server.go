@@ -217,7 +217,7 @@ func (k *Keeper) ChannelOpenInit(goCtx context.Context, msg *channeltypes.MsgCha
 	}
 
 	// Write channel into state
-	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
+	k.ChannelKeeper.WriteOpenInitChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version, msg.Channel.ReceiptExpiryPeriod)
 
 	ctx.Logger().Info("channel open init succeeded", "channel-id", channelID, "version", version)
 
@@ -241,7 +241,7 @@ func (k *Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChan
 	}
 
 	// Perform 04-channel verification
-	channelID, err := k.ChannelKeeper.ChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight)
+	channelID, err := k.ChannelKeeper.ChanOpenTry(ctx, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.PortId, msg.Channel.Counterparty, msg.CounterpartyVersion, msg.ProofInit, msg.ProofHeight, msg.Channel.ReceiptExpiryPeriod)
 	if err != nil {
 		ctx.Logger().Error("channel open try failed", "error", errorsmod.Wrap(err, "channel handshake open try failed"))
 		return nil, errorsmod.Wrap(err, "channel handshake open try failed")
@@ -255,7 +255,7 @@ func (k *Keeper) ChannelOpenTry(goCtx context.Context, msg *channeltypes.MsgChan
 	}
 
 	// Write channel into state
-	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version)
+	k.ChannelKeeper.WriteOpenTryChannel(ctx, msg.PortId, channelID, msg.Channel.Ordering, msg.Channel.ConnectionHops, msg.Channel.Counterparty, version, msg.Channel.ReceiptExpiryPeriod)
 
 	ctx.Logger().Info("channel open try succeeded", "channel-id", channelID, "port-id", msg.PortId, "version", version)
 
@@ -418,6 +418,13 @@ func (k *Keeper) RecvPacket(goCtx context.Context, msg *channeltypes.MsgRecvPack
 		// no-ops do not need event emission as they will be ignored
 		ctx.Logger().Debug("no-op on redundant relay", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
 		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.NOOP}, nil
+	case channeltypes.ErrTimeoutReceiptWritten:
+		// the packet timed out on an ORDERED_ALLOW_TIMEOUT channel, the timeout receipt is written
+		// but the packet is not passed to the application. Writing the timeout receipt is not redundant,
+		// in agreement with the RedundantRelayDecorator.
+		writeFn()
+		ctx.Logger().Info("timeout receipt written", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel)
+		return &channeltypes.MsgRecvPacketResponse{Result: channeltypes.SUCCESS}, nil
 	default:
 		ctx.Logger().Error("receive packet failed", "port-id", msg.Packet.SourcePort, "channel-id", msg.Packet.SourceChannel, "error", errorsmod.Wrap(err, "receive packet verification failed"))
 		return nil, errorsmod.Wrap(err, "receive packet verification failed")
@@ -613,6 +620,62 @@ func (k *Keeper) Acknowledgement(goCtx context.Context, msg *channeltypes.MsgAck
 	return &channeltypes.MsgAcknowledgementResponse{Result: channeltypes.SUCCESS}, nil
 }
 
+// RecvPackets defines a rpc handler method for MsgRecvPackets. The compressed proof is split into the
+// membership proofs of the packet commitments, and each packet is then received as a MsgRecvPacket,
+// verifying its membership proof independently. The batch fails if any packet fails to be received,
+// redundant packets are no-ops.
+//
+// NOTE: compressed proofs can only be split for light clients verifying ICS-23 merkle proofs.
+func (k *Keeper) RecvPackets(goCtx context.Context, msg *channeltypes.MsgRecvPackets) (*channeltypes.MsgRecvPacketsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recvMsgs, err := msg.RecvPacketMsgs()
+	if err != nil {
+		ctx.Logger().Error("receive packets failed", "error", errorsmod.Wrap(err, "invalid compressed proof"))
+		return nil, errorsmod.Wrap(err, "invalid compressed proof")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(recvMsgs))
+	for i, recvMsg := range recvMsgs {
+		res, err := k.RecvPacket(ctx, recvMsg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet %d", i)
+		}
+
+		results[i] = res.Result
+	}
+
+	return &channeltypes.MsgRecvPacketsResponse{Results: results}, nil
+}
+
+// Acknowledgements defines a rpc handler method for MsgAcknowledgements. The compressed proof is split into
+// the membership proofs of the acknowledgements, and each acknowledgement is then processed as a
+// MsgAcknowledgement, verifying its membership proof independently. The batch fails if any acknowledgement
+// fails, redundant acknowledgements are no-ops.
+//
+// NOTE: compressed proofs can only be split for light clients verifying ICS-23 merkle proofs.
+func (k *Keeper) Acknowledgements(goCtx context.Context, msg *channeltypes.MsgAcknowledgements) (*channeltypes.MsgAcknowledgementsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	ackMsgs, err := msg.AcknowledgementMsgs()
+	if err != nil {
+		ctx.Logger().Error("acknowledgements failed", "error", errorsmod.Wrap(err, "invalid compressed proof"))
+		return nil, errorsmod.Wrap(err, "invalid compressed proof")
+	}
+
+	results := make([]channeltypes.ResponseResultType, len(ackMsgs))
+	for i, ackMsg := range ackMsgs {
+		res, err := k.Acknowledgement(ctx, ackMsg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "packet %d", i)
+		}
+
+		results[i] = res.Result
+	}
+
+	return &channeltypes.MsgAcknowledgementsResponse{Results: results}, nil
+}
+
 // ChannelUpgradeInit defines a rpc handler method for MsgChannelUpgradeInit.
 func (k *Keeper) ChannelUpgradeInit(goCtx context.Context, msg *channeltypes.MsgChannelUpgradeInit) (*channeltypes.MsgChannelUpgradeInitResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
@@ -949,6 +1012,118 @@ func (k *Keeper) PruneAcknowledgements(goCtx context.Context, msg *channeltypes.
 	}, nil
 }
 
+// UpdateCounterpartyNextSequenceAck defines a rpc handler method for MsgUpdateCounterpartyNextSequenceAck.
+func (k *Keeper) UpdateCounterpartyNextSequenceAck(goCtx context.Context, msg *channeltypes.MsgUpdateCounterpartyNextSequenceAck) (*channeltypes.MsgUpdateCounterpartyNextSequenceAckResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.ChannelKeeper.UpdateCounterpartyNextSequenceAck(ctx, msg.PortId, msg.ChannelId, msg.NextSequenceAck, msg.ProofNextSequenceAck, msg.ProofHeight); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgUpdateCounterpartyNextSequenceAckResponse{}, nil
+}
+
+// ForceTimeoutPackets defines a rpc handler method for MsgForceTimeoutPackets.
+func (k *Keeper) ForceTimeoutPackets(goCtx context.Context, msg *channeltypes.MsgForceTimeoutPackets) (*channeltypes.MsgForceTimeoutPacketsResponse, error) {
+	if k.GetAuthority() != msg.Signer {
+		return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s, got %s", k.GetAuthority(), msg.Signer)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	signer, err := sdk.AccAddressFromBech32(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "Invalid address for msg Signer")
+	}
+
+	// Retrieve callbacks from router
+	cbs, ok := k.PortKeeper.Route(msg.PortId)
+	if !ok {
+		ctx.Logger().Error("force timeout failed", "port-id", msg.PortId, "error", errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to portID: %s", msg.PortId))
+		return nil, errorsmod.Wrapf(porttypes.ErrInvalidRoute, "route not found to portID: %s", msg.PortId)
+	}
+
+	for _, packet := range msg.Packets {
+		// If the packet was already timed out or acknowledged, perform a no-op
+		// Use a cached context to prevent accidental state changes
+		cacheCtx, writeFn := ctx.CacheContext()
+		channelVersion, err := k.ChannelKeeper.ForceTimeoutPacket(cacheCtx, packet)
+
+		switch err {
+		case nil:
+			writeFn()
+		case channeltypes.ErrNoOpMsg:
+			ctx.Logger().Debug("no-op on redundant force timeout", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+			continue
+		default:
+			ctx.Logger().Error("force timeout failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", errorsmod.Wrap(err, "force timeout packet verification failed"))
+			return nil, errorsmod.Wrapf(err, "force timeout packet verification failed for sequence %d", packet.Sequence)
+		}
+
+		// Delete packet commitment
+		if err := k.ChannelKeeper.TimeoutExecuted(ctx, packet); err != nil {
+			return nil, err
+		}
+
+		// Perform application logic callback
+		if err := cbs.OnTimeoutPacket(ctx, channelVersion, packet, signer); err != nil {
+			ctx.Logger().Error("force timeout failed", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence, "error", errorsmod.Wrap(err, "timeout packet callback failed"))
+			return nil, errorsmod.Wrapf(err, "timeout packet callback failed for sequence %d", packet.Sequence)
+		}
+
+		telemetry.ReportTimeoutPacket(packet, "force")
+
+		ctx.Logger().Info("force timeout packet callback succeeded", "port-id", packet.SourcePort, "channel-id", packet.SourceChannel, "sequence", packet.Sequence)
+	}
+
+	return &channeltypes.MsgForceTimeoutPacketsResponse{}, nil
+}
+
+// PauseChannel defines a rpc handler method for MsgPauseChannel.
+func (k *Keeper) PauseChannel(goCtx context.Context, msg *channeltypes.MsgPauseChannel) (*channeltypes.MsgPauseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.authorizeChannelPause(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.PauseChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgPauseChannelResponse{}, nil
+}
+
+// UnpauseChannel defines a rpc handler method for MsgUnpauseChannel.
+func (k *Keeper) UnpauseChannel(goCtx context.Context, msg *channeltypes.MsgUnpauseChannel) (*channeltypes.MsgUnpauseChannelResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := k.authorizeChannelPause(ctx, msg.Signer); err != nil {
+		return nil, err
+	}
+
+	if err := k.ChannelKeeper.UnpauseChannel(ctx, msg.PortId, msg.ChannelId); err != nil {
+		return nil, err
+	}
+
+	return &channeltypes.MsgUnpauseChannelResponse{}, nil
+}
+
+// authorizeChannelPause returns an error if the signer is neither the authority nor the pause guardian
+// set in the channel parameters.
+func (k *Keeper) authorizeChannelPause(ctx sdk.Context, signer string) error {
+	if signer == k.GetAuthority() {
+		return nil
+	}
+
+	guardian := k.ChannelKeeper.GetParams(ctx).PauseGuardian
+	if guardian != "" && signer == guardian {
+		return nil
+	}
+
+	return errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "expected %s or pause guardian %s, got %s", k.GetAuthority(), guardian, signer)
+}
+
 // UpdateClientParams defines a rpc handler method for MsgUpdateParams.
 func (k *Keeper) UpdateClientParams(goCtx context.Context, msg *clienttypes.MsgUpdateParams) (*clienttypes.MsgUpdateParamsResponse, error) {
 	if k.GetAuthority() != msg.Signer {
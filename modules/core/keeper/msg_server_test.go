@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"slices"
+	"time"
 
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
@@ -216,6 +218,35 @@ func (suite *KeeperTestSuite) TestHandleRecvPacket() {
 	}
 }
 
+// TestHandleRecvPacketTimeoutReceipt tests that receiving a timed out packet on an ORDERED_ALLOW_TIMEOUT
+// channel writes the timeout receipt, does not call the application and is not a no-op.
+func (suite *KeeperTestSuite) TestHandleRecvPacketTimeoutReceipt() {
+	path := ibctesting.NewPath(suite.chainA, suite.chainB)
+	path.SetChannelOrderedAllowTimeout()
+	path.Setup()
+
+	timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+	sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+	suite.Require().NoError(err)
+
+	packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+	proof, proofHeight := path.EndpointA.QueryProof(host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+	msg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+	ctx := suite.chainB.GetContext()
+	res, err := suite.chainB.App.GetIBCKeeper().RecvPacket(ctx, msg)
+	suite.Require().NoError(err)
+	suite.Require().Equal(channeltypes.SUCCESS, res.Result)
+	suite.Require().NotContains(ctx.EventManager().Events(), ibcmock.NewMockRecvPacketEvent())
+
+	receipt, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketReceipt(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().True(found)
+	suite.Require().Equal(string(channeltypes.TimeoutReceipt), receipt)
+
+	_, found = suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	suite.Require().False(found)
+}
+
 func (suite *KeeperTestSuite) TestRecoverClient() {
 	var msg *clienttypes.MsgRecoverClient
 
@@ -449,6 +480,218 @@ func (suite *KeeperTestSuite) TestHandleAcknowledgePacket() {
 	}
 }
 
+// tests the IBC handler receiving a batch of packets proven by a compressed proof. More rigorous
+// testing of receiving a packet can be found in TestHandleRecvPacket.
+func (suite *KeeperTestSuite) TestHandleRecvPackets() {
+	var (
+		packets []channeltypes.Packet
+		path    *ibctesting.Path
+		msg     *channeltypes.MsgRecvPackets
+	)
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []channeltypes.ResponseResultType
+		expErr     error
+	}{
+		{
+			"success",
+			func() {},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet already received is a no-op",
+			func() {
+				err := path.EndpointB.RecvPacket(packets[1])
+				suite.Require().NoError(err)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"failure: compressed proof does not prove all the packets",
+			func() {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				msg.Packets = append(msg.Packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: proof is not a compressed proof",
+			func() {
+				msg.ProofCommitments, _ = path.EndpointA.QueryProof(host.PacketCommitmentKey(packets[0].GetSourcePort(), packets[0].GetSourceChannel(), packets[0].GetSequence()))
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: packets are not in the order of the compressed proof",
+			func() {
+				msg.Packets[0], msg.Packets[1] = msg.Packets[1], msg.Packets[0]
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets = nil
+			var packetKeys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				packets = append(packets, packet)
+				packetKeys = append(packetKeys, host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence()))
+			}
+
+			proof, proofHeight := path.EndpointA.QueryCompressedProof(packetKeys...)
+			msg = channeltypes.NewMsgRecvPackets(slices.Clone(packets), proof, proofHeight, suite.chainB.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			res, err := suite.chainB.App.GetIBCKeeper().RecvPackets(suite.chainB.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+
+				for _, packet := range packets {
+					_, found := suite.chainB.App.GetIBCKeeper().ChannelKeeper.GetPacketAcknowledgement(suite.chainB.GetContext(), packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+					suite.Require().True(found)
+				}
+
+				// replay should not fail since it will be treated as a no-op
+				res, err = suite.chainB.App.GetIBCKeeper().RecvPackets(suite.chainB.GetContext(), msg)
+				suite.Require().NoError(err)
+				suite.Require().Equal([]channeltypes.ResponseResultType{channeltypes.NOOP, channeltypes.NOOP, channeltypes.NOOP}, res.Results)
+			} else {
+				suite.Require().Nil(res)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
+// tests the IBC handler acknowledging a batch of packets proven by a compressed proof. More rigorous
+// testing of acknowledging a packet can be found in TestHandleAcknowledgePacket.
+func (suite *KeeperTestSuite) TestHandleAcknowledgePackets() {
+	var (
+		packets []channeltypes.Packet
+		path    *ibctesting.Path
+		msg     *channeltypes.MsgAcknowledgements
+	)
+
+	ack := ibcmock.MockAcknowledgement.Acknowledgement()
+
+	testCases := []struct {
+		name       string
+		malleate   func()
+		expResults []channeltypes.ResponseResultType
+		expErr     error
+	}{
+		{
+			"success",
+			func() {},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.SUCCESS, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"success: packet already acknowledged is a no-op",
+			func() {
+				err := path.EndpointA.AcknowledgePacket(packets[1], ack)
+				suite.Require().NoError(err)
+			},
+			[]channeltypes.ResponseResultType{channeltypes.SUCCESS, channeltypes.NOOP, channeltypes.SUCCESS},
+			nil,
+		},
+		{
+			"failure: acknowledgements do not match packets",
+			func() {
+				msg.Acknowledgements = msg.Acknowledgements[:2]
+			},
+			nil,
+			channeltypes.ErrInvalidAcknowledgement,
+		},
+		{
+			"failure: compressed proof does not prove all the acknowledgements",
+			func() {
+				msg.Packets = msg.Packets[:2]
+				msg.Acknowledgements = msg.Acknowledgements[:2]
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+		{
+			"failure: acknowledgement does not match the compressed proof",
+			func() {
+				msg.Acknowledgements[2] = ibctesting.MockFailPacketData
+			},
+			nil,
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+
+		suite.Run(tc.name, func() {
+			suite.SetupTest() // reset
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			packets = nil
+			var ackKeys [][]byte
+			for i := 0; i < 3; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packet := channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0)
+				packets = append(packets, packet)
+				ackKeys = append(ackKeys, host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence()))
+			}
+
+			err := path.EndpointB.RecvPackets(packets...)
+			suite.Require().NoError(err)
+
+			proof, proofHeight := path.EndpointB.QueryCompressedProof(ackKeys...)
+			msg = channeltypes.NewMsgAcknowledgements(slices.Clone(packets), [][]byte{ack, ack, ack}, proof, proofHeight, suite.chainA.SenderAccount.GetAddress().String())
+
+			tc.malleate()
+
+			res, err := suite.chainA.App.GetIBCKeeper().Acknowledgements(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().Equal(tc.expResults, res.Results)
+
+				for _, packet := range packets {
+					has := suite.chainA.App.GetIBCKeeper().ChannelKeeper.HasPacketCommitment(suite.chainA.GetContext(), packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+					suite.Require().False(has)
+				}
+			} else {
+				suite.Require().Nil(res)
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+			}
+		})
+	}
+}
+
 // tests the IBC handler timing out a packet on ordered and unordered channels.
 // It verifies that the deletion of a packet commitment occurs. It tests
 // high level properties like ordering and basic sanity checks. More
@@ -2756,3 +2999,339 @@ func (suite *KeeperTestSuite) TestPruneAcknowledgements() {
 		})
 	}
 }
+
+func (suite *KeeperTestSuite) TestUpdateCounterpartyNextSequenceAck() {
+	var msg *channeltypes.MsgUpdateCounterpartyNextSequenceAck
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"failure: core keeper function fails, invalid proof",
+			func() {
+				msg.NextSequenceAck++
+			},
+			commitmenttypes.ErrInvalidProof,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path := ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.SetChannelOrdered()
+			path.Setup()
+
+			// send a packet from chainB which is received and acknowledged on chainA
+			ctx := suite.chainB.GetContext()
+			sequence, err := suite.chainB.App.GetIBCKeeper().ChannelKeeper.SendPacket(ctx, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, suite.chainA.GetTimeoutHeight(), 0, ibctesting.MockPacketData)
+			suite.Require().NoError(err)
+
+			packet, err := ibctesting.ParsePacketFromEvents(ctx.EventManager().Events().ToABCIEvents())
+			suite.Require().NoError(err)
+
+			suite.coordinator.CommitBlock(suite.chainB)
+
+			err = path.RelayPacket(packet)
+			suite.Require().NoError(err)
+
+			err = path.EndpointA.UpdateClient()
+			suite.Require().NoError(err)
+
+			nextSequenceAckKey := host.NextSequenceAckKey(path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID)
+			proof, proofHeight := suite.chainB.QueryProof(nextSequenceAckKey)
+
+			msg = channeltypes.NewMsgUpdateCounterpartyNextSequenceAck(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				sequence+1,
+				proof,
+				proofHeight,
+				suite.chainA.SenderAccount.GetAddress().String(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().UpdateCounterpartyNextSequenceAck(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				nextSequenceAck, found := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetCounterpartyNextSequenceAck(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().True(found)
+				suite.Require().Equal(sequence+1, nextSequenceAck)
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestForceTimeoutPackets() {
+	var (
+		path    *ibctesting.Path
+		packets []channeltypes.Packet
+		msg     *channeltypes.MsgForceTimeoutPackets
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success",
+			func() {},
+			nil,
+		},
+		{
+			"success: packet already timed out is skipped",
+			func() {
+				timeoutMsg := channeltypes.NewMsgForceTimeoutPackets(msg.PortId, msg.ChannelId, packets[:1], msg.Signer)
+				_, err := suite.chainA.App.GetIBCKeeper().ForceTimeoutPackets(suite.chainA.GetContext(), timeoutMsg)
+				suite.Require().NoError(err)
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = suite.chainA.SenderAccount.GetAddress().String()
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: port route not found",
+			func() {
+				msg.PortId = "invalid-port"
+			},
+			porttypes.ErrInvalidRoute,
+		},
+		{
+			"failure: force timeout not allowed",
+			func() {
+				params := channeltypes.NewParams(channeltypes.DefaultTimeout, 0)
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+			},
+			channeltypes.ErrForceTimeoutNotAllowed,
+		},
+		{
+			"failure: application callback fails",
+			func() {
+				suite.chainA.GetSimApp().IBCMockModule.IBCApp.OnTimeoutPacket = func(ctx context.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+					return ibcmock.MockApplicationCallbackError
+				}
+			},
+			ibcmock.MockApplicationCallbackError,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			timeoutHeight := clienttypes.GetSelfHeight(suite.chainB.GetContext())
+			packets = nil
+			for i := 0; i < 2; i++ {
+				sequence, err := path.EndpointA.SendPacket(timeoutHeight, 0, ibctesting.MockPacketData)
+				suite.Require().NoError(err)
+
+				packets = append(packets, channeltypes.NewPacket(ibctesting.MockPacketData, sequence, path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, path.EndpointB.ChannelConfig.PortID, path.EndpointB.ChannelID, timeoutHeight, 0))
+			}
+
+			// expire the client of chainB on chainA and exceed the force timeout threshold
+			suite.coordinator.IncrementTimeBy(ibctesting.TrustingPeriod + time.Duration(channeltypes.DefaultForceTimeoutThreshold))
+
+			msg = channeltypes.NewMsgForceTimeoutPackets(
+				path.EndpointA.ChannelConfig.PortID,
+				path.EndpointA.ChannelID,
+				packets,
+				suite.chainA.App.GetIBCKeeper().GetAuthority(),
+			)
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().ForceTimeoutPackets(suite.chainA.GetContext(), msg)
+
+			if tc.expErr == nil {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+
+				for _, packet := range packets {
+					commitment := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetPacketCommitment(suite.chainA.GetContext(), packet.SourcePort, packet.SourceChannel, packet.Sequence)
+					suite.Require().Empty(commitment)
+				}
+			} else {
+				ibctesting.RequireErrorIsOrContains(suite.T(), err, tc.expErr)
+				suite.Require().Nil(resp)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestPauseChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgPauseChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authority",
+			func() {},
+			nil,
+		},
+		{
+			"success: pause guardian",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PauseGuardian = ibctesting.TestAccAddress
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel not found",
+			func() {
+				msg.ChannelId = ibctesting.InvalidID
+			},
+			channeltypes.ErrChannelNotFound,
+		},
+		{
+			"failure: channel is already paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrChannelPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			msg = channeltypes.NewMsgPauseChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().PauseChannel(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().True(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
+
+func (suite *KeeperTestSuite) TestUnpauseChannel() {
+	var (
+		path *ibctesting.Path
+		msg  *channeltypes.MsgUnpauseChannel
+	)
+
+	testCases := []struct {
+		name     string
+		malleate func()
+		expErr   error
+	}{
+		{
+			"success: authority",
+			func() {},
+			nil,
+		},
+		{
+			"success: pause guardian",
+			func() {
+				params := suite.chainA.App.GetIBCKeeper().ChannelKeeper.GetParams(suite.chainA.GetContext())
+				params.PauseGuardian = ibctesting.TestAccAddress
+				suite.chainA.App.GetIBCKeeper().ChannelKeeper.SetParams(suite.chainA.GetContext(), params)
+
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			nil,
+		},
+		{
+			"failure: unauthorized signer",
+			func() {
+				msg.Signer = ibctesting.TestAccAddress
+			},
+			ibcerrors.ErrUnauthorized,
+		},
+		{
+			"failure: channel is not paused",
+			func() {
+				err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.UnpauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+				suite.Require().NoError(err)
+			},
+			channeltypes.ErrChannelNotPaused,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		suite.Run(tc.name, func() {
+			suite.SetupTest()
+
+			path = ibctesting.NewPath(suite.chainA, suite.chainB)
+			path.Setup()
+
+			err := suite.chainA.App.GetIBCKeeper().ChannelKeeper.PauseChannel(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID)
+			suite.Require().NoError(err)
+
+			msg = channeltypes.NewMsgUnpauseChannel(path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID, suite.chainA.App.GetIBCKeeper().GetAuthority())
+
+			tc.malleate()
+
+			resp, err := suite.chainA.App.GetIBCKeeper().UnpauseChannel(suite.chainA.GetContext(), msg)
+
+			expPass := tc.expErr == nil
+			if expPass {
+				suite.Require().NoError(err)
+				suite.Require().NotNil(resp)
+				suite.Require().False(suite.chainA.App.GetIBCKeeper().ChannelKeeper.IsChannelPaused(suite.chainA.GetContext(), path.EndpointA.ChannelConfig.PortID, path.EndpointA.ChannelID))
+			} else {
+				suite.Require().Nil(resp)
+				suite.Require().ErrorIs(err, tc.expErr)
+			}
+		})
+	}
+}
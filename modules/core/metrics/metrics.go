@@ -18,4 +18,9 @@ const (
 	LabelTimeoutType        = "timeout_type"
 	LabelDenom              = "denom"
 	LabelSource             = "source"
+
+	// 04-channel labels
+
+	LabelPortID    = "port_id"
+	LabelChannelID = "channel_id"
 )
@@ -26,6 +26,9 @@ import (
 	"cosmossdk.io/x/feegrant"
 	feegrantkeeper "cosmossdk.io/x/feegrant/keeper"
 	feegrantmodule "cosmossdk.io/x/feegrant/module"
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+	nftmodule "cosmossdk.io/x/nft/module"
 	"cosmossdk.io/x/tx/signing"
 	"cosmossdk.io/x/upgrade"
 	upgradekeeper "cosmossdk.io/x/upgrade/keeper"
@@ -112,6 +115,9 @@ import (
 	ibcfee "github.com/cosmos/ibc-go/v9/modules/apps/29-fee"
 	ibcfeekeeper "github.com/cosmos/ibc-go/v9/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	nfttransfer "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer"
+	nfttransferkeeper "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -142,6 +148,8 @@ var (
 		ibctransfertypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
+		nft.ModuleName:                 nil,
+		nfttransfertypes.ModuleName:    nil,
 	}
 )
 
@@ -185,6 +193,8 @@ type SimApp struct {
 	GroupKeeper           groupkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	CircuitKeeper         circuitkeeper.Keeper
+	NFTKeeper             nftkeeper.Keeper
+	NFTTransferKeeper     nfttransferkeeper.Keeper
 
 	// the module manager
 	ModuleManager      *module.Manager
@@ -232,6 +242,7 @@ func NewSimApp(
 
 	std.RegisterLegacyAminoCodec(legacyAmino)
 	std.RegisterInterfaces(interfaceRegistry)
+	RegisterNFTDataInterfaces(interfaceRegistry)
 
 	// Below we could construct and set an application specific mempool and
 	// ABCI 1.0 PrepareProposal and ProcessProposal handlers. These defaults are
@@ -271,6 +282,7 @@ func NewSimApp(
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey, feegrant.StoreKey,
 		evidencetypes.StoreKey, ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		authzkeeper.StoreKey, ibcfeetypes.StoreKey, consensusparamtypes.StoreKey, circuittypes.StoreKey,
+		nft.StoreKey, nfttransfertypes.StoreKey,
 	)
 
 	// register streaming services
@@ -326,6 +338,8 @@ func NewSimApp(
 
 	app.FeeGrantKeeper = feegrantkeeper.NewKeeper(appCodec, runtime.NewKVStoreService(keys[feegrant.StoreKey]), app.AccountKeeper)
 
+	app.NFTKeeper = nftkeeper.NewKeeper(runtime.NewKVStoreService(keys[nft.StoreKey]), appCodec, app.AccountKeeper, app.BankKeeper)
+
 	// register the staking hooks
 	// NOTE: stakingKeeper above is passed by reference, so that it will contain these hooks
 	app.StakingKeeper.SetHooks(
@@ -410,9 +424,17 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// protocol fees without a fee recipient are sent to the community pool
+	app.TransferKeeper.WithDistributionKeeper(app.DistrKeeper)
+	// the memos of transfers are validated against the memo keys registered by the transfer application.
+	// NOTE: when the transfer stack is wrapped by middlewares interpreting the memo, such as the callbacks
+	// middleware, their keys must be registered as well, e.g. the callbacks SourceCallbackKey and
+	// DestinationCallbackKey with the CallbackMemoSchema.
+	app.TransferKeeper.WithMemoRegistry(ibctransfertypes.NewMemoRegistry(false))
 
 	// Create Transfer Stack
 	// SendPacket, since it is originating from the application to core IBC:
@@ -433,6 +455,18 @@ func NewSimApp(
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 
+	// Create NFT Transfer Keeper, using an adapter of the x/nft keeper as the keeper of the non-fungible tokens
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[nfttransfertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: core IBC
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper, NewNFTKeeperAdapter(app.NFTKeeper),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Add nft-transfer stack to IBC Router
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nfttransfer.NewIBCModule(app.NFTTransferKeeper))
+
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
 	// icaControllerKeeper.SendTx -> fee.SendPacket -> channel.SendPacket
@@ -501,12 +535,14 @@ func NewSimApp(
 		groupmodule.NewAppModule(appCodec, app.GroupKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 		consensus.NewAppModule(appCodec, app.ConsensusParamsKeeper),
 		circuit.NewAppModule(appCodec, app.CircuitKeeper),
+		nftmodule.NewAppModule(appCodec, app.NFTKeeper, app.AccountKeeper, app.BankKeeper, app.interfaceRegistry),
 
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper, app.AccountKeeper, app.BankKeeper),
 
 		// IBC light clients
 		ibctm.NewAppModule(tmLightClientModule),
@@ -575,6 +611,7 @@ func NewSimApp(
 		ibcexported.ModuleName, genutiltypes.ModuleName, evidencetypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
 		icatypes.ModuleName, ibcfeetypes.ModuleName, feegrant.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, group.ModuleName, consensusparamtypes.ModuleName, circuittypes.ModuleName,
+		nft.ModuleName, nfttransfertypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
 	app.ModuleManager.SetOrderExportGenesis(genesisModuleOrder...)
@@ -0,0 +1,174 @@
+package simapp
+
+import (
+	"context"
+
+	"github.com/cosmos/gogoproto/proto"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"cosmossdk.io/x/nft"
+	nftkeeper "cosmossdk.io/x/nft/keeper"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+)
+
+var (
+	_ nfttransfertypes.NFTKeeper = (*NFTKeeperAdapter)(nil)
+	_ nfttransfertypes.NFTClass  = (*nftClass)(nil)
+	_ nfttransfertypes.NFT       = (*nftToken)(nil)
+)
+
+// NFTKeeperAdapter adapts the x/nft keeper to the keeper of the non-fungible tokens expected by the
+// nft-transfer application. The class and token data of ICS-721 are stored in the data of the x/nft
+// classes and tokens as a google.protobuf.StringValue, which must be registered on the interface
+// registry of the application using RegisterNFTDataInterfaces. The data of classes and tokens which
+// have not been created through the adapter is transferred as an empty string.
+type NFTKeeperAdapter struct {
+	keeper nftkeeper.Keeper
+}
+
+// NewNFTKeeperAdapter creates a new NFTKeeperAdapter instance.
+func NewNFTKeeperAdapter(keeper nftkeeper.Keeper) NFTKeeperAdapter {
+	return NFTKeeperAdapter{keeper: keeper}
+}
+
+// RegisterNFTDataInterfaces registers the type used by the NFTKeeperAdapter to store the class and token
+// data of ICS-721 in the x/nft classes and tokens, so that they can be encoded to JSON, e.g. on genesis export.
+func RegisterNFTDataInterfaces(registry codectypes.InterfaceRegistry) {
+	registry.RegisterImplementations((*proto.Message)(nil), &gogotypes.StringValue{})
+}
+
+// SaveClass stores a new class in the x/nft keeper. An error is returned if the class already exists.
+func (a NFTKeeperAdapter) SaveClass(ctx context.Context, classID, classURI, classData string) error {
+	data, err := newNFTData(classData)
+	if err != nil {
+		return err
+	}
+
+	return a.keeper.SaveClass(ctx, nft.Class{Id: classID, Uri: classURI, Data: data})
+}
+
+// GetClass returns the class with the provided identifier.
+func (a NFTKeeperAdapter) GetClass(ctx context.Context, classID string) (nfttransfertypes.NFTClass, bool) {
+	class, found := a.keeper.GetClass(ctx, classID)
+	if !found {
+		return nil, false
+	}
+
+	return nftClass{class: class}, true
+}
+
+// GetClasses returns all the classes.
+func (a NFTKeeperAdapter) GetClasses(ctx context.Context) []nfttransfertypes.NFTClass {
+	var classes []nfttransfertypes.NFTClass
+	for _, class := range a.keeper.GetClasses(ctx) {
+		classes = append(classes, nftClass{class: *class})
+	}
+
+	return classes
+}
+
+// Mint creates a new token of an existing class owned by the receiver. An error is returned
+// if the class does not exist or the token already exists.
+func (a NFTKeeperAdapter) Mint(ctx context.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	data, err := newNFTData(tokenData)
+	if err != nil {
+		return err
+	}
+
+	return a.keeper.Mint(ctx, nft.NFT{ClassId: classID, Id: tokenID, Uri: tokenURI, Data: data}, receiver)
+}
+
+// Transfer sets the owner of an existing token to the receiver.
+func (a NFTKeeperAdapter) Transfer(ctx context.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	return a.keeper.Transfer(ctx, classID, tokenID, receiver)
+}
+
+// Burn deletes an existing token.
+func (a NFTKeeperAdapter) Burn(ctx context.Context, classID, tokenID string) error {
+	return a.keeper.Burn(ctx, classID, tokenID)
+}
+
+// GetNFT returns the token of the class with the provided identifiers.
+func (a NFTKeeperAdapter) GetNFT(ctx context.Context, classID, tokenID string) (nfttransfertypes.NFT, bool) {
+	token, found := a.keeper.GetNFT(ctx, classID, tokenID)
+	if !found {
+		return nil, false
+	}
+
+	return nftToken{token: token}, true
+}
+
+// GetNFTsOfClassByOwner returns the tokens of the class owned by the provided owner.
+func (a NFTKeeperAdapter) GetNFTsOfClassByOwner(ctx context.Context, classID string, owner sdk.AccAddress) []nfttransfertypes.NFT {
+	var tokens []nfttransfertypes.NFT
+	for _, token := range a.keeper.GetNFTsOfClassByOwner(ctx, classID, owner) {
+		tokens = append(tokens, nftToken{token: token})
+	}
+
+	return tokens
+}
+
+// GetOwner returns the owner of the token, or nil if the token does not exist.
+func (a NFTKeeperAdapter) GetOwner(ctx context.Context, classID, tokenID string) sdk.AccAddress {
+	return a.keeper.GetOwner(ctx, classID, tokenID)
+}
+
+// nftClass wraps an x/nft class to implement nfttransfertypes.NFTClass.
+type nftClass struct {
+	class nft.Class
+}
+
+// GetID implements nfttransfertypes.NFTClass.
+func (c nftClass) GetID() string { return c.class.Id }
+
+// GetURI implements nfttransfertypes.NFTClass.
+func (c nftClass) GetURI() string { return c.class.Uri }
+
+// GetData implements nfttransfertypes.NFTClass.
+func (c nftClass) GetData() string { return nftDataString(c.class.Data) }
+
+// nftToken wraps an x/nft token to implement nfttransfertypes.NFT.
+type nftToken struct {
+	token nft.NFT
+}
+
+// GetClassID implements nfttransfertypes.NFT.
+func (t nftToken) GetClassID() string { return t.token.ClassId }
+
+// GetID implements nfttransfertypes.NFT.
+func (t nftToken) GetID() string { return t.token.Id }
+
+// GetURI implements nfttransfertypes.NFT.
+func (t nftToken) GetURI() string { return t.token.Uri }
+
+// GetData implements nfttransfertypes.NFT.
+func (t nftToken) GetData() string { return nftDataString(t.token.Data) }
+
+// newNFTData returns the data of an x/nft class or token holding the provided ICS-721 data,
+// or nil if the data is empty.
+func newNFTData(data string) (*codectypes.Any, error) {
+	if data == "" {
+		return nil, nil
+	}
+
+	return codectypes.NewAnyWithValue(&gogotypes.StringValue{Value: data})
+}
+
+// nftDataString returns the ICS-721 data held by the data of an x/nft class or token. An empty
+// string is returned if the data is not a google.protobuf.StringValue.
+func nftDataString(data *codectypes.Any) string {
+	if data == nil || data.TypeUrl != "/"+proto.MessageName(&gogotypes.StringValue{}) {
+		return ""
+	}
+
+	var value gogotypes.StringValue
+	if err := proto.Unmarshal(data.Value, &value); err != nil {
+		return ""
+	}
+
+	return value.Value
+}
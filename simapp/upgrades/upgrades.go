@@ -29,6 +29,8 @@ const (
 	V8_1 = "v8.1"
 	// V9 defines the upgrade name for the ibc-go/v9 upgrade handler.
 	V9 = "v9"
+	// V10 defines the upgrade name for the ibc-go/v10 upgrade handler.
+	V10 = "v10"
 )
 
 // CreateDefaultUpgradeHandler creates an upgrade handler which can be used for regular upgrade tests
@@ -251,6 +251,41 @@ func (chain *TestChain) QueryProofForStore(storeKey string, key []byte, height i
 	return proof, clienttypes.NewHeight(revision, uint64(res.Height)+1)
 }
 
+// QueryCompressedProof performs abci queries with the given keys and returns the proto encoded compressed proof of
+// the keys, in order, and the height at which the proof will succeed on a tendermint verifier. Only the IBC
+// store is supported.
+func (chain *TestChain) QueryCompressedProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	return chain.QueryCompressedProofAtHeight(chain.App.LastBlockHeight(), keys...)
+}
+
+// QueryCompressedProofAtHeight performs abci queries with the given keys at the provided height and returns the
+// proto encoded compressed proof of the keys, in order, and the height at which the proof will succeed on a
+// tendermint verifier. Only the IBC store is supported.
+func (chain *TestChain) QueryCompressedProofAtHeight(height int64, keys ...[]byte) ([]byte, clienttypes.Height) {
+	var (
+		merkleProofs []commitmenttypes.MerkleProof
+		proofHeight  clienttypes.Height
+	)
+
+	for _, key := range keys {
+		var bz []byte
+		bz, proofHeight = chain.QueryProofAtHeight(key, height)
+
+		var merkleProof commitmenttypes.MerkleProof
+		require.NoError(chain.TB, chain.App.AppCodec().Unmarshal(bz, &merkleProof))
+
+		merkleProofs = append(merkleProofs, merkleProof)
+	}
+
+	compressedProof, err := commitmenttypes.NewCompressedMerkleProof(merkleProofs)
+	require.NoError(chain.TB, err)
+
+	proof, err := chain.App.AppCodec().Marshal(&compressedProof)
+	require.NoError(chain.TB, err)
+
+	return proof, proofHeight
+}
+
 // QueryUpgradeProof performs an abci query with the given key and returns the proto encoded merkle proof
 // for the query and the height at which the proof will succeed on a tendermint verifier.
 func (chain *TestChain) QueryUpgradeProof(key []byte, height uint64) ([]byte, clienttypes.Height) {
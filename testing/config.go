@@ -47,10 +47,11 @@ func NewConnectionConfig() *ConnectionConfig {
 }
 
 type ChannelConfig struct {
-	PortID          string
-	Version         string
-	Order           channeltypes.Order
-	ProposedUpgrade channeltypes.Upgrade
+	PortID              string
+	Version             string
+	Order               channeltypes.Order
+	ProposedUpgrade     channeltypes.Upgrade
+	ReceiptExpiryPeriod uint64
 }
 
 func NewChannelConfig() *ChannelConfig {
@@ -84,6 +84,15 @@ func (endpoint *Endpoint) QueryProofAtHeight(key []byte, height uint64) ([]byte,
 	return endpoint.Chain.QueryProofAtHeight(key, int64(height))
 }
 
+// QueryCompressedProof queries the compressed proof of the provided keys associated with this endpoint using the
+// latest client state height on the counterparty chain.
+func (endpoint *Endpoint) QueryCompressedProof(keys ...[]byte) ([]byte, clienttypes.Height) {
+	// obtain the counterparty client height.
+	latestCounterpartyHeight := endpoint.Counterparty.GetClientLatestHeight()
+	// query proof on the counterparty using the latest height of the IBC client
+	return endpoint.Chain.QueryCompressedProofAtHeight(int64(latestCounterpartyHeight.GetRevisionHeight()), keys...)
+}
+
 // CreateClient creates an IBC client on the endpoint. It will update the
 // clientID for the endpoint if the message is successfully executed.
 // NOTE: a solo machine client will be created with an empty diversifier.
@@ -330,6 +339,7 @@ func (endpoint *Endpoint) ChanOpenInit() error {
 		endpoint.Counterparty.ChannelConfig.PortID,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.Channel.ReceiptExpiryPeriod = endpoint.ChannelConfig.ReceiptExpiryPeriod
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
@@ -362,6 +372,7 @@ func (endpoint *Endpoint) ChanOpenTry() error {
 		proof, height,
 		endpoint.Chain.SenderAccount.GetAddress().String(),
 	)
+	msg.Channel.ReceiptExpiryPeriod = endpoint.ChannelConfig.ReceiptExpiryPeriod
 	res, err := endpoint.Chain.SendMsgs(msg)
 	if err != nil {
 		return err
@@ -490,6 +501,26 @@ func (endpoint *Endpoint) RecvPacketWithResult(packet channeltypes.Packet) (*abc
 	return res, nil
 }
 
+// RecvPackets receives a batch of packets on the associated endpoint with a single MsgRecvPackets.
+// The counterparty client is updated.
+func (endpoint *Endpoint) RecvPackets(packets ...channeltypes.Packet) error {
+	// get compressed proof of packet commitments on source
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.Chain.QueryCompressedProof(packetKeys...)
+
+	recvMsg := channeltypes.NewMsgRecvPackets(packets, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	// receive on counterparty and update source client
+	if err := endpoint.Chain.sendMsgs(recvMsg); err != nil {
+		return err
+	}
+
+	return endpoint.Counterparty.UpdateClient()
+}
+
 // WriteAcknowledgement writes an acknowledgement on the channel associated with the endpoint.
 // The counterparty client is updated.
 func (endpoint *Endpoint) WriteAcknowledgement(ack exported.Acknowledgement, packet exported.PacketI) error {
@@ -527,6 +558,20 @@ func (endpoint *Endpoint) AcknowledgePacketWithResult(packet channeltypes.Packet
 	return endpoint.Chain.SendMsgs(ackMsg)
 }
 
+// AcknowledgePackets sends a MsgAcknowledgements for a batch of packets to the channel associated with the endpoint.
+func (endpoint *Endpoint) AcknowledgePackets(packets []channeltypes.Packet, acks [][]byte) error {
+	// get compressed proof of acknowledgements on counterparty
+	packetKeys := make([][]byte, len(packets))
+	for i, packet := range packets {
+		packetKeys[i] = host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+	proof, proofHeight := endpoint.Counterparty.QueryCompressedProof(packetKeys...)
+
+	ackMsg := channeltypes.NewMsgAcknowledgements(packets, acks, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
 // TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	// get proof for timeout based on channel order
@@ -535,8 +580,10 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.UNORDERED_EXPIRING_RECEIPTS:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = endpoint.orderedAllowTimeoutProofKey(packet)
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
@@ -554,6 +601,20 @@ func (endpoint *Endpoint) TimeoutPacket(packet channeltypes.Packet) error {
 	return endpoint.Chain.sendMsgs(timeoutMsg)
 }
 
+// orderedAllowTimeoutProofKey returns the key of the proof that a packet sent on an ORDERED_ALLOW_TIMEOUT channel
+// has not been received. The key is the timeout receipt of the packet if the counterparty skipped it, and the
+// next sequence receive of the counterparty otherwise.
+func (endpoint *Endpoint) orderedAllowTimeoutProofKey(packet channeltypes.Packet) []byte {
+	nextSeqRecv, found := endpoint.Counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(endpoint.Counterparty.Chain.GetContext(), endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	if nextSeqRecv > packet.GetSequence() {
+		return host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	}
+
+	return host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+}
+
 // TimeoutOnClose sends a MsgTimeoutOnClose to the channel associated with the endpoint.
 func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	// get proof for timeout based on channel order
@@ -562,8 +623,10 @@ func (endpoint *Endpoint) TimeoutOnClose(packet channeltypes.Packet) error {
 	switch endpoint.ChannelConfig.Order {
 	case channeltypes.ORDERED:
 		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
-	case channeltypes.UNORDERED:
+	case channeltypes.UNORDERED, channeltypes.UNORDERED_EXPIRING_RECEIPTS:
 		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	case channeltypes.ORDERED_ALLOW_TIMEOUT:
+		packetKey = endpoint.orderedAllowTimeoutProofKey(packet)
 	default:
 		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
 	}
@@ -0,0 +1,233 @@
+package mock
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corestore "cosmossdk.io/core/store"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
+)
+
+// NFTStoreKey is the store key of the mock keeper of the non-fungible tokens.
+const NFTStoreKey = "mocknft"
+
+var (
+	_ nfttransfertypes.NFTKeeper = (*NFTKeeper)(nil)
+	_ nfttransfertypes.NFTClass  = (*NFTClass)(nil)
+	_ nfttransfertypes.NFT       = (*NFT)(nil)
+
+	nftClassPrefix = []byte("class/")
+	nftPrefix      = []byte("nft/")
+)
+
+// NFTClass is the non-fungible token class stored by the mock NFTKeeper.
+type NFTClass struct {
+	ID   string `json:"id"`
+	URI  string `json:"uri"`
+	Data string `json:"data"`
+}
+
+// GetID implements nfttransfertypes.NFTClass.
+func (c NFTClass) GetID() string { return c.ID }
+
+// GetURI implements nfttransfertypes.NFTClass.
+func (c NFTClass) GetURI() string { return c.URI }
+
+// GetData implements nfttransfertypes.NFTClass.
+func (c NFTClass) GetData() string { return c.Data }
+
+// NFT is the non-fungible token stored by the mock NFTKeeper.
+type NFT struct {
+	ClassID string `json:"class_id"`
+	ID      string `json:"id"`
+	URI     string `json:"uri"`
+	Data    string `json:"data"`
+	Owner   string `json:"owner"`
+}
+
+// GetClassID implements nfttransfertypes.NFT.
+func (n NFT) GetClassID() string { return n.ClassID }
+
+// GetID implements nfttransfertypes.NFT.
+func (n NFT) GetID() string { return n.ID }
+
+// GetURI implements nfttransfertypes.NFT.
+func (n NFT) GetURI() string { return n.URI }
+
+// GetData implements nfttransfertypes.NFT.
+func (n NFT) GetData() string { return n.Data }
+
+// NFTKeeper is a minimal store backed keeper of non-fungible token classes and tokens which is used
+// to test the nft-transfer application. It is not meant to be used outside of tests.
+type NFTKeeper struct {
+	storeService corestore.KVStoreService
+}
+
+// NewNFTKeeper creates a new mock NFTKeeper.
+func NewNFTKeeper(storeService corestore.KVStoreService) NFTKeeper {
+	return NFTKeeper{storeService: storeService}
+}
+
+// SaveClass stores a new class. An error is returned if the class already exists.
+func (k NFTKeeper) SaveClass(ctx context.Context, classID, classURI, classData string) error {
+	if _, found := k.GetClass(ctx, classID); found {
+		return fmt.Errorf("class %s already exists", classID)
+	}
+
+	k.set(ctx, nftClassKey(classID), NFTClass{ID: classID, URI: classURI, Data: classData})
+	return nil
+}
+
+// GetClass returns the class with the provided identifier.
+func (k NFTKeeper) GetClass(ctx context.Context, classID string) (nfttransfertypes.NFTClass, bool) {
+	var class NFTClass
+	if !k.get(ctx, nftClassKey(classID), &class) {
+		return nil, false
+	}
+
+	return class, true
+}
+
+// GetClasses returns all the classes.
+func (k NFTKeeper) GetClasses(ctx context.Context) []nfttransfertypes.NFTClass {
+	var classes []nfttransfertypes.NFTClass
+	k.iterate(ctx, nftClassPrefix, func(bz []byte) {
+		var class NFTClass
+		mustUnmarshalJSON(bz, &class)
+		classes = append(classes, class)
+	})
+
+	return classes
+}
+
+// Mint creates a new token of an existing class owned by the receiver. An error is returned
+// if the class does not exist or the token already exists.
+func (k NFTKeeper) Mint(ctx context.Context, classID, tokenID, tokenURI, tokenData string, receiver sdk.AccAddress) error {
+	if _, found := k.GetClass(ctx, classID); !found {
+		return fmt.Errorf("class %s not found", classID)
+	}
+
+	if _, found := k.GetNFT(ctx, classID, tokenID); found {
+		return fmt.Errorf("token %s of class %s already exists", tokenID, classID)
+	}
+
+	k.set(ctx, nftKey(classID, tokenID), NFT{ClassID: classID, ID: tokenID, URI: tokenURI, Data: tokenData, Owner: receiver.String()})
+	return nil
+}
+
+// Transfer sets the owner of an existing token to the receiver.
+func (k NFTKeeper) Transfer(ctx context.Context, classID, tokenID string, receiver sdk.AccAddress) error {
+	var nft NFT
+	if !k.get(ctx, nftKey(classID, tokenID), &nft) {
+		return fmt.Errorf("token %s of class %s not found", tokenID, classID)
+	}
+
+	nft.Owner = receiver.String()
+	k.set(ctx, nftKey(classID, tokenID), nft)
+	return nil
+}
+
+// Burn deletes an existing token.
+func (k NFTKeeper) Burn(ctx context.Context, classID, tokenID string) error {
+	if _, found := k.GetNFT(ctx, classID, tokenID); !found {
+		return fmt.Errorf("token %s of class %s not found", tokenID, classID)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Delete(nftKey(classID, tokenID)); err != nil {
+		panic(err)
+	}
+	return nil
+}
+
+// GetNFT returns the token of the class with the provided identifiers.
+func (k NFTKeeper) GetNFT(ctx context.Context, classID, tokenID string) (nfttransfertypes.NFT, bool) {
+	var nft NFT
+	if !k.get(ctx, nftKey(classID, tokenID), &nft) {
+		return nil, false
+	}
+
+	return nft, true
+}
+
+// GetNFTsOfClassByOwner returns the tokens of the class owned by the provided owner.
+func (k NFTKeeper) GetNFTsOfClassByOwner(ctx context.Context, classID string, owner sdk.AccAddress) []nfttransfertypes.NFT {
+	var nfts []nfttransfertypes.NFT
+	k.iterate(ctx, nftKey(classID, ""), func(bz []byte) {
+		var nft NFT
+		mustUnmarshalJSON(bz, &nft)
+		if nft.Owner == owner.String() {
+			nfts = append(nfts, nft)
+		}
+	})
+
+	return nfts
+}
+
+// GetOwner returns the owner of the token, or nil if the token does not exist.
+func (k NFTKeeper) GetOwner(ctx context.Context, classID, tokenID string) sdk.AccAddress {
+	var nft NFT
+	if !k.get(ctx, nftKey(classID, tokenID), &nft) {
+		return nil
+	}
+
+	return sdk.MustAccAddressFromBech32(nft.Owner)
+}
+
+func (k NFTKeeper) set(ctx context.Context, key []byte, value any) {
+	bz, err := json.Marshal(value)
+	if err != nil {
+		panic(err)
+	}
+
+	if err := k.storeService.OpenKVStore(ctx).Set(key, bz); err != nil {
+		panic(err)
+	}
+}
+
+func (k NFTKeeper) get(ctx context.Context, key []byte, value any) bool {
+	bz, err := k.storeService.OpenKVStore(ctx).Get(key)
+	if err != nil {
+		panic(err)
+	}
+	if len(bz) == 0 {
+		return false
+	}
+
+	mustUnmarshalJSON(bz, value)
+	return true
+}
+
+func (k NFTKeeper) iterate(ctx context.Context, prefix []byte, cb func(bz []byte)) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, prefix)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		cb(iterator.Value())
+	}
+}
+
+// nftClassKey returns the store key of a class.
+func nftClassKey(classID string) []byte {
+	return append(append([]byte{}, nftClassPrefix...), classID...)
+}
+
+// nftKey returns the store key of a token. The class identifier is terminated by a zero byte,
+// as class identifiers may contain slashes.
+func nftKey(classID, tokenID string) []byte {
+	key := append(append([]byte{}, nftPrefix...), classID...)
+	key = append(key, 0)
+	return append(key, tokenID...)
+}
+
+func mustUnmarshalJSON(bz []byte, value any) {
+	if err := json.Unmarshal(bz, value); err != nil {
+		panic(err)
+	}
+}
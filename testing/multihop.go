@@ -0,0 +1,358 @@
+package ibctesting
+
+import (
+	"fmt"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	clienttypes "github.com/cosmos/ibc-go/v9/modules/core/02-client/types"
+	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
+	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v9/modules/core/24-host"
+)
+
+// MultihopPath contains the paths connecting consecutive chains of a route and two multi-hop endpoints
+// representing the ends of a multi-hop channel opened over the connections of the paths. EndpointA
+// lives on the first chain of the route and EndpointB on the last chain of the route.
+type MultihopPath struct {
+	Paths     []*Path
+	EndpointA *MultihopEndpoint
+	EndpointB *MultihopEndpoint
+}
+
+// MultihopEndpoint is a channel endpoint of a multi-hop channel. Its hops are the endpoints of the paths
+// along the route, ordered from the chain of the endpoint to the chain of the counterparty endpoint.
+type MultihopEndpoint struct {
+	Chain         *TestChain
+	Counterparty  *MultihopEndpoint
+	ChannelID     string
+	ChannelConfig *ChannelConfig
+
+	hops []*Endpoint
+}
+
+// NewMultihopPath constructs a path between each pair of consecutive chains provided using the default
+// values for the endpoints, and a multi-hop endpoint on the first and the last chains. At least three
+// chains must be provided.
+func NewMultihopPath(chains ...*TestChain) *MultihopPath {
+	require.GreaterOrEqual(chains[0].TB, len(chains), 3, "a multi-hop path requires at least three chains")
+
+	paths := make([]*Path, len(chains)-1)
+	for i := range paths {
+		paths[i] = NewPath(chains[i], chains[i+1])
+	}
+
+	endpointA := &MultihopEndpoint{
+		Chain:         chains[0],
+		ChannelConfig: NewChannelConfig(),
+	}
+	endpointB := &MultihopEndpoint{
+		Chain:         chains[len(chains)-1],
+		ChannelConfig: NewChannelConfig(),
+	}
+
+	for i := range paths {
+		endpointA.hops = append(endpointA.hops, paths[i].EndpointA)
+		endpointB.hops = append(endpointB.hops, paths[len(paths)-1-i].EndpointB)
+	}
+
+	endpointA.Counterparty = endpointB
+	endpointB.Counterparty = endpointA
+
+	return &MultihopPath{
+		Paths:     paths,
+		EndpointA: endpointA,
+		EndpointB: endpointB,
+	}
+}
+
+// SetChannelOrdered sets the channel order for both endpoints to ORDERED.
+func (path *MultihopPath) SetChannelOrdered() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
+}
+
+// Setup constructs a TM client and a connection on both chains of each path, and a multi-hop
+// channel on the first and the last chains. It will fail if any error occurs.
+func (path *MultihopPath) Setup() {
+	path.SetupConnections()
+
+	path.CreateChannels()
+}
+
+// SetupConnections is a helper function to create clients and the appropriate connections
+// on both chains of each path. It assumes the caller does not anticipate any errors.
+func (path *MultihopPath) SetupConnections() {
+	for _, p := range path.Paths {
+		p.SetupConnections()
+	}
+}
+
+// CreateChannels constructs and executes channel handshake messages in order to create an
+// OPEN multi-hop channel on the first and the last chains. The function expects the channels
+// to be successfully opened otherwise testing will fail.
+func (path *MultihopPath) CreateChannels() {
+	err := path.EndpointA.ChanOpenInit()
+	if err != nil {
+		panic(err)
+	}
+
+	err = path.EndpointB.ChanOpenTry()
+	if err != nil {
+		panic(err)
+	}
+
+	err = path.EndpointA.ChanOpenAck()
+	if err != nil {
+		panic(err)
+	}
+
+	err = path.EndpointB.ChanOpenConfirm()
+	if err != nil {
+		panic(err)
+	}
+}
+
+// RelayPacket relays a packet sent on EndpointA to EndpointB and the acknowledgement written
+// on EndpointB back to EndpointA. An error is returned if a relay step fails.
+func (path *MultihopPath) RelayPacket(packet channeltypes.Packet) error {
+	res, err := path.EndpointB.RecvPacketWithResult(packet)
+	if err != nil {
+		return err
+	}
+
+	ack, err := ParseAckFromEvents(res.Events)
+	if err != nil {
+		return err
+	}
+
+	return path.EndpointA.AcknowledgePacket(packet, ack)
+}
+
+// ConnectionHops returns the connection hops of the channel of the endpoint.
+func (endpoint *MultihopEndpoint) ConnectionHops() []string {
+	connectionHops := make([]string, len(endpoint.hops))
+	for i, hop := range endpoint.hops {
+		connectionHops[i] = hop.ConnectionID
+	}
+
+	return connectionHops
+}
+
+// QueryMultihopProof updates the clients along the route, starting from the counterparty chain,
+// and returns the multi-hop proof of the provided key on the counterparty chain along with the
+// proof height, i.e. the latest height of the client of the first connection hop.
+func (endpoint *MultihopEndpoint) QueryMultihopProof(key []byte) ([]byte, clienttypes.Height) {
+	for i := len(endpoint.hops) - 1; i >= 0; i-- {
+		err := endpoint.hops[i].UpdateClient()
+		require.NoError(endpoint.Chain.TB, err)
+	}
+
+	return endpoint.QueryMultihopProofWithoutClientUpdates(key)
+}
+
+// QueryMultihopProofWithoutClientUpdates returns the multi-hop proof of the provided key on the counterparty
+// chain along with the proof height, without updating the clients along the route beforehand. The proofs
+// are queried at the latest heights of the clients along the route.
+func (endpoint *MultihopEndpoint) QueryMultihopProofWithoutClientUpdates(key []byte) ([]byte, clienttypes.Height) {
+	lastHop := endpoint.hops[len(endpoint.hops)-1]
+	keyProof, _ := lastHop.Counterparty.QueryProof(key)
+
+	hops := make([]connectiontypes.HopProof, len(endpoint.hops)-1)
+	for i := range hops {
+		// the connection, the client state and the consensus state of the next hop are proven on the chain of the next hop
+		hop := endpoint.hops[i+1]
+		consensusHeight, ok := hop.GetClientLatestHeight().(clienttypes.Height)
+		require.True(endpoint.Chain.TB, ok)
+
+		connectionProof, _ := endpoint.hops[i].Counterparty.QueryProof(host.ConnectionKey(hop.ConnectionID))
+		consensusProof, _ := endpoint.hops[i].Counterparty.QueryProof(host.FullConsensusStateKey(hop.ClientID, consensusHeight))
+		consensusState := clienttypes.MustMarshalConsensusState(endpoint.Chain.Codec, hop.GetConsensusState(consensusHeight))
+		clientStateProof, _ := endpoint.hops[i].Counterparty.QueryProof(host.FullClientStateKey(hop.ClientID))
+		clientState := clienttypes.MustMarshalClientState(endpoint.Chain.Codec, hop.GetClientState())
+
+		hops[i] = connectiontypes.NewHopProof(hop.GetConnection(), connectionProof, consensusHeight, consensusState, consensusProof, clientState, clientStateProof)
+	}
+
+	proof, err := endpoint.Chain.Codec.Marshal(&connectiontypes.MultihopProof{Hops: hops, KeyProof: keyProof})
+	require.NoError(endpoint.Chain.TB, err)
+
+	proofHeight, ok := endpoint.hops[0].GetClientLatestHeight().(clienttypes.Height)
+	require.True(endpoint.Chain.TB, ok)
+
+	return proof, proofHeight
+}
+
+// ChanOpenInit will construct and execute a MsgChannelOpenInit on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenInit() error {
+	endpoint.hops[0].IncrementNextChannelSequence()
+	msg := channeltypes.NewMsgChannelOpenInit(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	msg.Channel.ReceiptExpiryPeriod = endpoint.ChannelConfig.ReceiptExpiryPeriod
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	// update version to selected app version
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+	endpoint.Counterparty.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenTry will construct and execute a MsgChannelOpenTry on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenTry() error {
+	endpoint.hops[0].IncrementNextChannelSequence()
+
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenTry(
+		endpoint.ChannelConfig.PortID,
+		endpoint.ChannelConfig.Version, endpoint.ChannelConfig.Order, endpoint.ConnectionHops(),
+		endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	msg.Channel.ReceiptExpiryPeriod = endpoint.ChannelConfig.ReceiptExpiryPeriod
+	res, err := endpoint.Chain.SendMsgs(msg)
+	if err != nil {
+		return err
+	}
+
+	endpoint.ChannelID, err = ParseChannelIDFromEvents(res.Events)
+	require.NoError(endpoint.Chain.TB, err)
+
+	// update version to selected app version
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+	endpoint.Counterparty.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenAck will construct and execute a MsgChannelOpenAck on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenAck() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenAck(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		endpoint.Counterparty.ChannelID, endpoint.Counterparty.ChannelConfig.Version,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	if err := endpoint.Chain.sendMsgs(msg); err != nil {
+		return err
+	}
+
+	endpoint.ChannelConfig.Version = endpoint.GetChannel().Version
+
+	return nil
+}
+
+// ChanOpenConfirm will construct and execute a MsgChannelOpenConfirm on the associated endpoint.
+func (endpoint *MultihopEndpoint) ChanOpenConfirm() error {
+	channelKey := host.ChannelKey(endpoint.Counterparty.ChannelConfig.PortID, endpoint.Counterparty.ChannelID)
+	proof, height := endpoint.QueryMultihopProof(channelKey)
+
+	msg := channeltypes.NewMsgChannelOpenConfirm(
+		endpoint.ChannelConfig.PortID, endpoint.ChannelID,
+		proof, height,
+		endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+	return endpoint.Chain.sendMsgs(msg)
+}
+
+// SendPacket sends a packet through the channel keeper using the associated endpoint.
+// The packet sequence generated for the packet to be sent is returned. An error is
+// returned if one occurs.
+func (endpoint *MultihopEndpoint) SendPacket(
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	// no need to send message, acting as a module
+	sequence, err := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.SendPacket(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
+	}
+
+	// commit changes since no message was sent
+	endpoint.Chain.Coordinator.CommitBlock(endpoint.Chain)
+
+	return sequence, nil
+}
+
+// RecvPacket receives a packet on the associated endpoint.
+func (endpoint *MultihopEndpoint) RecvPacket(packet channeltypes.Packet) error {
+	_, err := endpoint.RecvPacketWithResult(packet)
+	return err
+}
+
+// RecvPacketWithResult receives a packet on the associated endpoint and the result
+// of the transaction is returned.
+func (endpoint *MultihopEndpoint) RecvPacketWithResult(packet channeltypes.Packet) (*abci.ExecTxResult, error) {
+	packetKey := host.PacketCommitmentKey(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	recvMsg := channeltypes.NewMsgRecvPacket(packet, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.SendMsgs(recvMsg)
+}
+
+// AcknowledgePacket sends a MsgAcknowledgement to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) AcknowledgePacket(packet channeltypes.Packet, ack []byte) error {
+	packetKey := host.PacketAcknowledgementKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+
+	ackMsg := channeltypes.NewMsgAcknowledgement(packet, ack, proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String())
+
+	return endpoint.Chain.sendMsgs(ackMsg)
+}
+
+// TimeoutPacket sends a MsgTimeout to the channel associated with the endpoint.
+func (endpoint *MultihopEndpoint) TimeoutPacket(packet channeltypes.Packet) error {
+	// get proof for timeout based on channel order
+	var packetKey []byte
+
+	switch endpoint.ChannelConfig.Order {
+	case channeltypes.ORDERED:
+		packetKey = host.NextSequenceRecvKey(packet.GetDestPort(), packet.GetDestChannel())
+	case channeltypes.UNORDERED, channeltypes.UNORDERED_EXPIRING_RECEIPTS:
+		packetKey = host.PacketReceiptKey(packet.GetDestPort(), packet.GetDestChannel(), packet.GetSequence())
+	default:
+		return fmt.Errorf("unsupported order type %s", endpoint.ChannelConfig.Order)
+	}
+
+	counterparty := endpoint.Counterparty
+	proof, proofHeight := endpoint.QueryMultihopProof(packetKey)
+	nextSeqRecv, found := counterparty.Chain.App.GetIBCKeeper().ChannelKeeper.GetNextSequenceRecv(counterparty.Chain.GetContext(), counterparty.ChannelConfig.PortID, counterparty.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	timeoutMsg := channeltypes.NewMsgTimeout(
+		packet, nextSeqRecv,
+		proof, proofHeight, endpoint.Chain.SenderAccount.GetAddress().String(),
+	)
+
+	return endpoint.Chain.sendMsgs(timeoutMsg)
+}
+
+// GetChannel retrieves the IBC Channel for the endpoint. The channel
+// is expected to exist otherwise testing will fail.
+func (endpoint *MultihopEndpoint) GetChannel() channeltypes.Channel {
+	channel, found := endpoint.Chain.App.GetIBCKeeper().ChannelKeeper.GetChannel(endpoint.Chain.GetContext(), endpoint.ChannelConfig.PortID, endpoint.ChannelID)
+	require.True(endpoint.Chain.TB, found)
+
+	return channel
+}
@@ -6,6 +6,8 @@ import (
 
 	abci "github.com/cometbft/cometbft/abci/types"
 
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
 	transfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	channeltypes "github.com/cosmos/ibc-go/v9/modules/core/04-channel/types"
 	ibcmock "github.com/cosmos/ibc-go/v9/testing/mock"
@@ -53,6 +55,30 @@ func NewTransferPath(chainA, chainB *TestChain) *Path {
 	return path
 }
 
+// NewNFTTransferPath constructs a new path between each chain suitable for use with
+// the nft-transfer module.
+func NewNFTTransferPath(chainA, chainB *TestChain) *Path {
+	path := NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = NFTTransferPort
+	path.EndpointB.ChannelConfig.PortID = NFTTransferPort
+	path.EndpointA.ChannelConfig.Version = nfttransfertypes.Version
+	path.EndpointB.ChannelConfig.Version = nfttransfertypes.Version
+
+	return path
+}
+
+// NewInterchainQueryPath constructs a new path between each chain suitable for use with
+// the interchain queries module. EndpointA is the controller chain and EndpointB is the host chain.
+func NewInterchainQueryPath(chainA, chainB *TestChain) *Path {
+	path := NewPath(chainA, chainB)
+	path.EndpointA.ChannelConfig.PortID = icqtypes.ControllerPortID
+	path.EndpointB.ChannelConfig.PortID = icqtypes.HostPortID
+	path.EndpointA.ChannelConfig.Version = icqtypes.Version
+	path.EndpointB.ChannelConfig.Version = icqtypes.Version
+
+	return path
+}
+
 // NewTransferPathWithFeeEnabled constructs a new path between each chain suitable for use with
 // the transfer module, and it enables fee on it.
 func NewTransferPathWithFeeEnabled(chainA, chainB *TestChain) *Path {
@@ -66,6 +92,18 @@ func (path *Path) SetChannelOrdered() {
 	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED
 }
 
+// SetChannelOrderedAllowTimeout sets the channel order for both endpoints to ORDERED_ALLOW_TIMEOUT.
+func (path *Path) SetChannelOrderedAllowTimeout() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+	path.EndpointB.ChannelConfig.Order = channeltypes.ORDERED_ALLOW_TIMEOUT
+}
+
+// SetChannelUnorderedExpiringReceipts sets the channel order for both endpoints to UNORDERED_EXPIRING_RECEIPTS.
+func (path *Path) SetChannelUnorderedExpiringReceipts() {
+	path.EndpointA.ChannelConfig.Order = channeltypes.UNORDERED_EXPIRING_RECEIPTS
+	path.EndpointB.ChannelConfig.Order = channeltypes.UNORDERED_EXPIRING_RECEIPTS
+}
+
 // DisableUniqueChannelIDs provides an opt-out way to not have all channel IDs be different
 // while testing.
 func (path *Path) DisableUniqueChannelIDs() *Path {
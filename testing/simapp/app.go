@@ -93,6 +93,17 @@ import (
 	ibcfee "github.com/cosmos/ibc-go/v9/modules/apps/29-fee"
 	ibcfeekeeper "github.com/cosmos/ibc-go/v9/modules/apps/29-fee/keeper"
 	ibcfeetypes "github.com/cosmos/ibc-go/v9/modules/apps/29-fee/types"
+	icq "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries"
+	icqcontroller "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller"
+	icqcontrollerkeeper "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/keeper"
+	icqcontrollertypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/controller/types"
+	icqhost "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host"
+	icqhostkeeper "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/keeper"
+	icqhosttypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/host/types"
+	icqtypes "github.com/cosmos/ibc-go/v9/modules/apps/31-interchain-queries/types"
+	nfttransfer "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer"
+	nfttransferkeeper "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/keeper"
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
 	"github.com/cosmos/ibc-go/v9/modules/apps/transfer"
 	ibctransferkeeper "github.com/cosmos/ibc-go/v9/modules/apps/transfer/keeper"
 	ibctransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
@@ -131,6 +142,7 @@ var (
 		ibcfeetypes.ModuleName:         nil,
 		icatypes.ModuleName:            nil,
 		ibcmock.ModuleName:             nil,
+		nfttransfertypes.ModuleName:    nil,
 	}
 )
 
@@ -165,7 +177,11 @@ type SimApp struct {
 	IBCFeeKeeper          ibcfeekeeper.Keeper
 	ICAControllerKeeper   icacontrollerkeeper.Keeper
 	ICAHostKeeper         icahostkeeper.Keeper
+	ICQControllerKeeper   icqcontrollerkeeper.Keeper
+	ICQHostKeeper         icqhostkeeper.Keeper
 	TransferKeeper        ibctransferkeeper.Keeper
+	NFTTransferKeeper     nfttransferkeeper.Keeper
+	MockNFTKeeper         ibcmock.NFTKeeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 
 	// make IBC modules public for test purposes
@@ -259,6 +275,8 @@ func NewSimApp(
 		govtypes.StoreKey, group.StoreKey, paramstypes.StoreKey, ibcexported.StoreKey, upgradetypes.StoreKey,
 		ibctransfertypes.StoreKey, icacontrollertypes.StoreKey, icahosttypes.StoreKey,
 		authzkeeper.StoreKey, ibcfeetypes.StoreKey, consensusparamtypes.StoreKey,
+		nfttransfertypes.StoreKey, ibcmock.NFTStoreKey,
+		icqcontrollertypes.StoreKey, icqhosttypes.StoreKey,
 	)
 
 	// register streaming services
@@ -384,9 +402,17 @@ func NewSimApp(
 		appCodec, runtime.NewKVStoreService(keys[ibctransfertypes.StoreKey]), app.GetSubspace(ibctransfertypes.ModuleName),
 		app.IBCFeeKeeper, // ISC4 Wrapper: fee IBC middleware
 		app.IBCKeeper.ChannelKeeper,
+		app.IBCKeeper.ClientKeeper,
 		app.AccountKeeper, app.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
+	// protocol fees without a fee recipient are sent to the community pool
+	app.TransferKeeper.WithDistributionKeeper(app.DistrKeeper)
+	// the memos of transfers are validated against the memo keys registered by the transfer application.
+	// NOTE: when the transfer stack is wrapped by middlewares interpreting the memo, such as the callbacks
+	// middleware, their keys must be registered as well, e.g. the callbacks SourceCallbackKey and
+	// DestinationCallbackKey with the CallbackMemoSchema.
+	app.TransferKeeper.WithMemoRegistry(ibctransfertypes.NewMemoRegistry(false))
 
 	// Mock Module Stack
 
@@ -426,6 +452,44 @@ func NewSimApp(
 	// Add transfer stack to IBC Router
 	ibcRouter.AddRoute(ibctransfertypes.ModuleName, transferStack)
 
+	// Create NFT Transfer Keeper, using a mock keeper of the non-fungible tokens
+	// NOTE: the mock keeper of the non-fungible tokens is used only for testing the nft-transfer application.
+	// Chains are expected to provide an adapter of their own keeper, such as the adapter of the x/nft keeper in simapp.
+	app.MockNFTKeeper = ibcmock.NewNFTKeeper(runtime.NewKVStoreService(keys[ibcmock.NFTStoreKey]))
+	app.NFTTransferKeeper = nfttransferkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[nfttransfertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: core IBC
+		app.IBCKeeper.ChannelKeeper,
+		app.AccountKeeper, app.MockNFTKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Add nft-transfer stack to IBC Router
+	ibcRouter.AddRoute(nfttransfertypes.ModuleName, nfttransfer.NewIBCModule(app.NFTTransferKeeper))
+
+	// Create Interchain Queries Keepers
+	// NOTE: chains are expected to register the callbacks of their querying modules on the controller callback router.
+	app.ICQControllerKeeper = icqcontrollerkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[icqcontrollertypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: core IBC
+		app.IBCKeeper.ChannelKeeper,
+		icqcontrollertypes.NewCallbackRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	app.ICQHostKeeper = icqhostkeeper.NewKeeper(
+		appCodec, runtime.NewKVStoreService(keys[icqhosttypes.StoreKey]),
+		app.IBCKeeper.ChannelKeeper, // ICS4 Wrapper: core IBC
+		app.IBCKeeper.ChannelKeeper,
+		app.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	// Add interchain queries controller & host to IBC Router
+	ibcRouter.
+		AddRoute(icqcontrollertypes.SubModuleName, icqcontroller.NewIBCModule(app.ICQControllerKeeper)).
+		AddRoute(icqhosttypes.SubModuleName, icqhost.NewIBCModule(app.ICQHostKeeper))
+
 	// Create Interchain Accounts Stack
 	// SendPacket, since it is originating from the application to core IBC:
 	// icaControllerKeeper.SendTx -> fee.SendPacket -> channel.SendPacket
@@ -506,8 +570,10 @@ func NewSimApp(
 		// IBC modules
 		ibc.NewAppModule(app.IBCKeeper),
 		transfer.NewAppModule(app.TransferKeeper),
+		nfttransfer.NewAppModule(app.NFTTransferKeeper, app.AccountKeeper, app.BankKeeper),
 		ibcfee.NewAppModule(app.IBCFeeKeeper),
 		ica.NewAppModule(&app.ICAControllerKeeper, &app.ICAHostKeeper),
+		icq.NewAppModule(&app.ICQControllerKeeper, &app.ICQHostKeeper),
 		mockModule,
 
 		// IBC light clients
@@ -574,7 +640,7 @@ func NewSimApp(
 		banktypes.ModuleName, distrtypes.ModuleName, stakingtypes.ModuleName,
 		slashingtypes.ModuleName, govtypes.ModuleName, minttypes.ModuleName,
 		ibcexported.ModuleName, genutiltypes.ModuleName, authz.ModuleName, ibctransfertypes.ModuleName,
-		icatypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
+		nfttransfertypes.ModuleName, icatypes.ModuleName, icqtypes.ModuleName, ibcfeetypes.ModuleName, ibcmock.ModuleName, paramstypes.ModuleName, upgradetypes.ModuleName,
 		vestingtypes.ModuleName, group.ModuleName, consensusparamtypes.ModuleName,
 	}
 	app.ModuleManager.SetOrderInitGenesis(genesisModuleOrder...)
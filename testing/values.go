@@ -13,6 +13,7 @@ import (
 
 	"github.com/cometbft/cometbft/crypto/tmhash"
 
+	nfttransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/nft-transfer/types"
 	ibctransfertypes "github.com/cosmos/ibc-go/v9/modules/apps/transfer/types"
 	connectiontypes "github.com/cosmos/ibc-go/v9/modules/core/03-connection/types"
 	commitmenttypes "github.com/cosmos/ibc-go/v9/modules/core/23-commitment/types"
@@ -37,9 +38,10 @@ const (
 	InvalidID             = "IDisInvalid"
 
 	// Application Ports
-	TransferPort = ibctransfertypes.ModuleName
-	MockPort     = mock.ModuleName
-	MockFeePort  = simapp.MockFeePort
+	TransferPort    = ibctransfertypes.ModuleName
+	NFTTransferPort = nfttransfertypes.ModuleName
+	MockPort        = mock.ModuleName
+	MockFeePort     = simapp.MockFeePort
 
 	// used for testing proposals
 	Title       = "title"